@@ -8,16 +8,27 @@ import (
 	"sync"
 	"time"
 
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/encryption"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/events"
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/controller"
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/middleware"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/router"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/notifications"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/file"
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/memory"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/token"
 	"github.com/api-sage/fcy-payment-processor/src/internal/config"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
 	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
 	"github.com/shopspring/decimal"
 )
 
+const acceptedTransferResumeLimit = 10000
+
 func main() {
 	startupStart := time.Now()
 	decimal.MarshalJSONWithoutQuotes = true
@@ -26,6 +37,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("load config: %v", err)
 	}
+	models.SetHomeBankCode(cfg.GreyBankCode)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -40,14 +52,23 @@ func main() {
 	}
 	defer db.Close()
 
+	piiKeyProvider, err := encryption.NewLocalKeyProvider(cfg.PIIEncryptionKeys, cfg.PIIEncryptionKeyVersion)
+	if err != nil {
+		log.Fatalf("create pii key provider: %v", err)
+	}
+	piiCipher, err := encryption.NewFieldCipher(piiKeyProvider, cfg.PIIBlindIndexKey)
+	if err != nil {
+		log.Fatalf("create pii cipher: %v", err)
+	}
+
 	// Initialize repositories in parallel
 	var wg sync.WaitGroup
-	wg.Add(5)
+	wg.Add(21)
 
 	var userRepoImpl *implementations.UserRepository
 	go func() {
 		defer wg.Done()
-		userRepoImpl = implementations.NewUserRepository(db)
+		userRepoImpl = implementations.NewUserRepository(db, piiCipher)
 	}()
 
 	var accountRepoImpl *implementations.AccountRepository
@@ -74,35 +95,203 @@ func main() {
 		transientAccountRepoImpl = implementations.NewTransientAccountRepository(db)
 	}()
 
+	var webhookRepoImpl *implementations.WebhookRepository
+	go func() {
+		defer wg.Done()
+		webhookRepoImpl = implementations.NewWebhookRepository(db)
+	}()
+
+	var outboxRepoImpl *implementations.OutboxRepository
+	go func() {
+		defer wg.Done()
+		outboxRepoImpl = implementations.NewOutboxRepository(db)
+	}()
+
+	var screeningRepoImpl *implementations.ScreeningRepository
+	go func() {
+		defer wg.Done()
+		screeningRepoImpl = implementations.NewScreeningRepository(db)
+	}()
+
+	var sanctionsScreeningRepoImpl *implementations.SanctionsScreeningRepository
+	go func() {
+		defer wg.Done()
+		sanctionsScreeningRepoImpl = implementations.NewSanctionsScreeningRepository(db)
+	}()
+
+	var transferReviewRepoImpl *implementations.TransferReviewRepository
+	go func() {
+		defer wg.Done()
+		transferReviewRepoImpl = implementations.NewTransferReviewRepository(db)
+	}()
+
+	var accountHoldRepoImpl *implementations.AccountHoldRepository
+	go func() {
+		defer wg.Done()
+		accountHoldRepoImpl = implementations.NewAccountHoldRepository(db)
+	}()
+
+	var withdrawalRepoImpl *implementations.WithdrawalRepository
+	go func() {
+		defer wg.Done()
+		withdrawalRepoImpl = implementations.NewWithdrawalRepository(db)
+	}()
+
+	var exchangeRepoImpl *implementations.ExchangeRepository
+	go func() {
+		defer wg.Done()
+		exchangeRepoImpl = implementations.NewExchangeRepository(db)
+	}()
+
+	var interestRepoImpl *implementations.InterestRepository
+	go func() {
+		defer wg.Done()
+		interestRepoImpl = implementations.NewInterestRepository(db)
+	}()
+
+	var overdraftRepoImpl *implementations.OverdraftRepository
+	go func() {
+		defer wg.Done()
+		overdraftRepoImpl = implementations.NewOverdraftRepository(db)
+	}()
+
+	var dormancyRepoImpl *implementations.DormancyRepository
+	go func() {
+		defer wg.Done()
+		dormancyRepoImpl = implementations.NewDormancyRepository(db)
+	}()
+
+	var otpRepoImpl *implementations.OTPRepository
+	go func() {
+		defer wg.Done()
+		otpRepoImpl = implementations.NewOTPRepository(db)
+	}()
+
+	var kycRepoImpl *implementations.KYCRepository
+	go func() {
+		defer wg.Done()
+		kycRepoImpl = implementations.NewKYCRepository(db)
+	}()
+
+	var duplicateRepoImpl *implementations.CustomerDuplicateRepository
+	go func() {
+		defer wg.Done()
+		duplicateRepoImpl = implementations.NewCustomerDuplicateRepository(db, piiCipher)
+	}()
+
+	var customerDataRepoImpl *implementations.CustomerDataRepository
+	go func() {
+		defer wg.Done()
+		customerDataRepoImpl = implementations.NewCustomerDataRepository(db)
+	}()
+
+	var channelRepoImpl *implementations.ChannelRepository
+	go func() {
+		defer wg.Done()
+		channelRepoImpl = implementations.NewChannelRepository(db)
+	}()
+
 	wg.Wait()
 
+	// Customers written in plaintext or under a retired key are re-encrypted
+	// before serving, so every blind index lookup sees the current keys.
+	piiKeyRotationService := services.NewPIIKeyRotationService(userRepoImpl)
+	if _, err := piiKeyRotationService.ReencryptStale(context.Background()); err != nil {
+		log.Fatalf("re-encrypt customer pii: %v", err)
+	}
+	go piiKeyRotationService.StartWorker(context.Background(), cfg.PIIReencryptionInterval)
+
 	participantBankRepo := memory.NewParticipantBankRepository()
 
 	// Ensure default rates before creating services
 	if err := rateRepoImpl.EnsureDefaultRates(ctx); err != nil {
 		log.Fatalf("ensure default rates: %v", err)
 	}
+	if err := transientAccountRepoImpl.EnsureCurrencyGLAccounts(ctx, "Cash", cfg.CashGLAccountNumbers); err != nil {
+		log.Fatalf("ensure cash gl accounts: %v", err)
+	}
+	if err := transientAccountRepoImpl.EnsureCurrencyGLAccounts(ctx, "Funding", cfg.FundingGLAccountNumbers); err != nil {
+		log.Fatalf("ensure funding gl accounts: %v", err)
+	}
+	if err := transientAccountRepoImpl.EnsureCurrencyGLAccounts(ctx, "FX Income", cfg.FXIncomeGLAccountNumbers); err != nil {
+		log.Fatalf("ensure fx income gl accounts: %v", err)
+	}
+	if err := transientAccountRepoImpl.EnsureCurrencyGLAccounts(ctx, "Interest Expense", cfg.InterestExpenseGLAccountNumbers); err != nil {
+		log.Fatalf("ensure interest expense gl accounts: %v", err)
+	}
+	if err := transientAccountRepoImpl.EnsureCurrencyGLAccounts(ctx, "Withholding Tax", cfg.WithholdingTaxGLAccountNumbers); err != nil {
+		log.Fatalf("ensure withholding tax gl accounts: %v", err)
+	}
+
+	// Account numbers issued before check digits keep working in requests.
+	legacyAccountNumbers, err := accountRepoImpl.GetLegacyAccountNumbers(ctx, cfg.GreyBankCode)
+	if err != nil {
+		log.Fatalf("load legacy account numbers: %v", err)
+	}
+	models.SetLegacyAccountNumbers(legacyAccountNumbers)
+
+	webhookService := services.NewWebhookService(
+		webhookRepoImpl,
+		cfg.WebhookMaxAttempts,
+		cfg.WebhookRetryBackoff,
+		cfg.WebhookRequestTimeout,
+	)
+	webhookController := controller.NewWebhookController(webhookService)
+	go webhookService.StartDispatcher(context.Background(), cfg.WebhookDispatchInterval)
+
+	eventPublisher, closePublisher, err := newEventPublisher(cfg)
+	if err != nil {
+		log.Fatalf("create event publisher: %v", err)
+	}
+	defer closePublisher()
+	outboxRelayService := services.NewOutboxRelayService(outboxRepoImpl, eventPublisher, cfg.OutboxBatchSize, cfg.OutboxRetryBackoff)
+	outboxRelayService.EnableWebhooks(webhookService)
+	go outboxRelayService.Start(context.Background(), cfg.OutboxRelayInterval)
+
+	// Sanctions screening is enabled only when list files are configured.
+	var sanctionsScreener service_interfaces.SanctionsScreener
+	if len(cfg.SanctionsListFiles) > 0 {
+		sanctionsScreener = services.NewSanctionsService(
+			file.NewSanctionsListRepository(cfg.SanctionsListFiles...),
+			sanctionsScreeningRepoImpl,
+			cfg.SanctionsMatchThreshold,
+			cfg.SanctionsRefresh,
+		)
+	}
+
+	accountHoldService := services.NewAccountHoldService(accountHoldRepoImpl, accountRepoImpl, cfg.InternalTransientAccountNumber)
+	accountHoldController := controller.NewAccountHoldController(accountHoldService)
+	go accountHoldService.StartExpiryWorker(context.Background(), cfg.AccountHoldExpiryInterval)
+
+	referenceGenerator, err := services.NewReferenceGenerator(cfg.ReferenceNodeID, cfg.GreyBankCode, cfg.ExternalReferenceScheme, cfg.ReferenceChannelPrefixes)
+	if err != nil {
+		log.Fatalf("create reference generator: %v", err)
+	}
+
+	pinPolicy := domain.PinPolicy{
+		MaxFailedAttempts: cfg.PinMaxFailedAttempts,
+		LockDuration:      cfg.PinLockDuration,
+	}
+
+	otpSender, closeOTPSender, err := newOTPSender(cfg)
+	if err != nil {
+		log.Fatalf("create otp sender: %v", err)
+	}
+	defer closeOTPSender()
+	otpService := services.NewOTPService(otpRepoImpl, userRepoImpl, otpSender, cfg.OTPTTL, cfg.OTPMaxAttempts)
 
 	// Initialize services and controllers in parallel where possible
 	var wg2 sync.WaitGroup
-	wg2.Add(6)
+	wg2.Add(5)
 
 	var userService *services.UserService
 	var userController *controller.UserController
 	go func() {
 		defer wg2.Done()
-		userService = services.NewUserService(userRepoImpl)
+		userService = services.NewUserService(userRepoImpl, sanctionsScreener, pinPolicy)
 		userController = controller.NewUserController(userService)
 	}()
 
-	var accountService *services.AccountService
-	var accountController *controller.AccountController
-	go func() {
-		defer wg2.Done()
-		accountService = services.NewAccountService(accountRepoImpl, userRepoImpl, participantBankRepo, cfg.GreyBankCode)
-		accountController = controller.NewAccountController(accountService)
-	}()
-
 	var participantBankService *services.ParticipantBankService
 	var participantBankController *controller.ParticipantBankController
 	go func() {
@@ -129,11 +318,18 @@ func main() {
 			cfg.VATPercent,
 			cfg.ChargeMinAmount,
 			cfg.ChargeMaxAmount,
+			cfg.WithdrawalChargePercent,
+			cfg.WithdrawalChargeMinAmount,
+			cfg.WithdrawalChargeMaxAmount,
+			cfg.ExchangeChargePercent,
+			cfg.ExchangeChargeMinAmount,
+			cfg.ExchangeChargeMaxAmount,
 		)
 		chargesController = controller.NewChargesController(chargesService)
 	}()
 
 	var transferController *controller.TransferController
+	var transferReviewController *controller.TransferReviewController
 	go func() {
 		defer wg2.Done()
 		// Ensure transient accounts are set up first
@@ -150,6 +346,18 @@ func main() {
 			log.Fatalf("ensure transient accounts: %v", err)
 		}
 		transientAccountTransactionRepo := implementations.NewTransientAccountTransactionRepository(db)
+		var screeningRuleSource repo_interfaces.ScreeningRuleSource = screeningRepoImpl
+		if cfg.ScreeningRulesSource == "file" {
+			screeningRuleSource = file.NewScreeningRuleRepository(cfg.ScreeningRulesFile)
+		}
+		screeningService := services.NewScreeningService(
+			screeningRuleSource,
+			screeningRepoImpl,
+			rateService,
+			cfg.ScreeningRulesRefresh,
+			cfg.ScreeningReviewScore,
+			cfg.ScreeningBlockScore,
+		)
 		transferService := services.NewTransferService(
 			transferRepoImpl,
 			accountRepoImpl,
@@ -160,6 +368,10 @@ func main() {
 			userService,
 			rateService,
 			chargesService,
+			screeningService,
+			sanctionsScreener,
+			transferReviewRepoImpl,
+			referenceGenerator,
 			cfg.GreyBankCode,
 			cfg.InternalTransientAccountNumber,
 			cfg.InternalChargesAccountNumber,
@@ -169,12 +381,136 @@ func main() {
 			cfg.ExternalEURGLAccountNumber,
 			cfg.ExternalNGNGLAccountNumber,
 		)
+		transferService.EnableStepUp(otpService, domain.TransferStepUpPolicy{
+			Currency:  cfg.TransferStepUpCurrency,
+			Threshold: cfg.TransferStepUpThreshold,
+		})
+		transferService.EnableKYCLimits(userRepoImpl, domain.TransferLimitPolicy{
+			Currency:    cfg.KYCTransferLimitCurrency,
+			PerTransfer: cfg.KYCTransferLimits,
+		})
+		if cfg.TransferProcessingMode == "async" {
+			transferWorkerPool := services.NewTransferWorkerPool(transferService.ProcessAcceptedTransfer, cfg.TransferWorkers, cfg.TransferQueueSize)
+			transferWorkerPool.Start(context.Background())
+
+			// Resume transfers that were accepted but not picked up before the last
+			// shutdown. They are queued before new transfers can be dispatched so
+			// each account's recovered transfers run first.
+			accepted, err := transferService.GetAcceptedTransfers(ctx, acceptedTransferResumeLimit)
+			if err != nil {
+				log.Fatalf("load accepted transfers: %v", err)
+			}
+			transferWorkerPool.Requeue(context.Background(), accepted)
+			transferService.EnableAsyncProcessing(transferWorkerPool)
+		}
 		transferController = controller.NewTransferController(transferService)
+		transferReviewController = controller.NewTransferReviewController(transferService)
 	}()
 
 	wg2.Wait()
 
-	mux := router.New(accountController, userController, participantBankController, rateController, chargesController, transferController, middleware.BasicAuth(cfg.ChannelID, cfg.ChannelKey))
+	// New customers are matched against existing ones before they are stored.
+	userService.EnableDuplicateDetection(duplicateRepoImpl, cfg.DuplicateNameMatchThreshold)
+
+	// Withdrawals verify PINs and price fees through the user and charges services.
+	accountService := services.NewAccountService(
+		accountRepoImpl,
+		userRepoImpl,
+		participantBankRepo,
+		rateRepoImpl,
+		withdrawalRepoImpl,
+		userService,
+		chargesService,
+		referenceGenerator,
+		cfg.GreyBankCode,
+		cfg.InternalTransientAccountNumber,
+		cfg.InternalChargesAccountNumber,
+		cfg.InternalVATAccountNumber,
+		cfg.CashGLAccountNumbers,
+		cfg.FundingGLAccountNumbers,
+	)
+	accountService.EnableKYCLimits(userRepoImpl, rateService, domain.TransferLimitPolicy{
+		Currency:    cfg.KYCTransferLimitCurrency,
+		PerTransfer: cfg.KYCTransferLimits,
+	})
+	accountService.EnableStepUp(otpService, rateService, domain.TransferStepUpPolicy{
+		Currency:  cfg.TransferStepUpCurrency,
+		Threshold: cfg.TransferStepUpThreshold,
+	})
+	accountController := controller.NewAccountController(accountService)
+
+	exchangeService := services.NewExchangeService(
+		accountRepoImpl,
+		rateRepoImpl,
+		exchangeRepoImpl,
+		userService,
+		chargesService,
+		referenceGenerator,
+		cfg.ExchangeSpreadPercent,
+		cfg.InternalTransientAccountNumber,
+		cfg.InternalChargesAccountNumber,
+		cfg.InternalVATAccountNumber,
+		cfg.FXIncomeGLAccountNumbers,
+	)
+	exchangeController := controller.NewExchangeController(exchangeService)
+
+	portfolioService := services.NewPortfolioService(userRepoImpl, accountRepoImpl, accountHoldRepoImpl, rateService)
+	portfolioController := controller.NewPortfolioController(portfolioService)
+
+	interestService := services.NewInterestService(
+		interestRepoImpl,
+		referenceGenerator,
+		cfg.WithholdingTaxPercent,
+		cfg.InterestExpenseGLAccountNumbers,
+		cfg.WithholdingTaxGLAccountNumbers,
+	)
+	interestController := controller.NewInterestController(interestService)
+	go interestService.StartBatchRunner(context.Background(), cfg.InterestBatchInterval)
+
+	overdraftService := services.NewOverdraftService(overdraftRepoImpl)
+	overdraftController := controller.NewOverdraftController(overdraftService)
+	go overdraftService.StartWorker(context.Background(), cfg.OverdraftWorkerInterval)
+
+	dormancyService := services.NewDormancyService(dormancyRepoImpl, accountRepoImpl, userRepoImpl, cfg.DormancyPeriodDays)
+	dormancyController := controller.NewDormancyController(dormancyService)
+	go dormancyService.StartWorker(context.Background(), cfg.DormancyWorkerInterval)
+
+	pinService := services.NewPinService(userRepoImpl, userService, otpService, pinPolicy)
+	pinController := controller.NewPinController(pinService)
+
+	kycService := services.NewKYCService(kycRepoImpl, userRepoImpl)
+	kycController := controller.NewKYCController(kycService)
+
+	customerDuplicateService := services.NewCustomerDuplicateService(duplicateRepoImpl)
+	customerDuplicateController := controller.NewCustomerDuplicateController(customerDuplicateService)
+
+	customerDataService := services.NewCustomerDataService(customerDataRepoImpl, userRepoImpl, accountRepoImpl, kycRepoImpl)
+	customerDataController := controller.NewCustomerDataController(customerDataService)
+
+	// CHANNEL_ID/CHANNEL_KEY seed a channel with every scope when it does not
+	// exist yet; after that its stored keys are authoritative.
+	channelService := services.NewChannelService(channelRepoImpl, cfg.ChannelKeyGracePeriod)
+	if err := channelService.Bootstrap(context.Background(), cfg.ChannelID, cfg.ChannelKey); err != nil {
+		log.Fatalf("bootstrap channel: %v", err)
+	}
+	channelController := controller.NewChannelController(channelService)
+
+	// With JWT_JWKS_FILE set, account routes also need a customer token (or a
+	// back-office service token) naming the owner of the account.
+	authMiddleware := middleware.ChannelAuth(channelService)
+	if cfg.JWTJWKSFile != "" {
+		jwks, err := token.LoadJWKSFile(cfg.JWTJWKSFile)
+		if err != nil {
+			log.Fatalf("load jwks: %v", err)
+		}
+		channelAuth := authMiddleware
+		customerAuth := middleware.CustomerAuth(token.NewVerifier(jwks, cfg.JWTIssuer, cfg.JWTAudience, string(domain.ChannelScopeBackOffice)))
+		authMiddleware = func(next http.Handler) http.Handler {
+			return channelAuth(customerAuth(next))
+		}
+	}
+
+	mux := router.New(accountController, accountHoldController, userController, participantBankController, rateController, chargesController, transferController, transferReviewController, exchangeController, portfolioController, interestController, overdraftController, dormancyController, pinController, kycController, customerDuplicateController, customerDataController, channelController, webhookController, authMiddleware)
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -194,3 +530,35 @@ func main() {
 		log.Fatalf("start http server: %v", err)
 	}
 }
+
+// newEventPublisher builds the outbox publisher selected by EVENT_PUBLISHER.
+func newEventPublisher(cfg config.Config) (service_interfaces.EventPublisher, func(), error) {
+	switch cfg.EventPublisher {
+	case "memory":
+		return events.NewMemoryPublisher(), func() {}, nil
+	case "kafka":
+		return events.NewKafkaPublisher(cfg.KafkaRESTProxyURL, cfg.KafkaTopicPrefix, cfg.KafkaTimeout), func() {}, nil
+	default:
+		if cfg.EventLogFile == "" {
+			return events.NewLogPublisher(os.Stdout), func() {}, nil
+		}
+		file, err := os.OpenFile(cfg.EventLogFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+		return events.NewLogPublisher(file), func() { _ = file.Close() }, nil
+	}
+}
+
+// newOTPSender builds the OTP notifier: codes are written to OTP_LOG_FILE when
+// set, otherwise to stdout.
+func newOTPSender(cfg config.Config) (service_interfaces.OTPSender, func(), error) {
+	if cfg.OTPLogFile == "" {
+		return notifications.NewLogOTPSender(os.Stdout), func() {}, nil
+	}
+	file, err := os.OpenFile(cfg.OTPLogFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, nil, err
+	}
+	return notifications.NewLogOTPSender(file), func() { _ = file.Close() }, nil
+}
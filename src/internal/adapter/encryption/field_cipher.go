@@ -0,0 +1,122 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrMalformedCiphertext is returned for stored values not produced by
+// FieldCipher.Encrypt.
+var ErrMalformedCiphertext = errors.New("malformed field ciphertext")
+
+const fieldCiphertextPrefix = "v"
+
+// FieldCipher encrypts single column values with envelope encryption: every
+// value gets a fresh AES-256-GCM data key, which is wrapped by the provider's
+// current KEK and stored alongside it as "v<version>.<wrapped key>.<ciphertext>".
+// Rotating the KEK therefore only needs values re-encrypted, never a schema
+// change.
+//
+// Encrypted columns cannot be searched, so FieldCipher also derives blind
+// indexes: keyed HMAC-SHA256 digests that compare equal exactly when the
+// plaintexts do. The index key is separate from the KEKs and is not rotated.
+type FieldCipher struct {
+	provider KeyProvider
+	indexKey []byte
+}
+
+func NewFieldCipher(provider KeyProvider, indexKey []byte) (*FieldCipher, error) {
+	if provider == nil {
+		return nil, errors.New("key provider is required")
+	}
+	if len(indexKey) < 32 {
+		return nil, fmt.Errorf("blind index key must be at least 32 bytes, got %d", len(indexKey))
+	}
+	return &FieldCipher{provider: provider, indexKey: indexKey}, nil
+}
+
+// CurrentVersion is the KEK version new values are encrypted with.
+func (c *FieldCipher) CurrentVersion() int {
+	return c.provider.CurrentVersion()
+}
+
+func (c *FieldCipher) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("generate data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(aead, []byte(plaintext))
+	if err != nil {
+		return "", fmt.Errorf("encrypt field: %w", err)
+	}
+	version, wrapped, err := c.provider.WrapKey(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	return fieldCiphertextPrefix + strconv.Itoa(version) + "." +
+		base64.RawStdEncoding.EncodeToString(wrapped) + "." +
+		base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *FieldCipher) Decrypt(ciphertext string) (string, error) {
+	version, wrapped, sealed, err := parseFieldCiphertext(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := c.provider.UnwrapKey(version, wrapped)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(aead, sealed)
+	if err != nil {
+		return "", fmt.Errorf("decrypt field: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// BlindIndex returns the hex HMAC of value under the index key. field keeps
+// the indexes of different columns apart, so equal values in two columns do
+// not produce equal digests.
+func (c *FieldCipher) BlindIndex(field string, value string) string {
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(field))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func parseFieldCiphertext(ciphertext string) (int, []byte, []byte, error) {
+	parts := strings.Split(ciphertext, ".")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], fieldCiphertextPrefix) {
+		return 0, nil, nil, ErrMalformedCiphertext
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[0], fieldCiphertextPrefix))
+	if err != nil {
+		return 0, nil, nil, ErrMalformedCiphertext
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return 0, nil, nil, ErrMalformedCiphertext
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return 0, nil, nil, ErrMalformedCiphertext
+	}
+	return version, wrapped, sealed, nil
+}
@@ -0,0 +1,102 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownKeyVersion is returned when a value was wrapped with a key the
+// provider does not hold.
+var ErrUnknownKeyVersion = errors.New("unknown key encryption key version")
+
+// KeyProvider wraps and unwraps data keys with versioned key-encryption keys
+// (KEKs). Values are always wrapped with the current version; older versions
+// stay available for unwrapping until every value has been re-encrypted.
+type KeyProvider interface {
+	CurrentVersion() int
+	WrapKey(dataKey []byte) (version int, wrapped []byte, err error)
+	UnwrapKey(version int, wrapped []byte) ([]byte, error)
+}
+
+// LocalKeyProvider holds the KEKs in process memory, loaded from configuration.
+type LocalKeyProvider struct {
+	keys    map[int]cipher.AEAD
+	current int
+}
+
+// NewLocalKeyProvider builds a provider from 32-byte AES-256 keys by version.
+// current must be one of the versions.
+func NewLocalKeyProvider(keys map[int][]byte, current int) (*LocalKeyProvider, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("current key version %d is not configured", current)
+	}
+
+	aeads := make(map[int]cipher.AEAD, len(keys))
+	for version, key := range keys {
+		if version < 1 {
+			return nil, fmt.Errorf("key version %d must be positive", version)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("key version %d: %w", version, err)
+		}
+		aeads[version] = aead
+	}
+
+	return &LocalKeyProvider{keys: aeads, current: current}, nil
+}
+
+func (p *LocalKeyProvider) CurrentVersion() int {
+	return p.current
+}
+
+func (p *LocalKeyProvider) WrapKey(dataKey []byte) (int, []byte, error) {
+	wrapped, err := seal(p.keys[p.current], dataKey)
+	if err != nil {
+		return 0, nil, fmt.Errorf("wrap data key: %w", err)
+	}
+	return p.current, wrapped, nil
+}
+
+func (p *LocalKeyProvider) UnwrapKey(version int, wrapped []byte) ([]byte, error) {
+	aead, ok := p.keys[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownKeyVersion, version)
+	}
+	dataKey, err := open(aead, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce and returns nonce||ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+const (
+	kafkaRESTContentType = "application/vnd.kafka.json.v2+json"
+	kafkaRESTAccept      = "application/vnd.kafka.v2+json"
+)
+
+// KafkaPublisher produces events through a Kafka REST Proxy (v2 API). Records are
+// keyed by aggregate ID so every event of an aggregate lands on the same
+// partition and keeps its order. Topics are named <topicPrefix>.<aggregateType>.
+type KafkaPublisher struct {
+	baseURL     string
+	topicPrefix string
+	client      *http.Client
+}
+
+func NewKafkaPublisher(baseURL string, topicPrefix string, timeout time.Duration) *KafkaPublisher {
+	return &KafkaPublisher{
+		baseURL:     strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		topicPrefix: strings.TrimSpace(topicPrefix),
+		client:      &http.Client{Timeout: timeout},
+	}
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string               `json:"key"`
+	Value domain.EventEnvelope `json:"value"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	body, err := json.Marshal(kafkaProduceRequest{
+		Records: []kafkaRecord{{Key: event.AggregateID, Value: event.Envelope()}},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal kafka record: %w", err)
+	}
+
+	endpoint := p.baseURL + "/topics/" + url.PathEscape(p.Topic(event))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build kafka produce request: %w", err)
+	}
+	req.Header.Set("Content-Type", kafkaRESTContentType)
+	req.Header.Set("Accept", kafkaRESTAccept)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send kafka produce request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("kafka produce returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var produced kafkaProduceResponse
+	if err := json.Unmarshal(respBody, &produced); err != nil {
+		return fmt.Errorf("decode kafka produce response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("kafka produce failed with code %d: %s", *offset.ErrorCode, offset.Error)
+		}
+	}
+
+	return nil
+}
+
+func (p *KafkaPublisher) Topic(event domain.OutboxEvent) string {
+	if p.topicPrefix == "" {
+		return string(event.AggregateType)
+	}
+	return p.topicPrefix + "." + string(event.AggregateType)
+}
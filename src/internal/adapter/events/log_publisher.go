@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// LogPublisher writes each event envelope as one JSON line to the given writer,
+// typically stdout or an append-only file.
+type LogPublisher struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func NewLogPublisher(writer io.Writer) *LogPublisher {
+	return &LogPublisher{writer: writer}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, event domain.OutboxEvent) error {
+	line, err := json.Marshal(event.Envelope())
+	if err != nil {
+		return fmt.Errorf("marshal event envelope: %w", err)
+	}
+	line = append(line, '\n')
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.writer.Write(line); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// MemoryPublisher keeps published events in process. It is meant for tests and
+// local runs where no broker is available.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []domain.EventEnvelope
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, event domain.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event.Envelope())
+	return nil
+}
+
+// Events returns a copy of everything published so far, in publish order.
+func (p *MemoryPublisher) Events() []domain.EventEnvelope {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]domain.EventEnvelope, len(p.events))
+	copy(out, p.events)
+	return out
+}
@@ -13,9 +13,17 @@ import (
 )
 
 const (
-	createAccountPath = "/create-account"
-	getAccountPath    = "/get-account"
-	depositFundsPath  = "/deposit-funds"
+	createAccountPath     = "/create-account"
+	getAccountPath        = "/get-account"
+	depositFundsPath      = "/deposit-funds"
+	getDepositPath        = "/get-deposit"
+	withdrawFundsPath     = "/withdraw-funds"
+	confirmWithdrawalPath = "/confirm-withdrawal"
+
+	freezeAccountPath           = "/freeze-account"
+	unfreezeAccountPath         = "/unfreeze-account"
+	closeAccountPath            = "/close-account"
+	getAccountStatusHistoryPath = "/get-account-status-history"
 )
 
 type AccountController struct {
@@ -30,16 +38,37 @@ func (c *AccountController) RegisterRoutes(mux *http.ServeMux, authMiddleware fu
 	var createAccountHandler http.Handler = http.HandlerFunc(c.createAccount)
 	var getAccountHandler http.Handler = http.HandlerFunc(c.getAccount)
 	var depositFundsHandler http.Handler = http.HandlerFunc(c.depositFunds)
+	var getDepositHandler http.Handler = http.HandlerFunc(c.getDeposit)
+	var withdrawFundsHandler http.Handler = http.HandlerFunc(c.withdrawFunds)
+	var confirmWithdrawalHandler http.Handler = http.HandlerFunc(c.confirmWithdrawal)
+	var freezeAccountHandler http.Handler = http.HandlerFunc(c.freezeAccount)
+	var unfreezeAccountHandler http.Handler = http.HandlerFunc(c.unfreezeAccount)
+	var closeAccountHandler http.Handler = http.HandlerFunc(c.closeAccount)
+	var getAccountStatusHistoryHandler http.Handler = http.HandlerFunc(c.getAccountStatusHistory)
 
 	if authMiddleware != nil {
 		createAccountHandler = authMiddleware(createAccountHandler)
 		getAccountHandler = authMiddleware(getAccountHandler)
 		depositFundsHandler = authMiddleware(depositFundsHandler)
+		getDepositHandler = authMiddleware(getDepositHandler)
+		withdrawFundsHandler = authMiddleware(withdrawFundsHandler)
+		confirmWithdrawalHandler = authMiddleware(confirmWithdrawalHandler)
+		freezeAccountHandler = authMiddleware(freezeAccountHandler)
+		unfreezeAccountHandler = authMiddleware(unfreezeAccountHandler)
+		closeAccountHandler = authMiddleware(closeAccountHandler)
+		getAccountStatusHistoryHandler = authMiddleware(getAccountStatusHistoryHandler)
 	}
 
 	mux.Handle(createAccountPath, createAccountHandler)
 	mux.Handle(getAccountPath, getAccountHandler)
 	mux.Handle(depositFundsPath, depositFundsHandler)
+	mux.Handle(getDepositPath, getDepositHandler)
+	mux.Handle(withdrawFundsPath, withdrawFundsHandler)
+	mux.Handle(confirmWithdrawalPath, confirmWithdrawalHandler)
+	mux.Handle(freezeAccountPath, freezeAccountHandler)
+	mux.Handle(unfreezeAccountPath, unfreezeAccountHandler)
+	mux.Handle(closeAccountPath, closeAccountHandler)
+	mux.Handle(getAccountStatusHistoryPath, getAccountStatusHistoryHandler)
 }
 
 func (c *AccountController) createAccount(w http.ResponseWriter, r *http.Request) {
@@ -148,13 +177,54 @@ func (c *AccountController) depositFunds(w http.ResponseWriter, r *http.Request)
 	c.respondSuccess(w, http.StatusOK, response, r, start)
 }
 
+func (c *AccountController) getDeposit(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.DepositResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	req := models.GetDepositRequest{
+		Reference:         strings.TrimSpace(r.URL.Query().Get("reference")),
+		Source:            strings.TrimSpace(r.URL.Query().Get("source")),
+		ExternalReference: strings.TrimSpace(r.URL.Query().Get("externalReference")),
+	}
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.DepositResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.GetDeposit(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
 // mapResponseToStatus maps response messages to appropriate HTTP status codes
 func mapResponseToStatus(message string) int {
 	switch message {
-	case "validation failed":
+	case "validation failed", "invalid otp":
 		return http.StatusBadRequest
-	case "Account not found":
+	case "Account not found", "Customer not found", "Sweep account not found", "Deposit not found":
 		return http.StatusNotFound
+	case "Account is not active", "Account status does not allow this change", "Account balance must be zero", "Account has active holds", "Frozen account balance cannot be swept", "Duplicate external reference":
+		return http.StatusConflict
+	case "Account does not belong to the authenticated customer":
+		return http.StatusForbidden
+	case "Insufficient balance", "Transfer limit exceeded":
+		return http.StatusUnprocessableEntity
+	case "pin locked":
+		return http.StatusLocked
 	default:
 		return http.StatusInternalServerError
 	}
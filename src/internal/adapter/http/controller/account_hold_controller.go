@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	placeAccountHoldPath   = "/place-account-hold"
+	releaseAccountHoldPath = "/release-account-hold"
+	captureAccountHoldPath = "/capture-account-hold"
+	getAccountHoldsPath    = "/get-account-holds"
+)
+
+type AccountHoldController struct {
+	service service_interfaces.AccountHoldService
+}
+
+func NewAccountHoldController(service service_interfaces.AccountHoldService) *AccountHoldController {
+	return &AccountHoldController{service: service}
+}
+
+func (c *AccountHoldController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var placeHandler http.Handler = http.HandlerFunc(c.placeHold)
+	var releaseHandler http.Handler = http.HandlerFunc(c.releaseHold)
+	var captureHandler http.Handler = http.HandlerFunc(c.captureHold)
+	var getHoldsHandler http.Handler = http.HandlerFunc(c.getHolds)
+
+	if authMiddleware != nil {
+		placeHandler = authMiddleware(placeHandler)
+		releaseHandler = authMiddleware(releaseHandler)
+		captureHandler = authMiddleware(captureHandler)
+		getHoldsHandler = authMiddleware(getHoldsHandler)
+	}
+
+	mux.Handle(placeAccountHoldPath, placeHandler)
+	mux.Handle(releaseAccountHoldPath, releaseHandler)
+	mux.Handle(captureAccountHoldPath, captureHandler)
+	mux.Handle(getAccountHoldsPath, getHoldsHandler)
+}
+
+func (c *AccountHoldController) placeHold(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.AccountHoldResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.PlaceAccountHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountHoldResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.PlaceHold(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapAccountHoldResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusCreated, response, r, start)
+}
+
+func (c *AccountHoldController) releaseHold(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.AccountHoldResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.ReleaseAccountHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountHoldResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.ReleaseHold(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapAccountHoldResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *AccountHoldController) captureHold(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.AccountHoldResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.CaptureAccountHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountHoldResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.CaptureHold(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapAccountHoldResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *AccountHoldController) getHolds(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.AccountHoldResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	accountNumber := strings.TrimSpace(r.URL.Query().Get("accountNumber"))
+	if accountNumber == "" {
+		response := commons.ErrorResponse[[]models.AccountHoldResponse]("validation failed", "accountNumber is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"accountNumber": accountNumber})
+	response, err := c.service.GetHolds(r.Context(), accountNumber)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapAccountHoldResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapAccountHoldResponseToStatus maps account hold response messages to appropriate HTTP status codes
+func mapAccountHoldResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Account does not belong to the authenticated customer", "Back-office access required":
+		return http.StatusForbidden
+	case "Account not found", "Active hold not found":
+		return http.StatusNotFound
+	case "Hold reference already exists", "Account is not active", "Account hold has expired":
+		return http.StatusConflict
+	case "Insufficient balance":
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *AccountHoldController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *AccountHoldController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+func (c *AccountController) freezeAccount(w http.ResponseWriter, r *http.Request) {
+	c.changeAccountStatus(w, r, c.service.FreezeAccount)
+}
+
+func (c *AccountController) unfreezeAccount(w http.ResponseWriter, r *http.Request) {
+	c.changeAccountStatus(w, r, c.service.UnfreezeAccount)
+}
+
+func (c *AccountController) changeAccountStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	changeFn func(ctx context.Context, req models.AccountStatusChangeRequest) (commons.Response[models.AccountStatusChangeResponse], error),
+) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.AccountStatusChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := changeFn(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *AccountController) closeAccount(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.CloseAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.CloseAccount(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *AccountController) getAccountStatusHistory(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.AccountStatusEventResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	accountNumber := strings.TrimSpace(r.URL.Query().Get("accountNumber"))
+	if accountNumber == "" {
+		response := commons.ErrorResponse[[]models.AccountStatusEventResponse]("validation failed", "accountNumber is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"accountNumber": accountNumber})
+	response, err := c.service.GetAccountStatusHistory(r.Context(), accountNumber)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+func (c *AccountController) withdrawFunds(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.WithdrawFundsResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.WithdrawFundsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WithdrawFundsResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WithdrawFundsResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.WithdrawFunds(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, withdrawalSuccessStatus(response), response, r, start)
+}
+
+func (c *AccountController) confirmWithdrawal(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.WithdrawFundsResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.ConfirmWithdrawalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WithdrawFundsResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WithdrawFundsResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.ConfirmWithdrawal(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// withdrawalSuccessStatus answers a withdrawal waiting for its step-up code
+// with 202 Accepted.
+func withdrawalSuccessStatus(response commons.Response[models.WithdrawFundsResponse]) int {
+	if response.Data != nil && response.Data.StepUpChallenge != nil {
+		return http.StatusAccepted
+	}
+	return http.StatusOK
+}
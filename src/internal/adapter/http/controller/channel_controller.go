@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	createChannelPath    = "/create-channel"
+	getChannelPath       = "/get-channel"
+	getChannelsPath      = "/get-channels"
+	updateChannelPath    = "/update-channel"
+	rotateChannelKeyPath = "/rotate-channel-key"
+)
+
+type ChannelController struct {
+	service service_interfaces.ChannelService
+}
+
+func NewChannelController(service service_interfaces.ChannelService) *ChannelController {
+	return &ChannelController{service: service}
+}
+
+func (c *ChannelController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var createHandler http.Handler = http.HandlerFunc(c.createChannel)
+	var getHandler http.Handler = http.HandlerFunc(c.getChannel)
+	var getAllHandler http.Handler = http.HandlerFunc(c.getChannels)
+	var updateHandler http.Handler = http.HandlerFunc(c.updateChannel)
+	var rotateHandler http.Handler = http.HandlerFunc(c.rotateChannelKey)
+
+	if authMiddleware != nil {
+		createHandler = authMiddleware(createHandler)
+		getHandler = authMiddleware(getHandler)
+		getAllHandler = authMiddleware(getAllHandler)
+		updateHandler = authMiddleware(updateHandler)
+		rotateHandler = authMiddleware(rotateHandler)
+	}
+
+	mux.Handle(createChannelPath, createHandler)
+	mux.Handle(getChannelPath, getHandler)
+	mux.Handle(getChannelsPath, getAllHandler)
+	mux.Handle(updateChannelPath, updateHandler)
+	mux.Handle(rotateChannelKeyPath, rotateHandler)
+}
+
+func (c *ChannelController) createChannel(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.ChannelResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.CreateChannelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ChannelResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.CreateChannel(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapChannelResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusCreated, response, r, start)
+}
+
+func (c *ChannelController) getChannel(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.ChannelResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	channelID := strings.TrimSpace(r.URL.Query().Get("channelId"))
+	if channelID == "" {
+		response := commons.ErrorResponse[models.ChannelResponse]("validation failed", "channelId is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"channelId": channelID})
+	response, err := c.service.GetChannel(r.Context(), channelID)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapChannelResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *ChannelController) getChannels(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.ChannelResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	logRequest(r, nil)
+	response, err := c.service.GetChannels(r.Context())
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapChannelResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *ChannelController) updateChannel(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.ChannelResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.UpdateChannelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ChannelResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.UpdateChannel(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapChannelResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *ChannelController) rotateChannelKey(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.ChannelResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.RotateChannelKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ChannelResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.RotateChannelKey(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapChannelResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapChannelResponseToStatus maps channel response messages to appropriate HTTP status codes
+func mapChannelResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Channel not found":
+		return http.StatusNotFound
+	case "Channel already exists":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *ChannelController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *ChannelController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
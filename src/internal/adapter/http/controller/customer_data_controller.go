@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	exportCustomerDataPath = "/export-customer-data"
+	eraseCustomerDataPath  = "/erase-customer-data"
+)
+
+type CustomerDataController struct {
+	service service_interfaces.CustomerDataService
+}
+
+func NewCustomerDataController(service service_interfaces.CustomerDataService) *CustomerDataController {
+	return &CustomerDataController{service: service}
+}
+
+func (c *CustomerDataController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var exportHandler http.Handler = http.HandlerFunc(c.exportCustomerData)
+	var eraseHandler http.Handler = http.HandlerFunc(c.eraseCustomerData)
+
+	if authMiddleware != nil {
+		exportHandler = authMiddleware(exportHandler)
+		eraseHandler = authMiddleware(eraseHandler)
+	}
+
+	mux.Handle(exportCustomerDataPath, exportHandler)
+	mux.Handle(eraseCustomerDataPath, eraseHandler)
+}
+
+func (c *CustomerDataController) exportCustomerData(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.CustomerDataExportResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.CustomerDataRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.CustomerDataExportResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.CustomerDataExportResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.ExportCustomerData(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapCustomerDataResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	// The export carries the customer's personal data, so only a summary is logged.
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "customer-"+response.Data.CustomerID+"-export.json"))
+	if err := writeJSON(w, http.StatusOK, response); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, http.StatusOK, logger.Fields{
+		"requestId": response.Data.RequestID,
+		"accounts":  len(response.Data.Accounts),
+		"transfers": len(response.Data.Transfers),
+	}, start)
+}
+
+func (c *CustomerDataController) eraseCustomerData(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.CustomerErasureResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.CustomerDataRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.CustomerErasureResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.CustomerErasureResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.EraseCustomerData(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapCustomerDataResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapCustomerDataResponseToStatus maps data subject request response messages to appropriate HTTP status codes
+func mapCustomerDataResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "User not found":
+		return http.StatusNotFound
+	case "Customer has open accounts", "Customer data already erased":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *CustomerDataController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *CustomerDataController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	getDuplicateCandidatesPath    = "/get-duplicate-candidates"
+	dismissDuplicateCandidatePath = "/dismiss-duplicate-candidate"
+	mergeCustomersPath            = "/merge-customers"
+)
+
+type CustomerDuplicateController struct {
+	service service_interfaces.CustomerDuplicateService
+}
+
+func NewCustomerDuplicateController(service service_interfaces.CustomerDuplicateService) *CustomerDuplicateController {
+	return &CustomerDuplicateController{service: service}
+}
+
+func (c *CustomerDuplicateController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var getHandler http.Handler = http.HandlerFunc(c.getCandidates)
+	var dismissHandler http.Handler = http.HandlerFunc(c.dismissCandidate)
+	var mergeHandler http.Handler = http.HandlerFunc(c.mergeCustomers)
+
+	if authMiddleware != nil {
+		getHandler = authMiddleware(getHandler)
+		dismissHandler = authMiddleware(dismissHandler)
+		mergeHandler = authMiddleware(mergeHandler)
+	}
+
+	mux.Handle(getDuplicateCandidatesPath, getHandler)
+	mux.Handle(dismissDuplicateCandidatePath, dismissHandler)
+	mux.Handle(mergeCustomersPath, mergeHandler)
+}
+
+func (c *CustomerDuplicateController) getCandidates(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.DuplicateCandidateResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	query := r.URL.Query()
+	req := models.GetDuplicateCandidatesRequest{
+		CustomerID: strings.TrimSpace(query.Get("customerId")),
+		Status:     strings.TrimSpace(query.Get("status")),
+	}
+	if rawLimit := strings.TrimSpace(query.Get("limit")); rawLimit != "" {
+		parsed, err := strconv.Atoi(rawLimit)
+		if err != nil || parsed <= 0 {
+			response := commons.ErrorResponse[[]models.DuplicateCandidateResponse]("validation failed", "limit must be a positive integer")
+			c.respondError(w, http.StatusBadRequest, response, r, start)
+			return
+		}
+		req.Limit = parsed
+	}
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[[]models.DuplicateCandidateResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.GetDuplicateCandidates(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapCustomerDuplicateResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *CustomerDuplicateController) dismissCandidate(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.DuplicateCandidateResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.DismissDuplicateCandidateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.DuplicateCandidateResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.DuplicateCandidateResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.DismissDuplicateCandidate(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapCustomerDuplicateResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *CustomerDuplicateController) mergeCustomers(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.CustomerMergeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.MergeCustomersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.CustomerMergeResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.CustomerMergeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.MergeCustomers(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapCustomerDuplicateResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapCustomerDuplicateResponseToStatus maps duplicate review response messages to appropriate HTTP status codes
+func mapCustomerDuplicateResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Duplicate candidate not found":
+		return http.StatusNotFound
+	case "Duplicate candidate is not open", "Customers hold accounts in the same currency", "Only ACTIVE customers can be merged":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *CustomerDuplicateController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *CustomerDuplicateController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	reactivateAccountPath = "/reactivate-account"
+	getDormancyReportPath = "/get-dormancy-report"
+)
+
+type DormancyController struct {
+	service service_interfaces.DormancyService
+}
+
+func NewDormancyController(service service_interfaces.DormancyService) *DormancyController {
+	return &DormancyController{service: service}
+}
+
+func (c *DormancyController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var reactivateHandler http.Handler = http.HandlerFunc(c.reactivateAccount)
+	var getReportHandler http.Handler = http.HandlerFunc(c.getDormancyReport)
+
+	if authMiddleware != nil {
+		reactivateHandler = authMiddleware(reactivateHandler)
+		getReportHandler = authMiddleware(getReportHandler)
+	}
+
+	mux.Handle(reactivateAccountPath, reactivateHandler)
+	mux.Handle(getDormancyReportPath, getReportHandler)
+}
+
+func (c *DormancyController) reactivateAccount(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.ReactivateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.ReactivateAccount(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapDormancyResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *DormancyController) getDormancyReport(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.DormancyReportResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	logRequest(r, nil)
+	response, err := c.service.GetDormancyReport(r.Context())
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		c.respondError(w, http.StatusInternalServerError, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapDormancyResponseToStatus maps dormancy response messages to appropriate HTTP status codes
+func mapDormancyResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "KYC verification failed":
+		return http.StatusForbidden
+	case "Account not found", "Customer not found":
+		return http.StatusNotFound
+	case "Account status does not allow this change":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *DormancyController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *DormancyController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const exchangePath = "/exchange"
+
+type ExchangeController struct {
+	service service_interfaces.ExchangeService
+}
+
+func NewExchangeController(service service_interfaces.ExchangeService) *ExchangeController {
+	return &ExchangeController{service: service}
+}
+
+func (c *ExchangeController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var exchangeHandler http.Handler = http.HandlerFunc(c.exchange)
+
+	if authMiddleware != nil {
+		exchangeHandler = authMiddleware(exchangeHandler)
+	}
+
+	mux.Handle(exchangePath, exchangeHandler)
+}
+
+func (c *ExchangeController) exchange(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.ExchangeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.ExchangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ExchangeResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.ExchangeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.Exchange(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapExchangeResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapExchangeResponseToStatus maps exchange response messages to appropriate HTTP status codes
+func mapExchangeResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Accounts belong to different customers", "Account does not belong to the authenticated customer":
+		return http.StatusForbidden
+	case "Account not found", "Source account not found", "Destination account not found", "Rate not found":
+		return http.StatusNotFound
+	case "Account is not active":
+		return http.StatusConflict
+	case "Insufficient balance":
+		return http.StatusUnprocessableEntity
+	case "pin locked":
+		return http.StatusLocked
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *ExchangeController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *ExchangeController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	runInterestBatchPath     = "/run-interest-batch"
+	getInterestRateTiersPath = "/get-interest-rate-tiers"
+)
+
+type InterestController struct {
+	service service_interfaces.InterestService
+}
+
+func NewInterestController(service service_interfaces.InterestService) *InterestController {
+	return &InterestController{service: service}
+}
+
+func (c *InterestController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var runBatchHandler http.Handler = http.HandlerFunc(c.runBatch)
+	var getRateTiersHandler http.Handler = http.HandlerFunc(c.getRateTiers)
+
+	if authMiddleware != nil {
+		runBatchHandler = authMiddleware(runBatchHandler)
+		getRateTiersHandler = authMiddleware(getRateTiersHandler)
+	}
+
+	mux.Handle(runInterestBatchPath, runBatchHandler)
+	mux.Handle(getInterestRateTiersPath, getRateTiersHandler)
+}
+
+func (c *InterestController) runBatch(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.InterestBatchResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.RunInterestBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.InterestBatchResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.InterestBatchResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.RunBatch(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapInterestResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *InterestController) getRateTiers(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.InterestRateTierResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	logRequest(r, nil)
+	response, err := c.service.GetRateTiers(r.Context())
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		c.respondError(w, http.StatusInternalServerError, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapInterestResponseToStatus maps interest response messages to appropriate HTTP status codes
+func mapInterestResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *InterestController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *InterestController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
@@ -0,0 +1,207 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	submitKYCUpgradePath  = "/submit-kyc-upgrade"
+	getKYCUpgradesPath    = "/get-kyc-upgrades"
+	approveKYCUpgradePath = "/approve-kyc-upgrade"
+	rejectKYCUpgradePath  = "/reject-kyc-upgrade"
+)
+
+type KYCController struct {
+	service service_interfaces.KYCService
+}
+
+func NewKYCController(service service_interfaces.KYCService) *KYCController {
+	return &KYCController{service: service}
+}
+
+func (c *KYCController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var submitHandler http.Handler = http.HandlerFunc(c.submitUpgrade)
+	var getHandler http.Handler = http.HandlerFunc(c.getUpgrades)
+	var approveHandler http.Handler = http.HandlerFunc(c.approveUpgrade)
+	var rejectHandler http.Handler = http.HandlerFunc(c.rejectUpgrade)
+
+	if authMiddleware != nil {
+		submitHandler = authMiddleware(submitHandler)
+		getHandler = authMiddleware(getHandler)
+		approveHandler = authMiddleware(approveHandler)
+		rejectHandler = authMiddleware(rejectHandler)
+	}
+
+	mux.Handle(submitKYCUpgradePath, submitHandler)
+	mux.Handle(getKYCUpgradesPath, getHandler)
+	mux.Handle(approveKYCUpgradePath, approveHandler)
+	mux.Handle(rejectKYCUpgradePath, rejectHandler)
+}
+
+func (c *KYCController) submitUpgrade(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.KYCUpgradeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.SubmitKYCUpgradeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.KYCUpgradeResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.SubmitKYCUpgrade(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapKYCResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusCreated, response, r, start)
+}
+
+func (c *KYCController) getUpgrades(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.KYCUpgradeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	query := r.URL.Query()
+	req := models.GetKYCUpgradesRequest{
+		CustomerID: strings.TrimSpace(query.Get("customerId")),
+		Status:     strings.TrimSpace(query.Get("status")),
+	}
+	if rawLimit := strings.TrimSpace(query.Get("limit")); rawLimit != "" {
+		parsed, err := strconv.Atoi(rawLimit)
+		if err != nil || parsed <= 0 {
+			response := commons.ErrorResponse[[]models.KYCUpgradeResponse]("validation failed", "limit must be a positive integer")
+			c.respondError(w, http.StatusBadRequest, response, r, start)
+			return
+		}
+		req.Limit = parsed
+	}
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[[]models.KYCUpgradeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.GetKYCUpgrades(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapKYCResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *KYCController) approveUpgrade(w http.ResponseWriter, r *http.Request) {
+	c.decideUpgrade(w, r, c.service.ApproveKYCUpgrade)
+}
+
+func (c *KYCController) rejectUpgrade(w http.ResponseWriter, r *http.Request) {
+	c.decideUpgrade(w, r, c.service.RejectKYCUpgrade)
+}
+
+func (c *KYCController) decideUpgrade(
+	w http.ResponseWriter,
+	r *http.Request,
+	decide func(ctx context.Context, req models.KYCUpgradeDecisionRequest) (commons.Response[models.KYCUpgradeResponse], error),
+) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.KYCUpgradeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.KYCUpgradeDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.KYCUpgradeResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := decide(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapKYCResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapKYCResponseToStatus maps KYC response messages to appropriate HTTP status codes
+func mapKYCResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "User not found", "KYC upgrade not found":
+		return http.StatusNotFound
+	case "Reviewer must differ from initiator":
+		return http.StatusForbidden
+	case "KYC upgrade already pending", "KYC upgrade is not pending":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *KYCController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *KYCController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
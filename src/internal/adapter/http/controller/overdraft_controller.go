@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	approveOverdraftPath     = "/approve-overdraft"
+	getOverdraftPath         = "/get-overdraft"
+	getOverdraftBreachesPath = "/get-overdraft-breaches"
+)
+
+type OverdraftController struct {
+	service service_interfaces.OverdraftService
+}
+
+func NewOverdraftController(service service_interfaces.OverdraftService) *OverdraftController {
+	return &OverdraftController{service: service}
+}
+
+func (c *OverdraftController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var approveHandler http.Handler = http.HandlerFunc(c.approveOverdraft)
+	var getHandler http.Handler = http.HandlerFunc(c.getOverdraft)
+	var getBreachesHandler http.Handler = http.HandlerFunc(c.getBreaches)
+
+	if authMiddleware != nil {
+		approveHandler = authMiddleware(approveHandler)
+		getHandler = authMiddleware(getHandler)
+		getBreachesHandler = authMiddleware(getBreachesHandler)
+	}
+
+	mux.Handle(approveOverdraftPath, approveHandler)
+	mux.Handle(getOverdraftPath, getHandler)
+	mux.Handle(getOverdraftBreachesPath, getBreachesHandler)
+}
+
+func (c *OverdraftController) approveOverdraft(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.OverdraftFacilityResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.ApproveOverdraftRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.OverdraftFacilityResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.OverdraftFacilityResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.ApproveOverdraft(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapOverdraftResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *OverdraftController) getOverdraft(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.OverdraftFacilityResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	req := models.GetOverdraftRequest{
+		AccountNumber: strings.TrimSpace(r.URL.Query().Get("accountNumber")),
+	}
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.OverdraftFacilityResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.GetOverdraft(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapOverdraftResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *OverdraftController) getBreaches(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.OverdraftBreachResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	logRequest(r, nil)
+	response, err := c.service.GetLimitBreaches(r.Context())
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		c.respondError(w, http.StatusInternalServerError, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapOverdraftResponseToStatus maps overdraft response messages to appropriate HTTP status codes
+func mapOverdraftResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Account not found", "Overdraft not found":
+		return http.StatusNotFound
+	case "Account is not active":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *OverdraftController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *OverdraftController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
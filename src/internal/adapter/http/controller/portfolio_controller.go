@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const customerAccountsPath = "/customers/{customerId}/accounts"
+
+type PortfolioController struct {
+	service service_interfaces.PortfolioService
+}
+
+func NewPortfolioController(service service_interfaces.PortfolioService) *PortfolioController {
+	return &PortfolioController{service: service}
+}
+
+func (c *PortfolioController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var customerAccountsHandler http.Handler = http.HandlerFunc(c.getCustomerAccounts)
+
+	if authMiddleware != nil {
+		customerAccountsHandler = authMiddleware(customerAccountsHandler)
+	}
+
+	mux.Handle(customerAccountsPath, customerAccountsHandler)
+}
+
+func (c *PortfolioController) getCustomerAccounts(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.CustomerAccountsResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	req := models.GetCustomerAccountsRequest{
+		CustomerID:   strings.TrimSpace(r.PathValue("customerId")),
+		BaseCurrency: strings.TrimSpace(r.URL.Query().Get("baseCurrency")),
+	}
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.CustomerAccountsResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.GetCustomerAccounts(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapPortfolioResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+// mapPortfolioResponseToStatus maps portfolio response messages to appropriate HTTP status codes
+func mapPortfolioResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Account does not belong to the authenticated customer":
+		return http.StatusForbidden
+	case "Customer not found", "Rate not found":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *PortfolioController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *PortfolioController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
@@ -3,16 +3,20 @@ package controller
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
 	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
 	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
 	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
 )
 
 const (
-	transferFundsPath = "/transfer-funds"
+	transferFundsPath   = "/transfer-funds"
+	confirmTransferPath = "/confirm-transfer"
+	getTransferPath     = "/get-transfer"
 )
 
 type TransferController struct {
@@ -25,11 +29,17 @@ func NewTransferController(service service_interfaces.TransferService) *Transfer
 
 func (c *TransferController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
 	var handler http.Handler = http.HandlerFunc(c.transfer)
+	var confirmHandler http.Handler = http.HandlerFunc(c.confirmTransfer)
+	var getTransferHandler http.Handler = http.HandlerFunc(c.getTransfer)
 	if authMiddleware != nil {
 		handler = authMiddleware(handler)
+		confirmHandler = authMiddleware(confirmHandler)
+		getTransferHandler = authMiddleware(getTransferHandler)
 	}
 
 	mux.Handle(transferFundsPath, handler)
+	mux.Handle(confirmTransferPath, confirmHandler)
+	mux.Handle(getTransferPath, getTransferHandler)
 }
 
 func (c *TransferController) transfer(w http.ResponseWriter, r *http.Request) {
@@ -65,18 +75,102 @@ func (c *TransferController) transfer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	c.respondSuccess(w, transferSuccessStatus(response), response, r, start)
+}
+
+func (c *TransferController) confirmTransfer(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.InternalTransferResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.ConfirmTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.InternalTransferResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.ConfirmTransfer(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapTransferResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, transferSuccessStatus(response), response, r, start)
+}
+
+func (c *TransferController) getTransfer(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.InternalTransferResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	transactionReference := strings.TrimSpace(r.URL.Query().Get("transactionReference"))
+	if transactionReference == "" {
+		response := commons.ErrorResponse[models.InternalTransferResponse]("validation failed", "transactionReference is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"transactionReference": transactionReference})
+	response, err := c.service.GetTransfer(r.Context(), transactionReference)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapTransferResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
 	c.respondSuccess(w, http.StatusOK, response, r, start)
 }
 
+// transferSuccessStatus answers 202 for transfers that are not final yet:
+// queued, held for review or waiting for a step-up OTP.
+func transferSuccessStatus(response commons.Response[models.InternalTransferResponse]) int {
+	if response.Data == nil {
+		return http.StatusOK
+	}
+	switch domain.TransferStatus(response.Data.Status) {
+	case domain.TransferStatusAccepted, domain.TransferStatusHeld, domain.TransferStatusOTPRequired:
+		return http.StatusAccepted
+	default:
+		return http.StatusOK
+	}
+}
+
 // mapTransferResponseToStatus maps transfer response messages to appropriate HTTP status codes
 func mapTransferResponseToStatus(message string) int {
 	switch message {
-	case "validation failed":
+	case "validation failed", "invalid otp":
 		return http.StatusBadRequest
-	case "Debit account not found", "Credit account not found", "Rate not found":
+	case "Debit account not found", "Credit account not found", "Rate not found", "Transfer not found":
 		return http.StatusNotFound
-	case "Insufficient balance":
+	case "Insufficient balance", "Transfer limit exceeded":
 		return http.StatusUnprocessableEntity
+	case "Transfer blocked by screening", "Account does not belong to the authenticated customer":
+		return http.StatusForbidden
+	case "Transfer queue is full":
+		return http.StatusServiceUnavailable
+	case "pin locked":
+		return http.StatusLocked
 	default:
 		return http.StatusInternalServerError
 	}
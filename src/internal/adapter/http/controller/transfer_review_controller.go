@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	getHeldTransfersPath    = "/get-held-transfers"
+	getTransferReviewPath   = "/get-transfer-review"
+	approveHeldTransferPath = "/approve-held-transfer"
+	rejectHeldTransferPath  = "/reject-held-transfer"
+)
+
+type TransferReviewController struct {
+	service service_interfaces.TransferReviewService
+}
+
+func NewTransferReviewController(service service_interfaces.TransferReviewService) *TransferReviewController {
+	return &TransferReviewController{service: service}
+}
+
+func (c *TransferReviewController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var getHeldTransfersHandler http.Handler = http.HandlerFunc(c.getHeldTransfers)
+	var getTransferReviewHandler http.Handler = http.HandlerFunc(c.getTransferReview)
+	var approveHandler http.Handler = http.HandlerFunc(c.approve)
+	var rejectHandler http.Handler = http.HandlerFunc(c.reject)
+
+	if authMiddleware != nil {
+		getHeldTransfersHandler = authMiddleware(getHeldTransfersHandler)
+		getTransferReviewHandler = authMiddleware(getTransferReviewHandler)
+		approveHandler = authMiddleware(approveHandler)
+		rejectHandler = authMiddleware(rejectHandler)
+	}
+
+	mux.Handle(getHeldTransfersPath, getHeldTransfersHandler)
+	mux.Handle(getTransferReviewPath, getTransferReviewHandler)
+	mux.Handle(approveHeldTransferPath, approveHandler)
+	mux.Handle(rejectHeldTransferPath, rejectHandler)
+}
+
+func (c *TransferReviewController) getHeldTransfers(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.TransferReviewResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	limit := 0
+	if rawLimit := strings.TrimSpace(r.URL.Query().Get("limit")); rawLimit != "" {
+		parsed, err := strconv.Atoi(rawLimit)
+		if err != nil || parsed <= 0 {
+			response := commons.ErrorResponse[[]models.TransferReviewResponse]("validation failed", "limit must be a positive integer")
+			c.respondError(w, http.StatusBadRequest, response, r, start)
+			return
+		}
+		limit = parsed
+	}
+
+	logRequest(r, map[string]int{"limit": limit})
+	response, err := c.service.GetHeldTransfers(r.Context(), limit)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapTransferReviewResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *TransferReviewController) getTransferReview(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.TransferReviewResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	transactionReference := strings.TrimSpace(r.URL.Query().Get("transactionReference"))
+	if transactionReference == "" {
+		response := commons.ErrorResponse[models.TransferReviewResponse]("validation failed", "transactionReference is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"transactionReference": transactionReference})
+	response, err := c.service.GetTransferReview(r.Context(), transactionReference)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapTransferReviewResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *TransferReviewController) approve(w http.ResponseWriter, r *http.Request) {
+	c.decide(w, r, c.service.ApproveHeldTransfer)
+}
+
+func (c *TransferReviewController) reject(w http.ResponseWriter, r *http.Request) {
+	c.decide(w, r, c.service.RejectHeldTransfer)
+}
+
+func (c *TransferReviewController) decide(
+	w http.ResponseWriter,
+	r *http.Request,
+	decideFn func(ctx context.Context, req models.TransferReviewDecisionRequest) (commons.Response[models.InternalTransferResponse], error),
+) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.InternalTransferResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.TransferReviewDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.InternalTransferResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := decideFn(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapTransferReviewResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	status := http.StatusOK
+	if response.Data != nil && response.Data.Status == string(domain.TransferStatusAccepted) {
+		status = http.StatusAccepted
+	}
+	c.respondSuccess(w, status, response, r, start)
+}
+
+// mapTransferReviewResponseToStatus maps transfer review response messages to appropriate HTTP status codes
+func mapTransferReviewResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Transfer not found", "Transfer review not found", "Credit account not found", "Debit account not found":
+		return http.StatusNotFound
+	case "Reviewer must differ from initiator":
+		return http.StatusForbidden
+	case "Transfer is not held for review":
+		return http.StatusConflict
+	case "Insufficient balance":
+		return http.StatusUnprocessableEntity
+	case "Transfer queue is full":
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *TransferReviewController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *TransferReviewController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
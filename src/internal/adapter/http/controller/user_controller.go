@@ -1,8 +1,11 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
@@ -12,8 +15,13 @@ import (
 )
 
 const (
-	createUserPath    = "/create-user"
-	verifyUserPinPath = "/verify-pin"
+	createUserPath           = "/create-user"
+	getUserPath              = "/get-user"
+	updateContactDetailsPath = "/update-contact-details"
+	getProfileChangesPath    = "/get-profile-changes"
+	verifyUserPinPath        = "/verify-pin"
+	clearCustomerHoldPath    = "/clear-customer-hold"
+	rejectCustomerHoldPath   = "/reject-customer-hold"
 )
 
 type UserController struct {
@@ -26,15 +34,30 @@ func NewUserController(service service_interfaces.UserService) *UserController {
 
 func (c *UserController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
 	var createUserHandler http.Handler = http.HandlerFunc(c.createUser)
+	var getUserHandler http.Handler = http.HandlerFunc(c.getUser)
+	var updateContactDetailsHandler http.Handler = http.HandlerFunc(c.updateContactDetails)
+	var getProfileChangesHandler http.Handler = http.HandlerFunc(c.getProfileChanges)
 	var verifyPinHandler http.Handler = http.HandlerFunc(c.verifyUserPin)
+	var clearHoldHandler http.Handler = http.HandlerFunc(c.clearCustomerHold)
+	var rejectHoldHandler http.Handler = http.HandlerFunc(c.rejectCustomerHold)
 
 	if authMiddleware != nil {
 		createUserHandler = authMiddleware(createUserHandler)
+		getUserHandler = authMiddleware(getUserHandler)
+		updateContactDetailsHandler = authMiddleware(updateContactDetailsHandler)
+		getProfileChangesHandler = authMiddleware(getProfileChangesHandler)
 		verifyPinHandler = authMiddleware(verifyPinHandler)
+		clearHoldHandler = authMiddleware(clearHoldHandler)
+		rejectHoldHandler = authMiddleware(rejectHoldHandler)
 	}
 
 	mux.Handle(createUserPath, createUserHandler)
+	mux.Handle(getUserPath, getUserHandler)
+	mux.Handle(updateContactDetailsPath, updateContactDetailsHandler)
+	mux.Handle(getProfileChangesPath, getProfileChangesHandler)
 	mux.Handle(verifyUserPinPath, verifyPinHandler)
+	mux.Handle(clearCustomerHoldPath, clearHoldHandler)
+	mux.Handle(rejectCustomerHoldPath, rejectHoldHandler)
 }
 
 func (c *UserController) createUser(w http.ResponseWriter, r *http.Request) {
@@ -73,6 +96,110 @@ func (c *UserController) createUser(w http.ResponseWriter, r *http.Request) {
 	c.respondSuccess(w, http.StatusCreated, response, r, start)
 }
 
+func (c *UserController) getUser(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[models.GetUserResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	id := strings.TrimSpace(r.URL.Query().Get("id"))
+	if id == "" {
+		response := commons.ErrorResponse[models.GetUserResponse]("validation failed", "id is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"id": id})
+	response, err := c.service.GetUser(r.Context(), id)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapUserResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *UserController) updateContactDetails(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.UpdateContactDetailsResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.UpdateContactDetailsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.UpdateContactDetailsResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.UpdateContactDetailsResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.UpdateContactDetails(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapUserResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *UserController) getProfileChanges(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.ProfileChangeResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	req := models.GetProfileChangesRequest{
+		CustomerID: strings.TrimSpace(r.URL.Query().Get("customerId")),
+	}
+	if rawLimit := strings.TrimSpace(r.URL.Query().Get("limit")); rawLimit != "" {
+		parsed, err := strconv.Atoi(rawLimit)
+		if err != nil || parsed <= 0 {
+			response := commons.ErrorResponse[[]models.ProfileChangeResponse]("validation failed", "limit must be a positive integer")
+			c.respondError(w, http.StatusBadRequest, response, r, start)
+			return
+		}
+		req.Limit = parsed
+	}
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[[]models.ProfileChangeResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.GetProfileChanges(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapUserResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
 func (c *UserController) verifyUserPin(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 
@@ -109,6 +236,54 @@ func (c *UserController) verifyUserPin(w http.ResponseWriter, r *http.Request) {
 	c.respondSuccess(w, http.StatusOK, response, r, start)
 }
 
+func (c *UserController) clearCustomerHold(w http.ResponseWriter, r *http.Request) {
+	c.decideComplianceHold(w, r, c.service.ClearComplianceHold)
+}
+
+func (c *UserController) rejectCustomerHold(w http.ResponseWriter, r *http.Request) {
+	c.decideComplianceHold(w, r, c.service.RejectComplianceHold)
+}
+
+func (c *UserController) decideComplianceHold(
+	w http.ResponseWriter,
+	r *http.Request,
+	decide func(ctx context.Context, req models.ComplianceDecisionRequest) (commons.Response[models.GetUserResponse], error),
+) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.GetUserResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.ComplianceDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.GetUserResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.GetUserResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := decide(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapUserResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
 // mapUserResponseToStatus maps user response messages to appropriate HTTP status codes
 func mapUserResponseToStatus(message string) int {
 	switch message {
@@ -116,6 +291,10 @@ func mapUserResponseToStatus(message string) int {
 		return http.StatusBadRequest
 	case "User not found":
 		return http.StatusNotFound
+	case "Customer with this ID already exists", "Customer is not held for compliance review":
+		return http.StatusConflict
+	case "pin locked":
+		return http.StatusLocked
 	default:
 		return http.StatusInternalServerError
 	}
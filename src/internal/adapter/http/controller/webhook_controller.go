@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	createWebhookSubscriptionPath = "/create-webhook-subscription"
+	getWebhookSubscriptionsPath   = "/get-webhook-subscriptions"
+	getWebhookDeliveriesPath      = "/get-webhook-deliveries"
+	redeliverWebhookPath          = "/redeliver-webhook"
+)
+
+type WebhookController struct {
+	service service_interfaces.WebhookService
+}
+
+func NewWebhookController(service service_interfaces.WebhookService) *WebhookController {
+	return &WebhookController{service: service}
+}
+
+func (c *WebhookController) RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler) {
+	var createSubscriptionHandler http.Handler = http.HandlerFunc(c.createSubscription)
+	var getSubscriptionsHandler http.Handler = http.HandlerFunc(c.getSubscriptions)
+	var getDeliveriesHandler http.Handler = http.HandlerFunc(c.getDeliveries)
+	var redeliverHandler http.Handler = http.HandlerFunc(c.redeliver)
+
+	if authMiddleware != nil {
+		createSubscriptionHandler = authMiddleware(createSubscriptionHandler)
+		getSubscriptionsHandler = authMiddleware(getSubscriptionsHandler)
+		getDeliveriesHandler = authMiddleware(getDeliveriesHandler)
+		redeliverHandler = authMiddleware(redeliverHandler)
+	}
+
+	mux.Handle(createWebhookSubscriptionPath, createSubscriptionHandler)
+	mux.Handle(getWebhookSubscriptionsPath, getSubscriptionsHandler)
+	mux.Handle(getWebhookDeliveriesPath, getDeliveriesHandler)
+	mux.Handle(redeliverWebhookPath, redeliverHandler)
+}
+
+func (c *WebhookController) createSubscription(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.WebhookSubscriptionResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.CreateWebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WebhookSubscriptionResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WebhookSubscriptionResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.CreateSubscription(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapWebhookResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusCreated, response, r, start)
+}
+
+func (c *WebhookController) getSubscriptions(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.WebhookSubscriptionResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	channelID := strings.TrimSpace(r.URL.Query().Get("channelId"))
+	if channelID == "" {
+		response := commons.ErrorResponse[[]models.WebhookSubscriptionResponse]("validation failed", "channelId is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"channelId": channelID})
+	response, err := c.service.GetSubscriptions(r.Context(), channelID)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapWebhookResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *WebhookController) getDeliveries(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response := commons.ErrorResponse[[]models.WebhookDeliveryResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	subscriptionID := strings.TrimSpace(r.URL.Query().Get("subscriptionId"))
+	if subscriptionID == "" {
+		response := commons.ErrorResponse[[]models.WebhookDeliveryResponse]("validation failed", "subscriptionId is required")
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, map[string]string{"subscriptionId": subscriptionID})
+	response, err := c.service.GetDeliveries(r.Context(), subscriptionID)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapWebhookResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusOK, response, r, start)
+}
+
+func (c *WebhookController) redeliver(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response := commons.ErrorResponse[models.WebhookDeliveryResponse]("method not allowed")
+		c.respondError(w, http.StatusMethodNotAllowed, response, r, start)
+		return
+	}
+
+	var req models.RedeliverWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WebhookDeliveryResponse]("invalid request body", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logError(r, err, nil)
+		response := commons.ErrorResponse[models.WebhookDeliveryResponse]("validation failed", err.Error())
+		c.respondError(w, http.StatusBadRequest, response, r, start)
+		return
+	}
+
+	logRequest(r, req)
+	response, err := c.service.RedeliverWebhook(r.Context(), req)
+	if err != nil {
+		logError(r, err, logger.Fields{"message": response.Message})
+		status := mapWebhookResponseToStatus(response.Message)
+		c.respondError(w, status, response, r, start)
+		return
+	}
+
+	c.respondSuccess(w, http.StatusAccepted, response, r, start)
+}
+
+// mapWebhookResponseToStatus maps webhook response messages to appropriate HTTP status codes
+func mapWebhookResponseToStatus(message string) int {
+	switch message {
+	case "validation failed":
+		return http.StatusBadRequest
+	case "Webhook delivery not found", "Webhook subscription not found":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondSuccess sends a successful JSON response with logging
+func (c *WebhookController) respondSuccess(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write response"})
+	}
+	logResponse(r, status, payload, start)
+}
+
+// respondError sends an error JSON response with logging
+func (c *WebhookController) respondError(w http.ResponseWriter, status int, payload any, r *http.Request, start time.Time) {
+	if err := writeJSON(w, status, payload); err != nil {
+		logError(r, err, logger.Fields{"action": "write error response"})
+	}
+	logResponse(r, status, payload, start)
+}
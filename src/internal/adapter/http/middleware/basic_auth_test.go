@@ -1,41 +0,0 @@
-package middleware
-
-import (
-	"net/http"
-	"net/http/httptest"
-	"testing"
-)
-
-func TestBasicAuth_AllowsValidCredentials(t *testing.T) {
-	mw := BasicAuth("GreyApp", "GreyhoundKey001")
-	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
-
-	req := httptest.NewRequest(http.MethodGet, "/", nil)
-	req.SetBasicAuth("GreyApp", "GreyhoundKey001")
-
-	rr := httptest.NewRecorder()
-	mw(next).ServeHTTP(rr, req)
-
-	if rr.Code != http.StatusOK {
-		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
-	}
-}
-
-func TestBasicAuth_RejectsInvalidCredentials(t *testing.T) {
-	mw := BasicAuth("GreyApp", "GreyhoundKey001")
-	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
-
-	req := httptest.NewRequest(http.MethodGet, "/", nil)
-	req.SetBasicAuth("GreyApp", "WrongKey")
-
-	rr := httptest.NewRecorder()
-	mw(next).ServeHTTP(rr, req)
-
-	if rr.Code != http.StatusUnauthorized {
-		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rr.Code)
-	}
-}
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type authenticatorStub struct {
+	channel domain.Channel
+	err     error
+}
+
+func (s authenticatorStub) Authenticate(_ context.Context, channelID string, apiKey string, _ string) (domain.Channel, error) {
+	if s.err != nil {
+		return domain.Channel{}, s.err
+	}
+	if channelID != s.channel.ChannelID || apiKey != "GreyhoundKey001" {
+		return domain.Channel{}, commons.ErrInvalidChannelCredentials
+	}
+	return s.channel, nil
+}
+
+func serveChannelAuth(authenticator authenticatorStub, pattern string, key string) (*httptest.ResponseRecorder, string) {
+	var channelID string
+	mux := http.NewServeMux()
+	mux.Handle(pattern, ChannelAuth(authenticator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		channelID = commons.ChannelIDFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, pattern, nil)
+	req.SetBasicAuth("GreyApp", key)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	return rr, channelID
+}
+
+func ratesChannel() domain.Channel {
+	return domain.Channel{
+		ChannelID: "GreyApp",
+		Scopes:    []domain.ChannelScope{domain.ChannelScopeRatesRead},
+		Status:    domain.ChannelActive,
+	}
+}
+
+func TestChannelAuth_AllowsScopedRouteAndSetsChannelID(t *testing.T) {
+	rr, channelID := serveChannelAuth(authenticatorStub{channel: ratesChannel()}, "/get-rates", "GreyhoundKey001")
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+	if channelID != "GreyApp" {
+		t.Fatalf("expected channel ID GreyApp in context, got %q", channelID)
+	}
+}
+
+func TestChannelAuth_SetsChannelScopes(t *testing.T) {
+	var scopes []string
+	mux := http.NewServeMux()
+	mux.Handle("/get-rates", ChannelAuth(authenticatorStub{channel: ratesChannel()})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scopes = commons.ChannelScopesFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/get-rates", nil)
+	req.SetBasicAuth("GreyApp", "GreyhoundKey001")
+	mux.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(scopes) != 1 || scopes[0] != string(domain.ChannelScopeRatesRead) {
+		t.Fatalf("expected the channel's scopes in context, got %v", scopes)
+	}
+}
+
+func TestChannelAuth_RejectsInvalidCredentials(t *testing.T) {
+	rr, _ := serveChannelAuth(authenticatorStub{channel: ratesChannel()}, "/get-rates", "WrongKey")
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rr.Code)
+	}
+}
+
+func TestChannelAuth_RejectsRouteOutsideScopes(t *testing.T) {
+	rr, _ := serveChannelAuth(authenticatorStub{channel: ratesChannel()}, "/transfer-funds", "GreyhoundKey001")
+
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, rr.Code)
+	}
+}
+
+func TestChannelAuth_UnmappedRouteNeedsAllScope(t *testing.T) {
+	rr, _ := serveChannelAuth(authenticatorStub{channel: ratesChannel()}, "/unmapped-route", "GreyhoundKey001")
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, rr.Code)
+	}
+
+	admin := ratesChannel()
+	admin.Scopes = []domain.ChannelScope{domain.ChannelScopeAll}
+	rr, _ = serveChannelAuth(authenticatorStub{channel: admin}, "/unmapped-route", "GreyhoundKey001")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestChannelAuth_RejectsDisabledChannelAndDisallowedAddress(t *testing.T) {
+	for _, err := range []error{commons.ErrChannelDisabled, commons.ErrChannelIPNotAllowed} {
+		rr, _ := serveChannelAuth(authenticatorStub{err: err}, "/get-rates", "GreyhoundKey001")
+		if rr.Code != http.StatusForbidden {
+			t.Fatalf("%v: expected status %d, got %d", err, http.StatusForbidden, rr.Code)
+		}
+	}
+}
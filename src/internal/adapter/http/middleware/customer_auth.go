@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+// CustomerTokenHeader carries the customer or service JWT. The Authorization
+// header stays with channel basic auth.
+const CustomerTokenHeader = "X-Customer-Token"
+
+// CustomerTokenRoutes are the route patterns that act on a customer's account
+// and so need a customer or service token.
+var CustomerTokenRoutes = map[string]bool{
+	"/transfer-funds":                  true,
+	"/confirm-transfer":                true,
+	"/get-transfer":                    true,
+	"/get-account":                     true,
+	"/deposit-funds":                   true,
+	"/get-deposit":                     true,
+	"/withdraw-funds":                  true,
+	"/confirm-withdrawal":              true,
+	"/exchange":                        true,
+	"/place-account-hold":              true,
+	"/release-account-hold":            true,
+	"/capture-account-hold":            true,
+	"/get-account-holds":               true,
+	"/customers/{customerId}/accounts": true,
+}
+
+// TokenVerifier turns a signed token into the principal it names.
+type TokenVerifier interface {
+	Verify(token string) (commons.Principal, error)
+}
+
+// CustomerAuth verifies the token in CustomerTokenHeader and stores its
+// principal in the request context for the services to check account
+// ownership. The token is optional on routes outside CustomerTokenRoutes.
+func CustomerAuth(verifier TokenVerifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if verifier == nil {
+				logger.Error("customer auth middleware missing server configuration", nil, logger.Fields{
+					"method": r.Method,
+					"path":   r.URL.Path,
+				})
+				http.Error(w, "server auth configuration is missing", http.StatusInternalServerError)
+				return
+			}
+
+			token := strings.TrimSpace(r.Header.Get(CustomerTokenHeader))
+			token = strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+			if token == "" {
+				if CustomerTokenRoutes[r.Pattern] {
+					logger.Info("customer auth middleware unauthorized request", logger.Fields{
+						"method": r.Method,
+						"path":   r.URL.Path,
+						"token":  "missing",
+					})
+					http.Error(w, "customer token is required", http.StatusUnauthorized)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := verifier.Verify(token)
+			if err != nil {
+				logger.Info("customer auth middleware unauthorized request", logger.Fields{
+					"method": r.Method,
+					"path":   r.URL.Path,
+					"token":  "invalid",
+					"reason": err.Error(),
+				})
+				http.Error(w, "invalid customer token", http.StatusUnauthorized)
+				return
+			}
+
+			logger.Info("customer auth middleware authorized request", logger.Fields{
+				"method":     r.Method,
+				"path":       r.URL.Path,
+				"subject":    principal.Subject,
+				"customerId": principal.CustomerID,
+			})
+			next.ServeHTTP(w, r.WithContext(commons.WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type verifierStub struct{}
+
+func (verifierStub) Verify(token string) (commons.Principal, error) {
+	if token != "customer-token" {
+		return commons.Principal{}, errors.New("invalid token")
+	}
+	return commons.Principal{Subject: "user-C1", CustomerID: "C1"}, nil
+}
+
+func serveCustomerAuth(pattern string, header string) (*httptest.ResponseRecorder, commons.Principal) {
+	var principal commons.Principal
+	mux := http.NewServeMux()
+	mux.Handle(pattern, CustomerAuth(verifierStub{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ = commons.PrincipalFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	req := httptest.NewRequest(http.MethodPost, pattern, nil)
+	if header != "" {
+		req.Header.Set(CustomerTokenHeader, header)
+	}
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	return rr, principal
+}
+
+func TestCustomerAuth_StoresPrincipalFromBearerToken(t *testing.T) {
+	rr, principal := serveCustomerAuth("/transfer-funds", "Bearer customer-token")
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+	if principal.CustomerID != "C1" {
+		t.Fatalf("expected customer C1 in context, got %+v", principal)
+	}
+}
+
+func TestCustomerAuth_RequiresTokenOnAccountRoutes(t *testing.T) {
+	rr, _ := serveCustomerAuth("/deposit-funds", "")
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rr.Code)
+	}
+}
+
+func TestCustomerAuth_RejectsInvalidToken(t *testing.T) {
+	rr, _ := serveCustomerAuth("/get-rates", "forged-token")
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rr.Code)
+	}
+}
+
+func TestCustomerAuth_TokenOptionalOnOtherRoutes(t *testing.T) {
+	rr, _ := serveCustomerAuth("/get-rates", "")
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestCustomerAuth_RequiresTokenOnEveryCustomerRoute(t *testing.T) {
+	for pattern := range CustomerTokenRoutes {
+		var handler http.Handler = CustomerAuth(verifierStub{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		mux := http.NewServeMux()
+		mux.Handle(pattern, handler)
+
+		path := strings.ReplaceAll(pattern, "{customerId}", "C2")
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, path, nil))
+		if rr.Code != http.StatusUnauthorized {
+			t.Errorf("%s: expected status %d, got %d", pattern, http.StatusUnauthorized, rr.Code)
+		}
+	}
+}
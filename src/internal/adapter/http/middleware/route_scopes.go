@@ -0,0 +1,82 @@
+package middleware
+
+import "github.com/api-sage/fcy-payment-processor/src/internal/domain"
+
+// RouteScopes maps each authenticated route pattern to the scope a channel
+// needs to call it.
+var RouteScopes = map[string]domain.ChannelScope{
+	"/get-rates":               domain.ChannelScopeRatesRead,
+	"/get-rate":                domain.ChannelScopeRatesRead,
+	"/convert-fcy-amount":      domain.ChannelScopeRatesRead,
+	"/get-charges":             domain.ChannelScopeRatesRead,
+	"/get-participant-banks":   domain.ChannelScopeRatesRead,
+	"/get-interest-rate-tiers": domain.ChannelScopeRatesRead,
+
+	"/get-user":                        domain.ChannelScopeCustomersRead,
+	"/get-profile-changes":             domain.ChannelScopeCustomersRead,
+	"/get-kyc-upgrades":                domain.ChannelScopeCustomersRead,
+	"/get-pin-status":                  domain.ChannelScopeCustomersRead,
+	"/customers/{customerId}/accounts": domain.ChannelScopeCustomersRead,
+
+	"/create-user":            domain.ChannelScopeCustomersWrite,
+	"/update-contact-details": domain.ChannelScopeCustomersWrite,
+	"/submit-kyc-upgrade":     domain.ChannelScopeCustomersWrite,
+	"/verify-pin":             domain.ChannelScopeCustomersWrite,
+	"/change-pin":             domain.ChannelScopeCustomersWrite,
+	"/request-pin-reset":      domain.ChannelScopeCustomersWrite,
+	"/reset-pin":              domain.ChannelScopeCustomersWrite,
+
+	"/get-account":                domain.ChannelScopeAccountsRead,
+	"/get-deposit":                domain.ChannelScopeAccountsRead,
+	"/get-account-status-history": domain.ChannelScopeAccountsRead,
+	"/get-account-holds":          domain.ChannelScopeAccountsRead,
+	"/get-overdraft":              domain.ChannelScopeAccountsRead,
+
+	"/create-account":       domain.ChannelScopeAccountsWrite,
+	"/deposit-funds":        domain.ChannelScopeAccountsWrite,
+	"/withdraw-funds":       domain.ChannelScopeAccountsWrite,
+	"/confirm-withdrawal":   domain.ChannelScopeAccountsWrite,
+	"/exchange":             domain.ChannelScopeAccountsWrite,
+	"/place-account-hold":   domain.ChannelScopeAccountsWrite,
+	"/release-account-hold": domain.ChannelScopeAccountsWrite,
+	"/capture-account-hold": domain.ChannelScopeAccountsWrite,
+
+	"/get-transfer": domain.ChannelScopeTransfersRead,
+
+	"/transfer-funds":   domain.ChannelScopeTransfersWrite,
+	"/confirm-transfer": domain.ChannelScopeTransfersWrite,
+
+	"/create-webhook-subscription": domain.ChannelScopeWebhooks,
+	"/get-webhook-subscriptions":   domain.ChannelScopeWebhooks,
+	"/get-webhook-deliveries":      domain.ChannelScopeWebhooks,
+	"/redeliver-webhook":           domain.ChannelScopeWebhooks,
+
+	"/freeze-account":              domain.ChannelScopeBackOffice,
+	"/unfreeze-account":            domain.ChannelScopeBackOffice,
+	"/close-account":               domain.ChannelScopeBackOffice,
+	"/reactivate-account":          domain.ChannelScopeBackOffice,
+	"/get-dormancy-report":         domain.ChannelScopeBackOffice,
+	"/run-interest-batch":          domain.ChannelScopeBackOffice,
+	"/approve-overdraft":           domain.ChannelScopeBackOffice,
+	"/get-overdraft-breaches":      domain.ChannelScopeBackOffice,
+	"/approve-kyc-upgrade":         domain.ChannelScopeBackOffice,
+	"/reject-kyc-upgrade":          domain.ChannelScopeBackOffice,
+	"/unlock-pin":                  domain.ChannelScopeBackOffice,
+	"/clear-customer-hold":         domain.ChannelScopeBackOffice,
+	"/reject-customer-hold":        domain.ChannelScopeBackOffice,
+	"/get-held-transfers":          domain.ChannelScopeBackOffice,
+	"/get-transfer-review":         domain.ChannelScopeBackOffice,
+	"/approve-held-transfer":       domain.ChannelScopeBackOffice,
+	"/reject-held-transfer":        domain.ChannelScopeBackOffice,
+	"/get-duplicate-candidates":    domain.ChannelScopeBackOffice,
+	"/dismiss-duplicate-candidate": domain.ChannelScopeBackOffice,
+	"/merge-customers":             domain.ChannelScopeBackOffice,
+	"/export-customer-data":        domain.ChannelScopeBackOffice,
+	"/erase-customer-data":         domain.ChannelScopeBackOffice,
+
+	"/create-channel":     domain.ChannelScopeChannelsAdmin,
+	"/get-channel":        domain.ChannelScopeChannelsAdmin,
+	"/get-channels":       domain.ChannelScopeChannelsAdmin,
+	"/update-channel":     domain.ChannelScopeChannelsAdmin,
+	"/rotate-channel-key": domain.ChannelScopeChannelsAdmin,
+}
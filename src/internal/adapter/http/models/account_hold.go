@@ -0,0 +1,110 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+type PlaceAccountHoldRequest struct {
+	AccountNumber string          `json:"accountNumber"`
+	HoldType      string          `json:"holdType"`
+	Amount        decimal.Decimal `json:"amount"`
+	Reason        string          `json:"reason"`
+	Reference     string          `json:"reference"`
+	ExpiresAt     *time.Time      `json:"expiresAt,omitempty"`
+}
+
+func (r PlaceAccountHoldRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
+
+	// TRANSFER holds are placed by the transfer review flow only.
+	holdType := domain.AccountHoldType(strings.ToUpper(strings.TrimSpace(r.HoldType)))
+	if holdType != domain.AccountHoldTypeAuthorization && holdType != domain.AccountHoldTypeLien {
+		errs = append(errs, "holdType must be AUTHORIZATION or LIEN")
+	}
+	if r.Amount.LessThanOrEqual(decimal.Zero) {
+		errs = append(errs, "amount must be greater than zero")
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		errs = append(errs, "reason is required")
+	}
+	errs = append(errs, validateHoldReference(r.Reference)...)
+	if r.ExpiresAt != nil && !r.ExpiresAt.After(time.Now()) {
+		errs = append(errs, "expiresAt must be in the future")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type ReleaseAccountHoldRequest struct {
+	AccountNumber string `json:"accountNumber"`
+	Reference     string `json:"reference"`
+}
+
+func (r ReleaseAccountHoldRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
+	errs = append(errs, validateHoldReference(r.Reference)...)
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// CaptureAccountHoldRequest captures Amount, or the full hold when it is
+// omitted. A partial capture releases the remainder.
+type CaptureAccountHoldRequest struct {
+	AccountNumber string           `json:"accountNumber"`
+	Reference     string           `json:"reference"`
+	Amount        *decimal.Decimal `json:"amount,omitempty"`
+}
+
+func (r CaptureAccountHoldRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
+	errs = append(errs, validateHoldReference(r.Reference)...)
+	if r.Amount != nil && r.Amount.LessThanOrEqual(decimal.Zero) {
+		errs = append(errs, "amount must be greater than zero")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type AccountHoldResponse struct {
+	AccountNumber  string          `json:"accountNumber"`
+	HoldType       string          `json:"holdType"`
+	Amount         decimal.Decimal `json:"amount"`
+	CapturedAmount decimal.Decimal `json:"capturedAmount"`
+	Reason         string          `json:"reason"`
+	Reference      string          `json:"reference"`
+	Status         string          `json:"status"`
+	ExpiresAt      string          `json:"expiresAt,omitempty"`
+	ResolvedAt     string          `json:"resolvedAt,omitempty"`
+	CreatedAt      string          `json:"createdAt"`
+}
+
+func validateHoldReference(reference string) []string {
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return []string{"reference is required"}
+	}
+	if len(reference) > 64 {
+		return []string{"reference must be at most 64 characters"}
+	}
+	return nil
+}
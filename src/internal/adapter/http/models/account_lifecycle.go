@@ -0,0 +1,93 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// AccountStatusChangeRequest freezes or unfreezes an account.
+type AccountStatusChangeRequest struct {
+	AccountNumber string `json:"accountNumber"`
+	ReasonCode    string `json:"reasonCode"`
+	Comment       string `json:"comment,omitempty"`
+}
+
+func (r AccountStatusChangeRequest) Validate() error {
+	errs := validateAccountStatusChange(r.AccountNumber, r.ReasonCode)
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// CloseAccountRequest closes an account. SweepAccountNumber is required when
+// the account still holds a balance; it is converted to the destination's
+// currency when the two differ.
+type CloseAccountRequest struct {
+	AccountNumber      string `json:"accountNumber"`
+	ReasonCode         string `json:"reasonCode"`
+	Comment            string `json:"comment,omitempty"`
+	SweepAccountNumber string `json:"sweepAccountNumber,omitempty"`
+}
+
+func (r CloseAccountRequest) Validate() error {
+	errs := validateAccountStatusChange(r.AccountNumber, r.ReasonCode)
+
+	sweepAccountNumber := strings.TrimSpace(r.SweepAccountNumber)
+	if sweepAccountNumber != "" {
+		if sweepErrs := validateAccountNumber("sweepAccountNumber", homeBankCode, sweepAccountNumber); len(sweepErrs) > 0 {
+			errs = append(errs, sweepErrs...)
+		} else if sweepAccountNumber == strings.TrimSpace(r.AccountNumber) {
+			errs = append(errs, "sweepAccountNumber must differ from accountNumber")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type AccountStatusEventResponse struct {
+	AccountNumber      string           `json:"accountNumber"`
+	FromStatus         string           `json:"fromStatus"`
+	ToStatus           string           `json:"toStatus"`
+	ReasonCode         string           `json:"reasonCode"`
+	Comment            string           `json:"comment,omitempty"`
+	RequestedBy        string           `json:"requestedBy"`
+	SweepAccountNumber string           `json:"sweepAccountNumber,omitempty"`
+	SweptAmount        *decimal.Decimal `json:"sweptAmount,omitempty"`
+	CreatedAt          string           `json:"createdAt"`
+}
+
+type AccountStatusChangeResponse struct {
+	AccountNumber    string          `json:"accountNumber"`
+	Currency         string          `json:"currency"`
+	Status           string          `json:"status"`
+	AvailableBalance decimal.Decimal `json:"availableBalance"`
+	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
+	UpdatedAt        string          `json:"updatedAt"`
+}
+
+func validateAccountStatusChange(accountNumber string, reasonCode string) []string {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, accountNumber)...)
+
+	reason := domain.AccountStatusReason(strings.ToUpper(strings.TrimSpace(reasonCode)))
+	valid := false
+	for _, allowed := range domain.AccountStatusReasons {
+		if reason == allowed {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		errs = append(errs, "reasonCode must be one of FRAUD, COURT_ORDER, CUSTOMER_REQUEST, COMPLIANCE, OTHER")
+	}
+
+	return errs
+}
@@ -0,0 +1,194 @@
+package models
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type CreateChannelRequest struct {
+	ChannelID  string   `json:"channelId"`
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+	AllowedIPs []string `json:"allowedIps"`
+	CreatedBy  string   `json:"createdBy"`
+}
+
+func (r CreateChannelRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateChannelID(r.ChannelID)...)
+	if strings.TrimSpace(r.Name) == "" {
+		errs = append(errs, "name is required")
+	} else if len(strings.TrimSpace(r.Name)) > 128 {
+		errs = append(errs, "name must be at most 128 characters")
+	}
+	if len(r.Scopes) == 0 {
+		errs = append(errs, "scopes is required")
+	}
+	errs = append(errs, validateChannelScopes(r.Scopes)...)
+	errs = append(errs, validateAllowedIPs(r.AllowedIPs)...)
+	if strings.TrimSpace(r.CreatedBy) == "" {
+		errs = append(errs, "createdBy is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// UpdateChannelRequest changes the fields that are set; omitted fields keep
+// their value. An empty allowedIps list lifts the address restriction.
+type UpdateChannelRequest struct {
+	ChannelID  string    `json:"channelId"`
+	Name       string    `json:"name"`
+	Scopes     *[]string `json:"scopes"`
+	AllowedIPs *[]string `json:"allowedIps"`
+	Status     string    `json:"status"`
+	UpdatedBy  string    `json:"updatedBy"`
+}
+
+func (r UpdateChannelRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.ChannelID) == "" {
+		errs = append(errs, "channelId is required")
+	}
+	if len(strings.TrimSpace(r.Name)) > 128 {
+		errs = append(errs, "name must be at most 128 characters")
+	}
+	if r.Scopes != nil {
+		if len(*r.Scopes) == 0 {
+			errs = append(errs, "scopes must not be empty")
+		}
+		errs = append(errs, validateChannelScopes(*r.Scopes)...)
+	}
+	if r.AllowedIPs != nil {
+		errs = append(errs, validateAllowedIPs(*r.AllowedIPs)...)
+	}
+	if status := strings.ToUpper(strings.TrimSpace(r.Status)); status != "" &&
+		status != string(domain.ChannelActive) && status != string(domain.ChannelDisabled) {
+		errs = append(errs, "status must be ACTIVE or DISABLED")
+	}
+	if strings.TrimSpace(r.UpdatedBy) == "" {
+		errs = append(errs, "updatedBy is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// RotateChannelKeyRequest issues a new key. The keys it replaces keep working
+// for gracePeriodSeconds, or the configured grace period when omitted; 0
+// revokes them at once.
+type RotateChannelKeyRequest struct {
+	ChannelID          string `json:"channelId"`
+	RotatedBy          string `json:"rotatedBy"`
+	GracePeriodSeconds *int   `json:"gracePeriodSeconds"`
+}
+
+func (r RotateChannelKeyRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.ChannelID) == "" {
+		errs = append(errs, "channelId is required")
+	}
+	if strings.TrimSpace(r.RotatedBy) == "" {
+		errs = append(errs, "rotatedBy is required")
+	}
+	if r.GracePeriodSeconds != nil && *r.GracePeriodSeconds < 0 {
+		errs = append(errs, "gracePeriodSeconds must not be negative")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type ChannelKeyResponse struct {
+	ID        string `json:"id"`
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+type ChannelResponse struct {
+	ChannelID  string               `json:"channelId"`
+	Name       string               `json:"name"`
+	Scopes     []string             `json:"scopes"`
+	AllowedIPs []string             `json:"allowedIps"`
+	Status     string               `json:"status"`
+	CreatedBy  string               `json:"createdBy"`
+	UpdatedBy  string               `json:"updatedBy"`
+	CreatedAt  string               `json:"createdAt"`
+	UpdatedAt  string               `json:"updatedAt"`
+	Keys       []ChannelKeyResponse `json:"keys,omitempty"`
+	// APIKey is only returned when a key is issued.
+	APIKey string `json:"apiKey,omitempty"`
+}
+
+func validateChannelID(value string) []string {
+	channelID := strings.TrimSpace(value)
+	if channelID == "" {
+		return []string{"channelId is required"}
+	}
+	if len(channelID) > 64 {
+		return []string{"channelId must be at most 64 characters"}
+	}
+	for _, ch := range channelID {
+		if !(ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '-' || ch == '_') {
+			return []string{"channelId may only contain letters, digits, '-' and '_'"}
+		}
+	}
+	return nil
+}
+
+func validateChannelScopes(scopes []string) []string {
+	var errs []string
+	for _, scope := range scopes {
+		if !domain.IsChannelScope(strings.TrimSpace(scope)) {
+			errs = append(errs, "scopes contains unsupported scope "+strings.TrimSpace(scope))
+		}
+	}
+	return errs
+}
+
+func validateAllowedIPs(values []string) []string {
+	var errs []string
+	for _, value := range values {
+		if _, err := ParseAllowedIP(value); err != nil {
+			errs = append(errs, "allowedIps contains invalid address or range "+strings.TrimSpace(value))
+		}
+	}
+	return errs
+}
+
+// ParseAllowedIP parses an IP address or CIDR range into CIDR form, so a
+// single address becomes a /32 (or /128) range.
+func ParseAllowedIP(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if strings.Contains(trimmed, "/") {
+		_, network, err := net.ParseCIDR(trimmed)
+		if err != nil {
+			return "", err
+		}
+		return network.String(), nil
+	}
+	ip := net.ParseIP(trimmed)
+	if ip == nil {
+		return "", errors.New("invalid ip address")
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return (&net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}).String(), nil
+	}
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}).String(), nil
+}
@@ -10,6 +10,7 @@ import (
 type CreateAccountRequest struct {
 	CustomerID     string           `json:"customerId"`
 	Currency       string           `json:"currency"`
+	Product        string           `json:"product,omitempty"`
 	InitialDeposit *decimal.Decimal `json:"initialDeposit,omitempty"`
 }
 
@@ -27,6 +28,11 @@ func (r CreateAccountRequest) Validate() error {
 		errs = append(errs, "currency must be one of USD, EUR, GBP, NGN")
 	}
 
+	product := strings.ToUpper(strings.TrimSpace(r.Product))
+	if product != "" && product != "STANDARD" && product != "SAVINGS" {
+		errs = append(errs, "product must be one of STANDARD, SAVINGS")
+	}
+
 	if r.InitialDeposit != nil && r.InitialDeposit.LessThan(decimal.Zero) {
 		errs = append(errs, "initialDeposit cannot be negative")
 	}
@@ -43,6 +49,7 @@ type CreateAccountResponse struct {
 	CustomerID       string          `json:"customerId"`
 	AccountNumber    string          `json:"accountNumber"`
 	Currency         string          `json:"currency"`
+	Product          string          `json:"product,omitempty"`
 	AvailableBalance decimal.Decimal `json:"availableBalance"`
 	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
 	Status           string          `json:"status"`
@@ -58,37 +65,38 @@ type GetAccountResponse struct {
 	BankCode         string          `json:"bankCode"`
 	BankName         string          `json:"bankName,omitempty"`
 	Currency         string          `json:"currency"`
+	Product          string          `json:"product,omitempty"`
 	AvailableBalance decimal.Decimal `json:"availableBalance"`
 	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
+	OverdraftLimit   decimal.Decimal `json:"overdraftLimit"`
 	Status           string          `json:"status"`
+	LastActivityAt   string          `json:"lastActivityAt,omitempty"`
 	CreatedAt        string          `json:"createdAt"`
 	UpdatedAt        string          `json:"updatedAt"`
 }
 
 type DepositFundsRequest struct {
-	AccountNumber string          `json:"accountNumber"`
-	Amount        decimal.Decimal `json:"amount"`
+	AccountNumber     string          `json:"accountNumber"`
+	Amount            decimal.Decimal `json:"amount"`
+	Source            string          `json:"source"`
+	ExternalReference string          `json:"externalReference"`
+	Narration         string          `json:"narration,omitempty"`
 }
 
 func (r DepositFundsRequest) Validate() error {
 	var errs []string
 
-	accountNumber := strings.TrimSpace(r.AccountNumber)
-	if len(accountNumber) != 10 {
-		errs = append(errs, "accountNumber must be exactly 10 digits")
-	} else {
-		for _, ch := range accountNumber {
-			if ch < '0' || ch > '9' {
-				errs = append(errs, "accountNumber must be exactly 10 digits")
-				break
-			}
-		}
-	}
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
 
 	if r.Amount.LessThanOrEqual(decimal.Zero) {
 		errs = append(errs, "amount must be greater than zero")
+	} else if r.Amount.Exponent() < -2 {
+		errs = append(errs, "amount must have at most 2 decimal places")
 	}
 
+	errs = append(errs, validateDepositSource(r.Source)...)
+	errs = append(errs, validateExternalReference(r.ExternalReference)...)
+
 	if len(errs) > 0 {
 		return errors.New(strings.Join(errs, "; "))
 	}
@@ -96,9 +104,14 @@ func (r DepositFundsRequest) Validate() error {
 }
 
 type DepositFundsResponse struct {
-	AccountNumber    string          `json:"accountNumber"`
-	Currency         string          `json:"currency"`
-	DepositedAmount  decimal.Decimal `json:"depositedAmount"`
-	AvailableBalance decimal.Decimal `json:"availableBalance"`
-	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
+	Reference         string                 `json:"reference"`
+	AccountNumber     string                 `json:"accountNumber"`
+	Currency          string                 `json:"currency"`
+	DepositedAmount   decimal.Decimal        `json:"depositedAmount"`
+	AvailableBalance  decimal.Decimal        `json:"availableBalance"`
+	LedgerBalance     decimal.Decimal        `json:"ledgerBalance"`
+	Source            string                 `json:"source"`
+	ExternalReference string                 `json:"externalReference"`
+	JournalEntries    []JournalEntryResponse `json:"journalEntries"`
+	CreatedAt         string                 `json:"createdAt"`
 }
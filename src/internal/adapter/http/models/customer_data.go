@@ -0,0 +1,89 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// CustomerDataRequest is a data subject request for one customer: an export
+// of everything held about them, or the erasure of their personal data. The
+// requester is the authenticated caller.
+type CustomerDataRequest struct {
+	CustomerID string `json:"customerId"`
+	Reason     string `json:"reason"`
+}
+
+func (r CustomerDataRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		errs = append(errs, "reason is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// CustomerDataExportResponse is the machine-readable archive of a customer.
+type CustomerDataExportResponse struct {
+	RequestID      string                         `json:"requestId"`
+	CustomerID     string                         `json:"customerId"`
+	GeneratedAt    string                         `json:"generatedAt"`
+	Profile        GetUserResponse                `json:"profile"`
+	ProfileChanges []ProfileChangeResponse        `json:"profileChanges"`
+	KYCUpgrades    []KYCUpgradeResponse           `json:"kycUpgrades"`
+	Accounts       []CustomerDataAccountResponse  `json:"accounts"`
+	Transfers      []CustomerDataTransferResponse `json:"transfers"`
+}
+
+type CustomerDataAccountResponse struct {
+	AccountNumber    string          `json:"accountNumber"`
+	Currency         string          `json:"currency"`
+	Product          string          `json:"product"`
+	AvailableBalance decimal.Decimal `json:"availableBalance"`
+	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
+	Status           string          `json:"status"`
+	CreatedAt        string          `json:"createdAt"`
+	UpdatedAt        string          `json:"updatedAt"`
+}
+
+// CustomerDataTransferResponse is a transfer with its audit payload, the
+// sanitized request that created it, embedded as JSON.
+type CustomerDataTransferResponse struct {
+	TransferID           string          `json:"transferId"`
+	TransactionReference string          `json:"transactionReference"`
+	ExternalReference    string          `json:"externalReference"`
+	DebitAccountNumber   string          `json:"debitAccountNumber"`
+	CreditAccountNumber  string          `json:"creditAccountNumber"`
+	BeneficiaryBankCode  string          `json:"beneficiaryBankCode"`
+	DebitCurrency        string          `json:"debitCurrency"`
+	CreditCurrency       string          `json:"creditCurrency"`
+	DebitAmount          decimal.Decimal `json:"debitAmount"`
+	CreditAmount         decimal.Decimal `json:"creditAmount"`
+	FcyRate              decimal.Decimal `json:"fcyRate"`
+	ChargeAmount         decimal.Decimal `json:"chargeAmount"`
+	VATAmount            decimal.Decimal `json:"vatAmount"`
+	Narration            string          `json:"narration"`
+	Status               string          `json:"status"`
+	AuditPayload         json.RawMessage `json:"auditPayload,omitempty"`
+	CreatedAt            string          `json:"createdAt"`
+	ProcessedAt          string          `json:"processedAt,omitempty"`
+}
+
+type CustomerErasureResponse struct {
+	RequestID              string `json:"requestId"`
+	CustomerID             string `json:"customerId"`
+	RequestedBy            string `json:"requestedBy"`
+	TransfersScrubbed      int    `json:"transfersScrubbed"`
+	ProfileChangesScrubbed int    `json:"profileChangesScrubbed"`
+	ErasedAt               string `json:"erasedAt"`
+}
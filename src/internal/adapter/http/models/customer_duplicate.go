@@ -0,0 +1,111 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// GetDuplicateCandidatesRequest lists the candidates involving one customer,
+// or the review queue for a status when no customer is given.
+type GetDuplicateCandidatesRequest struct {
+	CustomerID string `json:"customerId"`
+	Status     string `json:"status"`
+	Limit      int    `json:"limit"`
+}
+
+func (r GetDuplicateCandidatesRequest) Validate() error {
+	var errs []string
+
+	switch domain.DuplicateCandidateStatus(strings.ToUpper(strings.TrimSpace(r.Status))) {
+	case "", domain.DuplicateCandidateOpen, domain.DuplicateCandidateDismissed, domain.DuplicateCandidateMerged:
+	default:
+		errs = append(errs, "status must be OPEN, DISMISSED or MERGED")
+	}
+	if r.Limit < 0 {
+		errs = append(errs, "limit must be a positive integer")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// DismissDuplicateCandidateRequest closes an OPEN candidate as not a duplicate.
+type DismissDuplicateCandidateRequest struct {
+	CandidateID string `json:"candidateId"`
+	Comment     string `json:"comment"`
+}
+
+func (r DismissDuplicateCandidateRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CandidateID) == "" {
+		errs = append(errs, "candidateId is required")
+	}
+	if strings.TrimSpace(r.Comment) == "" {
+		errs = append(errs, "comment is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// MergeCustomersRequest confirms an OPEN candidate as a duplicate. The
+// customer on the other side of the candidate from survivingCustomerId is
+// merged into it.
+type MergeCustomersRequest struct {
+	CandidateID         string `json:"candidateId"`
+	SurvivingCustomerID string `json:"survivingCustomerId"`
+	Comment             string `json:"comment"`
+}
+
+func (r MergeCustomersRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CandidateID) == "" {
+		errs = append(errs, "candidateId is required")
+	}
+	if strings.TrimSpace(r.SurvivingCustomerID) == "" {
+		errs = append(errs, "survivingCustomerId is required")
+	}
+	if strings.TrimSpace(r.Comment) == "" {
+		errs = append(errs, "comment is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type DuplicateCandidateResponse struct {
+	CandidateID       string   `json:"candidateId"`
+	CustomerID        string   `json:"customerId"`
+	MatchedCustomerID string   `json:"matchedCustomerId"`
+	MatchedOn         []string `json:"matchedOn"`
+	NameScore         float64  `json:"nameScore"`
+	Status            string   `json:"status"`
+	ReviewedBy        string   `json:"reviewedBy,omitempty"`
+	ReviewComment     string   `json:"reviewComment,omitempty"`
+	ReviewedAt        string   `json:"reviewedAt,omitempty"`
+	CreatedAt         string   `json:"createdAt"`
+}
+
+type CustomerMergeResponse struct {
+	MergeID             string   `json:"mergeId"`
+	CandidateID         string   `json:"candidateId"`
+	SurvivingCustomerID string   `json:"survivingCustomerId"`
+	MergedCustomerID    string   `json:"mergedCustomerId"`
+	AccountNumbers      []string `json:"accountNumbers"`
+	MergedBy            string   `json:"mergedBy"`
+	Comment             string   `json:"comment"`
+	CreatedAt           string   `json:"createdAt"`
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// GetDepositRequest looks a deposit up by our Reference, or by the funding
+// provider's Source and ExternalReference.
+type GetDepositRequest struct {
+	Reference         string `json:"reference,omitempty"`
+	Source            string `json:"source,omitempty"`
+	ExternalReference string `json:"externalReference,omitempty"`
+}
+
+func (r GetDepositRequest) Validate() error {
+	if strings.TrimSpace(r.Reference) != "" {
+		return nil
+	}
+
+	var errs []string
+	if strings.TrimSpace(r.Source) == "" && strings.TrimSpace(r.ExternalReference) == "" {
+		errs = append(errs, "reference, or source and externalReference, are required")
+	} else {
+		errs = append(errs, validateDepositSource(r.Source)...)
+		errs = append(errs, validateExternalReference(r.ExternalReference)...)
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type DepositResponse struct {
+	Reference         string                 `json:"reference"`
+	AccountNumber     string                 `json:"accountNumber"`
+	Currency          string                 `json:"currency"`
+	Amount            decimal.Decimal        `json:"amount"`
+	Source            string                 `json:"source"`
+	ExternalReference string                 `json:"externalReference"`
+	Narration         string                 `json:"narration,omitempty"`
+	JournalEntries    []JournalEntryResponse `json:"journalEntries"`
+	CreatedAt         string                 `json:"createdAt"`
+}
+
+func validateDepositSource(source string) []string {
+	value := domain.DepositSource(strings.ToUpper(strings.TrimSpace(source)))
+	if value == "" {
+		return []string{"source is required"}
+	}
+	for _, allowed := range domain.DepositSources {
+		if value == allowed {
+			return nil
+		}
+	}
+	return []string{"source must be one of CASH, CARD, EXTERNAL_BANK"}
+}
+
+func validateExternalReference(reference string) []string {
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return []string{"externalReference is required"}
+	}
+	if len(reference) > 64 {
+		return []string{"externalReference must be at most 64 characters"}
+	}
+	return nil
+}
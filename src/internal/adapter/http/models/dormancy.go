@@ -0,0 +1,62 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// ReactivateAccountRequest reactivates a DORMANT account. The identity
+// document and date of birth are re-verified against the customer's KYC
+// record before the account can be debited again. The verifier is the
+// authenticated caller.
+type ReactivateAccountRequest struct {
+	AccountNumber string `json:"accountNumber"`
+	IDType        string `json:"idType"`
+	IDNumber      string `json:"idNumber"`
+	DOB           string `json:"dob"`
+	Comment       string `json:"comment,omitempty"`
+}
+
+func (r ReactivateAccountRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
+
+	idType := strings.TrimSpace(r.IDType)
+	if idType == "" {
+		errs = append(errs, "idType is required")
+	} else if idType != string(domain.IDTypePassport) && idType != string(domain.IDTypeDL) {
+		errs = append(errs, "idType must be Passport or DL")
+	}
+	if strings.TrimSpace(r.IDNumber) == "" {
+		errs = append(errs, "idNumber is required")
+	}
+	if strings.TrimSpace(r.DOB) == "" {
+		errs = append(errs, "dob is required")
+	} else if _, err := time.Parse("2006-01-02", strings.TrimSpace(r.DOB)); err != nil {
+		errs = append(errs, "dob must be in YYYY-MM-DD format")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type DormancyReportResponse struct {
+	AsOf               string                       `json:"asOf"`
+	DormancyPeriodDays int                          `json:"dormancyPeriodDays"`
+	Currencies         []DormancyReportLineResponse `json:"currencies"`
+}
+
+type DormancyReportLineResponse struct {
+	Currency         string          `json:"currency"`
+	AccountCount     int             `json:"accountCount"`
+	AvailableBalance decimal.Decimal `json:"availableBalance"`
+	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
+	OldestActivityAt string          `json:"oldestActivityAt"`
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRequest converts Amount, in the source account's currency, into the
+// destination account's currency. Both accounts must belong to one customer.
+type ExchangeRequest struct {
+	SourceAccountNumber      string          `json:"sourceAccountNumber"`
+	DestinationAccountNumber string          `json:"destinationAccountNumber"`
+	Amount                   decimal.Decimal `json:"amount"`
+	TransactionPIN           string          `json:"transactionPIN"`
+	Narration                string          `json:"narration,omitempty"`
+}
+
+func (r ExchangeRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("sourceAccountNumber", homeBankCode, r.SourceAccountNumber)...)
+	errs = append(errs, validateAccountNumber("destinationAccountNumber", homeBankCode, r.DestinationAccountNumber)...)
+	if strings.TrimSpace(r.SourceAccountNumber) != "" && strings.TrimSpace(r.SourceAccountNumber) == strings.TrimSpace(r.DestinationAccountNumber) {
+		errs = append(errs, "sourceAccountNumber and destinationAccountNumber must differ")
+	}
+
+	if r.Amount.LessThanOrEqual(decimal.Zero) {
+		errs = append(errs, "amount must be greater than zero")
+	} else if r.Amount.Exponent() < -2 {
+		errs = append(errs, "amount must have at most 2 decimal places")
+	}
+
+	if strings.TrimSpace(r.TransactionPIN) == "" {
+		errs = append(errs, "transactionPIN is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// ExchangeResponse is the receipt for an own-account exchange.
+type ExchangeResponse struct {
+	Reference                   string                 `json:"reference"`
+	SourceAccountNumber         string                 `json:"sourceAccountNumber"`
+	DestinationAccountNumber    string                 `json:"destinationAccountNumber"`
+	SourceCurrency              string                 `json:"sourceCurrency"`
+	DestinationCurrency         string                 `json:"destinationCurrency"`
+	SourceAmount                decimal.Decimal        `json:"sourceAmount"`
+	DestinationAmount           decimal.Decimal        `json:"destinationAmount"`
+	MidRate                     decimal.Decimal        `json:"midRate"`
+	QuotedRate                  decimal.Decimal        `json:"quotedRate"`
+	SpreadPercent               decimal.Decimal        `json:"spreadPercent"`
+	ChargeAmount                decimal.Decimal        `json:"chargeAmount"`
+	VATAmount                   decimal.Decimal        `json:"vatAmount"`
+	TotalDebit                  decimal.Decimal        `json:"totalDebit"`
+	SourceAvailableBalance      decimal.Decimal        `json:"sourceAvailableBalance"`
+	DestinationAvailableBalance decimal.Decimal        `json:"destinationAvailableBalance"`
+	Narration                   string                 `json:"narration,omitempty"`
+	JournalEntries              []JournalEntryResponse `json:"journalEntries"`
+	CreatedAt                   string                 `json:"createdAt"`
+}
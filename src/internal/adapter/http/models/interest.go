@@ -0,0 +1,66 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RunInterestBatchRequest accrues interest for Date (YYYY-MM-DD) and, when it
+// is the last day of a month, capitalizes the month. Running a date again
+// posts nothing twice.
+type RunInterestBatchRequest struct {
+	Date string `json:"date"`
+}
+
+func (r RunInterestBatchRequest) Validate() error {
+	var errs []string
+
+	date := strings.TrimSpace(r.Date)
+	if date == "" {
+		errs = append(errs, "date is required")
+	} else if _, err := time.Parse(time.DateOnly, date); err != nil {
+		errs = append(errs, "date must be in YYYY-MM-DD format")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type InterestCapitalizationResponse struct {
+	Reference     string          `json:"reference"`
+	AccountNumber string          `json:"accountNumber"`
+	Currency      string          `json:"currency"`
+	PeriodStart   string          `json:"periodStart"`
+	PeriodEnd     string          `json:"periodEnd"`
+	GrossAmount   decimal.Decimal `json:"grossAmount"`
+	TaxAmount     decimal.Decimal `json:"taxAmount"`
+	NetAmount     decimal.Decimal `json:"netAmount"`
+	CreatedAt     string          `json:"createdAt"`
+}
+
+// InterestBatchResponse summarizes one batch run. AccrualsRecorded and
+// Capitalizations only count what this run posted; work already done by an
+// earlier run of the same date is reported as skipped.
+type InterestBatchResponse struct {
+	Date                   string                           `json:"date"`
+	AccountsEvaluated      int                              `json:"accountsEvaluated"`
+	AccrualsRecorded       int                              `json:"accrualsRecorded"`
+	AccrualsSkipped        int                              `json:"accrualsSkipped"`
+	MonthEnd               bool                             `json:"monthEnd"`
+	Capitalizations        []InterestCapitalizationResponse `json:"capitalizations"`
+	CapitalizationsSkipped int                              `json:"capitalizationsSkipped"`
+	CapitalizationsFailed  int                              `json:"capitalizationsFailed"`
+}
+
+type InterestRateTierResponse struct {
+	Currency          string          `json:"currency"`
+	Product           string          `json:"product"`
+	MinBalance        decimal.Decimal `json:"minBalance"`
+	AnnualRatePercent decimal.Decimal `json:"annualRatePercent"`
+	DayCount          string          `json:"dayCount"`
+}
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// SubmitKYCUpgradeRequest asks for a customer's KYC level to be raised. Only
+// document metadata is accepted; documentReference points at the scanned
+// document in the document store.
+type SubmitKYCUpgradeRequest struct {
+	CustomerID        string `json:"customerId"`
+	RequestedLevel    int    `json:"requestedLevel"`
+	DocumentType      string `json:"documentType"`
+	DocumentNumber    string `json:"documentNumber"`
+	IssuingCountry    string `json:"issuingCountry"`
+	DocumentExpiresAt string `json:"documentExpiresAt,omitempty"`
+	DocumentReference string `json:"documentReference"`
+}
+
+func (r SubmitKYCUpgradeRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if r.RequestedLevel < 2 || r.RequestedLevel > domain.MaxKYCLevel {
+		errs = append(errs, fmt.Sprintf("requestedLevel must be between 2 and %d", domain.MaxKYCLevel))
+	}
+	documentType := strings.TrimSpace(r.DocumentType)
+	if documentType == "" {
+		errs = append(errs, "documentType is required")
+	} else if !domain.KYCDocumentType(documentType).Valid() {
+		errs = append(errs, "documentType must be Passport, DL, NationalID or UtilityBill")
+	}
+	if strings.TrimSpace(r.DocumentNumber) == "" {
+		errs = append(errs, "documentNumber is required")
+	}
+	if country := strings.TrimSpace(r.IssuingCountry); len(country) != 2 {
+		errs = append(errs, "issuingCountry must be an ISO 3166-1 alpha-2 code")
+	}
+	if expiresAt := strings.TrimSpace(r.DocumentExpiresAt); expiresAt != "" {
+		if _, err := time.Parse(time.DateOnly, expiresAt); err != nil {
+			errs = append(errs, "documentExpiresAt must be in YYYY-MM-DD format")
+		}
+	}
+	if strings.TrimSpace(r.DocumentReference) == "" {
+		errs = append(errs, "documentReference is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// KYCUpgradeDecisionRequest approves or rejects a PENDING upgrade. The
+// reviewer must not be the one who submitted it.
+type KYCUpgradeDecisionRequest struct {
+	RequestID string `json:"requestId"`
+	Comment   string `json:"comment"`
+}
+
+func (r KYCUpgradeDecisionRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.RequestID) == "" {
+		errs = append(errs, "requestId is required")
+	}
+	if strings.TrimSpace(r.Comment) == "" {
+		errs = append(errs, "comment is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// GetKYCUpgradesRequest lists one customer's upgrade requests, or the review
+// queue for a status when no customer is given.
+type GetKYCUpgradesRequest struct {
+	CustomerID string `json:"customerId"`
+	Status     string `json:"status"`
+	Limit      int    `json:"limit"`
+}
+
+func (r GetKYCUpgradesRequest) Validate() error {
+	var errs []string
+
+	switch domain.KYCUpgradeStatus(strings.ToUpper(strings.TrimSpace(r.Status))) {
+	case "", domain.KYCUpgradePending, domain.KYCUpgradeApproved, domain.KYCUpgradeRejected:
+	default:
+		errs = append(errs, "status must be PENDING, APPROVED or REJECTED")
+	}
+	if r.Limit < 0 {
+		errs = append(errs, "limit must be a positive integer")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type KYCUpgradeResponse struct {
+	RequestID         string `json:"requestId"`
+	CustomerID        string `json:"customerId"`
+	CurrentLevel      int    `json:"currentLevel"`
+	RequestedLevel    int    `json:"requestedLevel"`
+	DocumentType      string `json:"documentType"`
+	DocumentNumber    string `json:"documentNumber"`
+	IssuingCountry    string `json:"issuingCountry"`
+	DocumentExpiresAt string `json:"documentExpiresAt,omitempty"`
+	DocumentReference string `json:"documentReference"`
+	Status            string `json:"status"`
+	SubmittedBy       string `json:"submittedBy"`
+	ReviewedBy        string `json:"reviewedBy,omitempty"`
+	ReviewComment     string `json:"reviewComment,omitempty"`
+	ReviewedAt        string `json:"reviewedAt,omitempty"`
+	CreatedAt         string `json:"createdAt"`
+}
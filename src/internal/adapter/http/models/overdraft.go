@@ -0,0 +1,92 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// ApproveOverdraftRequest sets the overdraft terms of an account. Approving an
+// account that already has a facility replaces its terms and reactivates it.
+type ApproveOverdraftRequest struct {
+	AccountNumber     string          `json:"accountNumber"`
+	Limit             decimal.Decimal `json:"limit"`
+	AnnualRatePercent decimal.Decimal `json:"annualRatePercent"`
+	DayCount          string          `json:"dayCount,omitempty"`
+	ExpiresAt         *time.Time      `json:"expiresAt"`
+}
+
+func (r ApproveOverdraftRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
+
+	if r.Limit.LessThanOrEqual(decimal.Zero) {
+		errs = append(errs, "limit must be greater than zero")
+	} else if r.Limit.Exponent() < -2 {
+		errs = append(errs, "limit must have at most 2 decimal places")
+	}
+	if r.AnnualRatePercent.IsNegative() || r.AnnualRatePercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		errs = append(errs, "annualRatePercent must be at least 0 and below 100")
+	}
+
+	dayCount := domain.DayCountConvention(strings.ToUpper(strings.TrimSpace(r.DayCount)))
+	if dayCount != "" && dayCount != domain.DayCountActual365 && dayCount != domain.DayCountActual360 {
+		errs = append(errs, "dayCount must be ACT/365 or ACT/360")
+	}
+
+	if r.ExpiresAt == nil {
+		errs = append(errs, "expiresAt is required")
+	} else if !r.ExpiresAt.After(time.Now()) {
+		errs = append(errs, "expiresAt must be in the future")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type GetOverdraftRequest struct {
+	AccountNumber string `json:"accountNumber"`
+}
+
+func (r GetOverdraftRequest) Validate() error {
+	errs := validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type OverdraftFacilityResponse struct {
+	AccountNumber     string          `json:"accountNumber"`
+	Currency          string          `json:"currency"`
+	Limit             decimal.Decimal `json:"limit"`
+	AnnualRatePercent decimal.Decimal `json:"annualRatePercent"`
+	DayCount          string          `json:"dayCount"`
+	Status            string          `json:"status"`
+	ApprovedBy        string          `json:"approvedBy"`
+	ExpiresAt         string          `json:"expiresAt"`
+	SuspendedAt       string          `json:"suspendedAt,omitempty"`
+	SuspensionReason  string          `json:"suspensionReason,omitempty"`
+	AccruedInterest   decimal.Decimal `json:"accruedInterest"`
+	CreatedAt         string          `json:"createdAt"`
+	UpdatedAt         string          `json:"updatedAt"`
+}
+
+// OverdraftBreachResponse is an account drawn beyond its overdraft limit.
+// ExcessAmount is how far the available balance is below -overdraftLimit.
+type OverdraftBreachResponse struct {
+	AccountNumber    string          `json:"accountNumber"`
+	Currency         string          `json:"currency"`
+	AvailableBalance decimal.Decimal `json:"availableBalance"`
+	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
+	OverdraftLimit   decimal.Decimal `json:"overdraftLimit"`
+	ExcessAmount     decimal.Decimal `json:"excessAmount"`
+	FacilityStatus   string          `json:"facilityStatus,omitempty"`
+	ExpiresAt        string          `json:"expiresAt,omitempty"`
+}
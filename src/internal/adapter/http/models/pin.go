@@ -0,0 +1,144 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// ChangePinRequest replaces a transaction PIN the customer still knows. A
+// wrong oldPin counts towards the lockout like any other failed verification.
+type ChangePinRequest struct {
+	CustomerID string `json:"customerId"`
+	OldPin     string `json:"oldPin"`
+	NewPin     string `json:"newPin"`
+}
+
+func (r ChangePinRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if strings.TrimSpace(r.OldPin) == "" {
+		errs = append(errs, "oldPin is required")
+	}
+	errs = append(errs, validateNewPin("newPin", r.NewPin)...)
+	if strings.TrimSpace(r.NewPin) != "" && strings.TrimSpace(r.NewPin) == strings.TrimSpace(r.OldPin) {
+		errs = append(errs, "newPin must differ from oldPin")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type RequestPinResetRequest struct {
+	CustomerID string `json:"customerId"`
+}
+
+func (r RequestPinResetRequest) Validate() error {
+	if strings.TrimSpace(r.CustomerID) == "" {
+		return errors.New("customerId is required")
+	}
+	return nil
+}
+
+// ResetPinRequest replaces a forgotten transaction PIN with the one-time code
+// sent for a PIN_RESET challenge.
+type ResetPinRequest struct {
+	CustomerID  string `json:"customerId"`
+	ChallengeID string `json:"challengeId"`
+	OTP         string `json:"otp"`
+	NewPin      string `json:"newPin"`
+}
+
+func (r ResetPinRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if strings.TrimSpace(r.ChallengeID) == "" {
+		errs = append(errs, "challengeId is required")
+	}
+	if strings.TrimSpace(r.OTP) == "" {
+		errs = append(errs, "otp is required")
+	}
+	errs = append(errs, validateNewPin("newPin", r.NewPin)...)
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// UnlockPinRequest lifts a PIN lockout before it lapses on its own.
+type UnlockPinRequest struct {
+	CustomerID string `json:"customerId"`
+	UnlockedBy string `json:"unlockedBy"`
+	Comment    string `json:"comment,omitempty"`
+}
+
+func (r UnlockPinRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if strings.TrimSpace(r.UnlockedBy) == "" {
+		errs = append(errs, "unlockedBy is required")
+	} else if len(strings.TrimSpace(r.UnlockedBy)) > 64 {
+		errs = append(errs, "unlockedBy must be at most 64 characters")
+	}
+	if len(strings.TrimSpace(r.Comment)) > 255 {
+		errs = append(errs, "comment must be at most 255 characters")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type GetPinStatusRequest struct {
+	CustomerID string `json:"customerId"`
+}
+
+func (r GetPinStatusRequest) Validate() error {
+	if strings.TrimSpace(r.CustomerID) == "" {
+		return errors.New("customerId is required")
+	}
+	return nil
+}
+
+type PinStatusResponse struct {
+	CustomerID        string `json:"customerId"`
+	Locked            bool   `json:"locked"`
+	LockedUntil       string `json:"lockedUntil,omitempty"`
+	FailedAttempts    int    `json:"failedAttempts"`
+	RemainingAttempts int    `json:"remainingAttempts"`
+	PinChangedAt      string `json:"pinChangedAt,omitempty"`
+}
+
+type OTPChallengeResponse struct {
+	ChallengeID string `json:"challengeId"`
+	Purpose     string `json:"purpose"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+func validateNewPin(field string, pin string) []string {
+	pin = strings.TrimSpace(pin)
+	if pin == "" {
+		return []string{field + " is required"}
+	}
+	if err := domain.ValidatePinStrength(pin); err != nil {
+		return []string{field + ": " + err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+type GetCustomerAccountsRequest struct {
+	CustomerID   string `json:"customerId"`
+	BaseCurrency string `json:"baseCurrency,omitempty"`
+}
+
+func (r GetCustomerAccountsRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+
+	ccy := strings.ToUpper(strings.TrimSpace(r.BaseCurrency))
+	if ccy != "" && ccy != "USD" && ccy != "EUR" && ccy != "GBP" && ccy != "NGN" {
+		errs = append(errs, "baseCurrency must be one of USD, EUR, GBP, NGN")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// PortfolioAccountResponse is one account of a customer with its balances
+// converted into the portfolio's base currency at Rate.
+type PortfolioAccountResponse struct {
+	AccountNumber        string                `json:"accountNumber"`
+	Currency             string                `json:"currency"`
+	Status               string                `json:"status"`
+	AvailableBalance     decimal.Decimal       `json:"availableBalance"`
+	LedgerBalance        decimal.Decimal       `json:"ledgerBalance"`
+	ActiveHoldAmount     decimal.Decimal       `json:"activeHoldAmount"`
+	ActiveHolds          []AccountHoldResponse `json:"activeHolds"`
+	Rate                 decimal.Decimal       `json:"rate"`
+	RateDate             string                `json:"rateDate"`
+	BaseAvailableBalance decimal.Decimal       `json:"baseAvailableBalance"`
+	BaseLedgerBalance    decimal.Decimal       `json:"baseLedgerBalance"`
+}
+
+type CustomerAccountsResponse struct {
+	CustomerID            string                     `json:"customerId"`
+	BaseCurrency          string                     `json:"baseCurrency"`
+	Accounts              []PortfolioAccountResponse `json:"accounts"`
+	TotalAvailableBalance decimal.Decimal            `json:"totalAvailableBalance"`
+	TotalLedgerBalance    decimal.Decimal            `json:"totalLedgerBalance"`
+}
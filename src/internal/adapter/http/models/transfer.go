@@ -29,6 +29,7 @@ type InternalTransferRequest struct {
 	TransactionPIN      string          `json:"transactionPIN"`
 	DebitBankName       string          `json:"debitBankName"`
 	CreditBankName      string          `json:"creditBankName"`
+	BeneficiaryName     string          `json:"beneficiaryName,omitempty"`
 	DebitCurrency       string          `json:"debitCurrency"`
 	CreditCurrency      string          `json:"creditCurrency"`
 	DebitAmount         decimal.Decimal `json:"debitAmount"`
@@ -38,14 +39,10 @@ type InternalTransferRequest struct {
 func (r InternalTransferRequest) Validate() error {
 	var errs []string
 
-	if !isTenDigits(r.DebitAccountNumber) {
-		errs = append(errs, "debitAccountNumber must be exactly 10 digits")
-	}
-	if !isTenDigits(r.CreditAccountNumber) {
-		errs = append(errs, "creditAccountNumber must be exactly 10 digits")
-	}
+	errs = append(errs, validateAccountNumber("debitAccountNumber", homeBankCode, r.DebitAccountNumber)...)
 
 	beneficiaryBankCode := strings.TrimSpace(r.BeneficiaryBankCode)
+	errs = append(errs, validateAccountNumber("creditAccountNumber", beneficiaryBankCode, r.CreditAccountNumber)...)
 	if len(beneficiaryBankCode) != 6 || !digitsOnly(beneficiaryBankCode) {
 		errs = append(errs, "beneficiaryBankCode must be exactly 6 digits")
 	}
@@ -83,21 +80,48 @@ func (r InternalTransferRequest) Validate() error {
 }
 
 type InternalTransferResponse struct {
-	TransactionReference string           `json:"transactionReference"`
-	ExternalReference    string           `json:"externalReference"`
-	DebitAccountNumber   string           `json:"debitAccountNumber"`
-	CreditAccountNumber  string           `json:"creditAccountNumber"`
-	BeneficiaryBankCode  string           `json:"beneficiaryBankCode"`
-	DebitCurrency        string           `json:"debitCurrency"`
-	CreditCurrency       string           `json:"creditCurrency"`
-	DebitAmount          *decimal.Decimal `json:"debitAmount"`
-	CreditAmount         *decimal.Decimal `json:"creditAmount"`
-	FcyRate              *decimal.Decimal `json:"fcyRate"`
-	ChargeAmount         *decimal.Decimal `json:"chargeAmount"`
-	VATAmount            *decimal.Decimal `json:"vatAmount"`
-	SumTotalDebit        *decimal.Decimal `json:"sumTotalDebit"`
-	Narration            string           `json:"narration"`
-	Status               string           `json:"status"`
+	TransactionReference string                `json:"transactionReference"`
+	ExternalReference    string                `json:"externalReference"`
+	DebitAccountNumber   string                `json:"debitAccountNumber"`
+	CreditAccountNumber  string                `json:"creditAccountNumber"`
+	BeneficiaryBankCode  string                `json:"beneficiaryBankCode"`
+	DebitCurrency        string                `json:"debitCurrency"`
+	CreditCurrency       string                `json:"creditCurrency"`
+	DebitAmount          *decimal.Decimal      `json:"debitAmount"`
+	CreditAmount         *decimal.Decimal      `json:"creditAmount"`
+	FcyRate              *decimal.Decimal      `json:"fcyRate"`
+	ChargeAmount         *decimal.Decimal      `json:"chargeAmount"`
+	VATAmount            *decimal.Decimal      `json:"vatAmount"`
+	SumTotalDebit        *decimal.Decimal      `json:"sumTotalDebit"`
+	Narration            string                `json:"narration"`
+	Status               string                `json:"status"`
+	ChannelID            string                `json:"channelId,omitempty"`
+	StepUpChallenge      *OTPChallengeResponse `json:"stepUpChallenge,omitempty"`
+}
+
+// ConfirmTransferRequest completes a transfer that was answered with an
+// OTP_REQUIRED step-up challenge.
+type ConfirmTransferRequest struct {
+	DebitAccountNumber string `json:"debitAccountNumber"`
+	ChallengeID        string `json:"challengeId"`
+	OTP                string `json:"otp"`
+}
+
+func (r ConfirmTransferRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("debitAccountNumber", homeBankCode, r.DebitAccountNumber)...)
+	if strings.TrimSpace(r.ChallengeID) == "" {
+		errs = append(errs, "challengeId is required")
+	}
+	if strings.TrimSpace(r.OTP) == "" {
+		errs = append(errs, "otp is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
 }
 
 func isAllowedNarration(value string) bool {
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type TransferReviewDecisionRequest struct {
+	TransactionReference string `json:"transactionReference"`
+	Comment              string `json:"comment"`
+}
+
+func (r TransferReviewDecisionRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.TransactionReference) == "" {
+		errs = append(errs, "transactionReference is required")
+	}
+	if strings.TrimSpace(r.Comment) == "" {
+		errs = append(errs, "comment is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type TransferReviewDecisionResponse struct {
+	Action    string    `json:"action"`
+	Actor     string    `json:"actor"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TransferReviewResponse struct {
+	Transfer    InternalTransferResponse         `json:"transfer"`
+	Reason      string                           `json:"reason"`
+	Status      string                           `json:"status"`
+	InitiatedBy string                           `json:"initiatedBy"`
+	HeldAmount  decimal.Decimal                  `json:"heldAmount"`
+	DecidedBy   string                           `json:"decidedBy,omitempty"`
+	DecidedAt   *time.Time                       `json:"decidedAt,omitempty"`
+	CreatedAt   time.Time                        `json:"createdAt"`
+	Decisions   []TransferReviewDecisionResponse `json:"decisions,omitempty"`
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"net/mail"
 	"strings"
 	"time"
 
@@ -14,6 +15,8 @@ type CreateUserRequest struct {
 	LastName       string `json:"lastName"`
 	DOB            string `json:"dob"`
 	PhoneNumber    string `json:"phoneNumber"`
+	Email          string `json:"email,omitempty"`
+	Address        string `json:"address,omitempty"`
 	IDType         string `json:"idType"`
 	IDNumber       string `json:"idNumber"`
 	KYCLevel       int    `json:"kycLevel"`
@@ -37,6 +40,7 @@ func (r CreateUserRequest) Validate() error {
 	if strings.TrimSpace(r.PhoneNumber) == "" {
 		errs = append(errs, "phoneNumber is required")
 	}
+	errs = append(errs, validateEmail(r.Email)...)
 	idType := strings.TrimSpace(r.IDType)
 	if idType == "" {
 		errs = append(errs, "idType is required")
@@ -49,9 +53,7 @@ func (r CreateUserRequest) Validate() error {
 	if r.KYCLevel <= 0 {
 		errs = append(errs, "kycLevel must be greater than zero")
 	}
-	if strings.TrimSpace(r.TransactionPin) == "" {
-		errs = append(errs, "transactionPin is required")
-	}
+	errs = append(errs, validateNewPin("transactionPin", r.TransactionPin)...)
 
 	if len(errs) > 0 {
 		return errors.New(strings.Join(errs, "; "))
@@ -61,26 +63,31 @@ func (r CreateUserRequest) Validate() error {
 }
 
 type CreateUserResponse struct {
-	ID         string `json:"id"`
-	CustomerID string `json:"customerId"`
-	FirstName  string `json:"firstName"`
-	LastName   string `json:"lastName"`
+	ID                   string   `json:"id"`
+	CustomerID           string   `json:"customerId"`
+	FirstName            string   `json:"firstName"`
+	LastName             string   `json:"lastName"`
+	Status               string   `json:"status"`
+	PotentialDuplicateOf []string `json:"potentialDuplicateOf,omitempty"`
 }
 
 type GetUserResponse struct {
-	ID                 string  `json:"id"`
-	CustomerID         string  `json:"customerId"`
-	FirstName          string  `json:"firstName"`
-	MiddleName         *string `json:"middleName,omitempty"`
-	LastName           string  `json:"lastName"`
-	DOB                string  `json:"dob"`
-	PhoneNumber        string  `json:"phoneNumber"`
-	IDType             string  `json:"idType"`
-	IDNumber           string  `json:"idNumber"`
-	KYCLevel           int     `json:"kycLevel"`
-	TransactionPinHash string  `json:"transactionPinHash"`
-	CreatedAt          string  `json:"createdAt"`
-	UpdatedAt          string  `json:"updatedAt"`
+	ID                   string  `json:"id"`
+	CustomerID           string  `json:"customerId"`
+	FirstName            string  `json:"firstName"`
+	MiddleName           *string `json:"middleName,omitempty"`
+	LastName             string  `json:"lastName"`
+	DOB                  string  `json:"dob"`
+	PhoneNumber          string  `json:"phoneNumber"`
+	Email                *string `json:"email,omitempty"`
+	Address              *string `json:"address,omitempty"`
+	IDType               string  `json:"idType"`
+	IDNumber             string  `json:"idNumber"`
+	KYCLevel             int     `json:"kycLevel"`
+	Status               string  `json:"status"`
+	MergedIntoCustomerID *string `json:"mergedIntoCustomerId,omitempty"`
+	CreatedAt            string  `json:"createdAt"`
+	UpdatedAt            string  `json:"updatedAt"`
 }
 
 type VerifyUserPinRequest struct {
@@ -109,3 +116,111 @@ type VerifyUserPinResponse struct {
 	CustomerID string `json:"customerId"`
 	IsValidPin bool   `json:"isValidPin"`
 }
+
+// ComplianceDecisionRequest clears or rejects a customer held on a potential
+// sanctions match. The reviewer is the authenticated caller.
+type ComplianceDecisionRequest struct {
+	CustomerID string `json:"customerId"`
+	Comment    string `json:"comment"`
+}
+
+func (r ComplianceDecisionRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if strings.TrimSpace(r.Comment) == "" {
+		errs = append(errs, "comment is required")
+	} else if len(strings.TrimSpace(r.Comment)) > 255 {
+		errs = append(errs, "comment must be at most 255 characters")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// UpdateContactDetailsRequest changes a customer's contact details. Omitted
+// fields keep their current value; an empty email or address clears it.
+type UpdateContactDetailsRequest struct {
+	CustomerID  string  `json:"customerId"`
+	PhoneNumber *string `json:"phoneNumber,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	Address     *string `json:"address,omitempty"`
+	UpdatedBy   string  `json:"updatedBy"`
+}
+
+func (r UpdateContactDetailsRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if strings.TrimSpace(r.UpdatedBy) == "" {
+		errs = append(errs, "updatedBy is required")
+	}
+	if r.PhoneNumber == nil && r.Email == nil && r.Address == nil {
+		errs = append(errs, "at least one of phoneNumber, email or address is required")
+	}
+	if r.PhoneNumber != nil && strings.TrimSpace(*r.PhoneNumber) == "" {
+		errs = append(errs, "phoneNumber cannot be empty")
+	}
+	if r.Email != nil {
+		errs = append(errs, validateEmail(*r.Email)...)
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type UpdateContactDetailsResponse struct {
+	User    GetUserResponse         `json:"user"`
+	Changes []ProfileChangeResponse `json:"changes"`
+}
+
+type GetProfileChangesRequest struct {
+	CustomerID string `json:"customerId"`
+	Limit      int    `json:"limit"`
+}
+
+func (r GetProfileChangesRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.CustomerID) == "" {
+		errs = append(errs, "customerId is required")
+	}
+	if r.Limit < 0 {
+		errs = append(errs, "limit must be a positive integer")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type ProfileChangeResponse struct {
+	Field     string `json:"field"`
+	OldValue  string `json:"oldValue"`
+	NewValue  string `json:"newValue"`
+	ChangedBy string `json:"changedBy"`
+	ChangedAt string `json:"changedAt"`
+}
+
+func validateEmail(email string) []string {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil
+	}
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return []string{"email must be a valid email address"}
+	}
+	return nil
+}
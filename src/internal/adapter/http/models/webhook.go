@@ -0,0 +1,98 @@
+package models
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type CreateWebhookSubscriptionRequest struct {
+	ChannelID  string   `json:"channelId"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"eventTypes"`
+}
+
+func (r CreateWebhookSubscriptionRequest) Validate() error {
+	var errs []string
+
+	if strings.TrimSpace(r.ChannelID) == "" {
+		errs = append(errs, "channelId is required")
+	}
+
+	rawURL := strings.TrimSpace(r.URL)
+	if rawURL == "" {
+		errs = append(errs, "url is required")
+	} else if parsed, err := url.Parse(rawURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errs = append(errs, "url must be an absolute http or https url")
+	}
+
+	for _, eventType := range r.EventTypes {
+		if !isSupportedTransferEventType(eventType) {
+			errs = append(errs, "eventTypes contains unsupported event "+strings.TrimSpace(eventType))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+type WebhookSubscriptionResponse struct {
+	ID         string   `json:"id"`
+	ChannelID  string   `json:"channelId"`
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret,omitempty"`
+	EventTypes []string `json:"eventTypes"`
+	Status     string   `json:"status"`
+	CreatedAt  string   `json:"createdAt"`
+	UpdatedAt  string   `json:"updatedAt"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID               string  `json:"id"`
+	SubscriptionID   string  `json:"subscriptionId"`
+	EventID          string  `json:"eventId"`
+	EventType        string  `json:"eventType"`
+	TransferID       string  `json:"transferId,omitempty"`
+	Status           string  `json:"status"`
+	Attempts         int     `json:"attempts"`
+	NextAttemptAt    string  `json:"nextAttemptAt"`
+	LastAttemptAt    string  `json:"lastAttemptAt,omitempty"`
+	LastResponseCode *int    `json:"lastResponseCode,omitempty"`
+	LastError        *string `json:"lastError,omitempty"`
+	RedeliveryOf     string  `json:"redeliveryOf,omitempty"`
+	CreatedAt        string  `json:"createdAt"`
+}
+
+type RedeliverWebhookRequest struct {
+	DeliveryID string `json:"deliveryId"`
+}
+
+func (r RedeliverWebhookRequest) Validate() error {
+	if strings.TrimSpace(r.DeliveryID) == "" {
+		return errors.New("deliveryId is required")
+	}
+	return nil
+}
+
+// TransferWebhookEvent is the JSON body posted to subscriber endpoints.
+type TransferWebhookEvent struct {
+	EventID    string                   `json:"eventId"`
+	EventType  string                   `json:"eventType"`
+	OccurredAt string                   `json:"occurredAt"`
+	TransferID string                   `json:"transferId"`
+	Data       InternalTransferResponse `json:"data"`
+}
+
+func isSupportedTransferEventType(value string) bool {
+	for _, eventType := range domain.TransferEventTypes {
+		if strings.EqualFold(strings.TrimSpace(value), string(eventType)) {
+			return true
+		}
+	}
+	return false
+}
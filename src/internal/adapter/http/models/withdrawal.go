@@ -0,0 +1,94 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+type WithdrawFundsRequest struct {
+	AccountNumber  string          `json:"accountNumber"`
+	Currency       string          `json:"currency"`
+	Amount         decimal.Decimal `json:"amount"`
+	TransactionPIN string          `json:"transactionPIN"`
+	Narration      string          `json:"narration,omitempty"`
+}
+
+func (r WithdrawFundsRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
+
+	ccy := strings.ToUpper(strings.TrimSpace(r.Currency))
+	if ccy == "" {
+		errs = append(errs, "currency is required")
+	} else if ccy != "USD" && ccy != "EUR" && ccy != "GBP" && ccy != "NGN" {
+		errs = append(errs, "currency must be one of USD, EUR, GBP, NGN")
+	}
+
+	if r.Amount.LessThanOrEqual(decimal.Zero) {
+		errs = append(errs, "amount must be greater than zero")
+	} else if r.Amount.Exponent() < -2 {
+		errs = append(errs, "amount must have at most 2 decimal places")
+	}
+
+	if strings.TrimSpace(r.TransactionPIN) == "" {
+		errs = append(errs, "transactionPIN is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// ConfirmWithdrawalRequest completes a withdrawal that was answered with an
+// OTP challenge.
+type ConfirmWithdrawalRequest struct {
+	AccountNumber string `json:"accountNumber"`
+	ChallengeID   string `json:"challengeId"`
+	OTP           string `json:"otp"`
+}
+
+func (r ConfirmWithdrawalRequest) Validate() error {
+	var errs []string
+
+	errs = append(errs, validateAccountNumber("accountNumber", homeBankCode, r.AccountNumber)...)
+	if strings.TrimSpace(r.ChallengeID) == "" {
+		errs = append(errs, "challengeId is required")
+	}
+	if strings.TrimSpace(r.OTP) == "" {
+		errs = append(errs, "otp is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type JournalEntryResponse struct {
+	AccountNumber string          `json:"accountNumber"`
+	EntryType     string          `json:"entryType"`
+	Currency      string          `json:"currency"`
+	Amount        decimal.Decimal `json:"amount"`
+}
+
+// WithdrawFundsResponse is the receipt for a withdrawal. A withdrawal waiting
+// for its step-up code carries only the request fields and StepUpChallenge.
+type WithdrawFundsResponse struct {
+	Reference        string                 `json:"reference"`
+	AccountNumber    string                 `json:"accountNumber"`
+	Currency         string                 `json:"currency"`
+	Amount           decimal.Decimal        `json:"amount"`
+	ChargeAmount     decimal.Decimal        `json:"chargeAmount"`
+	VATAmount        decimal.Decimal        `json:"vatAmount"`
+	TotalDebit       decimal.Decimal        `json:"totalDebit"`
+	AvailableBalance decimal.Decimal        `json:"availableBalance"`
+	LedgerBalance    decimal.Decimal        `json:"ledgerBalance"`
+	Narration        string                 `json:"narration,omitempty"`
+	JournalEntries   []JournalEntryResponse `json:"journalEntries"`
+	CreatedAt        string                 `json:"createdAt"`
+	StepUpChallenge  *OTPChallengeResponse  `json:"stepUpChallenge,omitempty"`
+}
@@ -6,6 +6,10 @@ type AccountRouteRegistrar interface {
 	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
 }
 
+type AccountHoldRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
 type UserRouteRegistrar interface {
 	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
 }
@@ -26,13 +30,74 @@ type TransferRouteRegistrar interface {
 	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
 }
 
+type TransferReviewRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type ExchangeRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type PortfolioRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type InterestRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type OverdraftRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type DormancyRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type PinRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type KYCRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type CustomerDuplicateRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type CustomerDataRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type ChannelRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
+type WebhookRouteRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux, authMiddleware func(http.Handler) http.Handler)
+}
+
 func New(
 	accountController AccountRouteRegistrar,
+	accountHoldController AccountHoldRouteRegistrar,
 	userController UserRouteRegistrar,
 	participantBankController ParticipantBankRouteRegistrar,
 	rateController RateRouteRegistrar,
 	chargesController ChargesRouteRegistrar,
 	transferController TransferRouteRegistrar,
+	transferReviewController TransferReviewRouteRegistrar,
+	exchangeController ExchangeRouteRegistrar,
+	portfolioController PortfolioRouteRegistrar,
+	interestController InterestRouteRegistrar,
+	overdraftController OverdraftRouteRegistrar,
+	dormancyController DormancyRouteRegistrar,
+	pinController PinRouteRegistrar,
+	kycController KYCRouteRegistrar,
+	customerDuplicateController CustomerDuplicateRouteRegistrar,
+	customerDataController CustomerDataRouteRegistrar,
+	channelController ChannelRouteRegistrar,
+	webhookController WebhookRouteRegistrar,
 	authMiddleware func(http.Handler) http.Handler,
 ) *http.ServeMux {
 	mux := http.NewServeMux()
@@ -41,6 +106,9 @@ func New(
 	if accountController != nil {
 		accountController.RegisterRoutes(mux, authMiddleware)
 	}
+	if accountHoldController != nil {
+		accountHoldController.RegisterRoutes(mux, authMiddleware)
+	}
 	if userController != nil {
 		userController.RegisterRoutes(mux, authMiddleware)
 	}
@@ -56,6 +124,42 @@ func New(
 	if transferController != nil {
 		transferController.RegisterRoutes(mux, authMiddleware)
 	}
+	if transferReviewController != nil {
+		transferReviewController.RegisterRoutes(mux, authMiddleware)
+	}
+	if exchangeController != nil {
+		exchangeController.RegisterRoutes(mux, authMiddleware)
+	}
+	if portfolioController != nil {
+		portfolioController.RegisterRoutes(mux, authMiddleware)
+	}
+	if interestController != nil {
+		interestController.RegisterRoutes(mux, authMiddleware)
+	}
+	if overdraftController != nil {
+		overdraftController.RegisterRoutes(mux, authMiddleware)
+	}
+	if dormancyController != nil {
+		dormancyController.RegisterRoutes(mux, authMiddleware)
+	}
+	if pinController != nil {
+		pinController.RegisterRoutes(mux, authMiddleware)
+	}
+	if kycController != nil {
+		kycController.RegisterRoutes(mux, authMiddleware)
+	}
+	if customerDuplicateController != nil {
+		customerDuplicateController.RegisterRoutes(mux, authMiddleware)
+	}
+	if customerDataController != nil {
+		customerDataController.RegisterRoutes(mux, authMiddleware)
+	}
+	if channelController != nil {
+		channelController.RegisterRoutes(mux, authMiddleware)
+	}
+	if webhookController != nil {
+		webhookController.RegisterRoutes(mux, authMiddleware)
+	}
 
 	return mux
 }
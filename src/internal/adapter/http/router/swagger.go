@@ -69,6 +69,7 @@ const openAPI = `{
                 "properties": {
                   "customerId": {"type": "string"},
                   "currency": {"type": "string", "enum": ["USD", "EUR", "GBP", "NGN"]},
+                  "product": {"type": "string", "enum": ["STANDARD", "SAVINGS"], "description": "Selects the interest rate table; defaults to STANDARD"},
                   "initialDeposit": {"type": "number", "format": "double", "example": 100.00}
                 }
               }
@@ -92,6 +93,7 @@ const openAPI = `{
                         "customerId": {"type": "string"},
                         "accountNumber": {"type": "string"},
                         "currency": {"type": "string"},
+                        "product": {"type": "string", "enum": ["STANDARD", "SAVINGS"]},
                         "availableBalance": {"type": "number", "format": "double", "example": 100.00},
                         "ledgerBalance": {"type": "number", "format": "double", "example": 100.00},
                         "status": {"type": "string"},
@@ -113,9 +115,11 @@ const openAPI = `{
     "/get-account": {
       "get": {
         "summary": "Get account by account number",
+        "description": "With customer tokens enabled, another customer's account is returned as a name enquiry: accountName, accountNumber, bankCode, bankName and currency only.",
         "security": [
           {
-            "BasicAuth": []
+            "BasicAuth": [],
+            "CustomerToken": []
           }
         ],
         "parameters": [
@@ -158,9 +162,12 @@ const openAPI = `{
                         "bankCode": {"type": "string"},
                         "bankName": {"type": "string"},
                         "currency": {"type": "string"},
+                        "product": {"type": "string", "enum": ["STANDARD", "SAVINGS"]},
                         "availableBalance": {"type": "number", "format": "double", "example": 100.00},
                         "ledgerBalance": {"type": "number", "format": "double", "example": 100.00},
-                        "status": {"type": "string"},
+                        "overdraftLimit": {"type": "number", "format": "double", "example": 0.00, "description": "Approved overdraft; debits may take availableBalance down to -overdraftLimit"},
+                        "status": {"type": "string", "enum": ["ACTIVE", "FROZEN", "DORMANT", "CLOSED"]},
+                        "lastActivityAt": {"type": "string", "format": "date-time", "description": "Last customer-initiated debit, opening or reactivation; the account becomes DORMANT after DORMANCY_PERIOD_DAYS without one"},
                         "createdAt": {"type": "string"},
                         "updatedAt": {"type": "string"}
                       }
@@ -171,7 +178,7 @@ const openAPI = `{
             }
           },
           "400": {"description": "Validation error"},
-          "401": {"description": "Unauthorized"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
           "500": {"description": "Server error"}
         }
       }
@@ -179,9 +186,11 @@ const openAPI = `{
     "/deposit-funds": {
       "post": {
         "summary": "Deposit funds into an internal account",
+        "description": "The account is credited against the funding GL account of its currency. Deposits are unique per source and externalReference: replaying a deposit with the same account and amount returns the original receipt, anything else is rejected as a duplicate.",
         "security": [
           {
-            "BasicAuth": []
+            "BasicAuth": [],
+            "CustomerToken": []
           }
         ],
         "requestBody": {
@@ -190,10 +199,13 @@ const openAPI = `{
             "application/json": {
               "schema": {
                 "type": "object",
-                "required": ["accountNumber", "amount"],
+                "required": ["accountNumber", "amount", "source", "externalReference"],
                 "properties": {
-                  "accountNumber": {"type": "string", "example": "0123456789"},
-                  "amount": {"type": "number", "format": "double", "example": 100.00}
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "amount": {"type": "number", "format": "double", "example": 100.00},
+                  "source": {"type": "string", "enum": ["CASH", "CARD", "EXTERNAL_BANK"], "example": "EXTERNAL_BANK"},
+                  "externalReference": {"type": "string", "maxLength": 64, "example": "NIP-000123"},
+                  "narration": {"type": "string", "example": "Inward transfer"}
                 }
               }
             }
@@ -201,7 +213,7 @@ const openAPI = `{
         },
         "responses": {
           "200": {
-            "description": "Deposit successful",
+            "description": "Deposit successful, or deposit already recorded",
             "content": {
               "application/json": {
                 "schema": {
@@ -212,11 +224,27 @@ const openAPI = `{
                     "data": {
                       "type": "object",
                       "properties": {
+                        "reference": {"type": "string"},
                         "accountNumber": {"type": "string"},
                         "currency": {"type": "string"},
                         "depositedAmount": {"type": "number", "format": "double", "example": 100.00},
                         "availableBalance": {"type": "number", "format": "double", "example": 500.00},
-                        "ledgerBalance": {"type": "number", "format": "double", "example": 500.00}
+                        "ledgerBalance": {"type": "number", "format": "double", "example": 500.00},
+                        "source": {"type": "string"},
+                        "externalReference": {"type": "string"},
+                        "journalEntries": {
+                          "type": "array",
+                          "items": {
+                            "type": "object",
+                            "properties": {
+                              "accountNumber": {"type": "string"},
+                              "entryType": {"type": "string", "enum": ["DEBIT", "CREDIT"]},
+                              "currency": {"type": "string"},
+                              "amount": {"type": "number", "format": "double"}
+                            }
+                          }
+                        },
+                        "createdAt": {"type": "string", "format": "date-time"}
                       }
                     }
                   }
@@ -225,48 +253,84 @@ const openAPI = `{
             }
           },
           "400": {"description": "Validation error"},
-          "401": {"description": "Unauthorized"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer"},
           "404": {"description": "Account not found"},
+          "409": {"description": "Account is frozen or closed, or duplicate external reference"},
           "500": {"description": "Server error"}
         }
       }
     },
-    "/get-participant-banks": {
+    "/get-deposit": {
       "get": {
-        "summary": "Get participant banks",
+        "summary": "Get a deposit by reference or by source and external reference",
         "security": [
           {
-            "BasicAuth": []
+            "BasicAuth": [],
+            "CustomerToken": []
           }
         ],
-        "responses": {
-          "200": {"description": "Participant banks fetched"},
-          "401": {"description": "Unauthorized"},
-          "500": {"description": "Server error"}
-        }
-      }
-    },
-    "/get-rates": {
-      "get": {
-        "summary": "Get all rates",
-        "security": [
-          {
-            "BasicAuth": []
-          }
+        "parameters": [
+          {"name": "reference", "in": "query", "required": false, "schema": {"type": "string"}},
+          {"name": "source", "in": "query", "required": false, "schema": {"type": "string", "enum": ["CASH", "CARD", "EXTERNAL_BANK"]}},
+          {"name": "externalReference", "in": "query", "required": false, "schema": {"type": "string"}}
         ],
         "responses": {
-          "200": {"description": "Rates fetched"},
-          "401": {"description": "Unauthorized"},
+          "200": {
+            "description": "Deposit fetched",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "object",
+                      "properties": {
+                        "reference": {"type": "string"},
+                        "accountNumber": {"type": "string"},
+                        "currency": {"type": "string"},
+                        "amount": {"type": "number", "format": "double"},
+                        "source": {"type": "string"},
+                        "externalReference": {"type": "string"},
+                        "narration": {"type": "string"},
+                        "journalEntries": {
+                          "type": "array",
+                          "items": {
+                            "type": "object",
+                            "properties": {
+                              "accountNumber": {"type": "string"},
+                              "entryType": {"type": "string", "enum": ["DEBIT", "CREDIT"]},
+                              "currency": {"type": "string"},
+                              "amount": {"type": "number", "format": "double"}
+                            }
+                          }
+                        },
+                        "createdAt": {"type": "string", "format": "date-time"}
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer"},
+          "404": {"description": "Deposit not found"},
           "500": {"description": "Server error"}
         }
       }
     },
-    "/get-rate": {
+    "/withdraw-funds": {
       "post": {
-        "summary": "Get a specific rate by currency pair",
+        "summary": "Withdraw cash from an internal account",
+        "description": "Requires the transaction PIN. The account is debited the amount plus withdrawal fees; the amount is paid out against the cash GL account of the currency and the fees settle to the charges and VAT accounts. The receipt lists the journal entries posted. Withdrawals are not run through fraud/AML screening, whose rules and HELD review apply to transfers; they are bounded by the KYC limit and the transfer step-up threshold instead.",
         "security": [
           {
-            "BasicAuth": []
+            "BasicAuth": [],
+            "CustomerToken": []
           }
         ],
         "requestBody": {
@@ -275,66 +339,89 @@ const openAPI = `{
             "application/json": {
               "schema": {
                 "type": "object",
-                "required": ["fromCurrency", "toCurrency"],
+                "required": ["accountNumber", "currency", "amount", "transactionPIN"],
                 "properties": {
-                  "fromCurrency": {"type": "string", "example": "USD"},
-                  "toCurrency": {"type": "string", "example": "NGN"}
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "currency": {"type": "string", "example": "USD"},
+                  "amount": {"type": "number", "format": "double", "example": 50.00},
+                  "transactionPIN": {"type": "string", "example": "1234"},
+                  "narration": {"type": "string", "example": "Teller cash withdrawal"}
                 }
               }
             }
           }
         },
         "responses": {
-          "200": {"description": "Rate fetched"},
-          "400": {"description": "Validation error"},
-          "401": {"description": "Unauthorized"},
-          "500": {"description": "Server error"}
-        }
-      }
-    },
-    "/get-charges": {
-      "get": {
-        "summary": "Get charge and VAT breakdown",
-        "security": [
-          {
-            "BasicAuth": []
-          }
-        ],
-        "parameters": [
-          {
-            "name": "amount",
-            "in": "query",
-            "required": true,
-            "schema": {
-              "type": "number",
-              "format": "double",
-              "example": 100.00
+          "200": {
+            "description": "Withdrawal successful",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "object",
+                      "properties": {
+                        "reference": {"type": "string"},
+                        "accountNumber": {"type": "string"},
+                        "currency": {"type": "string"},
+                        "amount": {"type": "number", "format": "double", "example": 50.00},
+                        "chargeAmount": {"type": "number", "format": "double", "example": 1.00},
+                        "vatAmount": {"type": "number", "format": "double", "example": 0.08},
+                        "totalDebit": {"type": "number", "format": "double", "example": 51.08},
+                        "availableBalance": {"type": "number", "format": "double", "example": 448.92},
+                        "ledgerBalance": {"type": "number", "format": "double", "example": 448.92},
+                        "narration": {"type": "string"},
+                        "journalEntries": {
+                          "type": "array",
+                          "items": {
+                            "type": "object",
+                            "properties": {
+                              "accountNumber": {"type": "string"},
+                              "entryType": {"type": "string", "enum": ["DEBIT", "CREDIT"]},
+                              "currency": {"type": "string"},
+                              "amount": {"type": "number", "format": "double"}
+                            }
+                          }
+                        },
+                        "createdAt": {"type": "string", "format": "date-time"},
+                        "stepUpChallenge": {
+                          "type": "object",
+                          "properties": {
+                            "challengeId": {"type": "string", "format": "uuid"},
+                            "purpose": {"type": "string", "example": "WITHDRAWAL_STEP_UP"},
+                            "expiresAt": {"type": "string", "format": "date-time"}
+                          }
+                        }
+                      }
+                    }
+                  }
+                }
+              }
             }
           },
-          {
-            "name": "fromCurrency",
-            "in": "query",
-            "required": true,
-            "schema": {
-              "type": "string",
-              "example": "USD"
-            }
-          }
-        ],
-        "responses": {
-          "200": {"description": "Charges fetched"},
-          "400": {"description": "Validation error"},
-          "401": {"description": "Unauthorized"},
+          "202": {"description": "OTP required: the amount, normalized to TRANSFER_STEP_UP_CURRENCY, exceeds TRANSFER_STEP_UP_THRESHOLD. Nothing is posted until the code in stepUpChallenge is submitted to /confirm-withdrawal"},
+          "400": {"description": "Validation error or invalid transaction PIN"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer"},
+          "404": {"description": "Account not found"},
+          "409": {"description": "Account is frozen or closed"},
+          "422": {"description": "Insufficient balance, or amount above the per-transfer limit of the customer's KYC level (KYC_TRANSFER_LIMITS)"},
+          "423": {"description": "Transaction PIN locked after too many failed attempts"},
           "500": {"description": "Server error"}
         }
       }
     },
-    "/convert-fcy-amount": {
+    "/confirm-withdrawal": {
       "post": {
-        "summary": "Get converted amount by currency pair",
+        "summary": "Complete a withdrawal held for a step-up OTP",
+        "description": "Submits the code sent for a withdrawal answered with stepUpChallenge. The original request is replayed without re-checking the pin; the account, KYC limit, charges and balance are evaluated at confirmation.",
         "security": [
           {
-            "BasicAuth": []
+            "BasicAuth": [],
+            "CustomerToken": []
           }
         ],
         "requestBody": {
@@ -343,30 +430,36 @@ const openAPI = `{
             "application/json": {
               "schema": {
                 "type": "object",
-                "required": ["amount", "fromCcy", "toCcy"],
+                "required": ["accountNumber", "challengeId", "otp"],
                 "properties": {
-                  "amount": {"type": "number", "format": "double", "example": 1500.00},
-                  "fromCcy": {"type": "string", "example": "USD"},
-                  "toCcy": {"type": "string", "example": "NGN"}
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "challengeId": {"type": "string", "format": "uuid"},
+                  "otp": {"type": "string", "example": "482913"}
                 }
               }
             }
           }
         },
         "responses": {
-          "200": {"description": "Converted rate fetched"},
-          "400": {"description": "Validation error"},
-          "401": {"description": "Unauthorized"},
+          "200": {"description": "Withdrawal successful"},
+          "400": {"description": "Validation error or invalid or expired otp"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer"},
+          "404": {"description": "Account not found"},
+          "409": {"description": "Account is frozen or closed"},
+          "422": {"description": "Insufficient balance, or amount above the per-transfer limit of the customer's KYC level (KYC_TRANSFER_LIMITS)"},
           "500": {"description": "Server error"}
         }
       }
     },
-    "/transfer-funds": {
+    "/exchange": {
       "post": {
-        "summary": "Transfer funds in multiple currencies",
+        "summary": "Exchange funds between two accounts of the same customer",
+        "description": "Requires the transaction PIN. The source account is debited the amount plus exchange fees and the destination account is credited at the quoted rate, which is the stored rate less EXCHANGE_SPREAD_PERCENT. The spread is booked to the FX income GL account of the destination currency.",
         "security": [
           {
-            "BasicAuth": []
+            "BasicAuth": [],
+            "CustomerToken": []
           }
         ],
         "requestBody": {
@@ -375,63 +468,82 @@ const openAPI = `{
             "application/json": {
               "schema": {
                 "type": "object",
-                "required": [
-                  "debitAccountNumber",
-                  "creditAccountNumber",
-                  "beneficiaryBankCode",
-                  "transactionPIN",
-                  "debitBankName",
-                  "creditBankName",
-                  "debitCurrency",
-                  "creditCurrency",
-                  "debitAmount",
-                  "narration"
-                ],
+                "required": ["sourceAccountNumber", "destinationAccountNumber", "amount", "transactionPIN"],
                 "properties": {
-                  "debitAccountNumber": {"type": "string", "example": "0123456789"},
-                  "creditAccountNumber": {"type": "string", "example": "0123456790"},
-                  "beneficiaryBankCode": {"type": "string", "example": "100100"},
+                  "sourceAccountNumber": {"type": "string", "example": "0123456788"},
+                  "destinationAccountNumber": {"type": "string", "example": "0000000011"},
+                  "amount": {"type": "number", "format": "double", "example": 100.00},
                   "transactionPIN": {"type": "string", "example": "1234"},
-                  "debitBankName": {"type": "string", "example": "Grey"},
-                  "creditBankName": {"type": "string", "example": "Grey"},
-                  "debitCurrency": {"type": "string", "example": "USD"},
-                  "creditCurrency": {"type": "string", "example": "USD"},
-                  "debitAmount": {"type": "number", "format": "double", "example": 100.00},
-                  "narration": {
-                    "type": "string",
-                    "enum": [
-                      "Travels and Holiday",
-                      "Salary",
-                      "Project charge",
-                      "Food and consumables",
-                      "Transportation",
-                      "Accomodation",
-                      "utility bill",
-                      "savings",
-                      "investment",
-                      "loan",
-                      "loan repayment",
-                      "others"
-                    ]
-                  }
+                  "narration": {"type": "string", "example": "USD to NGN"}
                 }
               }
             }
           }
         },
         "responses": {
-          "200": {"description": "Transfer processed"},
-          "400": {"description": "Validation error"},
-          "401": {"description": "Unauthorized"},
+          "200": {
+            "description": "Exchange successful",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "object",
+                      "properties": {
+                        "reference": {"type": "string"},
+                        "sourceAccountNumber": {"type": "string"},
+                        "destinationAccountNumber": {"type": "string"},
+                        "sourceCurrency": {"type": "string", "example": "USD"},
+                        "destinationCurrency": {"type": "string", "example": "NGN"},
+                        "sourceAmount": {"type": "number", "format": "double", "example": 100.00},
+                        "destinationAmount": {"type": "number", "format": "double", "example": 132499.62},
+                        "midRate": {"type": "number", "format": "double", "example": 1338.380059},
+                        "quotedRate": {"type": "number", "format": "double", "example": 1324.99625841},
+                        "spreadPercent": {"type": "number", "format": "double", "example": 1},
+                        "chargeAmount": {"type": "number", "format": "double", "example": 0},
+                        "vatAmount": {"type": "number", "format": "double", "example": 0},
+                        "totalDebit": {"type": "number", "format": "double", "example": 100.00},
+                        "sourceAvailableBalance": {"type": "number", "format": "double"},
+                        "destinationAvailableBalance": {"type": "number", "format": "double"},
+                        "narration": {"type": "string"},
+                        "journalEntries": {
+                          "type": "array",
+                          "items": {
+                            "type": "object",
+                            "properties": {
+                              "accountNumber": {"type": "string"},
+                              "entryType": {"type": "string", "enum": ["DEBIT", "CREDIT"]},
+                              "currency": {"type": "string"},
+                              "amount": {"type": "number", "format": "double"}
+                            }
+                          }
+                        },
+                        "createdAt": {"type": "string", "format": "date-time"}
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "400": {"description": "Validation error, same-currency accounts or invalid transaction PIN"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Accounts belong to different customers, or the source account does not belong to the token's customer"},
           "404": {"description": "Account or rate not found"},
+          "409": {"description": "Account is frozen or closed"},
           "422": {"description": "Insufficient balance"},
+          "423": {"description": "Transaction PIN locked after too many failed attempts"},
           "500": {"description": "Server error"}
         }
       }
     },
-    "/create-user": {
+    "/run-interest-batch": {
       "post": {
-        "summary": "Create user",
+        "summary": "Run the interest batch for a date",
+        "description": "Accrues one day of interest on every positive balance from the tiered rate table and, when the date is the last day of a month, capitalizes the open accruals: the gross amount is debited from the interest expense GL account, WITHHOLDING_TAX_PERCENT of it is credited to the withholding tax GL account and the rest to the customer account. Accruals and capitalizations are keyed by account and date, so a date can be run again without double-posting. The same batch runs automatically for the previous day.",
         "security": [
           {
             "BasicAuth": []
@@ -443,42 +555,84 @@ const openAPI = `{
             "application/json": {
               "schema": {
                 "type": "object",
-                "required": [
-                  "firstName",
-                  "lastName",
-                  "dob",
-                  "phoneNumber",
-                  "idType",
-                  "idNumber",
-                  "kycLevel",
-                  "transactionPin"
-                ],
+                "required": ["date"],
                 "properties": {
-                  "firstName": {"type": "string"},
-                  "middleName": {"type": "string"},
-                  "lastName": {"type": "string"},
-                  "dob": {"type": "string", "example": "1992-01-01"},
-                  "phoneNumber": {"type": "string"},
-                  "idType": {"type": "string", "enum": ["Passport", "DL"]},
-                  "idNumber": {"type": "string"},
-                  "kycLevel": {"type": "integer", "minimum": 1},
-                  "transactionPin": {"type": "string"}
+                  "date": {"type": "string", "format": "date", "example": "2026-09-30", "description": "Must be before today (UTC)"}
                 }
               }
             }
           }
         },
         "responses": {
-          "201": {"description": "Created"},
-          "400": {"description": "Validation error"},
+          "200": {
+            "description": "Batch completed",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "object",
+                      "properties": {
+                        "date": {"type": "string", "format": "date"},
+                        "accountsEvaluated": {"type": "integer"},
+                        "accrualsRecorded": {"type": "integer"},
+                        "accrualsSkipped": {"type": "integer", "description": "Accruals already recorded by an earlier run"},
+                        "monthEnd": {"type": "boolean"},
+                        "capitalizations": {
+                          "type": "array",
+                          "items": {
+                            "type": "object",
+                            "properties": {
+                              "reference": {"type": "string"},
+                              "accountNumber": {"type": "string"},
+                              "currency": {"type": "string"},
+                              "periodStart": {"type": "string", "format": "date"},
+                              "periodEnd": {"type": "string", "format": "date"},
+                              "grossAmount": {"type": "number", "format": "double", "example": 480.00},
+                              "taxAmount": {"type": "number", "format": "double", "example": 48.00},
+                              "netAmount": {"type": "number", "format": "double", "example": 432.00},
+                              "createdAt": {"type": "string", "format": "date-time"}
+                            }
+                          }
+                        },
+                        "capitalizationsSkipped": {"type": "integer", "description": "Accounts already capitalized for the month"},
+                        "capitalizationsFailed": {"type": "integer"}
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "400": {"description": "Validation error or date not in the past"},
           "401": {"description": "Unauthorized"},
           "500": {"description": "Server error"}
         }
       }
     },
-    "/verify-pin": {
+    "/get-interest-rate-tiers": {
+      "get": {
+        "summary": "Get interest rate tiers",
+        "description": "Returns the rate table by currency and product. The tier with the highest minBalance not above the balance applies to the whole balance.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "responses": {
+          "200": {"description": "Interest rate tiers fetched"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/approve-overdraft": {
       "post": {
-        "summary": "Verify user transaction pin",
+        "summary": "Approve an overdraft facility",
+        "description": "Sets the overdraft limit, rate and expiry of an ACTIVE account. Debits, withdrawals, exchanges and holds may take the available balance down to -limit. Approving an account that already has a facility replaces its terms and reactivates it. Overdrawn balances accrue interest daily at the facility rate; at expiry the facility is suspended and the limit drops to zero. The approver is the authenticated caller.",
         "security": [
           {
             "BasicAuth": []
@@ -490,19 +644,1928 @@ const openAPI = `{
             "application/json": {
               "schema": {
                 "type": "object",
-                "required": ["customerId", "pin"],
+                "required": ["accountNumber", "limit", "annualRatePercent", "expiresAt"],
                 "properties": {
-                  "customerId": {"type": "string"},
-                  "pin": {"type": "string"}
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "limit": {"type": "number", "format": "double", "example": 500.00},
+                  "annualRatePercent": {"type": "number", "format": "double", "example": 18.00},
+                  "dayCount": {"type": "string", "enum": ["ACT/365", "ACT/360"], "description": "Defaults to ACT/365"},
+                  "expiresAt": {"type": "string", "format": "date-time", "example": "2027-01-31T00:00:00Z"}
                 }
               }
             }
           }
         },
         "responses": {
-          "200": {"description": "Pin verified"},
-          "400": {"description": "Validation error or invalid pin"},
+          "200": {"description": "Overdraft approved"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Account not found"},
+          "409": {"description": "Account is not active"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-overdraft": {
+      "get": {
+        "summary": "Get the overdraft facility of an account",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "accountNumber",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string", "example": "0123456788"}
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Overdraft fetched",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "object",
+                      "properties": {
+                        "accountNumber": {"type": "string"},
+                        "currency": {"type": "string"},
+                        "limit": {"type": "number", "format": "double", "example": 500.00},
+                        "annualRatePercent": {"type": "number", "format": "double", "example": 18.00},
+                        "dayCount": {"type": "string", "enum": ["ACT/365", "ACT/360"]},
+                        "status": {"type": "string", "enum": ["ACTIVE", "SUSPENDED"]},
+                        "approvedBy": {"type": "string"},
+                        "expiresAt": {"type": "string", "format": "date-time"},
+                        "suspendedAt": {"type": "string", "format": "date-time"},
+                        "suspensionReason": {"type": "string", "example": "EXPIRED"},
+                        "accruedInterest": {"type": "number", "format": "double", "example": 12.35},
+                        "createdAt": {"type": "string", "format": "date-time"},
+                        "updatedAt": {"type": "string", "format": "date-time"}
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Overdraft not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-overdraft-breaches": {
+      "get": {
+        "summary": "List accounts drawn beyond their overdraft limit",
+        "description": "Returns every account whose available balance is below -overdraftLimit, largest excess first. Breaches can follow a suspended facility or a forced hold.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Overdraft breaches fetched",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "array",
+                      "items": {
+                        "type": "object",
+                        "properties": {
+                          "accountNumber": {"type": "string"},
+                          "currency": {"type": "string"},
+                          "availableBalance": {"type": "number", "format": "double", "example": -650.00},
+                          "ledgerBalance": {"type": "number", "format": "double", "example": -600.00},
+                          "overdraftLimit": {"type": "number", "format": "double", "example": 500.00},
+                          "excessAmount": {"type": "number", "format": "double", "example": 150.00},
+                          "facilityStatus": {"type": "string", "enum": ["ACTIVE", "SUSPENDED"]},
+                          "expiresAt": {"type": "string", "format": "date-time"}
+                        }
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/freeze-account": {
+      "post": {
+        "summary": "Freeze an active account",
+        "description": "A frozen account rejects every debit, credit, deposit and hold until it is unfrozen. The change is recorded in the account status history against the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["accountNumber", "reasonCode"],
+                "properties": {
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "reasonCode": {"type": "string", "enum": ["FRAUD", "COURT_ORDER", "CUSTOMER_REQUEST", "COMPLIANCE", "OTHER"]},
+                  "comment": {"type": "string", "example": "Court order ref CO/2026/114"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Account frozen"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Account not found"},
+          "409": {"description": "Account is not active"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/unfreeze-account": {
+      "post": {
+        "summary": "Unfreeze a frozen account",
+        "description": "Returns the account to ACTIVE and records the change in the account status history against the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["accountNumber", "reasonCode"],
+                "properties": {
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "reasonCode": {"type": "string", "enum": ["FRAUD", "COURT_ORDER", "CUSTOMER_REQUEST", "COMPLIANCE", "OTHER"]},
+                  "comment": {"type": "string", "example": "Court order ref CO/2026/114"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Account unfrozen"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Account not found"},
+          "409": {"description": "Account is not frozen"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/close-account": {
+      "post": {
+        "summary": "Close an account",
+        "description": "Closes an ACTIVE or FROZEN account. A non-zero balance requires sweepAccountNumber, an active account of the same customer; the balance moves through the suspense account and is converted at the current rate when the currencies differ. A FROZEN account cannot be swept and must be unfrozen first. The change is recorded against the authenticated caller. Closed accounts reject all postings.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["accountNumber", "reasonCode"],
+                "properties": {
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "reasonCode": {"type": "string", "enum": ["FRAUD", "COURT_ORDER", "CUSTOMER_REQUEST", "COMPLIANCE", "OTHER"]},
+                  "comment": {"type": "string", "example": "Court order ref CO/2026/114"},
+                  "sweepAccountNumber": {"type": "string", "example": "0000000028", "description": "Required when the account balance is not zero"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Account closed"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Account or sweep account not found"},
+          "409": {"description": "Account already closed, has active holds, has a balance and no sweep account, or is frozen and a sweep was requested"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/reactivate-account": {
+      "post": {
+        "summary": "Reactivate a dormant account",
+        "description": "Accounts without customer-initiated debits for DORMANCY_PERIOD_DAYS are moved to DORMANT by a batch job; they keep accepting credits but reject debits. Reactivation re-verifies the identity document and date of birth against the customer's KYC record, returns the account to ACTIVE, restarts the dormancy period and records a KYC_REVERIFIED entry in the account status history against the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["accountNumber", "idType", "idNumber", "dob"],
+                "properties": {
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "idType": {"type": "string", "enum": ["Passport", "DL"]},
+                  "idNumber": {"type": "string", "example": "A01234567"},
+                  "dob": {"type": "string", "format": "date", "example": "1990-04-12"},
+                  "comment": {"type": "string", "example": "Customer visited branch with passport"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Account reactivated"},
+          "400": {"description": "Validation error or customer held for compliance review"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "KYC verification failed"},
+          "404": {"description": "Account or customer not found"},
+          "409": {"description": "Account is not dormant"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-dormancy-report": {
+      "get": {
+        "summary": "Report dormant accounts per currency",
+        "description": "Returns the number, available and ledger balances and oldest last activity of DORMANT accounts per currency, for the regulatory dormant-account return.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Dormancy report fetched",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "object",
+                      "properties": {
+                        "asOf": {"type": "string", "format": "date-time"},
+                        "dormancyPeriodDays": {"type": "integer", "example": 365},
+                        "currencies": {
+                          "type": "array",
+                          "items": {
+                            "type": "object",
+                            "properties": {
+                              "currency": {"type": "string"},
+                              "accountCount": {"type": "integer", "example": 42},
+                              "availableBalance": {"type": "number", "format": "double", "example": 15230.75},
+                              "ledgerBalance": {"type": "number", "format": "double", "example": 15230.75},
+                              "oldestActivityAt": {"type": "string", "format": "date-time"}
+                            }
+                          }
+                        }
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-account-status-history": {
+      "get": {
+        "summary": "List the status changes of an account, oldest first",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "accountNumber",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Account status history fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/place-account-hold": {
+      "post": {
+        "summary": "Place a hold (authorization or lien) on an account",
+        "description": "Holds keep available_balance = ledger_balance - active holds. Holds placed for transfers under review (holdType TRANSFER) are listed but can only be resolved through the transfer review endpoints.",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["accountNumber", "holdType", "amount", "reason", "reference"],
+                "properties": {
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "holdType": {"type": "string", "enum": ["AUTHORIZATION", "LIEN"], "description": "A LIEN is placed even if it exceeds the available balance. LIEN holds are placed, released and captured only by a back-office channel or service token; customer tokens are refused"},
+                  "amount": {"type": "number", "format": "double", "example": 50.00},
+                  "reason": {"type": "string", "example": "Card authorization"},
+                  "reference": {"type": "string", "example": "AUTH-20261018-0001", "description": "Unique per account"},
+                  "expiresAt": {"type": "string", "format": "date-time", "description": "Optional; the hold is released automatically once it passes"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "201": {"description": "Hold placed; available balance reduced"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer, or a LIEN without back-office access"},
+          "404": {"description": "Account not found"},
+          "409": {"description": "Hold reference already exists or account is not active"},
+          "422": {"description": "Insufficient balance"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/release-account-hold": {
+      "post": {
+        "summary": "Release an active hold, restoring the available balance",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["accountNumber", "reference"],
+                "properties": {
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "reference": {"type": "string", "example": "AUTH-20261018-0001", "description": "Unique per account"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Hold released"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer, or a LIEN without back-office access"},
+          "404": {"description": "Active hold not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/capture-account-hold": {
+      "post": {
+        "summary": "Capture an active hold into a debit of the account",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["accountNumber", "reference"],
+                "properties": {
+                  "accountNumber": {"type": "string", "example": "0123456788"},
+                  "reference": {"type": "string", "example": "AUTH-20261018-0001", "description": "Unique per account"},
+                  "amount": {"type": "number", "format": "double", "example": 45.00, "description": "Defaults to the full hold; a partial capture releases the remainder"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Hold captured"},
+          "400": {"description": "Validation error or amount exceeds the hold"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer, or a LIEN without back-office access"},
+          "404": {"description": "Active hold not found"},
+          "409": {"description": "Account hold has expired (past expiresAt, awaiting release)"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-account-holds": {
+      "get": {
+        "summary": "List holds on an account, newest first",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "accountNumber",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Holds fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/customers/{customerId}/accounts": {
+      "get": {
+        "summary": "List a customer's accounts with a consolidated balance",
+        "description": "Balances are converted into baseCurrency (USD by default) at the current rates; each account carries the rate and rate date used and its active holds.",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "parameters": [
+          {"name": "customerId", "in": "path", "required": true, "schema": {"type": "string"}},
+          {"name": "baseCurrency", "in": "query", "required": false, "schema": {"type": "string", "enum": ["USD", "EUR", "GBP", "NGN"], "default": "USD"}}
+        ],
+        "responses": {
+          "200": {
+            "description": "Accounts fetched",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "success": {"type": "boolean"},
+                    "message": {"type": "string"},
+                    "data": {
+                      "type": "object",
+                      "properties": {
+                        "customerId": {"type": "string"},
+                        "baseCurrency": {"type": "string"},
+                        "totalAvailableBalance": {"type": "number", "format": "double"},
+                        "totalLedgerBalance": {"type": "number", "format": "double"},
+                        "accounts": {
+                          "type": "array",
+                          "items": {
+                            "type": "object",
+                            "properties": {
+                              "accountNumber": {"type": "string"},
+                              "currency": {"type": "string"},
+                              "status": {"type": "string", "enum": ["ACTIVE", "FROZEN", "DORMANT", "CLOSED"]},
+                              "availableBalance": {"type": "number", "format": "double"},
+                              "ledgerBalance": {"type": "number", "format": "double"},
+                              "activeHoldAmount": {"type": "number", "format": "double"},
+                              "activeHolds": {"type": "array", "items": {"type": "object"}},
+                              "rate": {"type": "number", "format": "double"},
+                              "rateDate": {"type": "string", "format": "date"},
+                              "baseAvailableBalance": {"type": "number", "format": "double"},
+                              "baseLedgerBalance": {"type": "number", "format": "double"}
+                            }
+                          }
+                        }
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer"},
+          "404": {"description": "Customer or rate not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-participant-banks": {
+      "get": {
+        "summary": "Get participant banks",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "responses": {
+          "200": {"description": "Participant banks fetched"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-rates": {
+      "get": {
+        "summary": "Get all rates",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "responses": {
+          "200": {"description": "Rates fetched"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-rate": {
+      "post": {
+        "summary": "Get a specific rate by currency pair",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["fromCurrency", "toCurrency"],
+                "properties": {
+                  "fromCurrency": {"type": "string", "example": "USD"},
+                  "toCurrency": {"type": "string", "example": "NGN"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Rate fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-charges": {
+      "get": {
+        "summary": "Get charge and VAT breakdown",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "amount",
+            "in": "query",
+            "required": true,
+            "schema": {
+              "type": "number",
+              "format": "double",
+              "example": 100.00
+            }
+          },
+          {
+            "name": "fromCurrency",
+            "in": "query",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "example": "USD"
+            }
+          }
+        ],
+        "responses": {
+          "200": {"description": "Charges fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/convert-fcy-amount": {
+      "post": {
+        "summary": "Get converted amount by currency pair",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["amount", "fromCcy", "toCcy"],
+                "properties": {
+                  "amount": {"type": "number", "format": "double", "example": 1500.00},
+                  "fromCcy": {"type": "string", "example": "USD"},
+                  "toCcy": {"type": "string", "example": "NGN"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Converted rate fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/transfer-funds": {
+      "post": {
+        "summary": "Transfer funds in multiple currencies",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": [
+                  "debitAccountNumber",
+                  "creditAccountNumber",
+                  "beneficiaryBankCode",
+                  "transactionPIN",
+                  "debitBankName",
+                  "creditBankName",
+                  "debitCurrency",
+                  "creditCurrency",
+                  "debitAmount",
+                  "narration"
+                ],
+                "properties": {
+                  "debitAccountNumber": {"type": "string", "example": "0123456788"},
+                  "creditAccountNumber": {"type": "string", "example": "0000000028", "description": "The NUBAN check digit is validated against beneficiaryBankCode"},
+                  "beneficiaryBankCode": {"type": "string", "example": "100100"},
+                  "transactionPIN": {"type": "string", "example": "1234"},
+                  "debitBankName": {"type": "string", "example": "Grey"},
+                  "creditBankName": {"type": "string", "example": "Grey"},
+                  "beneficiaryName": {"type": "string", "example": "Jane Doe", "description": "Required for external transfers; screened against sanctions lists"},
+                  "debitCurrency": {"type": "string", "example": "USD"},
+                  "creditCurrency": {"type": "string", "example": "USD"},
+                  "debitAmount": {"type": "number", "format": "double", "example": 100.00},
+                  "narration": {
+                    "type": "string",
+                    "enum": [
+                      "Travels and Holiday",
+                      "Salary",
+                      "Project charge",
+                      "Food and consumables",
+                      "Transportation",
+                      "Accomodation",
+                      "utility bill",
+                      "savings",
+                      "investment",
+                      "loan",
+                      "loan repayment",
+                      "others"
+                    ]
+                  }
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Transfer processed"},
+          "202": {"description": "Transfer accepted for asynchronous processing (TRANSFER_PROCESSING_MODE=async; poll /get-transfer), or HELD for manual review (screening REVIEW or potential sanctions match; funds are reserved), or OTP_REQUIRED when the amount, normalized to TRANSFER_STEP_UP_CURRENCY, exceeds TRANSFER_STEP_UP_THRESHOLD. Nothing is posted until the code in stepUpChallenge is submitted to /confirm-transfer"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Transfer blocked by screening, or the debit account does not belong to the token's customer"},
+          "404": {"description": "Account or rate not found"},
+          "422": {"description": "Insufficient balance, or amount above the per-transfer limit of the customer's KYC level (KYC_TRANSFER_LIMITS)"},
+          "423": {"description": "Transaction PIN locked after too many failed attempts"},
+          "500": {"description": "Server error"},
+          "503": {"description": "Transfer queue is full"}
+        }
+      }
+    },
+    "/confirm-transfer": {
+      "post": {
+        "summary": "Complete a transfer held for a step-up OTP",
+        "description": "Submits the code sent for an OTP_REQUIRED transfer. The original request is replayed without re-checking the pin; balances, rates, charges and screening are evaluated at confirmation.",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["debitAccountNumber", "challengeId", "otp"],
+                "properties": {
+                  "debitAccountNumber": {"type": "string", "example": "0123456788"},
+                  "challengeId": {"type": "string", "format": "uuid"},
+                  "otp": {"type": "string", "example": "482913"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Transfer processed"},
+          "202": {"description": "Transfer accepted for asynchronous processing or HELD for manual review"},
+          "400": {"description": "Validation error or invalid or expired otp"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Transfer blocked by screening, or the debit account does not belong to the token's customer"},
+          "404": {"description": "Account or rate not found"},
+          "422": {"description": "Insufficient balance"},
+          "500": {"description": "Server error"},
+          "503": {"description": "Transfer queue is full"}
+        }
+      }
+    },
+    "/get-transfer": {
+      "get": {
+        "summary": "Get a transfer and its current status by transaction reference",
+        "security": [
+          {
+            "BasicAuth": [],
+            "CustomerToken": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "transactionReference",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Transfer fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized, or missing or invalid customer token"},
+          "403": {"description": "Account does not belong to the token's customer"},
+          "404": {"description": "Transfer not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-held-transfers": {
+      "get": {
+        "summary": "List transfers held for manual review, oldest first",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "integer", "default": 50, "maximum": 200}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Held transfers fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-transfer-review": {
+      "get": {
+        "summary": "Get the review of a held transfer with its decision audit trail",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "transactionReference",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Transfer review fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Transfer or review not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/approve-held-transfer": {
+      "post": {
+        "summary": "Approve a held transfer and execute it",
+        "description": "The reviewer is the authenticated caller (the X-Customer-Token subject when one is sent, else the channel ID) and must differ from the caller that initiated the transfer. The reservation is released in the posting transaction.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["transactionReference", "comment"],
+                "properties": {
+                  "transactionReference": {"type": "string"},
+                  "comment": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Transfer approved and processed"},
+          "202": {"description": "Transfer approved and accepted for asynchronous processing"},
+          "400": {"description": "Validation error, or no authenticated reviewer"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Reviewer must differ from initiator"},
+          "404": {"description": "Transfer not found"},
+          "409": {"description": "Transfer is not held for review"},
+          "422": {"description": "Insufficient balance"},
+          "500": {"description": "Server error"},
+          "503": {"description": "Transfer queue is full"}
+        }
+      }
+    },
+    "/reject-held-transfer": {
+      "post": {
+        "summary": "Reject a held transfer, releasing its reservation and failing it",
+        "description": "The reviewer is the authenticated caller (the X-Customer-Token subject when one is sent, else the channel ID) and must differ from the caller that initiated the transfer.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["transactionReference", "comment"],
+                "properties": {
+                  "transactionReference": {"type": "string"},
+                  "comment": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Transfer rejected"},
+          "400": {"description": "Validation error, or no authenticated reviewer"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Reviewer must differ from initiator"},
+          "404": {"description": "Transfer not found"},
+          "409": {"description": "Transfer is not held for review"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/create-webhook-subscription": {
+      "post": {
+        "summary": "Subscribe a channel endpoint to signed transfer lifecycle webhooks",
+        "description": "Deliveries are POSTed with X-Webhook-Signature (t=<unix>,v1=<hex HMAC-SHA256 of \"<t>.<body>\">), X-Webhook-Timestamp, X-Webhook-Event, X-Webhook-Event-ID and X-Webhook-Delivery-ID headers. The signing secret is only returned on creation.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["channelId", "url"],
+                "properties": {
+                  "channelId": {"type": "string", "example": "GreyApp"},
+                  "url": {"type": "string", "example": "https://example.com/hooks/transfers"},
+                  "eventTypes": {
+                    "type": "array",
+                    "items": {
+                      "type": "string",
+                      "enum": ["TRANSFER_CREATED", "TRANSFER_SUCCEEDED", "TRANSFER_FAILED", "TRANSFER_CLOSED", "TRANSFER_REVERSED", "TRANSFER_HELD"]
+                    }
+                  }
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "201": {"description": "Subscription created"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-webhook-subscriptions": {
+      "get": {
+        "summary": "List webhook subscriptions for a channel",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "channelId",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Subscriptions fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-webhook-deliveries": {
+      "get": {
+        "summary": "Get the delivery log for a webhook subscription",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "subscriptionId",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Deliveries fetched"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/redeliver-webhook": {
+      "post": {
+        "summary": "Queue a manual redelivery of a logged webhook event",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["deliveryId"],
+                "properties": {
+                  "deliveryId": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "202": {"description": "Redelivery queued"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Delivery not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/create-user": {
+      "post": {
+        "summary": "Create user",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": [
+                  "firstName",
+                  "lastName",
+                  "dob",
+                  "phoneNumber",
+                  "idType",
+                  "idNumber",
+                  "kycLevel",
+                  "transactionPin"
+                ],
+                "properties": {
+                  "firstName": {"type": "string"},
+                  "middleName": {"type": "string"},
+                  "lastName": {"type": "string"},
+                  "dob": {"type": "string", "example": "1992-01-01"},
+                  "phoneNumber": {"type": "string"},
+                  "email": {"type": "string", "format": "email"},
+                  "address": {"type": "string"},
+                  "idType": {"type": "string", "enum": ["Passport", "DL"]},
+                  "idNumber": {"type": "string"},
+                  "kycLevel": {"type": "integer", "minimum": 1},
+                  "transactionPin": {"type": "string", "example": "7294", "description": "4 to 6 digits; not a repeated digit, a run of consecutive digits or a repeated pattern"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "201": {"description": "Created (status HELD when the name is a potential sanctions match, until /clear-customer-hold or /reject-customer-hold; potentialDuplicateOf lists customers flagged for duplicate review)"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "409": {"description": "Customer with this ID already exists"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/verify-pin": {
+      "post": {
+        "summary": "Verify user transaction pin",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "pin"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "pin": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Pin verified"},
+          "400": {"description": "Validation error or invalid pin; the error says how many attempts remain before the pin is locked"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "423": {"description": "Pin locked after PIN_MAX_FAILED_ATTEMPTS consecutive failures; it unlocks after PIN_LOCKOUT_MINUTES, by /unlock-pin or by /reset-pin"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/change-pin": {
+      "post": {
+        "summary": "Change transaction pin",
+        "description": "Replaces the pin once oldPin verifies; a wrong oldPin counts towards the lockout. New pins must be 4 to 6 digits and must not be a single repeated digit, a run of consecutive digits or a repeated pattern.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "oldPin", "newPin"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "oldPin": {"type": "string"},
+                  "newPin": {"type": "string", "example": "7294"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Pin changed"},
+          "400": {"description": "Validation error, weak new pin or invalid old pin"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "423": {"description": "Pin locked"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/request-pin-reset": {
+      "post": {
+        "summary": "Send a pin reset code",
+        "description": "Sends a one-time code to the customer's registered phone number. The code expires after OTP_TTL_SECONDS and is spent by one successful /reset-pin or OTP_MAX_ATTEMPTS wrong codes.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId"],
+                "properties": {
+                  "customerId": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Reset code sent; returns challengeId and expiresAt"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/reset-pin": {
+      "post": {
+        "summary": "Reset a forgotten transaction pin",
+        "description": "Replaces the pin with the code from /request-pin-reset. A reset also lifts an active lockout.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "challengeId", "otp", "newPin"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "challengeId": {"type": "string", "format": "uuid"},
+                  "otp": {"type": "string", "example": "482913"},
+                  "newPin": {"type": "string", "example": "7294"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Pin reset"},
+          "400": {"description": "Validation error, weak new pin or invalid or expired otp"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/unlock-pin": {
+      "post": {
+        "summary": "Lift a pin lockout",
+        "description": "Unlocks a locked pin before PIN_LOCKOUT_MINUTES elapse and records a CUSTOMER_PIN_UNLOCKED event.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "unlockedBy"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "unlockedBy": {"type": "string", "example": "support-agent-3"},
+                  "comment": {"type": "string", "example": "Customer identity confirmed on call"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Pin unlocked"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "409": {"description": "Pin is not locked"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-pin-status": {
+      "get": {
+        "summary": "Get transaction pin status",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "customerId",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Pin status with lock state, failed and remaining attempts"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/clear-customer-hold": {
+      "post": {
+        "summary": "Clear a customer held for compliance review",
+        "description": "Moves a customer HELD on a potential sanctions match back to ACTIVE and records a CUSTOMER_COMPLIANCE_CLEARED event. The reviewer is the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "comment"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "comment": {"type": "string", "maxLength": 255, "example": "False positive: date of birth differs from the listed party"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Customer cleared and ACTIVE"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Channel lacks the backoffice scope"},
+          "404": {"description": "User not found"},
+          "409": {"description": "Customer is not held for compliance review"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/reject-customer-hold": {
+      "post": {
+        "summary": "Reject a customer held for compliance review",
+        "description": "Confirms a potential sanctions match: the customer moves to REJECTED, cannot open accounts or upgrade KYC, and a CUSTOMER_COMPLIANCE_REJECTED event is recorded. The reviewer is the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "comment"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "comment": {"type": "string", "maxLength": 255, "example": "Match confirmed against the listed party"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Customer rejected"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Channel lacks the backoffice scope"},
+          "404": {"description": "User not found"},
+          "409": {"description": "Customer is not held for compliance review"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-user": {
+      "get": {
+        "summary": "Get user",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "id",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string", "format": "uuid"}
+          }
+        ],
+        "responses": {
+          "200": {"description": "User profile with contact details and KYC level"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/update-contact-details": {
+      "post": {
+        "summary": "Update customer contact details",
+        "description": "Omitted fields keep their value; an empty email or address clears it. Each changed field is recorded in the profile audit trail with a CUSTOMER_PROFILE_UPDATED event.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "updatedBy"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "phoneNumber": {"type": "string"},
+                  "email": {"type": "string", "format": "email"},
+                  "address": {"type": "string"},
+                  "updatedBy": {"type": "string", "example": "branch-officer-7"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Updated user and the changes recorded"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-profile-changes": {
+      "get": {
+        "summary": "Get the contact details audit trail",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "customerId",
+            "in": "query",
+            "required": true,
+            "schema": {"type": "string"}
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "integer", "minimum": 1, "maximum": 200, "default": 50}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Changes, newest first"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/submit-kyc-upgrade": {
+      "post": {
+        "summary": "Submit a KYC upgrade",
+        "description": "Queues a request to raise the customer's KYC level with the identity document's metadata. The level is unchanged until a reviewer approves it. A customer can have one upgrade pending at a time. The authenticated caller is recorded as the submitter.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "requestedLevel", "documentType", "documentNumber", "issuingCountry", "documentReference"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "requestedLevel": {"type": "integer", "minimum": 2, "maximum": 3},
+                  "documentType": {"type": "string", "enum": ["Passport", "DL", "NationalID", "UtilityBill"]},
+                  "documentNumber": {"type": "string"},
+                  "issuingCountry": {"type": "string", "example": "NG"},
+                  "documentExpiresAt": {"type": "string", "example": "2030-01-31"},
+                  "documentReference": {"type": "string", "example": "docs/kyc/C1/passport.pdf"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "201": {"description": "Upgrade submitted (PENDING)"},
+          "400": {"description": "Validation error, expired document or requested level not above the current level"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "409": {"description": "KYC upgrade already pending"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-kyc-upgrades": {
+      "get": {
+        "summary": "List KYC upgrades",
+        "description": "Returns a customer's upgrades, newest first, or the upgrades in a status, oldest first. Without either it returns the PENDING review queue.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "customerId",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "string"}
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "string", "enum": ["PENDING", "APPROVED", "REJECTED"]}
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "integer", "minimum": 1, "maximum": 200, "default": 50}
+          }
+        ],
+        "responses": {
+          "200": {"description": "KYC upgrades"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/approve-kyc-upgrade": {
+      "post": {
+        "summary": "Approve a KYC upgrade",
+        "description": "Raises the customer's KYC level to the requested level, which applies to transfer limits from the next transfer. The reviewer is the authenticated caller and must differ from the submitter.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["requestId", "comment"],
+                "properties": {
+                  "requestId": {"type": "string", "format": "uuid"},
+                  "comment": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Upgrade approved"},
+          "400": {"description": "Validation error, or no authenticated reviewer"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Reviewer must differ from initiator"},
+          "404": {"description": "KYC upgrade not found"},
+          "409": {"description": "KYC upgrade is not pending"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/reject-kyc-upgrade": {
+      "post": {
+        "summary": "Reject a KYC upgrade",
+        "description": "Closes the upgrade without changing the KYC level. The reviewer is the authenticated caller and must differ from the submitter.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["requestId", "comment"],
+                "properties": {
+                  "requestId": {"type": "string", "format": "uuid"},
+                  "comment": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Upgrade rejected"},
+          "400": {"description": "Validation error, or no authenticated reviewer"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Reviewer must differ from initiator"},
+          "404": {"description": "KYC upgrade not found"},
+          "409": {"description": "KYC upgrade is not pending"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-duplicate-candidates": {
+      "get": {
+        "summary": "List duplicate customer candidates",
+        "description": "Returns the candidates involving customerId, newest first, or the candidates in status (default OPEN), oldest first.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {
+            "name": "customerId",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "string"}
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "string", "enum": ["OPEN", "DISMISSED", "MERGED"]}
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "schema": {"type": "integer", "minimum": 1, "maximum": 200, "default": 50}
+          }
+        ],
+        "responses": {
+          "200": {"description": "Duplicate candidates"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/dismiss-duplicate-candidate": {
+      "post": {
+        "summary": "Dismiss a duplicate customer candidate",
+        "description": "Records that the two customers are different people. The reviewer is the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["candidateId", "comment"],
+                "properties": {
+                  "candidateId": {"type": "string", "format": "uuid"},
+                  "comment": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Candidate dismissed"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Duplicate candidate not found"},
+          "409": {"description": "Duplicate candidate is not open"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/merge-customers": {
+      "post": {
+        "summary": "Merge duplicate customers",
+        "description": "Moves the accounts of the other customer on the candidate to survivingCustomerId and marks it MERGED. Both customers must be ACTIVE. The merge is recorded against the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["candidateId", "survivingCustomerId", "comment"],
+                "properties": {
+                  "candidateId": {"type": "string", "format": "uuid"},
+                  "survivingCustomerId": {"type": "string"},
+                  "comment": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Customers merged"},
+          "400": {"description": "Validation error or survivingCustomerId is not on the candidate"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "Duplicate candidate not found"},
+          "409": {"description": "Duplicate candidate is not open, a customer is not ACTIVE, or both customers hold accounts in the same currency"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/export-customer-data": {
+      "post": {
+        "summary": "Export customer data",
+        "description": "Returns the profile, profile changes, KYC upgrades, accounts and transfers held for a customer as a JSON attachment, and records the request against the authenticated caller.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "reason"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "reason": {"type": "string", "example": "Subject access request"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Customer data export"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/erase-customer-data": {
+      "post": {
+        "summary": "Erase customer data",
+        "description": "Pseudonymizes a customer whose accounts are all closed. Accounts, transfers and ledger postings are retained; personal data in the profile, profile changes, transfer audit payloads, KYC upgrade documents and sanctions screenings is removed.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["customerId", "reason"],
+                "properties": {
+                  "customerId": {"type": "string"},
+                  "reason": {"type": "string", "example": "Subject access request"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Customer data erased"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "404": {"description": "User not found"},
+          "409": {"description": "Customer has open accounts, or customer data already erased"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/create-channel": {
+      "post": {
+        "summary": "Create channel",
+        "description": "Creates a channel with its scopes and allowed IP ranges and returns its first API key. The key is only returned here.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["channelId", "name", "scopes", "createdBy"],
+                "properties": {
+                  "channelId": {"type": "string", "example": "PartnerApp"},
+                  "name": {"type": "string"},
+                  "scopes": {"type": "array", "items": {"type": "string", "enum": ["*", "rates:read", "customers:read", "customers:write", "accounts:read", "accounts:write", "transfers:read", "transfers:write", "webhooks", "backoffice", "channels:admin"]}},
+                  "allowedIps": {"type": "array", "items": {"type": "string"}, "example": ["10.0.0.0/8", "203.0.113.7"]},
+                  "createdBy": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "201": {"description": "Channel created, with apiKey"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Channel lacks the channels:admin scope"},
+          "409": {"description": "Channel already exists"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-channel": {
+      "get": {
+        "summary": "Get channel",
+        "description": "Returns a channel with its valid keys (without the keys themselves).",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "parameters": [
+          {"name": "channelId", "in": "query", "required": true, "schema": {"type": "string"}}
+        ],
+        "responses": {
+          "200": {"description": "Channel"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Channel lacks the channels:admin scope"},
+          "404": {"description": "Channel not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/get-channels": {
+      "get": {
+        "summary": "List channels",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "responses": {
+          "200": {"description": "Channels"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Channel lacks the channels:admin scope"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/update-channel": {
+      "post": {
+        "summary": "Update channel",
+        "description": "Changes the name, scopes, allowed IP ranges or status (ACTIVE, DISABLED) of a channel. Omitted fields keep their value; an empty allowedIps list allows any address.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["channelId", "updatedBy"],
+                "properties": {
+                  "channelId": {"type": "string"},
+                  "name": {"type": "string"},
+                  "scopes": {"type": "array", "items": {"type": "string", "enum": ["*", "rates:read", "customers:read", "customers:write", "accounts:read", "accounts:write", "transfers:read", "transfers:write", "webhooks", "backoffice", "channels:admin"]}},
+                  "allowedIps": {"type": "array", "items": {"type": "string"}, "example": ["10.0.0.0/8", "203.0.113.7"]},
+                  "status": {"type": "string", "enum": ["ACTIVE", "DISABLED"]},
+                  "updatedBy": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Channel updated"},
+          "400": {"description": "Validation error"},
+          "401": {"description": "Unauthorized"},
+          "403": {"description": "Channel lacks the channels:admin scope"},
+          "404": {"description": "Channel not found"},
+          "500": {"description": "Server error"}
+        }
+      }
+    },
+    "/rotate-channel-key": {
+      "post": {
+        "summary": "Rotate channel API key",
+        "description": "Issues a new API key, returned once. The channel's other keys keep working for gracePeriodSeconds (default CHANNEL_KEY_GRACE_PERIOD_SECONDS); 0 revokes them at once.",
+        "security": [
+          {
+            "BasicAuth": []
+          }
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["channelId", "rotatedBy"],
+                "properties": {
+                  "channelId": {"type": "string"},
+                  "rotatedBy": {"type": "string"},
+                  "gracePeriodSeconds": {"type": "integer", "minimum": 0}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {"description": "Key rotated, with apiKey"},
+          "400": {"description": "Validation error"},
           "401": {"description": "Unauthorized"},
+          "403": {"description": "Channel lacks the channels:admin scope"},
+          "404": {"description": "Channel not found"},
           "500": {"description": "Server error"}
         }
       }
@@ -512,7 +2575,14 @@ const openAPI = `{
     "securitySchemes": {
       "BasicAuth": {
         "type": "http",
-        "scheme": "basic"
+        "scheme": "basic",
+        "description": "Channel ID as user name and the channel's API key as password. Returns 403 when the channel is disabled, calls from outside its allowed IP ranges or lacks the scope of the route."
+      },
+      "CustomerToken": {
+        "type": "apiKey",
+        "in": "header",
+        "name": "X-Customer-Token",
+        "description": "Customer JWT (HS256 or RS256, optional Bearer prefix), checked only when JWT_JWKS_FILE is set. The customer_id claim must own the account; service tokens without customer_id need the backoffice scope and may act on any account."
       }
     }
   }
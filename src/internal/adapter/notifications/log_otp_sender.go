@@ -0,0 +1,56 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// LogOTPSender writes each one-time code as one JSON line to the given writer
+// instead of sending an SMS. It stands in for an SMS gateway in local and test
+// environments only: the line carries the plaintext code.
+type LogOTPSender struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func NewLogOTPSender(writer io.Writer) *LogOTPSender {
+	return &LogOTPSender{writer: writer}
+}
+
+type otpMessage struct {
+	ChallengeID string            `json:"challengeId"`
+	CustomerID  string            `json:"customerId"`
+	PhoneNumber string            `json:"phoneNumber"`
+	Purpose     domain.OTPPurpose `json:"purpose"`
+	Code        string            `json:"code"`
+	ExpiresAt   time.Time         `json:"expiresAt"`
+}
+
+func (s *LogOTPSender) Send(_ context.Context, challenge domain.OTPChallenge, phoneNumber string, code string) error {
+	line, err := json.Marshal(otpMessage{
+		ChallengeID: challenge.ID,
+		CustomerID:  challenge.CustomerID,
+		PhoneNumber: phoneNumber,
+		Purpose:     challenge.Purpose,
+		Code:        code,
+		ExpiresAt:   challenge.ExpiresAt.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal otp message: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.writer.Write(line); err != nil {
+		return fmt.Errorf("write otp message: %w", err)
+	}
+	return nil
+}
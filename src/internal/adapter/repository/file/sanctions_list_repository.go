@@ -0,0 +1,201 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+// ofacNullField is the placeholder OFAC uses for empty columns.
+const ofacNullField = "-0-"
+
+var ofacAliasPattern = regexp.MustCompile(`(?i)a\.k\.a\.?\s*'([^']+)'`)
+
+// SanctionsListRepository loads sanctions lists from local files. Files ending in
+// .csv are read in the OFAC SDN layout (ent_num, SDN_Name, SDN_Type, ...,
+// Remarks) and files ending in .xml in the UN consolidated list layout. All files
+// are merged into one list whose version names every source publication.
+type SanctionsListRepository struct {
+	paths []string
+}
+
+func NewSanctionsListRepository(paths ...string) *SanctionsListRepository {
+	return &SanctionsListRepository{paths: paths}
+}
+
+func (r *SanctionsListRepository) LoadList(_ context.Context) (domain.SanctionsList, error) {
+	list := domain.SanctionsList{LoadedAt: time.Now().UTC()}
+	versions := make([]string, 0, len(r.paths))
+
+	for _, path := range r.paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			logger.Error("sanctions list file read failed", err, logger.Fields{
+				"path": path,
+			})
+			return domain.SanctionsList{}, fmt.Errorf("read sanctions list %s: %w", path, err)
+		}
+
+		var (
+			entries []domain.SanctionsEntry
+			version string
+		)
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			entries, version, err = parseOFACCSV(raw)
+		case ".xml":
+			entries, version, err = parseUNXML(raw)
+		default:
+			err = fmt.Errorf("unsupported sanctions list format %q", filepath.Ext(path))
+		}
+		if err != nil {
+			return domain.SanctionsList{}, fmt.Errorf("parse sanctions list %s: %w", path, err)
+		}
+
+		list.Entries = append(list.Entries, entries...)
+		versions = append(versions, version)
+	}
+
+	list.Version = strings.Join(versions, ";")
+	logger.Info("sanctions list loaded", logger.Fields{
+		"version": list.Version,
+		"entries": len(list.Entries),
+	})
+	return list, nil
+}
+
+// parseOFACCSV reads an SDN-style CSV. OFAC does not embed a publication id in
+// the file, so the version is derived from the content hash.
+func parseOFACCSV(raw []byte) ([]domain.SanctionsEntry, string, error) {
+	reader := csv.NewReader(bytes.NewReader(raw))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	entries := make([]domain.SanctionsEntry, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if len(record) < 2 {
+			continue
+		}
+
+		id := strings.TrimSpace(record[0])
+		name := ofacField(record, 1)
+		if id == "" || name == "" || strings.EqualFold(id, "ent_num") {
+			continue
+		}
+
+		entry := domain.SanctionsEntry{
+			ID:        "OFAC-" + id,
+			Source:    "OFAC",
+			EntryType: ofacField(record, 2),
+			Names:     []string{name},
+		}
+		if remarks := ofacField(record, 11); remarks != "" {
+			for _, match := range ofacAliasPattern.FindAllStringSubmatch(remarks, -1) {
+				entry.Names = append(entry.Names, strings.TrimSpace(match[1]))
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	sum := sha256.Sum256(raw)
+	return entries, "OFAC-SDN@sha256:" + hex.EncodeToString(sum[:])[:16], nil
+}
+
+func ofacField(record []string, index int) string {
+	if index >= len(record) {
+		return ""
+	}
+	value := strings.TrimSpace(record[index])
+	if value == ofacNullField {
+		return ""
+	}
+	return value
+}
+
+type unConsolidatedList struct {
+	XMLName       xml.Name  `xml:"CONSOLIDATED_LIST"`
+	DateGenerated string    `xml:"dateGenerated,attr"`
+	Individuals   []unParty `xml:"INDIVIDUALS>INDIVIDUAL"`
+	Entities      []unParty `xml:"ENTITIES>ENTITY"`
+}
+
+type unParty struct {
+	DataID            string    `xml:"DATAID"`
+	ReferenceNumber   string    `xml:"REFERENCE_NUMBER"`
+	FirstName         string    `xml:"FIRST_NAME"`
+	SecondName        string    `xml:"SECOND_NAME"`
+	ThirdName         string    `xml:"THIRD_NAME"`
+	FourthName        string    `xml:"FOURTH_NAME"`
+	IndividualAliases []unAlias `xml:"INDIVIDUAL_ALIAS"`
+	EntityAliases     []unAlias `xml:"ENTITY_ALIAS"`
+}
+
+type unAlias struct {
+	Name string `xml:"ALIAS_NAME"`
+}
+
+// parseUNXML reads the UN Security Council consolidated list. Its dateGenerated
+// attribute identifies the publication.
+func parseUNXML(raw []byte) ([]domain.SanctionsEntry, string, error) {
+	var doc unConsolidatedList
+	if err := xml.Unmarshal(raw, &doc); err != nil {
+		return nil, "", err
+	}
+
+	version := strings.TrimSpace(doc.DateGenerated)
+	if version == "" {
+		sum := sha256.Sum256(raw)
+		version = "sha256:" + hex.EncodeToString(sum[:])[:16]
+	}
+
+	entries := make([]domain.SanctionsEntry, 0, len(doc.Individuals)+len(doc.Entities))
+	appendParties := func(parties []unParty, entryType string) {
+		for _, party := range parties {
+			name := strings.Join(strings.Fields(strings.Join([]string{party.FirstName, party.SecondName, party.ThirdName, party.FourthName}, " ")), " ")
+			if name == "" {
+				continue
+			}
+			id := strings.TrimSpace(party.ReferenceNumber)
+			if id == "" {
+				id = strings.TrimSpace(party.DataID)
+			}
+
+			entry := domain.SanctionsEntry{
+				ID:        "UN-" + id,
+				Source:    "UN",
+				EntryType: entryType,
+				Names:     []string{name},
+			}
+			for _, alias := range append(party.IndividualAliases, party.EntityAliases...) {
+				if aliasName := strings.TrimSpace(alias.Name); aliasName != "" {
+					entry.Names = append(entry.Names, aliasName)
+				}
+			}
+			entries = append(entries, entry)
+		}
+	}
+	appendParties(doc.Individuals, "individual")
+	appendParties(doc.Entities, "entity")
+
+	return entries, "UN@" + version, nil
+}
@@ -0,0 +1,38 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+// ScreeningRuleRepository reads screening rules from a JSON file holding an array
+// of rules. The file is re-read on every load so edits apply without a redeploy.
+type ScreeningRuleRepository struct {
+	path string
+}
+
+func NewScreeningRuleRepository(path string) *ScreeningRuleRepository {
+	return &ScreeningRuleRepository{path: path}
+}
+
+func (r *ScreeningRuleRepository) LoadRules(_ context.Context) ([]domain.ScreeningRule, error) {
+	raw, err := os.ReadFile(r.path)
+	if err != nil {
+		logger.Error("screening rule file read failed", err, logger.Fields{
+			"path": r.path,
+		})
+		return nil, fmt.Errorf("read screening rules file: %w", err)
+	}
+
+	var rules []domain.ScreeningRule
+	if err := json.Unmarshal(raw, &rules); err != nil {
+		return nil, fmt.Errorf("decode screening rules file: %w", err)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,444 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/shopspring/decimal"
+)
+
+type AccountHoldRepository struct {
+	db *sql.DB
+}
+
+func NewAccountHoldRepository(db *sql.DB) *AccountHoldRepository {
+	return &AccountHoldRepository{db: db}
+}
+
+const accountHoldColumns = `id, account_number, hold_type, amount, captured_amount, reason, reference, status, expires_at, resolved_at, created_at, updated_at`
+
+// PlaceHold records an ACTIVE hold and takes its amount out of the available
+// balance in the same transaction.
+func (r *AccountHoldRepository) PlaceHold(ctx context.Context, hold domain.AccountHold) (domain.AccountHold, error) {
+	logger.Info("account hold repository place hold", logger.Fields{
+		"accountNumber": hold.AccountNumber,
+		"holdType":      hold.HoldType,
+		"amount":        hold.Amount,
+		"reference":     hold.Reference,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("account hold repository begin place tx failed", err, nil)
+		return domain.AccountHold{}, fmt.Errorf("begin place hold transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var placed domain.AccountHold
+	if placed, err = placeAccountHold(ctx, tx, hold); err != nil {
+		if errors.Is(err, errPostingFailed) {
+			err = r.accountPostingError(ctx, hold.AccountNumber)
+			return domain.AccountHold{}, err
+		}
+		logger.Error("account hold repository place hold failed", err, logger.Fields{
+			"accountNumber": hold.AccountNumber,
+			"reference":     hold.Reference,
+		})
+		return domain.AccountHold{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("account hold repository commit place tx failed", err, nil)
+		return domain.AccountHold{}, fmt.Errorf("commit place hold transaction: %w", err)
+	}
+
+	return placed, nil
+}
+
+// ReleaseHold closes an ACTIVE hold with status (RELEASED or EXPIRED) and returns
+// its amount to the available balance. It returns commons.ErrRecordNotFound if no
+// active hold has the reference.
+func (r *AccountHoldRepository) ReleaseHold(ctx context.Context, accountNumber string, reference string, status domain.AccountHoldStatus) (domain.AccountHold, error) {
+	logger.Info("account hold repository release hold", logger.Fields{
+		"accountNumber": accountNumber,
+		"reference":     reference,
+		"status":        status,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("account hold repository begin release tx failed", err, nil)
+		return domain.AccountHold{}, fmt.Errorf("begin release hold transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var released domain.AccountHold
+	if released, err = releaseAccountHold(ctx, tx, accountNumber, reference, status); err != nil {
+		return domain.AccountHold{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("account hold repository commit release tx failed", err, nil)
+		return domain.AccountHold{}, fmt.Errorf("commit release hold transaction: %w", err)
+	}
+
+	return released, nil
+}
+
+// CaptureHold turns an ACTIVE hold into a real debit of amount, crediting the
+// suspense account. Any uncaptured remainder goes back to the available balance.
+// The account status is not checked: the funds were already reserved.
+func (r *AccountHoldRepository) CaptureHold(
+	ctx context.Context,
+	accountNumber string,
+	reference string,
+	amount decimal.Decimal,
+	suspenseAccountNumber string,
+) (domain.AccountHold, error) {
+	logger.Info("account hold repository capture hold", logger.Fields{
+		"accountNumber": accountNumber,
+		"reference":     reference,
+		"amount":        amount,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("account hold repository begin capture tx failed", err, nil)
+		return domain.AccountHold{}, fmt.Errorf("begin capture hold transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const holdQuery = `
+UPDATE account_holds
+SET status = 'CAPTURED',
+    captured_amount = $3::numeric,
+    resolved_at = NOW(),
+    updated_at = NOW()
+WHERE account_number = $1
+  AND reference = $2
+  AND status = 'ACTIVE'
+  AND (expires_at IS NULL OR expires_at > NOW())
+  AND amount >= $3::numeric
+RETURNING ` + accountHoldColumns
+
+	var captured domain.AccountHold
+	if err = scanAccountHold(tx.QueryRowContext(ctx, holdQuery, accountNumber, reference, amount), &captured); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = holdCaptureMissError(ctx, tx, accountNumber, reference)
+			return domain.AccountHold{}, err
+		}
+		return domain.AccountHold{}, fmt.Errorf("capture hold: %w", err)
+	}
+
+	const accountQuery = `
+UPDATE accounts
+SET available_balance = available_balance + ($3::numeric - $2::numeric),
+    ledger_balance = ledger_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+RETURNING customer_id, currency, available_balance, ledger_balance`
+
+	data := domain.AccountEventData{
+		AccountNumber: accountNumber,
+		Amount:        amount,
+		HoldReference: reference,
+	}
+	if err = tx.QueryRowContext(ctx, accountQuery, accountNumber, amount, captured.Amount).Scan(
+		&data.CustomerID,
+		&data.Currency,
+		&data.AvailableBalance,
+		&data.LedgerBalance,
+	); err != nil {
+		return domain.AccountHold{}, fmt.Errorf("post captured hold: %w", err)
+	}
+
+	const suspenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1`
+	if _, err = execRequiredRows(ctx, tx, suspenseQuery, suspenseAccountNumber, amount); err != nil {
+		return domain.AccountHold{}, err
+	}
+
+	var event domain.OutboxEvent
+	if event, err = domain.NewAccountOutboxEvent(domain.AccountEventDebited, data); err != nil {
+		return domain.AccountHold{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.AccountHold{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("account hold repository commit capture tx failed", err, nil)
+		return domain.AccountHold{}, fmt.Errorf("commit capture hold transaction: %w", err)
+	}
+
+	return captured, nil
+}
+
+// holdCaptureMissError tells a hold that lapsed before the expiry sweeper
+// released it apart from one that is missing or no longer active.
+func holdCaptureMissError(ctx context.Context, tx *sql.Tx, accountNumber string, reference string) error {
+	const query = `
+SELECT EXISTS (
+    SELECT 1
+    FROM account_holds
+    WHERE account_number = $1
+      AND reference = $2
+      AND status = 'ACTIVE'
+      AND expires_at <= NOW()
+)`
+
+	var expired bool
+	if err := tx.QueryRowContext(ctx, query, accountNumber, reference).Scan(&expired); err != nil {
+		return fmt.Errorf("check hold expiry: %w", err)
+	}
+	if expired {
+		return commons.ErrAccountHoldExpired
+	}
+	return commons.ErrRecordNotFound
+}
+
+func (r *AccountHoldRepository) GetByReference(ctx context.Context, accountNumber string, reference string) (domain.AccountHold, error) {
+	const query = `
+SELECT ` + accountHoldColumns + `
+FROM account_holds
+WHERE account_number = $1
+  AND reference = $2`
+
+	var hold domain.AccountHold
+	if err := scanAccountHold(r.db.QueryRowContext(ctx, query, accountNumber, reference), &hold); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.AccountHold{}, commons.ErrRecordNotFound
+		}
+		logger.Error("account hold repository get by reference failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+			"reference":     reference,
+		})
+		return domain.AccountHold{}, fmt.Errorf("get account hold: %w", err)
+	}
+	return hold, nil
+}
+
+// GetByAccountNumber returns every hold on an account, newest first.
+func (r *AccountHoldRepository) GetByAccountNumber(ctx context.Context, accountNumber string) ([]domain.AccountHold, error) {
+	const query = `
+SELECT ` + accountHoldColumns + `
+FROM account_holds
+WHERE account_number = $1
+ORDER BY created_at DESC`
+
+	return r.queryHolds(ctx, query, accountNumber)
+}
+
+// GetExpired returns ACTIVE holds whose expiry has passed, oldest expiry first.
+func (r *AccountHoldRepository) GetExpired(ctx context.Context, limit int) ([]domain.AccountHold, error) {
+	const query = `
+SELECT ` + accountHoldColumns + `
+FROM account_holds
+WHERE status = 'ACTIVE'
+  AND expires_at <= NOW()
+ORDER BY expires_at ASC
+LIMIT $1`
+
+	return r.queryHolds(ctx, query, limit)
+}
+
+func (r *AccountHoldRepository) queryHolds(ctx context.Context, query string, args ...any) ([]domain.AccountHold, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("account hold repository query holds failed", err, nil)
+		return nil, fmt.Errorf("query account holds: %w", err)
+	}
+	defer rows.Close()
+
+	holds := make([]domain.AccountHold, 0)
+	for rows.Next() {
+		var hold domain.AccountHold
+		if err := scanAccountHold(rows, &hold); err != nil {
+			return nil, fmt.Errorf("scan account hold: %w", err)
+		}
+		holds = append(holds, hold)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate account holds: %w", err)
+	}
+	return holds, nil
+}
+
+// accountPostingError explains why a guarded update on the account matched no
+// row.
+func (r *AccountHoldRepository) accountPostingError(ctx context.Context, accountNumber string) error {
+	const query = `SELECT status FROM accounts WHERE account_number = $1`
+
+	var status domain.AccountStatus
+	if err := r.db.QueryRowContext(ctx, query, accountNumber).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return commons.ErrRecordNotFound
+		}
+		return fmt.Errorf("get account status: %w", err)
+	}
+	if status != domain.AccountStatusActive {
+		return fmt.Errorf("account is not active")
+	}
+	return commons.ErrInsufficientBalance
+}
+
+// placeAccountHold inserts an ACTIVE hold and reserves its amount. Liens may take
+// the available balance below zero; every other hold needs the funds available,
+// counting any approved overdraft limit.
+// It returns errPostingFailed when the account is missing, inactive or short.
+func placeAccountHold(ctx context.Context, tx *sql.Tx, hold domain.AccountHold) (domain.AccountHold, error) {
+	const insertQuery = `
+INSERT INTO account_holds (
+	account_number,
+	hold_type,
+	amount,
+	reason,
+	reference,
+	expires_at
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING ` + accountHoldColumns
+
+	var placed domain.AccountHold
+	if err := scanAccountHold(tx.QueryRowContext(ctx, insertQuery, hold.AccountNumber, hold.HoldType, hold.Amount, hold.Reason, hold.Reference, hold.ExpiresAt), &placed); err != nil {
+		return domain.AccountHold{}, fmt.Errorf("insert account hold: %w", err)
+	}
+
+	const reserveQuery = `
+UPDATE accounts
+SET available_balance = available_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND status = 'ACTIVE'
+  AND ($3 OR available_balance + overdraft_limit >= $2::numeric)
+RETURNING customer_id, currency, available_balance, ledger_balance`
+
+	data := domain.AccountEventData{
+		AccountNumber: placed.AccountNumber,
+		Amount:        placed.Amount,
+		HoldReference: placed.Reference,
+	}
+	if err := tx.QueryRowContext(ctx, reserveQuery, placed.AccountNumber, placed.Amount, placed.HoldType == domain.AccountHoldTypeLien).Scan(
+		&data.CustomerID,
+		&data.Currency,
+		&data.AvailableBalance,
+		&data.LedgerBalance,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.AccountHold{}, errPostingFailed
+		}
+		return domain.AccountHold{}, fmt.Errorf("reserve account hold: %w", err)
+	}
+
+	event, err := domain.NewAccountOutboxEvent(domain.AccountEventHoldPlaced, data)
+	if err != nil {
+		return domain.AccountHold{}, err
+	}
+	if err := insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.AccountHold{}, err
+	}
+	return placed, nil
+}
+
+// releaseAccountHold closes an ACTIVE hold with status and returns its amount to
+// the available balance, whatever the account status.
+func releaseAccountHold(ctx context.Context, tx *sql.Tx, accountNumber string, reference string, status domain.AccountHoldStatus) (domain.AccountHold, error) {
+	const holdQuery = `
+UPDATE account_holds
+SET status = $3::varchar,
+    resolved_at = NOW(),
+    updated_at = NOW()
+WHERE account_number = $1
+  AND reference = $2
+  AND status = 'ACTIVE'
+RETURNING ` + accountHoldColumns
+
+	var released domain.AccountHold
+	if err := scanAccountHold(tx.QueryRowContext(ctx, holdQuery, accountNumber, reference, status), &released); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.AccountHold{}, commons.ErrRecordNotFound
+		}
+		return domain.AccountHold{}, fmt.Errorf("release account hold: %w", err)
+	}
+
+	const restoreQuery = `
+UPDATE accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+RETURNING customer_id, currency, available_balance, ledger_balance`
+
+	data := domain.AccountEventData{
+		AccountNumber: accountNumber,
+		Amount:        released.Amount,
+		HoldReference: reference,
+	}
+	if err := tx.QueryRowContext(ctx, restoreQuery, accountNumber, released.Amount).Scan(
+		&data.CustomerID,
+		&data.Currency,
+		&data.AvailableBalance,
+		&data.LedgerBalance,
+	); err != nil {
+		return domain.AccountHold{}, fmt.Errorf("restore held funds: %w", err)
+	}
+
+	event, err := domain.NewAccountOutboxEvent(domain.AccountEventHoldReleased, data)
+	if err != nil {
+		return domain.AccountHold{}, err
+	}
+	if err := insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.AccountHold{}, err
+	}
+	return released, nil
+}
+
+func scanAccountHold(row rowScanner, hold *domain.AccountHold) error {
+	var (
+		expiresAt  sql.NullTime
+		resolvedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&hold.ID,
+		&hold.AccountNumber,
+		&hold.HoldType,
+		&hold.Amount,
+		&hold.CapturedAmount,
+		&hold.Reason,
+		&hold.Reference,
+		&hold.Status,
+		&expiresAt,
+		&resolvedAt,
+		&hold.CreatedAt,
+		&hold.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if expiresAt.Valid {
+		value := expiresAt.Time
+		hold.ExpiresAt = &value
+	}
+	if resolvedAt.Valid {
+		value := resolvedAt.Time
+		hold.ResolvedAt = &value
+	}
+	return nil
+}
@@ -33,26 +33,42 @@ INSERT INTO accounts (
 	customer_id,
 	account_number,
 	currency,
+	product,
 	available_balance,
 	ledger_balance,
 	status
-) VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, created_at, updated_at`
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, last_activity_at, created_at, updated_at`
 
 	var createdAt time.Time
 	var updatedAt time.Time
 	var id string
+	if account.Product == "" {
+		account.Product = domain.AccountProductStandard
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("account repository begin create tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("begin create account transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
 
-	if err := r.db.QueryRowContext(
+	if err = tx.QueryRowContext(
 		ctx,
 		query,
 		account.CustomerID,
 		account.AccountNumber,
 		account.Currency,
+		account.Product,
 		account.AvailableBalance,
 		account.LedgerBalance,
 		account.Status,
-	).Scan(&id, &createdAt, &updatedAt); err != nil {
+	).Scan(&id, &account.LastActivityAt, &createdAt, &updatedAt); err != nil {
 		logger.Error("account repository create failed", err, logger.Fields{
 			"customerId":    account.CustomerID,
 			"accountNumber": account.AccountNumber,
@@ -63,6 +79,27 @@ RETURNING id, created_at, updated_at`
 	account.ID = id
 	account.CreatedAt = createdAt
 	account.UpdatedAt = updatedAt
+
+	event, err := domain.NewAccountOutboxEvent(domain.AccountEventOpened, domain.AccountEventData{
+		AccountNumber:    account.AccountNumber,
+		CustomerID:       account.CustomerID,
+		Currency:         account.Currency,
+		Amount:           decimal.Zero,
+		AvailableBalance: account.AvailableBalance,
+		LedgerBalance:    account.LedgerBalance,
+	})
+	if err != nil {
+		return domain.Account{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.Account{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("account repository commit create tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("commit create account transaction: %w", err)
+	}
+
 	logger.Info("account repository create success", logger.Fields{
 		"accountId":     account.ID,
 		"accountNumber": account.AccountNumber,
@@ -71,28 +108,64 @@ RETURNING id, created_at, updated_at`
 	return account, nil
 }
 
+// NextAccountSerial allocates the serial part of a new account number. Sequence
+// values are never reused, so concurrent instances cannot allocate the same one.
+func (r *AccountRepository) NextAccountSerial(ctx context.Context) (int64, error) {
+	const query = `SELECT nextval('account_number_serial_seq')`
+
+	var serial int64
+	if err := r.db.QueryRowContext(ctx, query).Scan(&serial); err != nil {
+		logger.Error("account repository next account serial failed", err, nil)
+		return 0, fmt.Errorf("next account serial: %w", err)
+	}
+	return serial, nil
+}
+
+// GetLegacyAccountNumbers lists the account numbers whose check digit does not
+// match bankCode. They were issued before numbers were allocated from
+// account_number_serial_seq.
+func (r *AccountRepository) GetLegacyAccountNumbers(ctx context.Context, bankCode string) ([]string, error) {
+	const query = `SELECT account_number FROM accounts`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		logger.Error("account repository get legacy account numbers failed", err, nil)
+		return nil, fmt.Errorf("get legacy account numbers: %w", err)
+	}
+	defer rows.Close()
+
+	legacy := make([]string, 0)
+	for rows.Next() {
+		var accountNumber string
+		if err := rows.Scan(&accountNumber); err != nil {
+			return nil, fmt.Errorf("scan account number: %w", err)
+		}
+		if !commons.IsValidAccountNumber(bankCode, accountNumber) {
+			legacy = append(legacy, accountNumber)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate account numbers: %w", err)
+	}
+
+	logger.Info("account repository get legacy account numbers success", logger.Fields{
+		"count": len(legacy),
+	})
+	return legacy, nil
+}
+
 func (r *AccountRepository) GetByAccountNumber(ctx context.Context, accountNumber string) (domain.Account, error) {
 	logger.Info("account repository get by account number", logger.Fields{
 		"accountNumber": accountNumber,
 	})
 
 	const query = `
-SELECT id, customer_id, account_number, currency, available_balance, ledger_balance, status, created_at, updated_at
+SELECT ` + accountColumns + `
 FROM accounts
 WHERE account_number = $1`
 
 	var account domain.Account
-	if err := r.db.QueryRowContext(ctx, query, accountNumber).Scan(
-		&account.ID,
-		&account.CustomerID,
-		&account.AccountNumber,
-		&account.Currency,
-		&account.AvailableBalance,
-		&account.LedgerBalance,
-		&account.Status,
-		&account.CreatedAt,
-		&account.UpdatedAt,
-	); err != nil {
+	if err := scanAccount(r.db.QueryRowContext(ctx, query, accountNumber), &account); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("account repository record not found", logger.Fields{
 				"accountNumber": accountNumber,
@@ -113,6 +186,46 @@ WHERE account_number = $1`
 	return account, nil
 }
 
+// GetByCustomerID returns every account of a customer, oldest first.
+func (r *AccountRepository) GetByCustomerID(ctx context.Context, customerID string) ([]domain.Account, error) {
+	logger.Info("account repository get by customer id", logger.Fields{
+		"customerId": customerID,
+	})
+
+	const query = `
+SELECT ` + accountColumns + `
+FROM accounts
+WHERE customer_id = $1
+ORDER BY created_at, account_number`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID)
+	if err != nil {
+		logger.Error("account repository get by customer id failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return nil, fmt.Errorf("get accounts by customer id: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := make([]domain.Account, 0)
+	for rows.Next() {
+		var account domain.Account
+		if err := scanAccount(rows, &account); err != nil {
+			return nil, fmt.Errorf("scan account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate accounts: %w", err)
+	}
+
+	logger.Info("account repository get by customer id success", logger.Fields{
+		"customerId": customerID,
+		"count":      len(accounts),
+	})
+	return accounts, nil
+}
+
 func (r *AccountRepository) HasAccountForCustomerIDAndCurrency(ctx context.Context, customerID string, currency string) (bool, error) {
 	logger.Info("account repository has account for customer id and currency", logger.Fields{
 		"customerId": customerID,
@@ -242,54 +355,511 @@ WHERE account_number = $1
 	return nil
 }
 
-func (r *AccountRepository) DepositFunds(ctx context.Context, accountNumber string, amount decimal.Decimal) error {
+// DepositFunds records the deposit and posts it in one transaction: the funding
+// GL account of the deposit currency is debited, the customer account credited
+// and both legs journaled. It returns commons.ErrDuplicateExternalReference when
+// the source already delivered a deposit with the same external reference.
+func (r *AccountRepository) DepositFunds(ctx context.Context, deposit domain.Deposit) (domain.Deposit, error) {
 	logger.Info("account repository deposit funds", logger.Fields{
+		"reference":              deposit.Reference,
+		"accountNumber":          deposit.AccountNumber,
+		"amount":                 deposit.Amount,
+		"source":                 deposit.Source,
+		"externalReference":      deposit.ExternalReference,
+		"fundingGLAccountNumber": deposit.FundingGLAccountNumber,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("account repository begin deposit tx failed", err, nil)
+		return domain.Deposit{}, fmt.Errorf("begin deposit transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const insertQuery = `
+INSERT INTO deposits (
+	reference,
+	account_number,
+	currency,
+	amount,
+	source,
+	external_reference,
+	funding_gl_account_number,
+	narration,
+	channel
+) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9, ''))
+ON CONFLICT (source, external_reference) DO NOTHING
+RETURNING id, created_at`
+
+	if err = tx.QueryRowContext(
+		ctx,
+		insertQuery,
+		deposit.Reference,
+		deposit.AccountNumber,
+		deposit.Currency,
+		deposit.Amount,
+		deposit.Source,
+		deposit.ExternalReference,
+		deposit.FundingGLAccountNumber,
+		deposit.Narration,
+		deposit.Channel,
+	).Scan(&deposit.ID, &deposit.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrDuplicateExternalReference
+			return domain.Deposit{}, err
+		}
+		logger.Error("account repository insert deposit failed", err, logger.Fields{
+			"reference": deposit.Reference,
+		})
+		return domain.Deposit{}, fmt.Errorf("create deposit: %w", err)
+	}
+
+	// Funding GL accounts may run negative: they carry what the provider owes.
+	const debitFundingGLQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND UPPER(currency) = UPPER($3)`
+	if _, err = execRequiredRows(ctx, tx, debitFundingGLQuery, deposit.FundingGLAccountNumber, deposit.Amount, deposit.Currency); err != nil {
+		logger.Error("account repository debit funding gl failed", err, logger.Fields{
+			"fundingGLAccountNumber": deposit.FundingGLAccountNumber,
+		})
+		return domain.Deposit{}, fmt.Errorf("debit funding gl account: %w", err)
+	}
+
+	if err = postAccountBalance(ctx, tx, creditAccountBalanceQuery, domain.AccountEventCredited, deposit.AccountNumber, deposit.Amount, ""); err != nil {
+		if !errors.Is(err, errPostingFailed) {
+			logger.Error("account repository deposit funds failed", err, logger.Fields{
+				"accountNumber": deposit.AccountNumber,
+				"amount":        deposit.Amount,
+			})
+			return domain.Deposit{}, fmt.Errorf("deposit funds: %w", err)
+		}
+
+		account, getErr := r.GetByAccountNumber(ctx, deposit.AccountNumber)
+		if getErr != nil {
+			if errors.Is(getErr, commons.ErrRecordNotFound) {
+				return domain.Deposit{}, commons.ErrRecordNotFound
+			}
+			return domain.Deposit{}, getErr
+		}
+		if !account.Status.AcceptsCredits() {
+			return domain.Deposit{}, fmt.Errorf("account is not active")
+		}
+		return domain.Deposit{}, commons.ErrRecordNotFound
+	}
+
+	entries := []domain.JournalEntry{
+		depositJournalEntry(deposit, deposit.FundingGLAccountNumber, domain.LedgerEntryDebit),
+		depositJournalEntry(deposit, deposit.AccountNumber, domain.LedgerEntryCredit),
+	}
+	if deposit.JournalEntries, err = insertJournalEntries(ctx, tx, entries); err != nil {
+		logger.Error("account repository deposit journal failed", err, logger.Fields{
+			"reference": deposit.Reference,
+		})
+		return domain.Deposit{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("account repository commit deposit tx failed", err, nil)
+		return domain.Deposit{}, fmt.Errorf("commit deposit transaction: %w", err)
+	}
+
+	logger.Info("account repository deposit funds success", logger.Fields{
+		"depositId":     deposit.ID,
+		"accountNumber": deposit.AccountNumber,
+		"amount":        deposit.Amount,
+	})
+	return deposit, nil
+}
+
+func (r *AccountRepository) GetDeposit(ctx context.Context, reference string) (domain.Deposit, error) {
+	const query = `
+SELECT ` + depositColumns + `
+FROM deposits
+WHERE reference = $1`
+
+	return r.getDeposit(ctx, query, reference)
+}
+
+func (r *AccountRepository) GetDepositByExternalReference(ctx context.Context, source domain.DepositSource, externalReference string) (domain.Deposit, error) {
+	const query = `
+SELECT ` + depositColumns + `
+FROM deposits
+WHERE source = $1
+  AND external_reference = $2`
+
+	return r.getDeposit(ctx, query, source, externalReference)
+}
+
+func (r *AccountRepository) getDeposit(ctx context.Context, query string, args ...any) (domain.Deposit, error) {
+	logger.Info("account repository get deposit", logger.Fields{
+		"args": args,
+	})
+
+	var deposit domain.Deposit
+	var narration, channel sql.NullString
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(
+		&deposit.ID,
+		&deposit.Reference,
+		&deposit.AccountNumber,
+		&deposit.Currency,
+		&deposit.Amount,
+		&deposit.Source,
+		&deposit.ExternalReference,
+		&deposit.FundingGLAccountNumber,
+		&narration,
+		&channel,
+		&deposit.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Deposit{}, commons.ErrRecordNotFound
+		}
+		logger.Error("account repository get deposit failed", err, nil)
+		return domain.Deposit{}, fmt.Errorf("get deposit: %w", err)
+	}
+	deposit.Narration = narration.String
+	deposit.Channel = channel.String
+
+	entries, err := getJournalEntries(ctx, r.db, domain.JournalReferenceDeposit, deposit.ID)
+	if err != nil {
+		logger.Error("account repository get deposit journal failed", err, logger.Fields{
+			"depositId": deposit.ID,
+		})
+		return domain.Deposit{}, err
+	}
+	deposit.JournalEntries = entries
+
+	return deposit, nil
+}
+
+// ChangeStatus moves an account from one status to event.ToStatus and records the
+// change in the status history. It returns commons.ErrRecordNotFound if the
+// account is not currently in from.
+func (r *AccountRepository) ChangeStatus(ctx context.Context, accountNumber string, from domain.AccountStatus, event domain.AccountStatusEvent) (domain.Account, error) {
+	logger.Info("account repository change status", logger.Fields{
 		"accountNumber": accountNumber,
-		"amount":        amount,
+		"from":          from,
+		"to":            event.ToStatus,
+		"reason":        event.Reason,
 	})
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("account repository begin change status tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("begin change account status transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
 	const query = `
 UPDATE accounts
-SET available_balance = available_balance + $2::numeric,
-    ledger_balance = ledger_balance + $2::numeric,
+SET status = $3::varchar,
     updated_at = NOW()
 WHERE account_number = $1
-  AND status = 'ACTIVE'`
+  AND status = $2::varchar
+RETURNING ` + accountColumns
 
-	result, err := r.db.ExecContext(ctx, query, accountNumber, amount)
+	var account domain.Account
+	if err = scanAccount(tx.QueryRowContext(ctx, query, accountNumber, from, event.ToStatus), &account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.Account{}, err
+		}
+		return domain.Account{}, fmt.Errorf("change account status: %w", err)
+	}
+
+	event.AccountNumber = accountNumber
+	event.FromStatus = from
+	if err = insertAccountStatusEvent(ctx, tx, account, event); err != nil {
+		return domain.Account{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("account repository commit change status tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("commit change account status transaction: %w", err)
+	}
+
+	return account, nil
+}
+
+// CloseAccount closes an ACTIVE or FROZEN account. A non-zero balance is only
+// allowed with a sweep, which moves the whole balance through the suspense
+// account to the destination at sweep.Rate. Accounts with active holds or a
+// negative balance cannot be closed, and FROZEN accounts cannot be swept.
+func (r *AccountRepository) CloseAccount(ctx context.Context, accountNumber string, sweep *domain.AccountSweep, event domain.AccountStatusEvent) (domain.Account, error) {
+	logger.Info("account repository close account", logger.Fields{
+		"accountNumber": accountNumber,
+		"sweep":         sweep != nil,
+		"reason":        event.Reason,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		logger.Error("account repository deposit funds failed", err, logger.Fields{
-			"accountNumber": accountNumber,
-			"amount":        amount,
-		})
-		return fmt.Errorf("deposit funds: %w", err)
+		logger.Error("account repository begin close tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("begin close account transaction: %w", err)
 	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
 
-	rowsAffected, err := result.RowsAffected()
+	const lockQuery = `
+SELECT ` + accountColumns + `
+FROM accounts
+WHERE account_number = $1
+  AND status IN ('ACTIVE', 'FROZEN')
+FOR UPDATE`
+
+	var account domain.Account
+	if err = scanAccount(tx.QueryRowContext(ctx, lockQuery, accountNumber), &account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.Account{}, err
+		}
+		return domain.Account{}, fmt.Errorf("lock account: %w", err)
+	}
+	if sweep != nil && account.Status == domain.AccountStatusFrozen {
+		err = commons.ErrFrozenAccountSweep
+		return domain.Account{}, err
+	}
+
+	const holdsQuery = `
+SELECT EXISTS (
+	SELECT 1
+	FROM account_holds
+	WHERE account_number = $1
+	  AND status = 'ACTIVE'
+)`
+	var hasHolds bool
+	if err = tx.QueryRowContext(ctx, holdsQuery, accountNumber).Scan(&hasHolds); err != nil {
+		return domain.Account{}, fmt.Errorf("check active holds: %w", err)
+	}
+	if hasHolds {
+		err = commons.ErrAccountHasActiveHolds
+		return domain.Account{}, err
+	}
+
+	balance := account.LedgerBalance
+	event.AccountNumber = accountNumber
+	event.FromStatus = account.Status
+	event.ToStatus = domain.AccountStatusClosed
+
+	if !balance.IsZero() {
+		if sweep == nil || balance.IsNegative() {
+			err = commons.ErrAccountBalanceNotZero
+			return domain.Account{}, err
+		}
+		if err = sweepClosingBalance(ctx, tx, account, *sweep); err != nil {
+			return domain.Account{}, err
+		}
+		event.SweepAccountNumber = &sweep.DestinationAccountNumber
+		event.SweptAmount = &balance
+	}
+
+	const closeQuery = `
+UPDATE accounts
+SET status = 'CLOSED',
+    available_balance = 0,
+    ledger_balance = 0,
+    updated_at = NOW()
+WHERE account_number = $1
+RETURNING ` + accountColumns
+	if err = scanAccount(tx.QueryRowContext(ctx, closeQuery, accountNumber), &account); err != nil {
+		return domain.Account{}, fmt.Errorf("close account: %w", err)
+	}
+
+	if err = insertAccountStatusEvent(ctx, tx, account, event); err != nil {
+		return domain.Account{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("account repository commit close tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("commit close account transaction: %w", err)
+	}
+
+	return account, nil
+}
+
+// GetStatusHistory returns the status changes of an account, oldest first.
+func (r *AccountRepository) GetStatusHistory(ctx context.Context, accountNumber string) ([]domain.AccountStatusEvent, error) {
+	const query = `
+SELECT id, account_number, from_status, to_status, reason, comment, actor, sweep_account_number, swept_amount, created_at
+FROM account_status_events
+WHERE account_number = $1
+ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, accountNumber)
 	if err != nil {
-		logger.Error("account repository deposit funds rows affected failed", err, logger.Fields{
+		logger.Error("account repository get status history failed", err, logger.Fields{
 			"accountNumber": accountNumber,
 		})
-		return fmt.Errorf("deposit funds rows affected: %w", err)
+		return nil, fmt.Errorf("get account status history: %w", err)
 	}
-
-	if rowsAffected == 0 {
-		account, getErr := r.GetByAccountNumber(ctx, accountNumber)
-		if getErr != nil {
-			if errors.Is(getErr, commons.ErrRecordNotFound) {
-				return commons.ErrRecordNotFound
-			}
-			return getErr
+	defer rows.Close()
+
+	events := make([]domain.AccountStatusEvent, 0)
+	for rows.Next() {
+		var (
+			event              domain.AccountStatusEvent
+			sweepAccountNumber sql.NullString
+			sweptAmount        decimal.NullDecimal
+		)
+		if err := rows.Scan(
+			&event.ID,
+			&event.AccountNumber,
+			&event.FromStatus,
+			&event.ToStatus,
+			&event.Reason,
+			&event.Comment,
+			&event.Actor,
+			&sweepAccountNumber,
+			&sweptAmount,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan account status event: %w", err)
 		}
-		if account.Status != domain.AccountStatusActive {
-			return fmt.Errorf("account is not active")
+		if sweepAccountNumber.Valid {
+			value := sweepAccountNumber.String
+			event.SweepAccountNumber = &value
 		}
-		return commons.ErrRecordNotFound
+		if sweptAmount.Valid {
+			value := sweptAmount.Decimal
+			event.SweptAmount = &value
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate account status events: %w", err)
 	}
+	return events, nil
+}
 
-	logger.Info("account repository deposit funds success", logger.Fields{
-		"accountNumber": accountNumber,
-		"amount":        amount,
+// sweepClosingBalance debits the whole balance of a locked closing account and
+// credits the converted amount to the destination via the suspense account, as
+// an internal transfer would. The suspense account is credited and debited with
+// the same source-currency balance, so it nets to zero.
+func sweepClosingBalance(ctx context.Context, tx *sql.Tx, account domain.Account, sweep domain.AccountSweep) error {
+	balance := account.LedgerBalance
+	creditAmount := balance.Mul(sweep.Rate).Round(2)
+
+	const debitQuery = `
+UPDATE accounts
+SET available_balance = available_balance - $2::numeric,
+    ledger_balance = ledger_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+RETURNING customer_id, currency, available_balance, ledger_balance`
+	if err := postAccountBalance(ctx, tx, debitQuery, domain.AccountEventDebited, account.AccountNumber, balance, ""); err != nil {
+		return err
+	}
+
+	const creditSuspenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1`
+	if _, err := execRequiredRows(ctx, tx, creditSuspenseQuery, sweep.SuspenseAccountNumber, balance); err != nil {
+		return err
+	}
+
+	const debitSuspenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND available_balance >= $2::numeric`
+	if _, err := execRequiredRows(ctx, tx, debitSuspenseQuery, sweep.SuspenseAccountNumber, balance); err != nil {
+		return err
+	}
+
+	return postAccountBalance(ctx, tx, creditAccountBalanceQuery, domain.AccountEventCredited, sweep.DestinationAccountNumber, creditAmount, "")
+}
+
+func insertAccountStatusEvent(ctx context.Context, tx *sql.Tx, account domain.Account, event domain.AccountStatusEvent) error {
+	const query = `
+INSERT INTO account_status_events (
+	account_number,
+	from_status,
+	to_status,
+	reason,
+	comment,
+	actor,
+	sweep_account_number,
+	swept_amount
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	var sweptAmount decimal.NullDecimal
+	if event.SweptAmount != nil {
+		sweptAmount = decimal.NewNullDecimal(*event.SweptAmount)
+	}
+	if _, err := tx.ExecContext(ctx, query,
+		event.AccountNumber,
+		event.FromStatus,
+		event.ToStatus,
+		event.Reason,
+		event.Comment,
+		event.Actor,
+		event.SweepAccountNumber,
+		sweptAmount,
+	); err != nil {
+		return fmt.Errorf("insert account status event: %w", err)
+	}
+
+	outboxEvent, err := domain.NewAccountOutboxEvent(domain.AccountEventStatusChanged, domain.AccountEventData{
+		AccountNumber:    account.AccountNumber,
+		CustomerID:       account.CustomerID,
+		Currency:         account.Currency,
+		Amount:           decimal.Zero,
+		AvailableBalance: account.AvailableBalance,
+		LedgerBalance:    account.LedgerBalance,
+		Status:           event.ToStatus,
+		StatusReason:     string(event.Reason),
 	})
-	return nil
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(ctx, tx, outboxEvent)
+}
+
+const accountColumns = `id, customer_id, account_number, currency, product, available_balance, ledger_balance, overdraft_limit, status, last_activity_at, created_at, updated_at`
+
+func scanAccount(row rowScanner, account *domain.Account) error {
+	return row.Scan(
+		&account.ID,
+		&account.CustomerID,
+		&account.AccountNumber,
+		&account.Currency,
+		&account.Product,
+		&account.AvailableBalance,
+		&account.LedgerBalance,
+		&account.OverdraftLimit,
+		&account.Status,
+		&account.LastActivityAt,
+		&account.CreatedAt,
+		&account.UpdatedAt,
+	)
+}
+
+const depositColumns = `id, reference, account_number, currency, amount, source, external_reference, funding_gl_account_number, narration, channel, created_at`
+
+func depositJournalEntry(deposit domain.Deposit, accountNumber string, entryType domain.LedgerEntryType) domain.JournalEntry {
+	return domain.JournalEntry{
+		ReferenceType: domain.JournalReferenceDeposit,
+		ReferenceID:   deposit.ID,
+		Reference:     deposit.Reference,
+		AccountNumber: accountNumber,
+		EntryType:     entryType,
+		Currency:      deposit.Currency,
+		Amount:        deposit.Amount,
+	}
 }
@@ -0,0 +1,345 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/lib/pq"
+)
+
+type ChannelRepository struct {
+	db *sql.DB
+}
+
+func NewChannelRepository(db *sql.DB) *ChannelRepository {
+	return &ChannelRepository{db: db}
+}
+
+const channelColumns = `channel_id, name, scopes, allowed_cidrs, status, created_by, updated_by, created_at, updated_at`
+
+const channelKeyColumns = `id, channel_id, key_hash, created_by, created_at, expires_at`
+
+func (r *ChannelRepository) Create(ctx context.Context, channel domain.Channel, key domain.ChannelKey) (domain.Channel, error) {
+	logger.Info("channel repository create", logger.Fields{
+		"channelId": channel.ChannelID,
+		"scopes":    channel.Scopes,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("channel repository begin create tx failed", err, nil)
+		return domain.Channel{}, fmt.Errorf("begin create channel transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+INSERT INTO channels (channel_id, name, scopes, allowed_cidrs, status, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $6)
+ON CONFLICT (channel_id) DO NOTHING
+RETURNING ` + channelColumns
+
+	var created domain.Channel
+	if err = scanChannel(tx.QueryRowContext(
+		ctx,
+		query,
+		channel.ChannelID,
+		channel.Name,
+		pq.Array(scopesToStrings(channel.Scopes)),
+		pq.Array(channel.AllowedCIDRs),
+		channel.Status,
+		channel.CreatedBy,
+	), &created); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrDuplicateChannel
+			return domain.Channel{}, err
+		}
+		logger.Error("channel repository create failed", err, logger.Fields{
+			"channelId": channel.ChannelID,
+		})
+		return domain.Channel{}, fmt.Errorf("create channel: %w", err)
+	}
+
+	key.ChannelID = created.ChannelID
+	var createdKey domain.ChannelKey
+	if createdKey, err = insertChannelKey(ctx, tx, key); err != nil {
+		return domain.Channel{}, err
+	}
+	created.Keys = []domain.ChannelKey{createdKey}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("channel repository commit create tx failed", err, nil)
+		return domain.Channel{}, fmt.Errorf("commit create channel transaction: %w", err)
+	}
+
+	logger.Info("channel repository create success", logger.Fields{
+		"channelId": created.ChannelID,
+	})
+	return created, nil
+}
+
+func (r *ChannelRepository) GetByChannelID(ctx context.Context, channelID string, now time.Time) (domain.Channel, error) {
+	const query = `
+SELECT ` + channelColumns + `
+FROM channels
+WHERE channel_id = $1`
+
+	var channel domain.Channel
+	if err := scanChannel(r.db.QueryRowContext(ctx, query, channelID), &channel); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Channel{}, commons.ErrRecordNotFound
+		}
+		logger.Error("channel repository get failed", err, logger.Fields{
+			"channelId": channelID,
+		})
+		return domain.Channel{}, fmt.Errorf("get channel: %w", err)
+	}
+
+	keys, err := r.getKeys(ctx, channelID, now)
+	if err != nil {
+		return domain.Channel{}, err
+	}
+	channel.Keys = keys
+	return channel, nil
+}
+
+func (r *ChannelRepository) GetAll(ctx context.Context) ([]domain.Channel, error) {
+	const query = `
+SELECT ` + channelColumns + `
+FROM channels
+ORDER BY channel_id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		logger.Error("channel repository get all failed", err, nil)
+		return nil, fmt.Errorf("get channels: %w", err)
+	}
+	defer rows.Close()
+
+	channels := make([]domain.Channel, 0)
+	for rows.Next() {
+		var channel domain.Channel
+		if err := scanChannel(rows, &channel); err != nil {
+			return nil, fmt.Errorf("scan channel: %w", err)
+		}
+		channels = append(channels, channel)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate channels: %w", err)
+	}
+	return channels, nil
+}
+
+func (r *ChannelRepository) Update(ctx context.Context, channel domain.Channel) (domain.Channel, error) {
+	logger.Info("channel repository update", logger.Fields{
+		"channelId": channel.ChannelID,
+		"scopes":    channel.Scopes,
+		"status":    channel.Status,
+		"updatedBy": channel.UpdatedBy,
+	})
+
+	const query = `
+UPDATE channels
+SET name = $2,
+    scopes = $3,
+    allowed_cidrs = $4,
+    status = $5,
+    updated_by = $6,
+    updated_at = NOW()
+WHERE channel_id = $1
+RETURNING ` + channelColumns
+
+	var updated domain.Channel
+	if err := scanChannel(r.db.QueryRowContext(
+		ctx,
+		query,
+		channel.ChannelID,
+		channel.Name,
+		pq.Array(scopesToStrings(channel.Scopes)),
+		pq.Array(channel.AllowedCIDRs),
+		channel.Status,
+		channel.UpdatedBy,
+	), &updated); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Channel{}, commons.ErrRecordNotFound
+		}
+		logger.Error("channel repository update failed", err, logger.Fields{
+			"channelId": channel.ChannelID,
+		})
+		return domain.Channel{}, fmt.Errorf("update channel: %w", err)
+	}
+	return updated, nil
+}
+
+func (r *ChannelRepository) RotateKey(ctx context.Context, key domain.ChannelKey, retireAt time.Time) (domain.Channel, error) {
+	logger.Info("channel repository rotate key", logger.Fields{
+		"channelId": key.ChannelID,
+		"createdBy": key.CreatedBy,
+		"retireAt":  retireAt,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("channel repository begin rotate key tx failed", err, nil)
+		return domain.Channel{}, fmt.Errorf("begin rotate channel key transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Locking the channel serializes rotations, so two concurrent rotations
+	// cannot both leave their predecessor without an expiry.
+	const lockQuery = `
+SELECT ` + channelColumns + `
+FROM channels
+WHERE channel_id = $1
+FOR UPDATE`
+
+	var channel domain.Channel
+	if err = scanChannel(tx.QueryRowContext(ctx, lockQuery, key.ChannelID), &channel); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.Channel{}, err
+		}
+		return domain.Channel{}, fmt.Errorf("lock channel: %w", err)
+	}
+
+	const retireQuery = `
+UPDATE channel_keys
+SET expires_at = $2
+WHERE channel_id = $1
+  AND (expires_at IS NULL OR expires_at > $2)`
+
+	if _, err = tx.ExecContext(ctx, retireQuery, key.ChannelID, retireAt); err != nil {
+		return domain.Channel{}, fmt.Errorf("retire channel keys: %w", err)
+	}
+
+	if _, err = insertChannelKey(ctx, tx, key); err != nil {
+		return domain.Channel{}, err
+	}
+
+	const touchQuery = `
+UPDATE channels
+SET updated_by = $2,
+    updated_at = NOW()
+WHERE channel_id = $1`
+
+	if _, err = tx.ExecContext(ctx, touchQuery, key.ChannelID, key.CreatedBy); err != nil {
+		return domain.Channel{}, fmt.Errorf("touch channel: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("channel repository commit rotate key tx failed", err, nil)
+		return domain.Channel{}, fmt.Errorf("commit rotate channel key transaction: %w", err)
+	}
+
+	return r.GetByChannelID(ctx, key.ChannelID, time.Now())
+}
+
+func (r *ChannelRepository) getKeys(ctx context.Context, channelID string, now time.Time) ([]domain.ChannelKey, error) {
+	const query = `
+SELECT ` + channelKeyColumns + `
+FROM channel_keys
+WHERE channel_id = $1
+  AND (expires_at IS NULL OR expires_at > $2)
+ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, channelID, now)
+	if err != nil {
+		logger.Error("channel repository get keys failed", err, logger.Fields{
+			"channelId": channelID,
+		})
+		return nil, fmt.Errorf("get channel keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]domain.ChannelKey, 0)
+	for rows.Next() {
+		var key domain.ChannelKey
+		if err := scanChannelKey(rows, &key); err != nil {
+			return nil, fmt.Errorf("scan channel key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate channel keys: %w", err)
+	}
+	return keys, nil
+}
+
+func insertChannelKey(ctx context.Context, tx *sql.Tx, key domain.ChannelKey) (domain.ChannelKey, error) {
+	const query = `
+INSERT INTO channel_keys (channel_id, key_hash, created_by, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING ` + channelKeyColumns
+
+	var created domain.ChannelKey
+	if err := scanChannelKey(tx.QueryRowContext(ctx, query, key.ChannelID, key.KeyHash, key.CreatedBy, key.ExpiresAt), &created); err != nil {
+		logger.Error("channel repository insert key failed", err, logger.Fields{
+			"channelId": key.ChannelID,
+		})
+		return domain.ChannelKey{}, fmt.Errorf("insert channel key: %w", err)
+	}
+	return created, nil
+}
+
+func scanChannel(row rowScanner, channel *domain.Channel) error {
+	var scopes []string
+	if err := row.Scan(
+		&channel.ChannelID,
+		&channel.Name,
+		pq.Array(&scopes),
+		pq.Array(&channel.AllowedCIDRs),
+		&channel.Status,
+		&channel.CreatedBy,
+		&channel.UpdatedBy,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	channel.Scopes = make([]domain.ChannelScope, 0, len(scopes))
+	for _, scope := range scopes {
+		channel.Scopes = append(channel.Scopes, domain.ChannelScope(scope))
+	}
+	return nil
+}
+
+func scanChannelKey(row rowScanner, key *domain.ChannelKey) error {
+	var expiresAt sql.NullTime
+	if err := row.Scan(
+		&key.ID,
+		&key.ChannelID,
+		&key.KeyHash,
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&expiresAt,
+	); err != nil {
+		return err
+	}
+	if expiresAt.Valid {
+		value := expiresAt.Time
+		key.ExpiresAt = &value
+	}
+	return nil
+}
+
+func scopesToStrings(scopes []domain.ChannelScope) []string {
+	values := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		values = append(values, string(scope))
+	}
+	return values
+}
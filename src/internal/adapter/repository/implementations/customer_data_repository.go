@@ -0,0 +1,276 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/lib/pq"
+)
+
+type CustomerDataRepository struct {
+	db *sql.DB
+}
+
+func NewCustomerDataRepository(db *sql.DB) *CustomerDataRepository {
+	return &CustomerDataRepository{db: db}
+}
+
+func (r *CustomerDataRepository) GetTransfersByCustomerID(ctx context.Context, customerID string) ([]domain.Transfer, error) {
+	const query = `
+SELECT ` + transferColumns + `
+FROM transfers
+WHERE debit_account_number IN (SELECT account_number FROM accounts WHERE customer_id = $1)
+   OR credit_account_number IN (SELECT account_number FROM accounts WHERE customer_id = $1)
+ORDER BY created_at, id`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID)
+	if err != nil {
+		logger.Error("customer data repository get transfers failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return nil, fmt.Errorf("get customer transfers: %w", err)
+	}
+	defer rows.Close()
+
+	transfers := make([]domain.Transfer, 0)
+	for rows.Next() {
+		var transfer domain.Transfer
+		if err := scanTransfer(rows, &transfer); err != nil {
+			return nil, fmt.Errorf("scan customer transfer: %w", err)
+		}
+		transfers = append(transfers, transfer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate customer transfers: %w", err)
+	}
+	return transfers, nil
+}
+
+func (r *CustomerDataRepository) RecordExport(ctx context.Context, request domain.DataSubjectRequest) (domain.DataSubjectRequest, error) {
+	request.Type = domain.DataSubjectRequestExport
+	if err := r.db.QueryRowContext(ctx, insertDataSubjectRequestQuery,
+		request.CustomerID,
+		request.Type,
+		request.RequestedBy,
+		request.Reason,
+		request.TransfersScrubbed,
+		request.ProfileChangesScrubbed,
+	).Scan(&request.ID, &request.CreatedAt); err != nil {
+		logger.Error("customer data repository record export failed", err, logger.Fields{
+			"customerId": request.CustomerID,
+		})
+		return domain.DataSubjectRequest{}, fmt.Errorf("record data export: %w", err)
+	}
+	return request, nil
+}
+
+func (r *CustomerDataRepository) Erase(ctx context.Context, request domain.DataSubjectRequest) (domain.DataSubjectRequest, error) {
+	logger.Info("customer data repository erase", logger.Fields{
+		"customerId":  request.CustomerID,
+		"requestedBy": request.RequestedBy,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("customer data repository begin erase tx failed", err, nil)
+		return domain.DataSubjectRequest{}, fmt.Errorf("begin erase transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const lockUserQuery = `
+SELECT status
+FROM users
+WHERE customer_id = $1
+FOR UPDATE`
+
+	var status domain.UserStatus
+	if err = tx.QueryRowContext(ctx, lockUserQuery, request.CustomerID).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.DataSubjectRequest{}, err
+		}
+		return domain.DataSubjectRequest{}, fmt.Errorf("lock customer: %w", err)
+	}
+	if status == domain.UserStatusErased {
+		err = commons.ErrCustomerErased
+		return domain.DataSubjectRequest{}, err
+	}
+
+	// Accounts are locked too, so none can be reopened or credited into a
+	// state that needs the customer's identity while the erasure commits.
+	const openAccountsQuery = `
+SELECT account_number
+FROM accounts
+WHERE customer_id = $1
+  AND status <> 'CLOSED'
+FOR UPDATE`
+
+	var openAccount string
+	err = tx.QueryRowContext(ctx, openAccountsQuery, request.CustomerID).Scan(&openAccount)
+	switch {
+	case err == nil:
+		err = commons.ErrCustomerHasOpenAccounts
+		return domain.DataSubjectRequest{}, err
+	case !errors.Is(err, sql.ErrNoRows):
+		return domain.DataSubjectRequest{}, fmt.Errorf("check open accounts: %w", err)
+	}
+
+	// The row is left in the pre-encryption form (pii_key_version 0) with
+	// nothing to encrypt, and without blind indexes, so it can never match a
+	// lookup and the identity document can be onboarded again.
+	const eraseUserQuery = `
+UPDATE users
+SET first_name = $2,
+    middle_name = NULL,
+    last_name = $2,
+    dob = NULL,
+    dob_encrypted = NULL,
+    phone_number = '',
+    email = NULL,
+    address = NULL,
+    id_number = '',
+    transaction_pin_hash = '',
+    identity_key = NULL,
+    dob_index = NULL,
+    phone_index = NULL,
+    pii_key_version = 0,
+    status = 'ERASED',
+    erased_at = NOW(),
+    updated_at = NOW()
+WHERE customer_id = $1`
+
+	if _, err = tx.ExecContext(ctx, eraseUserQuery, request.CustomerID, domain.ErasedName); err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("erase customer: %w", err)
+	}
+
+	const scrubTransfersQuery = `
+UPDATE transfers
+SET audit_payload = (audit_payload::jsonb - $2::text[])::text
+WHERE (debit_account_number IN (SELECT account_number FROM accounts WHERE customer_id = $1)
+    OR credit_account_number IN (SELECT account_number FROM accounts WHERE customer_id = $1))
+  AND audit_payload::jsonb ?| $2::text[]`
+
+	result, err := tx.ExecContext(ctx, scrubTransfersQuery, request.CustomerID, pq.Array(domain.AuditPayloadPIIKeys))
+	if err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("scrub transfer audit payloads: %w", err)
+	}
+	transfersScrubbed, err := result.RowsAffected()
+	if err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("count scrubbed transfers: %w", err)
+	}
+
+	const scrubProfileChangesQuery = `
+UPDATE customer_profile_changes
+SET old_value = '',
+    new_value = '',
+    key_version = 0
+WHERE customer_id = $1`
+
+	result, err = tx.ExecContext(ctx, scrubProfileChangesQuery, request.CustomerID)
+	if err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("scrub profile changes: %w", err)
+	}
+	profileChangesScrubbed, err := result.RowsAffected()
+	if err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("count scrubbed profile changes: %w", err)
+	}
+
+	// Upgrade requests keep the document type, issuing country, levels and
+	// review outcome as evidence the customer was verified; the document
+	// number and the reference to the stored image identify the person and go.
+	const scrubKYCUpgradesQuery = `
+UPDATE kyc_upgrade_requests
+SET document_number = '',
+    document_reference = '',
+    updated_at = NOW()
+WHERE customer_id = $1`
+
+	if _, err = tx.ExecContext(ctx, scrubKYCUpgradesQuery, request.CustomerID); err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("scrub kyc upgrade requests: %w", err)
+	}
+
+	// Screening decisions are kept as AML evidence with their list version and
+	// the listed party they matched, which is published data. The name that
+	// was screened is the customer's own, or the counterparty on one of their
+	// transfers, and is pseudonymized like the user row.
+	const scrubSanctionsScreeningsQuery = `
+UPDATE sanctions_screenings
+SET screened_name = $2
+WHERE (subject_type = 'USER' AND subject_id = $1)
+   OR (subject_type = 'TRANSFER' AND subject_id IN (
+        SELECT id::text
+        FROM transfers
+        WHERE debit_account_number IN (SELECT account_number FROM accounts WHERE customer_id = $1)
+           OR credit_account_number IN (SELECT account_number FROM accounts WHERE customer_id = $1)))`
+
+	if _, err = tx.ExecContext(ctx, scrubSanctionsScreeningsQuery, request.CustomerID, domain.ErasedName); err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("scrub sanctions screenings: %w", err)
+	}
+
+	const dismissCandidatesQuery = `
+UPDATE customer_duplicate_candidates
+SET status = 'DISMISSED',
+    reviewed_by = $2,
+    review_comment = 'Customer data erased',
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE status = 'OPEN'
+  AND (customer_id = $1 OR matched_customer_id = $1)`
+
+	if _, err = tx.ExecContext(ctx, dismissCandidatesQuery, request.CustomerID, request.RequestedBy); err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("dismiss duplicate candidates: %w", err)
+	}
+
+	request.Type = domain.DataSubjectRequestErasure
+	request.TransfersScrubbed = int(transfersScrubbed)
+	request.ProfileChangesScrubbed = int(profileChangesScrubbed)
+	if err = tx.QueryRowContext(ctx, insertDataSubjectRequestQuery,
+		request.CustomerID,
+		request.Type,
+		request.RequestedBy,
+		request.Reason,
+		request.TransfersScrubbed,
+		request.ProfileChangesScrubbed,
+	).Scan(&request.ID, &request.CreatedAt); err != nil {
+		return domain.DataSubjectRequest{}, fmt.Errorf("record erasure: %w", err)
+	}
+
+	var event domain.OutboxEvent
+	if event, err = domain.NewCustomerOutboxEvent(domain.CustomerEventErased, domain.CustomerEventData{
+		CustomerID:    request.CustomerID,
+		Actor:         request.RequestedBy,
+		DataRequestID: request.ID,
+	}); err != nil {
+		return domain.DataSubjectRequest{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.DataSubjectRequest{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("customer data repository commit erase tx failed", err, nil)
+		return domain.DataSubjectRequest{}, fmt.Errorf("commit erase transaction: %w", err)
+	}
+
+	logger.Info("customer data repository erase success", logger.Fields{
+		"customerId":             request.CustomerID,
+		"requestId":              request.ID,
+		"transfersScrubbed":      request.TransfersScrubbed,
+		"profileChangesScrubbed": request.ProfileChangesScrubbed,
+	})
+	return request, nil
+}
+
+const insertDataSubjectRequestQuery = `
+INSERT INTO customer_data_requests (customer_id, request_type, requested_by, reason, transfers_scrubbed, profile_changes_scrubbed)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at`
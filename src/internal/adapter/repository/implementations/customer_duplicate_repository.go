@@ -0,0 +1,458 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/encryption"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/lib/pq"
+)
+
+type CustomerDuplicateRepository struct {
+	db     *sql.DB
+	cipher *encryption.FieldCipher
+}
+
+func NewCustomerDuplicateRepository(db *sql.DB, cipher *encryption.FieldCipher) *CustomerDuplicateRepository {
+	return &CustomerDuplicateRepository{db: db, cipher: cipher}
+}
+
+func (r *CustomerDuplicateRepository) FindPotentialDuplicates(ctx context.Context, identityKey string, dob time.Time, phoneKey string) ([]domain.User, error) {
+	const query = `
+SELECT ` + userColumns + `
+FROM users
+WHERE status <> 'MERGED'
+  AND (
+	identity_key = $1
+	OR dob_index = $2
+	OR phone_index = NULLIF($3, '')
+  )
+ORDER BY created_at
+LIMIT 100`
+
+	rows, err := r.db.QueryContext(ctx, query, identityIndex(r.cipher, identityKey), dobIndex(r.cipher, dob), phoneIndex(r.cipher, phoneKey))
+	if err != nil {
+		logger.Error("customer duplicate repository find potential duplicates failed", err, nil)
+		return nil, fmt.Errorf("find potential duplicates: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+	for rows.Next() {
+		var user domain.User
+		if err := scanUser(rows, &user, r.cipher); err != nil {
+			return nil, fmt.Errorf("scan potential duplicate: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate potential duplicates: %w", err)
+	}
+	return users, nil
+}
+
+func (r *CustomerDuplicateRepository) Flag(ctx context.Context, customerID string, candidates []domain.DuplicateCandidate) ([]domain.DuplicateCandidate, error) {
+	logger.Info("customer duplicate repository flag", logger.Fields{
+		"customerId": customerID,
+		"candidates": len(candidates),
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("customer duplicate repository begin flag tx failed", err, nil)
+		return nil, fmt.Errorf("begin duplicate flag transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+INSERT INTO customer_duplicate_candidates (customer_id, matched_customer_id, matched_on, name_score)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (customer_id, matched_customer_id) DO NOTHING
+RETURNING ` + duplicateCandidateColumns
+
+	flagged := make([]domain.DuplicateCandidate, 0, len(candidates))
+	matchedCustomerIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		var created domain.DuplicateCandidate
+		err = scanDuplicateCandidate(tx.QueryRowContext(
+			ctx,
+			query,
+			customerID,
+			candidate.MatchedCustomerID,
+			joinDuplicateSignals(candidate.MatchedOn),
+			candidate.NameScore,
+		), &created)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			continue
+		}
+		if err != nil {
+			logger.Error("customer duplicate repository insert candidate failed", err, logger.Fields{
+				"customerId":        customerID,
+				"matchedCustomerId": candidate.MatchedCustomerID,
+			})
+			return nil, fmt.Errorf("insert duplicate candidate: %w", err)
+		}
+		flagged = append(flagged, created)
+		matchedCustomerIDs = append(matchedCustomerIDs, created.MatchedCustomerID)
+	}
+
+	if len(flagged) > 0 {
+		var event domain.OutboxEvent
+		if event, err = domain.NewCustomerOutboxEvent(domain.CustomerEventDuplicateFlagged, domain.CustomerEventData{
+			CustomerID:         customerID,
+			MatchedCustomerIDs: matchedCustomerIDs,
+		}); err != nil {
+			return nil, err
+		}
+		if err = insertOutboxEvents(ctx, tx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("customer duplicate repository commit flag tx failed", err, nil)
+		return nil, fmt.Errorf("commit duplicate flag transaction: %w", err)
+	}
+	return flagged, nil
+}
+
+func (r *CustomerDuplicateRepository) GetByID(ctx context.Context, id string) (domain.DuplicateCandidate, error) {
+	const query = `
+SELECT ` + duplicateCandidateColumns + `
+FROM customer_duplicate_candidates
+WHERE id = $1`
+
+	var candidate domain.DuplicateCandidate
+	if err := scanDuplicateCandidate(r.db.QueryRowContext(ctx, query, id), &candidate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.DuplicateCandidate{}, commons.ErrRecordNotFound
+		}
+		logger.Error("customer duplicate repository get by id failed", err, logger.Fields{
+			"candidateId": id,
+		})
+		return domain.DuplicateCandidate{}, fmt.Errorf("get duplicate candidate: %w", err)
+	}
+	return candidate, nil
+}
+
+func (r *CustomerDuplicateRepository) GetByStatus(ctx context.Context, status domain.DuplicateCandidateStatus, limit int) ([]domain.DuplicateCandidate, error) {
+	const query = `
+SELECT ` + duplicateCandidateColumns + `
+FROM customer_duplicate_candidates
+WHERE status = $1
+ORDER BY created_at
+LIMIT $2`
+
+	return r.query(ctx, query, status, limit)
+}
+
+func (r *CustomerDuplicateRepository) GetByCustomerID(ctx context.Context, customerID string) ([]domain.DuplicateCandidate, error) {
+	const query = `
+SELECT ` + duplicateCandidateColumns + `
+FROM customer_duplicate_candidates
+WHERE customer_id = $1 OR matched_customer_id = $1
+ORDER BY created_at DESC`
+
+	return r.query(ctx, query, customerID)
+}
+
+func (r *CustomerDuplicateRepository) Dismiss(ctx context.Context, id string, reviewedBy string, comment string) (domain.DuplicateCandidate, error) {
+	logger.Info("customer duplicate repository dismiss", logger.Fields{
+		"candidateId": id,
+		"reviewedBy":  reviewedBy,
+	})
+
+	const query = `
+UPDATE customer_duplicate_candidates
+SET status = 'DISMISSED',
+    reviewed_by = $2,
+    review_comment = $3,
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'OPEN'
+RETURNING ` + duplicateCandidateColumns
+
+	var dismissed domain.DuplicateCandidate
+	if err := scanDuplicateCandidate(r.db.QueryRowContext(ctx, query, id, reviewedBy, comment), &dismissed); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.DuplicateCandidate{}, commons.ErrRecordNotFound
+		}
+		logger.Error("customer duplicate repository dismiss failed", err, logger.Fields{
+			"candidateId": id,
+		})
+		return domain.DuplicateCandidate{}, fmt.Errorf("dismiss duplicate candidate: %w", err)
+	}
+	return dismissed, nil
+}
+
+func (r *CustomerDuplicateRepository) Merge(ctx context.Context, merge domain.CustomerMerge) (domain.CustomerMerge, error) {
+	logger.Info("customer duplicate repository merge", logger.Fields{
+		"candidateId":         merge.CandidateID,
+		"survivingCustomerId": merge.SurvivingCustomerID,
+		"mergedCustomerId":    merge.MergedCustomerID,
+		"mergedBy":            merge.MergedBy,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("customer duplicate repository begin merge tx failed", err, nil)
+		return domain.CustomerMerge{}, fmt.Errorf("begin customer merge transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const lockCandidateQuery = `
+SELECT id
+FROM customer_duplicate_candidates
+WHERE id = $1
+  AND status = 'OPEN'
+FOR UPDATE`
+
+	var candidateID string
+	if err = tx.QueryRowContext(ctx, lockCandidateQuery, merge.CandidateID).Scan(&candidateID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.CustomerMerge{}, err
+		}
+		return domain.CustomerMerge{}, fmt.Errorf("lock duplicate candidate: %w", err)
+	}
+
+	// Both customers are locked in customer_id order so concurrent merges of
+	// overlapping pairs cannot deadlock.
+	const lockUsersQuery = `
+SELECT customer_id, status
+FROM users
+WHERE customer_id IN ($1, $2)
+ORDER BY customer_id
+FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, lockUsersQuery, merge.SurvivingCustomerID, merge.MergedCustomerID)
+	if err != nil {
+		return domain.CustomerMerge{}, fmt.Errorf("lock merged customers: %w", err)
+	}
+	locked := 0
+	mergeable := true
+	for rows.Next() {
+		var customerID string
+		var status domain.UserStatus
+		if err = rows.Scan(&customerID, &status); err != nil {
+			rows.Close()
+			return domain.CustomerMerge{}, fmt.Errorf("scan merged customer: %w", err)
+		}
+		locked++
+		// HELD, REJECTED, ERASED and MERGED customers are under review or no
+		// longer in use; neither side of a merge may be one of them.
+		mergeable = mergeable && status == domain.UserStatusActive
+	}
+	if err = rows.Err(); err != nil {
+		rows.Close()
+		return domain.CustomerMerge{}, fmt.Errorf("lock merged customers: %w", err)
+	}
+	rows.Close()
+	if locked != 2 {
+		err = commons.ErrRecordNotFound
+		return domain.CustomerMerge{}, err
+	}
+	if !mergeable {
+		err = commons.ErrCustomerNotMergeable
+		return domain.CustomerMerge{}, err
+	}
+
+	const conflictQuery = `
+SELECT EXISTS (
+	SELECT 1
+	FROM accounts surviving
+	JOIN accounts merged ON merged.currency = surviving.currency
+	WHERE surviving.customer_id = $1
+	  AND merged.customer_id = $2
+	  AND surviving.status <> 'CLOSED'
+	  AND merged.status <> 'CLOSED'
+)`
+
+	var conflict bool
+	if err = tx.QueryRowContext(ctx, conflictQuery, merge.SurvivingCustomerID, merge.MergedCustomerID).Scan(&conflict); err != nil {
+		return domain.CustomerMerge{}, fmt.Errorf("check merge currency conflict: %w", err)
+	}
+	if conflict {
+		err = commons.ErrCustomerAccountConflict
+		return domain.CustomerMerge{}, err
+	}
+
+	const moveAccountsQuery = `
+UPDATE accounts
+SET customer_id = $1,
+    updated_at = NOW()
+WHERE customer_id = $2
+RETURNING account_number`
+
+	accountRows, err := tx.QueryContext(ctx, moveAccountsQuery, merge.SurvivingCustomerID, merge.MergedCustomerID)
+	if err != nil {
+		logger.Error("customer duplicate repository move accounts failed", err, logger.Fields{
+			"mergedCustomerId": merge.MergedCustomerID,
+		})
+		return domain.CustomerMerge{}, fmt.Errorf("move merged accounts: %w", err)
+	}
+	accountNumbers := make([]string, 0)
+	for accountRows.Next() {
+		var accountNumber string
+		if err = accountRows.Scan(&accountNumber); err != nil {
+			accountRows.Close()
+			return domain.CustomerMerge{}, fmt.Errorf("scan merged account: %w", err)
+		}
+		accountNumbers = append(accountNumbers, accountNumber)
+	}
+	if err = accountRows.Err(); err != nil {
+		accountRows.Close()
+		return domain.CustomerMerge{}, fmt.Errorf("iterate merged accounts: %w", err)
+	}
+	accountRows.Close()
+
+	// The merged customer gives up its identity key so the document stays
+	// unique to the surviving customer.
+	const mergeUserQuery = `
+UPDATE users
+SET status = 'MERGED',
+    merged_into_customer_id = $1,
+    identity_key = NULL,
+    updated_at = NOW()
+WHERE customer_id = $2`
+
+	if _, err = tx.ExecContext(ctx, mergeUserQuery, merge.SurvivingCustomerID, merge.MergedCustomerID); err != nil {
+		return domain.CustomerMerge{}, fmt.Errorf("mark customer merged: %w", err)
+	}
+
+	const closeCandidatesQuery = `
+UPDATE customer_duplicate_candidates
+SET status = CASE WHEN id = $1 THEN 'MERGED' ELSE 'DISMISSED' END,
+    reviewed_by = $3,
+    review_comment = CASE WHEN id = $1 THEN $4 ELSE 'Customer merged into ' || $5 END,
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE status = 'OPEN'
+  AND (id = $1 OR customer_id = $2 OR matched_customer_id = $2)`
+
+	if _, err = tx.ExecContext(ctx, closeCandidatesQuery, merge.CandidateID, merge.MergedCustomerID, merge.MergedBy, merge.Comment, merge.SurvivingCustomerID); err != nil {
+		return domain.CustomerMerge{}, fmt.Errorf("close duplicate candidates: %w", err)
+	}
+
+	const insertMergeQuery = `
+INSERT INTO customer_merges (candidate_id, surviving_customer_id, merged_customer_id, account_numbers, merged_by, comment)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at`
+
+	merge.AccountNumbers = accountNumbers
+	if err = tx.QueryRowContext(
+		ctx,
+		insertMergeQuery,
+		merge.CandidateID,
+		merge.SurvivingCustomerID,
+		merge.MergedCustomerID,
+		pq.Array(accountNumbers),
+		merge.MergedBy,
+		merge.Comment,
+	).Scan(&merge.ID, &merge.CreatedAt); err != nil {
+		return domain.CustomerMerge{}, fmt.Errorf("record customer merge: %w", err)
+	}
+
+	var event domain.OutboxEvent
+	if event, err = domain.NewCustomerOutboxEvent(domain.CustomerEventMerged, domain.CustomerEventData{
+		CustomerID:           merge.MergedCustomerID,
+		MergedIntoCustomerID: merge.SurvivingCustomerID,
+		AccountNumbers:       accountNumbers,
+		Actor:                merge.MergedBy,
+		Comment:              merge.Comment,
+	}); err != nil {
+		return domain.CustomerMerge{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.CustomerMerge{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("customer duplicate repository commit merge tx failed", err, nil)
+		return domain.CustomerMerge{}, fmt.Errorf("commit customer merge transaction: %w", err)
+	}
+
+	logger.Info("customer duplicate repository merge success", logger.Fields{
+		"mergeId":             merge.ID,
+		"survivingCustomerId": merge.SurvivingCustomerID,
+		"mergedCustomerId":    merge.MergedCustomerID,
+		"accountNumbers":      accountNumbers,
+	})
+	return merge, nil
+}
+
+func (r *CustomerDuplicateRepository) query(ctx context.Context, query string, args ...any) ([]domain.DuplicateCandidate, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("customer duplicate repository query failed", err, nil)
+		return nil, fmt.Errorf("query duplicate candidates: %w", err)
+	}
+	defer rows.Close()
+
+	candidates := make([]domain.DuplicateCandidate, 0)
+	for rows.Next() {
+		var candidate domain.DuplicateCandidate
+		if err := scanDuplicateCandidate(rows, &candidate); err != nil {
+			return nil, fmt.Errorf("scan duplicate candidate: %w", err)
+		}
+		candidates = append(candidates, candidate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate duplicate candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+func joinDuplicateSignals(signals []domain.DuplicateSignal) string {
+	parts := make([]string, 0, len(signals))
+	for _, signal := range signals {
+		parts = append(parts, string(signal))
+	}
+	return strings.Join(parts, "+")
+}
+
+const duplicateCandidateColumns = `id, customer_id, matched_customer_id, matched_on, name_score, status, reviewed_by, review_comment, reviewed_at, created_at, updated_at`
+
+func scanDuplicateCandidate(row rowScanner, candidate *domain.DuplicateCandidate) error {
+	var matchedOn string
+	if err := row.Scan(
+		&candidate.ID,
+		&candidate.CustomerID,
+		&candidate.MatchedCustomerID,
+		&matchedOn,
+		&candidate.NameScore,
+		&candidate.Status,
+		&candidate.ReviewedBy,
+		&candidate.ReviewComment,
+		&candidate.ReviewedAt,
+		&candidate.CreatedAt,
+		&candidate.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	candidate.MatchedOn = candidate.MatchedOn[:0]
+	for _, signal := range strings.Split(matchedOn, "+") {
+		if signal != "" {
+			candidate.MatchedOn = append(candidate.MatchedOn, domain.DuplicateSignal(signal))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+type DormancyRepository struct {
+	db *sql.DB
+}
+
+func NewDormancyRepository(db *sql.DB) *DormancyRepository {
+	return &DormancyRepository{db: db}
+}
+
+// MarkDormant moves up to limit ACTIVE accounts whose last activity is before
+// inactiveSince to DORMANT, oldest first, and records each change in the status
+// history in the same transaction. Accounts locked by a concurrent posting are
+// skipped and picked up by a later run.
+func (r *DormancyRepository) MarkDormant(ctx context.Context, inactiveSince time.Time, limit int) ([]domain.Account, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("dormancy repository begin mark dormant tx failed", err, nil)
+		return nil, fmt.Errorf("begin mark dormant transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+UPDATE accounts
+SET status = 'DORMANT',
+    updated_at = NOW()
+WHERE account_number IN (
+	SELECT account_number
+	FROM accounts
+	WHERE status = 'ACTIVE'
+	  AND last_activity_at < $1
+	ORDER BY last_activity_at
+	LIMIT $2
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + accountColumns
+
+	rows, err := tx.QueryContext(ctx, query, inactiveSince, limit)
+	if err != nil {
+		logger.Error("dormancy repository mark dormant failed", err, nil)
+		return nil, fmt.Errorf("mark accounts dormant: %w", err)
+	}
+	accounts := make([]domain.Account, 0)
+	for rows.Next() {
+		var account domain.Account
+		if err = scanAccount(rows, &account); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err = rows.Close(); err != nil {
+		return nil, fmt.Errorf("close account rows: %w", err)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate dormant accounts: %w", err)
+	}
+
+	for _, account := range accounts {
+		if err = insertAccountStatusEvent(ctx, tx, account, domain.AccountStatusEvent{
+			AccountNumber: account.AccountNumber,
+			FromStatus:    domain.AccountStatusActive,
+			ToStatus:      domain.AccountStatusDormant,
+			Reason:        domain.AccountStatusReasonInactivity,
+			Comment:       "No customer-initiated activity since " + account.LastActivityAt.UTC().Format(time.DateOnly),
+			Actor:         domain.AccountStatusSystemActor,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("dormancy repository commit mark dormant tx failed", err, nil)
+		return nil, fmt.Errorf("commit mark dormant transaction: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// Reactivate moves a DORMANT account back to ACTIVE, restarts its dormancy
+// period and records the change in the status history. It returns
+// commons.ErrRecordNotFound if the account is not DORMANT.
+func (r *DormancyRepository) Reactivate(ctx context.Context, accountNumber string, event domain.AccountStatusEvent) (domain.Account, error) {
+	logger.Info("dormancy repository reactivate", logger.Fields{
+		"accountNumber": accountNumber,
+		"actor":         event.Actor,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("dormancy repository begin reactivate tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("begin reactivate account transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+UPDATE accounts
+SET status = 'ACTIVE',
+    last_activity_at = NOW(),
+    updated_at = NOW()
+WHERE account_number = $1
+  AND status = 'DORMANT'
+RETURNING ` + accountColumns
+
+	var account domain.Account
+	if err = scanAccount(tx.QueryRowContext(ctx, query, accountNumber), &account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.Account{}, err
+		}
+		return domain.Account{}, fmt.Errorf("reactivate account: %w", err)
+	}
+
+	event.AccountNumber = accountNumber
+	event.FromStatus = domain.AccountStatusDormant
+	event.ToStatus = domain.AccountStatusActive
+	if err = insertAccountStatusEvent(ctx, tx, account, event); err != nil {
+		return domain.Account{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("dormancy repository commit reactivate tx failed", err, nil)
+		return domain.Account{}, fmt.Errorf("commit reactivate account transaction: %w", err)
+	}
+
+	return account, nil
+}
+
+// GetReport returns the number and balances of DORMANT accounts per currency.
+func (r *DormancyRepository) GetReport(ctx context.Context) ([]domain.DormancyReportLine, error) {
+	const query = `
+SELECT currency,
+       COUNT(*),
+       COALESCE(SUM(available_balance), 0),
+       COALESCE(SUM(ledger_balance), 0),
+       MIN(last_activity_at)
+FROM accounts
+WHERE status = 'DORMANT'
+GROUP BY currency
+ORDER BY currency`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		logger.Error("dormancy repository get report failed", err, nil)
+		return nil, fmt.Errorf("get dormancy report: %w", err)
+	}
+	defer rows.Close()
+
+	lines := make([]domain.DormancyReportLine, 0)
+	for rows.Next() {
+		var line domain.DormancyReportLine
+		if err := rows.Scan(
+			&line.Currency,
+			&line.AccountCount,
+			&line.AvailableBalance,
+			&line.LedgerBalance,
+			&line.OldestActivityAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan dormancy report line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate dormancy report lines: %w", err)
+	}
+	return lines, nil
+}
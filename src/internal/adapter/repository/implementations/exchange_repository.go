@@ -0,0 +1,233 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/shopspring/decimal"
+)
+
+type ExchangeRepository struct {
+	db *sql.DB
+}
+
+func NewExchangeRepository(db *sql.DB) *ExchangeRepository {
+	return &ExchangeRepository{db: db}
+}
+
+// Create records the exchange and posts it in one transaction: the source
+// account is debited the total, the suspense account passes it on, the
+// destination account is credited the bought amount, the spread is credited to
+// the FX income GL account and the fees to the charges and VAT accounts. Every
+// leg is journaled. It returns commons.ErrInsufficientBalance when the source
+// account cannot cover the total.
+func (r *ExchangeRepository) Create(ctx context.Context, exchange domain.Exchange, posting domain.ExchangePosting) (domain.Exchange, error) {
+	logger.Info("exchange repository create", logger.Fields{
+		"reference":                exchange.Reference,
+		"sourceAccountNumber":      exchange.SourceAccountNumber,
+		"destinationAccountNumber": exchange.DestinationAccountNumber,
+		"sourceAmount":             exchange.SourceAmount,
+		"destinationAmount":        exchange.DestinationAmount,
+		"quotedRate":               exchange.QuotedRate,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("exchange repository begin tx failed", err, nil)
+		return domain.Exchange{}, fmt.Errorf("begin exchange transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const insertQuery = `
+INSERT INTO exchanges (
+	reference,
+	customer_id,
+	source_account_number,
+	destination_account_number,
+	source_currency,
+	destination_currency,
+	source_amount,
+	destination_amount,
+	mid_rate,
+	quoted_rate,
+	spread_percent,
+	fx_income_amount,
+	charge_amount,
+	vat_amount,
+	total_debit,
+	fx_income_gl_account_number,
+	narration,
+	channel
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NULLIF($17, ''), NULLIF($18, ''))
+RETURNING id, created_at`
+
+	if err = tx.QueryRowContext(
+		ctx,
+		insertQuery,
+		exchange.Reference,
+		exchange.CustomerID,
+		exchange.SourceAccountNumber,
+		exchange.DestinationAccountNumber,
+		exchange.SourceCurrency,
+		exchange.DestinationCurrency,
+		exchange.SourceAmount,
+		exchange.DestinationAmount,
+		exchange.MidRate,
+		exchange.QuotedRate,
+		exchange.SpreadPercent,
+		exchange.FXIncomeAmount,
+		exchange.ChargeAmount,
+		exchange.VATAmount,
+		exchange.TotalDebit,
+		exchange.FXIncomeGLAccountNumber,
+		exchange.Narration,
+		exchange.Channel,
+	).Scan(&exchange.ID, &exchange.CreatedAt); err != nil {
+		logger.Error("exchange repository insert failed", err, logger.Fields{
+			"reference": exchange.Reference,
+		})
+		return domain.Exchange{}, fmt.Errorf("create exchange: %w", err)
+	}
+
+	if err = postAccountBalance(ctx, tx, debitAccountBalanceQuery, domain.AccountEventDebited, exchange.SourceAccountNumber, exchange.TotalDebit, ""); err != nil {
+		if errors.Is(err, errPostingFailed) {
+			err = r.accountPostingError(ctx, exchange.SourceAccountNumber)
+			return domain.Exchange{}, err
+		}
+		logger.Error("exchange repository debit source account failed", err, logger.Fields{
+			"accountNumber": exchange.SourceAccountNumber,
+		})
+		return domain.Exchange{}, err
+	}
+
+	if err = postAccountBalance(ctx, tx, creditAccountBalanceQuery, domain.AccountEventCredited, exchange.DestinationAccountNumber, exchange.DestinationAmount, ""); err != nil {
+		if errors.Is(err, errPostingFailed) {
+			err = r.accountPostingError(ctx, exchange.DestinationAccountNumber)
+			if errors.Is(err, commons.ErrInsufficientBalance) {
+				err = fmt.Errorf("credit destination account: %w", errPostingFailed)
+			}
+			return domain.Exchange{}, err
+		}
+		logger.Error("exchange repository credit destination account failed", err, logger.Fields{
+			"accountNumber": exchange.DestinationAccountNumber,
+		})
+		return domain.Exchange{}, err
+	}
+
+	if err = postExchangeLegs(ctx, tx, exchange, posting); err != nil {
+		logger.Error("exchange repository post legs failed", err, logger.Fields{
+			"reference": exchange.Reference,
+		})
+		return domain.Exchange{}, err
+	}
+
+	if exchange.JournalEntries, err = insertJournalEntries(ctx, tx, exchangeJournalEntries(exchange, posting)); err != nil {
+		logger.Error("exchange repository journal failed", err, logger.Fields{
+			"reference": exchange.Reference,
+		})
+		return domain.Exchange{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("exchange repository commit tx failed", err, nil)
+		return domain.Exchange{}, fmt.Errorf("commit exchange transaction: %w", err)
+	}
+
+	logger.Info("exchange repository create success", logger.Fields{
+		"exchangeId": exchange.ID,
+		"reference":  exchange.Reference,
+	})
+	return exchange, nil
+}
+
+func (r *ExchangeRepository) accountPostingError(ctx context.Context, accountNumber string) error {
+	const query = `SELECT status FROM accounts WHERE account_number = $1`
+
+	var status domain.AccountStatus
+	if err := r.db.QueryRowContext(ctx, query, accountNumber).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return commons.ErrRecordNotFound
+		}
+		return fmt.Errorf("get account status: %w", err)
+	}
+	if status != domain.AccountStatusActive {
+		return fmt.Errorf("account is not active")
+	}
+	return commons.ErrInsufficientBalance
+}
+
+// postExchangeLegs passes the debited total through the suspense account and
+// settles the spread into the FX income GL account and the fees into the fee
+// accounts.
+func postExchangeLegs(ctx context.Context, tx *sql.Tx, exchange domain.Exchange, posting domain.ExchangePosting) error {
+	const creditSuspenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1`
+	if _, err := execRequiredRows(ctx, tx, creditSuspenseQuery, posting.SuspenseAccountNumber, exchange.TotalDebit); err != nil {
+		return err
+	}
+
+	const debitSuspenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND available_balance >= $2::numeric`
+	if _, err := execRequiredRows(ctx, tx, debitSuspenseQuery, posting.SuspenseAccountNumber, exchange.TotalDebit); err != nil {
+		return err
+	}
+
+	const creditGLQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND UPPER(currency) = UPPER($3)`
+	if _, err := execRequiredRows(ctx, tx, creditGLQuery, exchange.FXIncomeGLAccountNumber, exchange.FXIncomeAmount, exchange.DestinationCurrency); err != nil {
+		return err
+	}
+	if _, err := execRequiredRows(ctx, tx, creditGLQuery, posting.ChargesAccountNumber, posting.ChargeUSD, "USD"); err != nil {
+		return err
+	}
+	if _, err := execRequiredRows(ctx, tx, creditGLQuery, posting.VATAccountNumber, posting.VATUSD, "USD"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func exchangeJournalEntries(exchange domain.Exchange, posting domain.ExchangePosting) []domain.JournalEntry {
+	entry := func(accountNumber string, entryType domain.LedgerEntryType, currency string, amount decimal.Decimal) domain.JournalEntry {
+		return domain.JournalEntry{
+			ReferenceType: domain.JournalReferenceExchange,
+			ReferenceID:   exchange.ID,
+			Reference:     exchange.Reference,
+			AccountNumber: accountNumber,
+			EntryType:     entryType,
+			Currency:      currency,
+			Amount:        amount,
+		}
+	}
+	fees := exchange.ChargeAmount.Add(exchange.VATAmount)
+
+	return []domain.JournalEntry{
+		entry(exchange.SourceAccountNumber, domain.LedgerEntryDebit, exchange.SourceCurrency, exchange.TotalDebit),
+		entry(posting.SuspenseAccountNumber, domain.LedgerEntryCredit, exchange.SourceCurrency, exchange.TotalDebit),
+		entry(posting.SuspenseAccountNumber, domain.LedgerEntryDebit, exchange.SourceCurrency, exchange.SourceAmount),
+		entry(exchange.DestinationAccountNumber, domain.LedgerEntryCredit, exchange.DestinationCurrency, exchange.DestinationAmount),
+		entry(exchange.FXIncomeGLAccountNumber, domain.LedgerEntryCredit, exchange.DestinationCurrency, exchange.FXIncomeAmount),
+		entry(posting.SuspenseAccountNumber, domain.LedgerEntryDebit, exchange.SourceCurrency, fees),
+		entry(posting.ChargesAccountNumber, domain.LedgerEntryCredit, "USD", posting.ChargeUSD),
+		entry(posting.VATAccountNumber, domain.LedgerEntryCredit, "USD", posting.VATUSD),
+	}
+}
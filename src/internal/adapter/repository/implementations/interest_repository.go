@@ -0,0 +1,435 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/shopspring/decimal"
+)
+
+type InterestRepository struct {
+	db *sql.DB
+}
+
+func NewInterestRepository(db *sql.DB) *InterestRepository {
+	return &InterestRepository{db: db}
+}
+
+// GetRateTiers returns the whole rate table ordered by currency, product and
+// ascending minimum balance.
+func (r *InterestRepository) GetRateTiers(ctx context.Context) ([]domain.InterestRateTier, error) {
+	const query = `
+SELECT id, currency, product, min_balance, annual_rate_percent, day_count
+FROM interest_rate_tiers
+ORDER BY currency, product, min_balance`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		logger.Error("interest repository get rate tiers failed", err, nil)
+		return nil, fmt.Errorf("get interest rate tiers: %w", err)
+	}
+	defer rows.Close()
+
+	tiers := make([]domain.InterestRateTier, 0)
+	for rows.Next() {
+		var tier domain.InterestRateTier
+		if err := rows.Scan(
+			&tier.ID,
+			&tier.Currency,
+			&tier.Product,
+			&tier.MinBalance,
+			&tier.AnnualRatePercent,
+			&tier.DayCount,
+		); err != nil {
+			return nil, fmt.Errorf("scan interest rate tier: %w", err)
+		}
+		tiers = append(tiers, tier)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate interest rate tiers: %w", err)
+	}
+	return tiers, nil
+}
+
+// GetInterestBearingAccounts returns the accounts that earn interest for the
+// date: not closed and with a positive ledger balance at the end of the date.
+// That balance is the one carried by the account's last opened, credited or
+// debited event before the end of the date, since every posting records its
+// event in the same transaction. Accounts opened after the date have no such
+// event and are left out.
+func (r *InterestRepository) GetInterestBearingAccounts(ctx context.Context, date time.Time) ([]domain.Account, error) {
+	const query = `
+SELECT a.id, a.customer_id, a.account_number, a.currency, a.product, a.available_balance, eod.ledger_balance, a.overdraft_limit, a.status, a.last_activity_at, a.created_at, a.updated_at
+FROM accounts a
+CROSS JOIN LATERAL (
+	SELECT (o.payload::jsonb ->> 'ledgerBalance')::numeric AS ledger_balance
+	FROM outbox o
+	WHERE o.aggregate_type = 'account'
+	  AND o.aggregate_id = a.account_number
+	  AND o.event_type IN ('ACCOUNT_OPENED', 'ACCOUNT_CREDITED', 'ACCOUNT_DEBITED')
+	  AND o.created_at < ($1::date + 1)::timestamp AT TIME ZONE 'UTC'
+	ORDER BY o.aggregate_version DESC
+	LIMIT 1
+) eod
+WHERE a.status <> 'CLOSED'
+  AND eod.ledger_balance > 0
+ORDER BY a.account_number`
+
+	rows, err := r.db.QueryContext(ctx, query, date.Format(time.DateOnly))
+	if err != nil {
+		logger.Error("interest repository get interest bearing accounts failed", err, logger.Fields{
+			"date": date.Format(time.DateOnly),
+		})
+		return nil, fmt.Errorf("get interest bearing accounts: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := make([]domain.Account, 0)
+	for rows.Next() {
+		var account domain.Account
+		if err := scanAccount(rows, &account); err != nil {
+			return nil, fmt.Errorf("scan account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// GetLastBatchRunDate returns the latest date the batch runner completed, or
+// nil when it has not completed one yet.
+func (r *InterestRepository) GetLastBatchRunDate(ctx context.Context) (*time.Time, error) {
+	const query = `SELECT MAX(batch_date) FROM interest_batch_runs`
+
+	var date sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query).Scan(&date); err != nil {
+		logger.Error("interest repository get last batch run date failed", err, nil)
+		return nil, fmt.Errorf("get last interest batch run date: %w", err)
+	}
+	if !date.Valid {
+		return nil, nil
+	}
+	return &date.Time, nil
+}
+
+// RecordBatchRun marks the date as completed by the batch runner. Recording a
+// date twice is a no-op.
+func (r *InterestRepository) RecordBatchRun(ctx context.Context, date time.Time) error {
+	const query = `
+INSERT INTO interest_batch_runs (batch_date)
+VALUES ($1::date)
+ON CONFLICT (batch_date) DO NOTHING`
+
+	if _, err := r.db.ExecContext(ctx, query, date.Format(time.DateOnly)); err != nil {
+		logger.Error("interest repository record batch run failed", err, logger.Fields{
+			"date": date.Format(time.DateOnly),
+		})
+		return fmt.Errorf("record interest batch run: %w", err)
+	}
+	return nil
+}
+
+// RecordAccruals stores the daily accruals in one transaction. An account that
+// already has an accrual for the date keeps it, so re-running a date records
+// nothing new. It returns the number of accruals inserted.
+func (r *InterestRepository) RecordAccruals(ctx context.Context, accruals []domain.InterestAccrual) (int, error) {
+	if len(accruals) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("interest repository begin record accruals tx failed", err, nil)
+		return 0, fmt.Errorf("begin record accruals transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+INSERT INTO interest_accruals (
+	account_number,
+	accrual_date,
+	currency,
+	product,
+	balance,
+	annual_rate_percent,
+	day_count,
+	amount
+) VALUES ($1, $2::date, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (account_number, accrual_date) DO NOTHING`
+
+	inserted := 0
+	for _, accrual := range accruals {
+		var result sql.Result
+		result, err = tx.ExecContext(
+			ctx,
+			query,
+			accrual.AccountNumber,
+			accrual.AccrualDate.Format(time.DateOnly),
+			accrual.Currency,
+			accrual.Product,
+			accrual.Balance,
+			accrual.AnnualRatePercent,
+			accrual.DayCount,
+			accrual.Amount,
+		)
+		if err != nil {
+			logger.Error("interest repository insert accrual failed", err, logger.Fields{
+				"accountNumber": accrual.AccountNumber,
+			})
+			return 0, fmt.Errorf("insert interest accrual: %w", err)
+		}
+		var rows int64
+		if rows, err = result.RowsAffected(); err != nil {
+			return 0, fmt.Errorf("read rows affected: %w", err)
+		}
+		inserted += int(rows)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("interest repository commit record accruals tx failed", err, nil)
+		return 0, fmt.Errorf("commit record accruals transaction: %w", err)
+	}
+
+	logger.Info("interest repository record accruals success", logger.Fields{
+		"count":    len(accruals),
+		"inserted": inserted,
+	})
+	return inserted, nil
+}
+
+// GetOpenAccrualTotals sums, per account, the accruals dated up to periodEnd
+// that have not been capitalized yet. Amounts carried over from earlier periods
+// are included, so PeriodStart is the oldest open accrual date.
+func (r *InterestRepository) GetOpenAccrualTotals(ctx context.Context, periodEnd time.Time) ([]domain.InterestCapitalization, error) {
+	const query = `
+SELECT account_number, currency, MIN(accrual_date), SUM(amount)
+FROM interest_accruals
+WHERE capitalization_id IS NULL
+  AND accrual_date <= $1::date
+GROUP BY account_number, currency
+ORDER BY account_number`
+
+	rows, err := r.db.QueryContext(ctx, query, periodEnd.Format(time.DateOnly))
+	if err != nil {
+		logger.Error("interest repository get open accrual totals failed", err, nil)
+		return nil, fmt.Errorf("get open accrual totals: %w", err)
+	}
+	defer rows.Close()
+
+	totals := make([]domain.InterestCapitalization, 0)
+	for rows.Next() {
+		total := domain.InterestCapitalization{PeriodEnd: periodEnd}
+		if err := rows.Scan(&total.AccountNumber, &total.Currency, &total.PeriodStart, &total.AccruedAmount); err != nil {
+			return nil, fmt.Errorf("scan open accrual total: %w", err)
+		}
+		totals = append(totals, total)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate open accrual totals: %w", err)
+	}
+	return totals, nil
+}
+
+// Capitalize records the capitalization, closes the accruals it pays and posts
+// it in one transaction: the interest expense GL account is debited the gross
+// amount, the customer account is credited the net amount and the withheld tax
+// is credited to the tax GL account. Every leg is journaled. It returns
+// commons.ErrInterestAlreadyCapitalized when the account was already
+// capitalized for the period, and commons.ErrRecordNotFound when the account is
+// missing or closed.
+func (r *InterestRepository) Capitalize(ctx context.Context, capitalization domain.InterestCapitalization) (domain.InterestCapitalization, error) {
+	logger.Info("interest repository capitalize", logger.Fields{
+		"reference":     capitalization.Reference,
+		"accountNumber": capitalization.AccountNumber,
+		"periodEnd":     capitalization.PeriodEnd.Format(time.DateOnly),
+		"grossAmount":   capitalization.GrossAmount,
+		"taxAmount":     capitalization.TaxAmount,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("interest repository begin capitalize tx failed", err, nil)
+		return domain.InterestCapitalization{}, fmt.Errorf("begin capitalize interest transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const insertQuery = `
+INSERT INTO interest_capitalizations (
+	reference,
+	account_number,
+	currency,
+	period_start,
+	period_end,
+	accrued_amount,
+	gross_amount,
+	tax_amount,
+	net_amount,
+	withholding_tax_percent,
+	expense_gl_account_number,
+	tax_gl_account_number
+) VALUES ($1, $2, $3, $4::date, $5::date, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (account_number, period_end) DO NOTHING
+RETURNING id, created_at`
+
+	if err = tx.QueryRowContext(
+		ctx,
+		insertQuery,
+		capitalization.Reference,
+		capitalization.AccountNumber,
+		capitalization.Currency,
+		capitalization.PeriodStart.Format(time.DateOnly),
+		capitalization.PeriodEnd.Format(time.DateOnly),
+		capitalization.AccruedAmount,
+		capitalization.GrossAmount,
+		capitalization.TaxAmount,
+		capitalization.NetAmount,
+		capitalization.WithholdingTaxPercent,
+		capitalization.ExpenseGLAccountNumber,
+		capitalization.TaxGLAccountNumber,
+	).Scan(&capitalization.ID, &capitalization.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrInterestAlreadyCapitalized
+			return domain.InterestCapitalization{}, err
+		}
+		logger.Error("interest repository insert capitalization failed", err, logger.Fields{
+			"reference": capitalization.Reference,
+		})
+		return domain.InterestCapitalization{}, fmt.Errorf("create interest capitalization: %w", err)
+	}
+
+	const closeAccrualsQuery = `
+UPDATE interest_accruals
+SET capitalization_id = $1
+WHERE account_number = $2
+  AND accrual_date <= $3::date
+  AND capitalization_id IS NULL
+RETURNING amount`
+
+	rows, err := tx.QueryContext(ctx, closeAccrualsQuery, capitalization.ID, capitalization.AccountNumber, capitalization.PeriodEnd.Format(time.DateOnly))
+	if err != nil {
+		return domain.InterestCapitalization{}, fmt.Errorf("close interest accruals: %w", err)
+	}
+	closed := decimal.Zero
+	for rows.Next() {
+		var amount decimal.Decimal
+		if err = rows.Scan(&amount); err != nil {
+			_ = rows.Close()
+			return domain.InterestCapitalization{}, fmt.Errorf("scan closed accrual: %w", err)
+		}
+		closed = closed.Add(amount)
+	}
+	if err = rows.Close(); err != nil {
+		return domain.InterestCapitalization{}, fmt.Errorf("close accrual rows: %w", err)
+	}
+	if err = rows.Err(); err != nil {
+		return domain.InterestCapitalization{}, fmt.Errorf("iterate closed accruals: %w", err)
+	}
+	// An accrual recorded between reading the totals and closing them would
+	// make the posting disagree with the stored accrued amount.
+	if !closed.Equal(capitalization.AccruedAmount) {
+		err = fmt.Errorf("closed accruals total %s does not match accrued amount %s", closed, capitalization.AccruedAmount)
+		return domain.InterestCapitalization{}, err
+	}
+
+	const debitExpenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND UPPER(currency) = UPPER($3)`
+	if _, err = execRequiredRows(ctx, tx, debitExpenseQuery, capitalization.ExpenseGLAccountNumber, capitalization.GrossAmount, capitalization.Currency); err != nil {
+		logger.Error("interest repository debit expense account failed", err, logger.Fields{
+			"accountNumber": capitalization.ExpenseGLAccountNumber,
+		})
+		return domain.InterestCapitalization{}, err
+	}
+
+	if err = postAccountBalance(ctx, tx, creditInterestQuery, domain.AccountEventCredited, capitalization.AccountNumber, capitalization.NetAmount, ""); err != nil {
+		if errors.Is(err, errPostingFailed) {
+			err = commons.ErrRecordNotFound
+			return domain.InterestCapitalization{}, err
+		}
+		logger.Error("interest repository credit customer account failed", err, logger.Fields{
+			"accountNumber": capitalization.AccountNumber,
+		})
+		return domain.InterestCapitalization{}, err
+	}
+
+	const creditTaxQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND UPPER(currency) = UPPER($3)`
+	if _, err = execRequiredRows(ctx, tx, creditTaxQuery, capitalization.TaxGLAccountNumber, capitalization.TaxAmount, capitalization.Currency); err != nil {
+		logger.Error("interest repository credit tax account failed", err, logger.Fields{
+			"accountNumber": capitalization.TaxGLAccountNumber,
+		})
+		return domain.InterestCapitalization{}, err
+	}
+
+	if capitalization.JournalEntries, err = insertJournalEntries(ctx, tx, interestJournalEntries(capitalization)); err != nil {
+		logger.Error("interest repository journal failed", err, logger.Fields{
+			"reference": capitalization.Reference,
+		})
+		return domain.InterestCapitalization{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("interest repository commit capitalize tx failed", err, nil)
+		return domain.InterestCapitalization{}, fmt.Errorf("commit capitalize interest transaction: %w", err)
+	}
+
+	logger.Info("interest repository capitalize success", logger.Fields{
+		"capitalizationId": capitalization.ID,
+		"reference":        capitalization.Reference,
+	})
+	return capitalization, nil
+}
+
+// creditInterestQuery credits capitalized interest. Unlike other credits it
+// also pays FROZEN accounts, since the interest was earned before the freeze.
+const creditInterestQuery = `
+UPDATE accounts
+SET available_balance = available_balance + $2::numeric,
+    ledger_balance = ledger_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND status <> 'CLOSED'
+RETURNING customer_id, currency, available_balance, ledger_balance`
+
+func interestJournalEntries(capitalization domain.InterestCapitalization) []domain.JournalEntry {
+	entry := func(accountNumber string, entryType domain.LedgerEntryType, amount decimal.Decimal) domain.JournalEntry {
+		return domain.JournalEntry{
+			ReferenceType: domain.JournalReferenceInterest,
+			ReferenceID:   capitalization.ID,
+			Reference:     capitalization.Reference,
+			AccountNumber: accountNumber,
+			EntryType:     entryType,
+			Currency:      capitalization.Currency,
+			Amount:        amount,
+		}
+	}
+
+	return []domain.JournalEntry{
+		entry(capitalization.ExpenseGLAccountNumber, domain.LedgerEntryDebit, capitalization.GrossAmount),
+		entry(capitalization.AccountNumber, domain.LedgerEntryCredit, capitalization.NetAmount),
+		entry(capitalization.TaxGLAccountNumber, domain.LedgerEntryCredit, capitalization.TaxAmount),
+	}
+}
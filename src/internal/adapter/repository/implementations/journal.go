@@ -0,0 +1,87 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// insertJournalEntries records the legs of a posting in the same transaction as
+// the balance updates. Zero-amount legs are skipped.
+func insertJournalEntries(ctx context.Context, tx *sql.Tx, entries []domain.JournalEntry) ([]domain.JournalEntry, error) {
+	const query = `
+INSERT INTO journal_entries (
+	reference_type,
+	reference_id,
+	reference,
+	account_number,
+	entry_type,
+	currency,
+	amount
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at`
+
+	recorded := make([]domain.JournalEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Amount.IsZero() {
+			continue
+		}
+		if err := tx.QueryRowContext(
+			ctx,
+			query,
+			entry.ReferenceType,
+			entry.ReferenceID,
+			entry.Reference,
+			entry.AccountNumber,
+			entry.EntryType,
+			entry.Currency,
+			entry.Amount,
+		).Scan(&entry.ID, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("insert journal entry: %w", err)
+		}
+		recorded = append(recorded, entry)
+	}
+	return recorded, nil
+}
+
+// getJournalEntries returns the legs recorded for one posting in the order they
+// were written.
+func getJournalEntries(ctx context.Context, db *sql.DB, referenceType domain.JournalReferenceType, referenceID string) ([]domain.JournalEntry, error) {
+	const query = `
+SELECT id, reference_type, reference_id, reference, account_number, entry_type, currency, amount, created_at
+FROM journal_entries
+WHERE reference_type = $1
+  AND reference_id = $2
+ORDER BY created_at`
+
+	rows, err := db.QueryContext(ctx, query, referenceType, referenceID)
+	if err != nil {
+		return nil, fmt.Errorf("get journal entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.JournalEntry
+	for rows.Next() {
+		var entry domain.JournalEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.ReferenceType,
+			&entry.ReferenceID,
+			&entry.Reference,
+			&entry.AccountNumber,
+			&entry.EntryType,
+			&entry.Currency,
+			&entry.Amount,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate journal entries: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,273 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+type KYCRepository struct {
+	db *sql.DB
+}
+
+func NewKYCRepository(db *sql.DB) *KYCRepository {
+	return &KYCRepository{db: db}
+}
+
+func (r *KYCRepository) Submit(ctx context.Context, request domain.KYCUpgradeRequest) (domain.KYCUpgradeRequest, error) {
+	logger.Info("kyc repository submit", logger.Fields{
+		"customerId":     request.CustomerID,
+		"requestedLevel": request.RequestedLevel,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("kyc repository begin submit tx failed", err, nil)
+		return domain.KYCUpgradeRequest{}, fmt.Errorf("begin kyc submit transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+INSERT INTO kyc_upgrade_requests (
+	customer_id,
+	current_level,
+	requested_level,
+	document_type,
+	document_number,
+	issuing_country,
+	document_expires_at,
+	document_reference,
+	submitted_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING ` + kycUpgradeColumns
+
+	var created domain.KYCUpgradeRequest
+	if err = scanKYCUpgradeRequest(tx.QueryRowContext(
+		ctx,
+		query,
+		request.CustomerID,
+		request.CurrentLevel,
+		request.RequestedLevel,
+		request.DocumentType,
+		request.DocumentNumber,
+		request.IssuingCountry,
+		request.DocumentExpiresAt,
+		request.DocumentReference,
+		request.SubmittedBy,
+	), &created); err != nil {
+		logger.Error("kyc repository submit failed", err, logger.Fields{
+			"customerId": request.CustomerID,
+		})
+		return domain.KYCUpgradeRequest{}, fmt.Errorf("create kyc upgrade request: %w", err)
+	}
+
+	if err = insertKYCEvent(ctx, tx, domain.CustomerEventKYCUpgradeSubmitted, created, created.SubmittedBy); err != nil {
+		return domain.KYCUpgradeRequest{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("kyc repository commit submit tx failed", err, nil)
+		return domain.KYCUpgradeRequest{}, fmt.Errorf("commit kyc submit transaction: %w", err)
+	}
+
+	logger.Info("kyc repository submit success", logger.Fields{
+		"requestId":  created.ID,
+		"customerId": created.CustomerID,
+	})
+	return created, nil
+}
+
+func (r *KYCRepository) GetByID(ctx context.Context, id string) (domain.KYCUpgradeRequest, error) {
+	const query = `
+SELECT ` + kycUpgradeColumns + `
+FROM kyc_upgrade_requests
+WHERE id = $1`
+
+	var request domain.KYCUpgradeRequest
+	if err := scanKYCUpgradeRequest(r.db.QueryRowContext(ctx, query, id), &request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.KYCUpgradeRequest{}, commons.ErrRecordNotFound
+		}
+		logger.Error("kyc repository get by id failed", err, logger.Fields{
+			"requestId": id,
+		})
+		return domain.KYCUpgradeRequest{}, fmt.Errorf("get kyc upgrade request: %w", err)
+	}
+	return request, nil
+}
+
+func (r *KYCRepository) GetByStatus(ctx context.Context, status domain.KYCUpgradeStatus, limit int) ([]domain.KYCUpgradeRequest, error) {
+	const query = `
+SELECT ` + kycUpgradeColumns + `
+FROM kyc_upgrade_requests
+WHERE status = $1
+ORDER BY created_at
+LIMIT $2`
+
+	return r.query(ctx, query, status, limit)
+}
+
+func (r *KYCRepository) GetByCustomerID(ctx context.Context, customerID string) ([]domain.KYCUpgradeRequest, error) {
+	const query = `
+SELECT ` + kycUpgradeColumns + `
+FROM kyc_upgrade_requests
+WHERE customer_id = $1
+ORDER BY created_at DESC`
+
+	return r.query(ctx, query, customerID)
+}
+
+func (r *KYCRepository) Approve(ctx context.Context, id string, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error) {
+	return r.resolve(ctx, id, domain.KYCUpgradeApproved, reviewedBy, comment)
+}
+
+func (r *KYCRepository) Reject(ctx context.Context, id string, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error) {
+	return r.resolve(ctx, id, domain.KYCUpgradeRejected, reviewedBy, comment)
+}
+
+// resolve closes a PENDING request and, on approval, raises the customer's KYC
+// level in the same transaction. The level only ever goes up, so approving a
+// request for a level the customer already has leaves it unchanged.
+func (r *KYCRepository) resolve(ctx context.Context, id string, status domain.KYCUpgradeStatus, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error) {
+	logger.Info("kyc repository resolve", logger.Fields{
+		"requestId":  id,
+		"status":     status,
+		"reviewedBy": reviewedBy,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("kyc repository begin resolve tx failed", err, nil)
+		return domain.KYCUpgradeRequest{}, fmt.Errorf("begin kyc resolve transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const resolveQuery = `
+UPDATE kyc_upgrade_requests
+SET status = $2,
+    reviewed_by = $3,
+    review_comment = $4,
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING'
+RETURNING ` + kycUpgradeColumns
+
+	var resolved domain.KYCUpgradeRequest
+	if err = scanKYCUpgradeRequest(tx.QueryRowContext(ctx, resolveQuery, id, status, reviewedBy, comment), &resolved); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.KYCUpgradeRequest{}, err
+		}
+		logger.Error("kyc repository resolve failed", err, logger.Fields{
+			"requestId": id,
+		})
+		return domain.KYCUpgradeRequest{}, fmt.Errorf("resolve kyc upgrade request: %w", err)
+	}
+
+	eventType := domain.CustomerEventKYCUpgradeRejected
+	if status == domain.KYCUpgradeApproved {
+		eventType = domain.CustomerEventKYCUpgraded
+
+		const levelQuery = `
+UPDATE users
+SET kyc_level = GREATEST(kyc_level, $2),
+    updated_at = NOW()
+WHERE customer_id = $1`
+
+		if _, err = tx.ExecContext(ctx, levelQuery, resolved.CustomerID, resolved.RequestedLevel); err != nil {
+			logger.Error("kyc repository raise kyc level failed", err, logger.Fields{
+				"customerId": resolved.CustomerID,
+			})
+			return domain.KYCUpgradeRequest{}, fmt.Errorf("raise kyc level: %w", err)
+		}
+	}
+
+	if err = insertKYCEvent(ctx, tx, eventType, resolved, reviewedBy); err != nil {
+		return domain.KYCUpgradeRequest{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("kyc repository commit resolve tx failed", err, nil)
+		return domain.KYCUpgradeRequest{}, fmt.Errorf("commit kyc resolve transaction: %w", err)
+	}
+
+	logger.Info("kyc repository resolve success", logger.Fields{
+		"requestId":  resolved.ID,
+		"customerId": resolved.CustomerID,
+		"status":     resolved.Status,
+	})
+	return resolved, nil
+}
+
+func (r *KYCRepository) query(ctx context.Context, query string, args ...any) ([]domain.KYCUpgradeRequest, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("kyc repository query failed", err, nil)
+		return nil, fmt.Errorf("query kyc upgrade requests: %w", err)
+	}
+	defer rows.Close()
+
+	requests := make([]domain.KYCUpgradeRequest, 0)
+	for rows.Next() {
+		var request domain.KYCUpgradeRequest
+		if err := scanKYCUpgradeRequest(rows, &request); err != nil {
+			return nil, fmt.Errorf("scan kyc upgrade request: %w", err)
+		}
+		requests = append(requests, request)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate kyc upgrade requests: %w", err)
+	}
+	return requests, nil
+}
+
+func insertKYCEvent(ctx context.Context, tx *sql.Tx, eventType domain.CustomerEventType, request domain.KYCUpgradeRequest, actor string) error {
+	event, err := domain.NewCustomerOutboxEvent(eventType, domain.CustomerEventData{
+		CustomerID:   request.CustomerID,
+		Actor:        actor,
+		Comment:      request.ReviewComment,
+		KYCRequestID: request.ID,
+		KYCLevel:     request.RequestedLevel,
+	})
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(ctx, tx, event)
+}
+
+const kycUpgradeColumns = `id, customer_id, current_level, requested_level, document_type, document_number, issuing_country, document_expires_at, document_reference, status, submitted_by, reviewed_by, review_comment, reviewed_at, created_at, updated_at`
+
+func scanKYCUpgradeRequest(row rowScanner, request *domain.KYCUpgradeRequest) error {
+	return row.Scan(
+		&request.ID,
+		&request.CustomerID,
+		&request.CurrentLevel,
+		&request.RequestedLevel,
+		&request.DocumentType,
+		&request.DocumentNumber,
+		&request.IssuingCountry,
+		&request.DocumentExpiresAt,
+		&request.DocumentReference,
+		&request.Status,
+		&request.SubmittedBy,
+		&request.ReviewedBy,
+		&request.ReviewComment,
+		&request.ReviewedAt,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+}
@@ -0,0 +1,121 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+type OTPRepository struct {
+	db *sql.DB
+}
+
+func NewOTPRepository(db *sql.DB) *OTPRepository {
+	return &OTPRepository{db: db}
+}
+
+func (r *OTPRepository) Create(ctx context.Context, challenge domain.OTPChallenge) (domain.OTPChallenge, error) {
+	logger.Info("otp repository create", logger.Fields{
+		"customerId": challenge.CustomerID,
+		"purpose":    challenge.Purpose,
+	})
+
+	const query = `
+INSERT INTO otp_challenges (
+	customer_id,
+	purpose,
+	code_hash,
+	payload,
+	max_attempts,
+	expires_at
+) VALUES ($1, $2, $3, NULLIF($4, '')::jsonb, $5, $6)
+RETURNING ` + otpChallengeColumns
+
+	var created domain.OTPChallenge
+	if err := scanOTPChallenge(r.db.QueryRowContext(
+		ctx,
+		query,
+		challenge.CustomerID,
+		challenge.Purpose,
+		challenge.CodeHash,
+		challenge.Payload,
+		challenge.MaxAttempts,
+		challenge.ExpiresAt,
+	), &created); err != nil {
+		logger.Error("otp repository create failed", err, logger.Fields{
+			"customerId": challenge.CustomerID,
+		})
+		return domain.OTPChallenge{}, fmt.Errorf("create otp challenge: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *OTPRepository) GetByID(ctx context.Context, id string) (domain.OTPChallenge, error) {
+	const query = `
+SELECT ` + otpChallengeColumns + `
+FROM otp_challenges
+WHERE id = $1`
+
+	return r.queryOne(ctx, "get otp challenge", query, id)
+}
+
+func (r *OTPRepository) RecordFailedAttempt(ctx context.Context, id string) (domain.OTPChallenge, error) {
+	const query = `
+UPDATE otp_challenges
+SET attempts = attempts + 1
+WHERE id = $1
+  AND consumed_at IS NULL
+RETURNING ` + otpChallengeColumns
+
+	return r.queryOne(ctx, "record failed otp attempt", query, id)
+}
+
+func (r *OTPRepository) Consume(ctx context.Context, id string) (domain.OTPChallenge, error) {
+	const query = `
+UPDATE otp_challenges
+SET consumed_at = NOW()
+WHERE id = $1
+  AND consumed_at IS NULL
+  AND expires_at > NOW()
+  AND attempts < max_attempts
+RETURNING ` + otpChallengeColumns
+
+	return r.queryOne(ctx, "consume otp challenge", query, id)
+}
+
+func (r *OTPRepository) queryOne(ctx context.Context, action string, query string, id string) (domain.OTPChallenge, error) {
+	var challenge domain.OTPChallenge
+	if err := scanOTPChallenge(r.db.QueryRowContext(ctx, query, id), &challenge); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.OTPChallenge{}, commons.ErrRecordNotFound
+		}
+		logger.Error("otp repository "+action+" failed", err, logger.Fields{
+			"challengeId": id,
+		})
+		return domain.OTPChallenge{}, fmt.Errorf("%s: %w", action, err)
+	}
+	return challenge, nil
+}
+
+const otpChallengeColumns = `id, customer_id, purpose, code_hash, COALESCE(payload::text, ''), attempts, max_attempts, expires_at, consumed_at, created_at`
+
+func scanOTPChallenge(row rowScanner, challenge *domain.OTPChallenge) error {
+	return row.Scan(
+		&challenge.ID,
+		&challenge.CustomerID,
+		&challenge.Purpose,
+		&challenge.CodeHash,
+		&challenge.Payload,
+		&challenge.Attempts,
+		&challenge.MaxAttempts,
+		&challenge.ExpiresAt,
+		&challenge.ConsumedAt,
+		&challenge.CreatedAt,
+	)
+}
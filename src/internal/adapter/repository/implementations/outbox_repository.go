@@ -0,0 +1,219 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+const outboxColumns = `id, sequence, aggregate_type, aggregate_id, aggregate_version, event_type, schema_version, payload, attempts, next_attempt_at, published_at, last_error, created_at`
+
+// ClaimPending leases the oldest unpublished event of each aggregate. Later events
+// of an aggregate are not handed out until the earlier ones are published, which
+// keeps per-aggregate ordering even with several relays running.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEvent, error) {
+	const query = `
+UPDATE outbox
+SET next_attempt_at = NOW() + ($2::bigint * INTERVAL '1 millisecond')
+WHERE id IN (
+	SELECT o.id
+	FROM outbox o
+	WHERE o.published_at IS NULL
+	  AND o.next_attempt_at <= NOW()
+	  AND NOT EXISTS (
+		SELECT 1
+		FROM outbox earlier
+		WHERE earlier.aggregate_type = o.aggregate_type
+		  AND earlier.aggregate_id = o.aggregate_id
+		  AND earlier.published_at IS NULL
+		  AND earlier.sequence < o.sequence
+	  )
+	ORDER BY o.sequence ASC
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + outboxColumns
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		logger.Error("outbox repository claim pending failed", err, nil)
+		return nil, fmt.Errorf("claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.OutboxEvent, 0)
+	for rows.Next() {
+		var event domain.OutboxEvent
+		if err := scanOutboxEvent(rows, &event); err != nil {
+			logger.Error("outbox repository scan event failed", err, nil)
+			return nil, fmt.Errorf("scan outbox event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate outbox events: %w", err)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Sequence < events[j].Sequence
+	})
+
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id string) error {
+	const query = `
+UPDATE outbox
+SET published_at = NOW(),
+    attempts = attempts + 1,
+    last_error = NULL
+WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		logger.Error("outbox repository mark published failed", err, logger.Fields{
+			"eventId": id,
+		})
+		return fmt.Errorf("mark outbox event published: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("mark outbox event published rows affected: %w", err)
+	}
+	if rows == 0 {
+		return commons.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, event domain.OutboxEvent) error {
+	logger.Info("outbox repository mark failed", logger.Fields{
+		"eventId":  event.ID,
+		"attempts": event.Attempts,
+	})
+
+	const query = `
+UPDATE outbox
+SET attempts = $2,
+    next_attempt_at = $3,
+    last_error = $4
+WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, event.ID, event.Attempts, event.NextAttemptAt, event.LastError)
+	if err != nil {
+		logger.Error("outbox repository mark failed failed", err, logger.Fields{
+			"eventId": event.ID,
+		})
+		return fmt.Errorf("mark outbox event failed: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("mark outbox event failed rows affected: %w", err)
+	}
+	if rows == 0 {
+		return commons.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// insertOutboxEvents appends events inside the caller's transaction. The
+// aggregate version is derived from the previous event of the same aggregate;
+// callers must already hold the aggregate row lock so concurrent writers queue up.
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, events ...domain.OutboxEvent) error {
+	const query = `
+INSERT INTO outbox (
+	aggregate_type,
+	aggregate_id,
+	aggregate_version,
+	event_type,
+	schema_version,
+	payload
+) VALUES (
+	$1,
+	$2,
+	COALESCE((
+		SELECT MAX(aggregate_version)
+		FROM outbox
+		WHERE aggregate_type = $1
+		  AND aggregate_id = $2
+	), 0) + 1,
+	$3,
+	$4,
+	$5
+)`
+
+	for _, event := range events {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			event.AggregateType,
+			event.AggregateID,
+			event.EventType,
+			event.SchemaVersion,
+			event.Payload,
+		); err != nil {
+			logger.Error("outbox repository insert event failed", err, logger.Fields{
+				"aggregateType": event.AggregateType,
+				"aggregateId":   event.AggregateID,
+				"eventType":     event.EventType,
+			})
+			return fmt.Errorf("insert outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func scanOutboxEvent(row rowScanner, event *domain.OutboxEvent) error {
+	var (
+		publishedAt sql.NullTime
+		lastError   sql.NullString
+	)
+
+	if err := row.Scan(
+		&event.ID,
+		&event.Sequence,
+		&event.AggregateType,
+		&event.AggregateID,
+		&event.AggregateVersion,
+		&event.EventType,
+		&event.SchemaVersion,
+		&event.Payload,
+		&event.Attempts,
+		&event.NextAttemptAt,
+		&publishedAt,
+		&lastError,
+		&event.CreatedAt,
+	); err != nil {
+		return err
+	}
+
+	if publishedAt.Valid {
+		value := publishedAt.Time
+		event.PublishedAt = &value
+	}
+	if lastError.Valid {
+		value := lastError.String
+		event.LastError = &value
+	}
+	return nil
+}
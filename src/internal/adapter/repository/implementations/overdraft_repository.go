@@ -0,0 +1,394 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+type OverdraftRepository struct {
+	db *sql.DB
+}
+
+func NewOverdraftRepository(db *sql.DB) *OverdraftRepository {
+	return &OverdraftRepository{db: db}
+}
+
+// Approve creates the account's facility, or replaces the terms of an existing
+// one and reactivates it, and sets the account's overdraft limit in the same
+// transaction. It returns commons.ErrRecordNotFound when the account does not
+// exist and an error when it is not ACTIVE.
+func (r *OverdraftRepository) Approve(ctx context.Context, facility domain.OverdraftFacility) (domain.OverdraftFacility, error) {
+	logger.Info("overdraft repository approve", logger.Fields{
+		"accountNumber": facility.AccountNumber,
+		"limit":         facility.Limit,
+		"expiresAt":     facility.ExpiresAt,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("overdraft repository begin approve tx failed", err, nil)
+		return domain.OverdraftFacility{}, fmt.Errorf("begin approve overdraft transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const lockQuery = `
+SELECT status, currency
+FROM accounts
+WHERE account_number = $1
+FOR UPDATE`
+
+	var status domain.AccountStatus
+	if err = tx.QueryRowContext(ctx, lockQuery, facility.AccountNumber).Scan(&status, &facility.Currency); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.OverdraftFacility{}, err
+		}
+		return domain.OverdraftFacility{}, fmt.Errorf("lock account: %w", err)
+	}
+	if status != domain.AccountStatusActive {
+		err = fmt.Errorf("account is not active")
+		return domain.OverdraftFacility{}, err
+	}
+
+	const upsertQuery = `
+INSERT INTO overdraft_facilities (
+	account_number,
+	currency,
+	limit_amount,
+	annual_rate_percent,
+	day_count,
+	status,
+	approved_by,
+	expires_at
+) VALUES ($1, $2, $3, $4, $5, 'ACTIVE', $6, $7)
+ON CONFLICT (account_number) DO UPDATE
+SET limit_amount = EXCLUDED.limit_amount,
+    annual_rate_percent = EXCLUDED.annual_rate_percent,
+    day_count = EXCLUDED.day_count,
+    status = 'ACTIVE',
+    approved_by = EXCLUDED.approved_by,
+    expires_at = EXCLUDED.expires_at,
+    suspended_at = NULL,
+    suspension_reason = NULL,
+    updated_at = NOW()
+RETURNING ` + overdraftFacilityColumns
+
+	var approved domain.OverdraftFacility
+	if err = scanOverdraftFacility(tx.QueryRowContext(
+		ctx,
+		upsertQuery,
+		facility.AccountNumber,
+		facility.Currency,
+		facility.Limit,
+		facility.AnnualRatePercent,
+		facility.DayCount,
+		facility.ApprovedBy,
+		facility.ExpiresAt,
+	), &approved); err != nil {
+		logger.Error("overdraft repository upsert facility failed", err, logger.Fields{
+			"accountNumber": facility.AccountNumber,
+		})
+		return domain.OverdraftFacility{}, fmt.Errorf("approve overdraft facility: %w", err)
+	}
+
+	const limitQuery = `
+UPDATE accounts
+SET overdraft_limit = $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1`
+	if _, err = execRequiredRows(ctx, tx, limitQuery, facility.AccountNumber, facility.Limit); err != nil {
+		return domain.OverdraftFacility{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("overdraft repository commit approve tx failed", err, nil)
+		return domain.OverdraftFacility{}, fmt.Errorf("commit approve overdraft transaction: %w", err)
+	}
+
+	logger.Info("overdraft repository approve success", logger.Fields{
+		"facilityId":    approved.ID,
+		"accountNumber": approved.AccountNumber,
+	})
+	return approved, nil
+}
+
+// GetByAccountNumber returns the account's facility with the overdraft interest
+// accrued on it so far.
+func (r *OverdraftRepository) GetByAccountNumber(ctx context.Context, accountNumber string) (domain.OverdraftFacility, error) {
+	const query = `
+SELECT ` + overdraftFacilityColumns + `
+FROM overdraft_facilities
+WHERE account_number = $1`
+
+	var facility domain.OverdraftFacility
+	if err := scanOverdraftFacility(r.db.QueryRowContext(ctx, query, accountNumber), &facility); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.OverdraftFacility{}, commons.ErrRecordNotFound
+		}
+		logger.Error("overdraft repository get failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return domain.OverdraftFacility{}, fmt.Errorf("get overdraft facility: %w", err)
+	}
+
+	const accruedQuery = `
+SELECT COALESCE(SUM(amount), 0)
+FROM overdraft_interest_accruals
+WHERE account_number = $1`
+	if err := r.db.QueryRowContext(ctx, accruedQuery, accountNumber).Scan(&facility.AccruedInterest); err != nil {
+		return domain.OverdraftFacility{}, fmt.Errorf("get accrued overdraft interest: %w", err)
+	}
+	return facility, nil
+}
+
+// SuspendExpired suspends every ACTIVE facility that expired by now and sets
+// the overdraft limit of those accounts to zero in the same transaction.
+func (r *OverdraftRepository) SuspendExpired(ctx context.Context, now time.Time) ([]domain.OverdraftFacility, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("overdraft repository begin suspend tx failed", err, nil)
+		return nil, fmt.Errorf("begin suspend overdrafts transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const suspendQuery = `
+UPDATE overdraft_facilities
+SET status = 'SUSPENDED',
+    suspended_at = NOW(),
+    suspension_reason = $2,
+    updated_at = NOW()
+WHERE status = 'ACTIVE'
+  AND expires_at <= $1
+RETURNING ` + overdraftFacilityColumns
+
+	rows, err := tx.QueryContext(ctx, suspendQuery, now, domain.OverdraftSuspensionExpired)
+	if err != nil {
+		logger.Error("overdraft repository suspend expired failed", err, nil)
+		return nil, fmt.Errorf("suspend expired overdrafts: %w", err)
+	}
+	suspended := make([]domain.OverdraftFacility, 0)
+	for rows.Next() {
+		var facility domain.OverdraftFacility
+		if err = scanOverdraftFacility(rows, &facility); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan overdraft facility: %w", err)
+		}
+		suspended = append(suspended, facility)
+	}
+	if err = rows.Close(); err != nil {
+		return nil, fmt.Errorf("close overdraft rows: %w", err)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate overdraft facilities: %w", err)
+	}
+
+	const clearLimitQuery = `
+UPDATE accounts a
+SET overdraft_limit = 0,
+    updated_at = NOW()
+FROM overdraft_facilities f
+WHERE f.account_number = a.account_number
+  AND f.status = 'SUSPENDED'
+  AND a.overdraft_limit > 0`
+	if _, err = tx.ExecContext(ctx, clearLimitQuery); err != nil {
+		return nil, fmt.Errorf("clear overdraft limits: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("overdraft repository commit suspend tx failed", err, nil)
+		return nil, fmt.Errorf("commit suspend overdrafts transaction: %w", err)
+	}
+
+	if len(suspended) > 0 {
+		logger.Info("overdraft repository suspend expired success", logger.Fields{
+			"count": len(suspended),
+		})
+	}
+	return suspended, nil
+}
+
+// GetOverdrawnPositions returns every account that is not closed and has a
+// negative ledger balance, with the terms of its facility if it has one.
+func (r *OverdraftRepository) GetOverdrawnPositions(ctx context.Context) ([]domain.OverdraftPosition, error) {
+	const query = `
+SELECT ` + overdraftPositionColumns + `
+FROM accounts a
+LEFT JOIN overdraft_facilities f ON f.account_number = a.account_number
+WHERE a.status <> 'CLOSED'
+  AND a.ledger_balance < 0
+ORDER BY a.account_number`
+
+	return r.queryPositions(ctx, query)
+}
+
+// GetLimitBreaches returns the accounts whose available balance is below their
+// overdraft limit, for example after a facility was suspended or reduced while
+// drawn, or after a lien.
+func (r *OverdraftRepository) GetLimitBreaches(ctx context.Context) ([]domain.OverdraftPosition, error) {
+	const query = `
+SELECT ` + overdraftPositionColumns + `
+FROM accounts a
+LEFT JOIN overdraft_facilities f ON f.account_number = a.account_number
+WHERE a.status <> 'CLOSED'
+  AND a.available_balance + a.overdraft_limit < 0
+ORDER BY a.available_balance + a.overdraft_limit, a.account_number`
+
+	return r.queryPositions(ctx, query)
+}
+
+// RecordInterestAccruals stores the daily overdraft interest in one
+// transaction. An account that already has an accrual for the date keeps it,
+// so re-running a date records nothing new. It returns the number inserted.
+func (r *OverdraftRepository) RecordInterestAccruals(ctx context.Context, accruals []domain.OverdraftInterestAccrual) (int, error) {
+	if len(accruals) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("overdraft repository begin record accruals tx failed", err, nil)
+		return 0, fmt.Errorf("begin record overdraft accruals transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+INSERT INTO overdraft_interest_accruals (
+	account_number,
+	accrual_date,
+	currency,
+	overdrawn_amount,
+	annual_rate_percent,
+	day_count,
+	amount
+) VALUES ($1, $2::date, $3, $4, $5, $6, $7)
+ON CONFLICT (account_number, accrual_date) DO NOTHING`
+
+	inserted := 0
+	for _, accrual := range accruals {
+		var result sql.Result
+		result, err = tx.ExecContext(
+			ctx,
+			query,
+			accrual.AccountNumber,
+			accrual.AccrualDate.Format(time.DateOnly),
+			accrual.Currency,
+			accrual.OverdrawnAmount,
+			accrual.AnnualRatePercent,
+			accrual.DayCount,
+			accrual.Amount,
+		)
+		if err != nil {
+			logger.Error("overdraft repository insert accrual failed", err, logger.Fields{
+				"accountNumber": accrual.AccountNumber,
+			})
+			return 0, fmt.Errorf("insert overdraft interest accrual: %w", err)
+		}
+		var rows int64
+		if rows, err = result.RowsAffected(); err != nil {
+			return 0, fmt.Errorf("read rows affected: %w", err)
+		}
+		inserted += int(rows)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("overdraft repository commit record accruals tx failed", err, nil)
+		return 0, fmt.Errorf("commit record overdraft accruals transaction: %w", err)
+	}
+	return inserted, nil
+}
+
+func (r *OverdraftRepository) queryPositions(ctx context.Context, query string) ([]domain.OverdraftPosition, error) {
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		logger.Error("overdraft repository get positions failed", err, nil)
+		return nil, fmt.Errorf("get overdraft positions: %w", err)
+	}
+	defer rows.Close()
+
+	positions := make([]domain.OverdraftPosition, 0)
+	for rows.Next() {
+		var (
+			position  domain.OverdraftPosition
+			expiresAt sql.NullTime
+		)
+		if err := rows.Scan(
+			&position.AccountNumber,
+			&position.Currency,
+			&position.AvailableBalance,
+			&position.LedgerBalance,
+			&position.OverdraftLimit,
+			&position.FacilityStatus,
+			&position.AnnualRatePercent,
+			&position.DayCount,
+			&expiresAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan overdraft position: %w", err)
+		}
+		if expiresAt.Valid {
+			value := expiresAt.Time
+			position.ExpiresAt = &value
+		}
+		positions = append(positions, position)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate overdraft positions: %w", err)
+	}
+	return positions, nil
+}
+
+const overdraftFacilityColumns = `id, account_number, currency, limit_amount, annual_rate_percent, day_count, status, approved_by, expires_at, suspended_at, COALESCE(suspension_reason, ''), created_at, updated_at`
+
+const overdraftPositionColumns = `a.account_number,
+       a.currency,
+       a.available_balance,
+       a.ledger_balance,
+       a.overdraft_limit,
+       COALESCE(f.status, ''),
+       COALESCE(f.annual_rate_percent, 0),
+       COALESCE(f.day_count, ''),
+       f.expires_at`
+
+func scanOverdraftFacility(row rowScanner, facility *domain.OverdraftFacility) error {
+	var suspendedAt sql.NullTime
+	if err := row.Scan(
+		&facility.ID,
+		&facility.AccountNumber,
+		&facility.Currency,
+		&facility.Limit,
+		&facility.AnnualRatePercent,
+		&facility.DayCount,
+		&facility.Status,
+		&facility.ApprovedBy,
+		&facility.ExpiresAt,
+		&suspendedAt,
+		&facility.SuspensionReason,
+		&facility.CreatedAt,
+		&facility.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if suspendedAt.Valid {
+		value := suspendedAt.Time
+		facility.SuspendedAt = &value
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+type SanctionsScreeningRepository struct {
+	db *sql.DB
+}
+
+func NewSanctionsScreeningRepository(db *sql.DB) *SanctionsScreeningRepository {
+	return &SanctionsScreeningRepository{db: db}
+}
+
+func (r *SanctionsScreeningRepository) SaveScreening(ctx context.Context, screening domain.SanctionsScreening) (domain.SanctionsScreening, error) {
+	logger.Info("sanctions screening repository save", logger.Fields{
+		"subjectType": screening.SubjectType,
+		"subjectId":   screening.SubjectID,
+		"decision":    screening.Decision,
+		"listVersion": screening.ListVersion,
+	})
+
+	const query = `
+INSERT INTO sanctions_screenings (
+	subject_type,
+	subject_id,
+	screened_name,
+	decision,
+	list_version,
+	matched_entry_id,
+	matched_name,
+	match_score
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, created_at`
+
+	if err := r.db.QueryRowContext(
+		ctx,
+		query,
+		screening.SubjectType,
+		screening.SubjectID,
+		screening.ScreenedName,
+		screening.Decision,
+		screening.ListVersion,
+		screening.MatchedEntryID,
+		screening.MatchedName,
+		screening.MatchScore,
+	).Scan(&screening.ID, &screening.CreatedAt); err != nil {
+		logger.Error("sanctions screening repository save failed", err, logger.Fields{
+			"subjectType": screening.SubjectType,
+			"subjectId":   screening.SubjectID,
+		})
+		return domain.SanctionsScreening{}, fmt.Errorf("save sanctions screening: %w", err)
+	}
+
+	return screening, nil
+}
+
+func (r *SanctionsScreeningRepository) GetLatestBySubject(ctx context.Context, subjectType domain.SanctionsSubjectType, subjectID string) (domain.SanctionsScreening, error) {
+	const query = `
+SELECT id, subject_type, subject_id, screened_name, decision, list_version, matched_entry_id, matched_name, match_score, created_at
+FROM sanctions_screenings
+WHERE subject_type = $1 AND subject_id = $2
+ORDER BY created_at DESC
+LIMIT 1`
+
+	var screening domain.SanctionsScreening
+	if err := r.db.QueryRowContext(ctx, query, subjectType, subjectID).Scan(
+		&screening.ID,
+		&screening.SubjectType,
+		&screening.SubjectID,
+		&screening.ScreenedName,
+		&screening.Decision,
+		&screening.ListVersion,
+		&screening.MatchedEntryID,
+		&screening.MatchedName,
+		&screening.MatchScore,
+		&screening.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.SanctionsScreening{}, commons.ErrRecordNotFound
+		}
+		logger.Error("sanctions screening repository get latest failed", err, logger.Fields{
+			"subjectType": subjectType,
+			"subjectId":   subjectID,
+		})
+		return domain.SanctionsScreening{}, fmt.Errorf("get sanctions screening: %w", err)
+	}
+
+	return screening, nil
+}
@@ -0,0 +1,242 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/shopspring/decimal"
+)
+
+type ScreeningRepository struct {
+	db *sql.DB
+}
+
+func NewScreeningRepository(db *sql.DB) *ScreeningRepository {
+	return &ScreeningRepository{db: db}
+}
+
+func (r *ScreeningRepository) LoadRules(ctx context.Context) ([]domain.ScreeningRule, error) {
+	const query = `
+SELECT id, name, rule_type, enabled, action, score, params
+FROM screening_rules
+ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		logger.Error("screening repository load rules failed", err, nil)
+		return nil, fmt.Errorf("load screening rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]domain.ScreeningRule, 0)
+	for rows.Next() {
+		var (
+			rule   domain.ScreeningRule
+			params string
+		)
+		if err := rows.Scan(&rule.ID, &rule.Name, &rule.Type, &rule.Enabled, &rule.Action, &rule.Score, &params); err != nil {
+			return nil, fmt.Errorf("scan screening rule: %w", err)
+		}
+		if err := json.Unmarshal([]byte(params), &rule.Params); err != nil {
+			return nil, fmt.Errorf("decode params for screening rule %s: %w", rule.ID, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate screening rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *ScreeningRepository) GetOutgoingTransferStats(ctx context.Context, accountNumber string, since time.Time, excludeTransferID string) (int, decimal.Decimal, error) {
+	const query = `
+SELECT COUNT(*), COALESCE(SUM(debit_amount), 0)
+FROM transfers
+WHERE debit_account_number = $1
+  AND created_at >= $2
+  AND status <> 'FAILED'
+  AND id::text <> $3`
+
+	var (
+		count int
+		total decimal.Decimal
+	)
+	if err := r.db.QueryRowContext(ctx, query, accountNumber, since, excludeTransferID).Scan(&count, &total); err != nil {
+		logger.Error("screening repository get outgoing transfer stats failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return 0, decimal.Zero, fmt.Errorf("get outgoing transfer stats: %w", err)
+	}
+
+	return count, total, nil
+}
+
+func (r *ScreeningRepository) CountRoundAmountTransfers(ctx context.Context, accountNumber string, since time.Time, roundUnit decimal.Decimal, excludeTransferID string) (int, error) {
+	const query = `
+SELECT COUNT(*)
+FROM transfers
+WHERE debit_account_number = $1
+  AND created_at >= $2
+  AND status <> 'FAILED'
+  AND MOD(debit_amount, $3::numeric) = 0
+  AND id::text <> $4`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, accountNumber, since, roundUnit, excludeTransferID).Scan(&count); err != nil {
+		logger.Error("screening repository count round amount transfers failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return 0, fmt.Errorf("count round amount transfers: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *ScreeningRepository) HasPriorTransferToBeneficiary(ctx context.Context, accountNumber string, beneficiaryAccountNumber string, beneficiaryBankCode string, excludeTransferID string) (bool, error) {
+	const query = `
+SELECT EXISTS (
+	SELECT 1
+	FROM transfers
+	WHERE debit_account_number = $1
+	  AND credit_account_number = $2
+	  AND COALESCE(beneficiary_bank_code, '') = $3
+	  AND status IN ('SUCCESS', 'CLOSED')
+	  AND id::text <> $4
+)`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, accountNumber, beneficiaryAccountNumber, beneficiaryBankCode, excludeTransferID).Scan(&exists); err != nil {
+		logger.Error("screening repository has prior transfer to beneficiary failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return false, fmt.Errorf("check prior transfer to beneficiary: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetInboundCreditTotal sums the ACCOUNT_CREDITED events recorded for the account,
+// which covers both deposits and incoming transfers.
+func (r *ScreeningRepository) GetInboundCreditTotal(ctx context.Context, accountNumber string, since time.Time) (decimal.Decimal, error) {
+	const query = `
+SELECT COALESCE(SUM((payload::jsonb ->> 'amount')::numeric), 0)
+FROM outbox
+WHERE aggregate_type = 'account'
+  AND aggregate_id = $1
+  AND event_type = 'ACCOUNT_CREDITED'
+  AND created_at >= $2`
+
+	var total decimal.Decimal
+	if err := r.db.QueryRowContext(ctx, query, accountNumber, since).Scan(&total); err != nil {
+		logger.Error("screening repository get inbound credit total failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return decimal.Zero, fmt.Errorf("get inbound credit total: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *ScreeningRepository) GetLastOutgoingTransferAt(ctx context.Context, accountNumber string, excludeTransferID string) (*time.Time, error) {
+	const query = `
+SELECT MAX(created_at)
+FROM transfers
+WHERE debit_account_number = $1
+  AND status IN ('SUCCESS', 'CLOSED')
+  AND id::text <> $2`
+
+	var last sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query, accountNumber, excludeTransferID).Scan(&last); err != nil {
+		logger.Error("screening repository get last outgoing transfer failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return nil, fmt.Errorf("get last outgoing transfer: %w", err)
+	}
+	if !last.Valid {
+		return nil, nil
+	}
+
+	value := last.Time
+	return &value, nil
+}
+
+func (r *ScreeningRepository) SaveScreening(ctx context.Context, screening domain.TransferScreening) (domain.TransferScreening, error) {
+	logger.Info("screening repository save screening", logger.Fields{
+		"transferId": screening.TransferID,
+		"decision":   screening.Decision,
+		"totalScore": screening.TotalScore,
+	})
+
+	results, err := json.Marshal(screening.Results)
+	if err != nil {
+		return domain.TransferScreening{}, fmt.Errorf("marshal screening results: %w", err)
+	}
+
+	const query = `
+INSERT INTO transfer_screenings (
+	transfer_id,
+	decision,
+	total_score,
+	results
+) VALUES ($1, $2, $3, $4)
+RETURNING id, created_at`
+
+	if err := r.db.QueryRowContext(
+		ctx,
+		query,
+		screening.TransferID,
+		screening.Decision,
+		screening.TotalScore,
+		string(results),
+	).Scan(&screening.ID, &screening.CreatedAt); err != nil {
+		logger.Error("screening repository save screening failed", err, logger.Fields{
+			"transferId": screening.TransferID,
+		})
+		return domain.TransferScreening{}, fmt.Errorf("save transfer screening: %w", err)
+	}
+
+	return screening, nil
+}
+
+func (r *ScreeningRepository) GetScreeningByTransferID(ctx context.Context, transferID string) (domain.TransferScreening, error) {
+	const query = `
+SELECT id, transfer_id, decision, total_score, results, created_at
+FROM transfer_screenings
+WHERE transfer_id = $1`
+
+	var (
+		screening domain.TransferScreening
+		results   string
+	)
+	if err := r.db.QueryRowContext(ctx, query, transferID).Scan(
+		&screening.ID,
+		&screening.TransferID,
+		&screening.Decision,
+		&screening.TotalScore,
+		&results,
+		&screening.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TransferScreening{}, commons.ErrRecordNotFound
+		}
+		logger.Error("screening repository get screening failed", err, logger.Fields{
+			"transferId": transferID,
+		})
+		return domain.TransferScreening{}, fmt.Errorf("get transfer screening: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(results), &screening.Results); err != nil {
+		return domain.TransferScreening{}, fmt.Errorf("decode screening results: %w", err)
+	}
+
+	return screening, nil
+}
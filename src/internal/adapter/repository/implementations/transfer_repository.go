@@ -48,9 +48,10 @@ INSERT INTO transfers (
 	vat_amount,
 	narration,
 	status,
-	audit_payload
+	audit_payload,
+	channel_id
 ) VALUES (
-	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
 )
 RETURNING id, created_at, updated_at, processed_at`
 
@@ -61,7 +62,18 @@ RETURNING id, created_at, updated_at, processed_at`
 		processedAt sql.NullTime
 	)
 
-	if err := r.db.QueryRowContext(
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("transfer repository begin create tx failed", err, nil)
+		return domain.Transfer{}, fmt.Errorf("begin create transfer transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = tx.QueryRowContext(
 		ctx,
 		query,
 		transfer.ExternalRefernece,
@@ -81,6 +93,7 @@ RETURNING id, created_at, updated_at, processed_at`
 		transfer.Narration,
 		transfer.Status,
 		transfer.AuditPayload,
+		transfer.ChannelID,
 	).Scan(&id, &createdAt, &updatedAt, &processedAt); err != nil {
 		logger.Error("transfer repository create failed", err, logger.Fields{
 			"transactionReference": transfer.TransactionReference,
@@ -96,6 +109,19 @@ RETURNING id, created_at, updated_at, processed_at`
 		transfer.ProcessedAt = &value
 	}
 
+	event, err := domain.NewTransferOutboxEvent(domain.TransferEventCreated, transfer)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.Transfer{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("transfer repository commit create tx failed", err, nil)
+		return domain.Transfer{}, fmt.Errorf("commit create transfer transaction: %w", err)
+	}
+
 	logger.Info("transfer repository create success", logger.Fields{
 		"transferId":           transfer.ID,
 		"transactionReference": transfer.TransactionReference,
@@ -210,27 +236,7 @@ func (r *TransferRepository) Get(ctx context.Context, id string, transactionRefe
 	})
 
 	const query = `
-SELECT id,
-       external_refernece,
-       transaction_reference,
-       debit_account_number,
-       credit_account_number,
-       beneficiary_bank_code,
-       debit_bank_name,
-       credit_bank_name,
-       debit_currency,
-       credit_currency,
-       debit_amount,
-       credit_amount,
-       fcy_rate,
-       charge_amount,
-       vat_amount,
-       narration,
-       status,
-       audit_payload,
-       created_at,
-       updated_at,
-       processed_at
+SELECT ` + transferColumns + `
 FROM transfers
 WHERE ($1 <> '' AND id::text = $1)
    OR ($2 <> '' AND transaction_reference = $2)
@@ -238,41 +244,8 @@ WHERE ($1 <> '' AND id::text = $1)
 ORDER BY updated_at DESC
 LIMIT 1`
 
-	var (
-		transfer               domain.Transfer
-		externalReference      sql.NullString
-		transactionReferenceDB sql.NullString
-		creditAccountNumber    sql.NullString
-		beneficiaryBankCode    sql.NullString
-		debitBankName          sql.NullString
-		creditBankName         sql.NullString
-		narration              sql.NullString
-		processedAt            sql.NullTime
-	)
-
-	if err := r.db.QueryRowContext(ctx, query, trimmedID, trimmedTxRef, trimmedExternalRef).Scan(
-		&transfer.ID,
-		&externalReference,
-		&transactionReferenceDB,
-		&transfer.DebitAccountNumber,
-		&creditAccountNumber,
-		&beneficiaryBankCode,
-		&debitBankName,
-		&creditBankName,
-		&transfer.DebitCurrency,
-		&transfer.CreditCurrency,
-		&transfer.DebitAmount,
-		&transfer.CreditAmount,
-		&transfer.FCYRate,
-		&transfer.ChargeAmount,
-		&transfer.VATAmount,
-		&narration,
-		&transfer.Status,
-		&transfer.AuditPayload,
-		&transfer.CreatedAt,
-		&transfer.UpdatedAt,
-		&processedAt,
-	); err != nil {
+	var transfer domain.Transfer
+	if err := scanTransfer(r.db.QueryRowContext(ctx, query, trimmedID, trimmedTxRef, trimmedExternalRef), &transfer); err != nil {
 		if err == sql.ErrNoRows {
 			logger.Info("transfer repository record not found", logger.Fields{
 				"id":                   trimmedID,
@@ -289,39 +262,6 @@ LIMIT 1`
 		return domain.Transfer{}, fmt.Errorf("get transfer: %w", err)
 	}
 
-	if externalReference.Valid {
-		value := externalReference.String
-		transfer.ExternalRefernece = &value
-	}
-	if transactionReferenceDB.Valid {
-		value := transactionReferenceDB.String
-		transfer.TransactionReference = &value
-	}
-	if creditAccountNumber.Valid {
-		value := creditAccountNumber.String
-		transfer.CreditAccountNumber = &value
-	}
-	if beneficiaryBankCode.Valid {
-		value := beneficiaryBankCode.String
-		transfer.BeneficiaryBankCode = &value
-	}
-	if debitBankName.Valid {
-		value := debitBankName.String
-		transfer.DebitBankName = &value
-	}
-	if creditBankName.Valid {
-		value := creditBankName.String
-		transfer.CreditBankName = &value
-	}
-	if narration.Valid {
-		value := narration.String
-		transfer.Narration = &value
-	}
-	if processedAt.Valid {
-		value := processedAt.Time
-		transfer.ProcessedAt = &value
-	}
-
 	logger.Info("transfer repository get success", logger.Fields{
 		"transferId":           transfer.ID,
 		"transactionReference": transfer.TransactionReference,
@@ -331,8 +271,11 @@ LIMIT 1`
 	return transfer, nil
 }
 
-func (r *TransferRepository) ProcessInternalTransfer(ctx context.Context, debitAccountNumber string, debitAmount decimal.Decimal, suspenseAccountNumber string, debitSuspenseAccountAmount decimal.Decimal, creditAccountNumber string, creditAmount decimal.Decimal) error {
+// ProcessInternalTransfer posts an internal transfer and marks it SUCCESS,
+// with its TRANSFER_SUCCEEDED outbox event, in the same transaction.
+func (r *TransferRepository) ProcessInternalTransfer(ctx context.Context, transferID string, debitAccountNumber string, debitAmount decimal.Decimal, suspenseAccountNumber string, debitSuspenseAccountAmount decimal.Decimal, creditAccountNumber string, creditAmount decimal.Decimal) error {
 	logger.Info("transfer repository process internal transfer", logger.Fields{
+		"transferId":                 transferID,
 		"debitAccountNumber":         debitAccountNumber,
 		"debitAmount":                debitAmount,
 		"suspenseAccountNumber":      suspenseAccountNumber,
@@ -352,15 +295,10 @@ func (r *TransferRepository) ProcessInternalTransfer(ctx context.Context, debitA
 		}
 	}()
 
-	debitSenderQuery := `
-UPDATE accounts
-SET available_balance = available_balance - $2::numeric,
-    ledger_balance = ledger_balance - $2::numeric,
-    updated_at = NOW()
-WHERE account_number = $1
-  AND status = 'ACTIVE'
-  AND available_balance >= $2::numeric`
-	if _, err = execRequiredRows(ctx, tx, debitSenderQuery, debitAccountNumber, debitAmount); err != nil {
+	if err = releaseTransferHold(ctx, tx, debitAccountNumber, transferID); err != nil {
+		return err
+	}
+	if err = postAccountBalance(ctx, tx, debitAccountBalanceQuery, domain.AccountEventDebited, debitAccountNumber, debitAmount, transferID); err != nil {
 		return err
 	}
 
@@ -383,14 +321,11 @@ WHERE account_number = $1
 		return err
 	}
 
-	creditBeneficiaryQuery := `
-UPDATE accounts
-SET available_balance = available_balance + $2::numeric,
-    ledger_balance = ledger_balance + $2::numeric,
-    updated_at = NOW()
-WHERE account_number = $1
-  AND status = 'ACTIVE'`
-	if _, err = execRequiredRows(ctx, tx, creditBeneficiaryQuery, creditAccountNumber, creditAmount); err != nil {
+	if err = postAccountBalance(ctx, tx, creditAccountBalanceQuery, domain.AccountEventCredited, creditAccountNumber, creditAmount, transferID); err != nil {
+		return err
+	}
+
+	if _, err = setTransferStatus(ctx, tx, transferID, domain.TransferStatusSuccess); err != nil {
 		return err
 	}
 
@@ -406,8 +341,12 @@ WHERE account_number = $1
 	return nil
 }
 
+// ProcessExternalTransfer posts an external transfer to the GL account of the
+// credit currency and marks it SUCCESS, with its TRANSFER_SUCCEEDED outbox
+// event, in the same transaction.
 func (r *TransferRepository) ProcessExternalTransfer(
 	ctx context.Context,
+	transferID string,
 	debitAccountNumber string,
 	totalDebitAmount decimal.Decimal,
 	suspenseAccountNumber string,
@@ -417,6 +356,7 @@ func (r *TransferRepository) ProcessExternalTransfer(
 	externalAccountCurrency string,
 ) error {
 	logger.Info("transfer repository process external transfer", logger.Fields{
+		"transferId":                 transferID,
 		"debitAccountNumber":         debitAccountNumber,
 		"totalDebitAmount":           totalDebitAmount,
 		"suspenseAccountNumber":      suspenseAccountNumber,
@@ -437,15 +377,10 @@ func (r *TransferRepository) ProcessExternalTransfer(
 		}
 	}()
 
-	debitSenderQuery := `
-UPDATE accounts
-SET available_balance = available_balance - $2::numeric,
-    ledger_balance = ledger_balance - $2::numeric,
-    updated_at = NOW()
-WHERE account_number = $1
-  AND status = 'ACTIVE'
-  AND available_balance >= $2::numeric`
-	if _, err = execRequiredRows(ctx, tx, debitSenderQuery, debitAccountNumber, totalDebitAmount); err != nil {
+	if err = releaseTransferHold(ctx, tx, debitAccountNumber, transferID); err != nil {
+		return err
+	}
+	if err = postAccountBalance(ctx, tx, debitAccountBalanceQuery, domain.AccountEventDebited, debitAccountNumber, totalDebitAmount, transferID); err != nil {
 		return err
 	}
 
@@ -478,6 +413,10 @@ WHERE account_number = $1
 		return err
 	}
 
+	if _, err = setTransferStatus(ctx, tx, transferID, domain.TransferStatusSuccess); err != nil {
+		return err
+	}
+
 	if err = tx.Commit(); err != nil {
 		logger.Error("transfer repository commit external tx failed", err, nil)
 		return fmt.Errorf("commit external transfer transaction: %w", err)
@@ -496,37 +435,492 @@ func (r *TransferRepository) UpdateStatus(ctx context.Context, transferID string
 		"status":     status,
 	})
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("transfer repository begin update status tx failed", err, nil)
+		return fmt.Errorf("begin update transfer status transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var transfer domain.Transfer
+	if transfer, err = setTransferStatus(ctx, tx, transferID, status); err != nil {
+		logger.Error("transfer repository update status failed", err, logger.Fields{
+			"transferId": transferID,
+			"status":     status,
+		})
+		return err
+	}
+
+	if status == domain.TransferStatusFailed {
+		if err = releaseTransferHold(ctx, tx, transfer.DebitAccountNumber, transfer.ID); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("transfer repository commit update status tx failed", err, nil)
+		return fmt.Errorf("commit update transfer status transaction: %w", err)
+	}
+
+	logger.Info("transfer repository update status success", logger.Fields{
+		"transferId": transferID,
+		"status":     status,
+	})
+	return nil
+}
+
+// TransitionStatus moves a transfer from one status to another only if it is still
+// in the expected status. It returns commons.ErrRecordNotFound otherwise.
+func (r *TransferRepository) TransitionStatus(ctx context.Context, transferID string, from domain.TransferStatus, to domain.TransferStatus) error {
+	logger.Info("transfer repository transition status", logger.Fields{
+		"transferId": transferID,
+		"from":       from,
+		"to":         to,
+	})
+
 	const query = `
 UPDATE transfers
-SET status = $2::varchar,
-    updated_at = NOW(),
-    processed_at = CASE
-        WHEN $2::varchar IN ('SUCCESS', 'FAILED', 'CLOSED') THEN NOW()
-        ELSE processed_at
-    END
-WHERE id = $1`
+SET status = $3::varchar,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = $2::varchar`
 
-	result, err := r.db.ExecContext(ctx, query, transferID, status)
+	result, err := r.db.ExecContext(ctx, query, transferID, from, to)
 	if err != nil {
-		logger.Error("transfer repository update status failed", err, logger.Fields{
+		logger.Error("transfer repository transition status failed", err, logger.Fields{
 			"transferId": transferID,
-			"status":     status,
 		})
-		return fmt.Errorf("update transfer status: %w", err)
+		return fmt.Errorf("transition transfer status: %w", err)
 	}
 
 	rows, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("update transfer status rows affected: %w", err)
+		return fmt.Errorf("transition transfer status rows affected: %w", err)
 	}
 	if rows == 0 {
 		return commons.ErrRecordNotFound
 	}
 
-	logger.Info("transfer repository update status success", logger.Fields{
-		"transferId": transferID,
+	return nil
+}
+
+func (r *TransferRepository) GetByStatus(ctx context.Context, status domain.TransferStatus, limit int) ([]domain.Transfer, error) {
+	logger.Info("transfer repository get by status", logger.Fields{
+		"status": status,
+		"limit":  limit,
+	})
+
+	const query = `
+SELECT ` + transferColumns + `
+FROM transfers
+WHERE status = $1
+ORDER BY created_at ASC
+LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, status, limit)
+	if err != nil {
+		logger.Error("transfer repository get by status failed", err, logger.Fields{
+			"status": status,
+		})
+		return nil, fmt.Errorf("get transfers by status: %w", err)
+	}
+	defer rows.Close()
+
+	transfers := make([]domain.Transfer, 0)
+	for rows.Next() {
+		var transfer domain.Transfer
+		if err := scanTransfer(rows, &transfer); err != nil {
+			return nil, fmt.Errorf("scan transfer: %w", err)
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate transfers: %w", err)
+	}
+
+	return transfers, nil
+}
+
+// HoldTransfer parks a PENDING or ACCEPTED transfer in HELD, places a TRANSFER
+// hold for the reviewed amount on the debit account (referenced by transfer ID)
+// and opens the review case, all in one transaction.
+func (r *TransferRepository) HoldTransfer(ctx context.Context, review domain.TransferReview, debitAccountNumber string) (domain.TransferReview, error) {
+	logger.Info("transfer repository hold transfer", logger.Fields{
+		"transferId":         review.TransferID,
+		"debitAccountNumber": debitAccountNumber,
+		"heldAmount":         review.HeldAmount,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("transfer repository begin hold tx failed", err, nil)
+		return domain.TransferReview{}, fmt.Errorf("begin hold transfer transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const holdQuery = `
+UPDATE transfers
+SET status = 'HELD',
+    updated_at = NOW()
+WHERE id = $1
+  AND status IN ('PENDING', 'ACCEPTED')
+RETURNING ` + transferColumns
+
+	var transfer domain.Transfer
+	if err = scanTransfer(tx.QueryRowContext(ctx, holdQuery, review.TransferID), &transfer); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.TransferReview{}, err
+		}
+		return domain.TransferReview{}, fmt.Errorf("hold transfer: %w", err)
+	}
+
+	if _, err = placeAccountHold(ctx, tx, domain.AccountHold{
+		AccountNumber: debitAccountNumber,
+		HoldType:      domain.AccountHoldTypeTransfer,
+		Amount:        review.HeldAmount,
+		Reason:        review.Reason,
+		Reference:     review.TransferID,
+	}); err != nil {
+		return domain.TransferReview{}, err
+	}
+
+	if review, err = insertTransferReview(ctx, tx, review); err != nil {
+		return domain.TransferReview{}, err
+	}
+
+	var event domain.OutboxEvent
+	if event, err = domain.NewTransferOutboxEvent(domain.TransferEventHeld, transfer); err != nil {
+		return domain.TransferReview{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.TransferReview{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("transfer repository commit hold tx failed", err, nil)
+		return domain.TransferReview{}, fmt.Errorf("commit hold transfer transaction: %w", err)
+	}
+
+	return review, nil
+}
+
+// ResolveHold closes a PENDING review with outcome and moves the transfer from
+// HELD to status. A rejection releases the transfer's account hold here; an
+// approval leaves it active for the posting transaction (or the failure that
+// ends the transfer) to release, so the reserved funds are never free in
+// between. It returns commons.ErrRecordNotFound if the review was already
+// decided.
+func (r *TransferRepository) ResolveHold(
+	ctx context.Context,
+	review domain.TransferReview,
+	debitAccountNumber string,
+	outcome domain.TransferReviewStatus,
+	status domain.TransferStatus,
+	decision domain.TransferReviewDecision,
+) (domain.Transfer, error) {
+	logger.Info("transfer repository resolve hold", logger.Fields{
+		"transferId": review.TransferID,
+		"reviewId":   review.ID,
+		"outcome":    outcome,
 		"status":     status,
 	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("transfer repository begin resolve hold tx failed", err, nil)
+		return domain.Transfer{}, fmt.Errorf("begin resolve hold transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const reviewQuery = `
+UPDATE transfer_reviews
+SET status = $2::varchar,
+    decided_by = $3,
+    decided_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING'`
+
+	if _, err = execRequiredRows(ctx, tx, reviewQuery, review.ID, outcome, decision.Actor); err != nil {
+		if errors.Is(err, errPostingFailed) {
+			err = commons.ErrRecordNotFound
+		}
+		return domain.Transfer{}, err
+	}
+
+	const transferQuery = `
+UPDATE transfers
+SET status = $2::varchar,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'HELD'
+RETURNING ` + transferColumns
+
+	var transfer domain.Transfer
+	if err = scanTransfer(tx.QueryRowContext(ctx, transferQuery, review.TransferID, status), &transfer); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.Transfer{}, err
+		}
+		return domain.Transfer{}, fmt.Errorf("release held transfer: %w", err)
+	}
+
+	if outcome != domain.TransferReviewApproved {
+		if _, err = releaseAccountHold(ctx, tx, debitAccountNumber, review.TransferID, domain.AccountHoldStatusReleased); err != nil {
+			return domain.Transfer{}, err
+		}
+	}
+
+	decision.ReviewID = review.ID
+	if err = insertTransferReviewDecision(ctx, tx, decision); err != nil {
+		return domain.Transfer{}, err
+	}
+
+	if eventType, ok := transferStatusEventType(status); ok {
+		var event domain.OutboxEvent
+		if event, err = domain.NewTransferOutboxEvent(eventType, transfer); err != nil {
+			return domain.Transfer{}, err
+		}
+		if err = insertOutboxEvents(ctx, tx, event); err != nil {
+			return domain.Transfer{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("transfer repository commit resolve hold tx failed", err, nil)
+		return domain.Transfer{}, fmt.Errorf("commit resolve hold transaction: %w", err)
+	}
+
+	return transfer, nil
+}
+
+// releaseTransferHold returns the funds reserved for a transfer approved after
+// review to its debit account, if that hold is still active. Callers post or
+// fail the transfer in the same transaction.
+func releaseTransferHold(ctx context.Context, tx *sql.Tx, debitAccountNumber string, transferID string) error {
+	if _, err := releaseAccountHold(ctx, tx, debitAccountNumber, transferID, domain.AccountHoldStatusReleased); err != nil && !errors.Is(err, commons.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
+
+var errPostingFailed = errors.New("transaction posting failed: record not found, inactive, or insufficient balance")
+
+// debitAccountBalanceQuery lets a debit draw on the account's approved
+// overdraft limit, so the available balance may go negative down to it. Every
+// caller posts a customer-initiated debit, so it also restarts the account's
+// dormancy period.
+const debitAccountBalanceQuery = `
+UPDATE accounts
+SET available_balance = available_balance - $2::numeric,
+    ledger_balance = ledger_balance - $2::numeric,
+    last_activity_at = NOW(),
+    updated_at = NOW()
+WHERE account_number = $1
+  AND status = 'ACTIVE'
+  AND available_balance + overdraft_limit >= $2::numeric
+RETURNING customer_id, currency, available_balance, ledger_balance`
+
+// creditAccountBalanceQuery also credits DORMANT accounts; inbound credits do
+// not count as customer activity.
+const creditAccountBalanceQuery = `
+UPDATE accounts
+SET available_balance = available_balance + $2::numeric,
+    ledger_balance = ledger_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND status IN ('ACTIVE', 'DORMANT')
+RETURNING customer_id, currency, available_balance, ledger_balance`
+
+// postAccountBalance applies a customer account posting and records the matching
+// account event in the same transaction.
+func postAccountBalance(ctx context.Context, tx *sql.Tx, query string, eventType domain.AccountEventType, accountNumber string, amount decimal.Decimal, transferID string) error {
+	data := domain.AccountEventData{
+		AccountNumber: accountNumber,
+		Amount:        amount,
+		TransferID:    transferID,
+	}
+	if err := tx.QueryRowContext(ctx, query, accountNumber, amount).Scan(
+		&data.CustomerID,
+		&data.Currency,
+		&data.AvailableBalance,
+		&data.LedgerBalance,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errPostingFailed
+		}
+		return fmt.Errorf("execute transaction statement: %w", err)
+	}
+
+	event, err := domain.NewAccountOutboxEvent(eventType, data)
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(ctx, tx, event)
+}
+
+// setTransferStatus writes status onto a transfer inside tx and queues the
+// matching transfer event in the outbox, so the event commits with the change.
+func setTransferStatus(ctx context.Context, tx *sql.Tx, transferID string, status domain.TransferStatus) (domain.Transfer, error) {
+	const query = `
+UPDATE transfers
+SET status = $2::varchar,
+    updated_at = NOW(),
+    processed_at = CASE
+        WHEN $2::varchar IN ('SUCCESS', 'FAILED', 'CLOSED') THEN NOW()
+        ELSE processed_at
+    END
+WHERE id = $1
+RETURNING ` + transferColumns
+
+	var transfer domain.Transfer
+	if err := scanTransfer(tx.QueryRowContext(ctx, query, transferID, status), &transfer); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Transfer{}, commons.ErrRecordNotFound
+		}
+		return domain.Transfer{}, fmt.Errorf("update transfer status: %w", err)
+	}
+
+	if eventType, ok := transferStatusEventType(status); ok {
+		event, err := domain.NewTransferOutboxEvent(eventType, transfer)
+		if err != nil {
+			return domain.Transfer{}, err
+		}
+		if err := insertOutboxEvents(ctx, tx, event); err != nil {
+			return domain.Transfer{}, err
+		}
+	}
+	return transfer, nil
+}
+
+func transferStatusEventType(status domain.TransferStatus) (domain.TransferEventType, bool) {
+	switch status {
+	case domain.TransferStatusSuccess:
+		return domain.TransferEventSucceeded, true
+	case domain.TransferStatusFailed:
+		return domain.TransferEventFailed, true
+	case domain.TransferStatusClosed:
+		return domain.TransferEventClosed, true
+	case domain.TransferStatusHeld:
+		return domain.TransferEventHeld, true
+	default:
+		return "", false
+	}
+}
+
+const transferColumns = `id,
+       external_refernece,
+       transaction_reference,
+       debit_account_number,
+       credit_account_number,
+       beneficiary_bank_code,
+       debit_bank_name,
+       credit_bank_name,
+       debit_currency,
+       credit_currency,
+       debit_amount,
+       credit_amount,
+       fcy_rate,
+       charge_amount,
+       vat_amount,
+       narration,
+       status,
+       audit_payload,
+       channel_id,
+       created_at,
+       updated_at,
+       processed_at`
+
+func scanTransfer(row rowScanner, transfer *domain.Transfer) error {
+	var (
+		externalReference      sql.NullString
+		transactionReferenceDB sql.NullString
+		creditAccountNumber    sql.NullString
+		beneficiaryBankCode    sql.NullString
+		debitBankName          sql.NullString
+		creditBankName         sql.NullString
+		narration              sql.NullString
+		channelID              sql.NullString
+		processedAt            sql.NullTime
+	)
+
+	if err := row.Scan(
+		&transfer.ID,
+		&externalReference,
+		&transactionReferenceDB,
+		&transfer.DebitAccountNumber,
+		&creditAccountNumber,
+		&beneficiaryBankCode,
+		&debitBankName,
+		&creditBankName,
+		&transfer.DebitCurrency,
+		&transfer.CreditCurrency,
+		&transfer.DebitAmount,
+		&transfer.CreditAmount,
+		&transfer.FCYRate,
+		&transfer.ChargeAmount,
+		&transfer.VATAmount,
+		&narration,
+		&transfer.Status,
+		&transfer.AuditPayload,
+		&channelID,
+		&transfer.CreatedAt,
+		&transfer.UpdatedAt,
+		&processedAt,
+	); err != nil {
+		return err
+	}
+
+	if externalReference.Valid {
+		value := externalReference.String
+		transfer.ExternalRefernece = &value
+	}
+	if transactionReferenceDB.Valid {
+		value := transactionReferenceDB.String
+		transfer.TransactionReference = &value
+	}
+	if creditAccountNumber.Valid {
+		value := creditAccountNumber.String
+		transfer.CreditAccountNumber = &value
+	}
+	if beneficiaryBankCode.Valid {
+		value := beneficiaryBankCode.String
+		transfer.BeneficiaryBankCode = &value
+	}
+	if debitBankName.Valid {
+		value := debitBankName.String
+		transfer.DebitBankName = &value
+	}
+	if creditBankName.Valid {
+		value := creditBankName.String
+		transfer.CreditBankName = &value
+	}
+	if narration.Valid {
+		value := narration.String
+		transfer.Narration = &value
+	}
+	if channelID.Valid {
+		value := channelID.String
+		transfer.ChannelID = &value
+	}
+	if processedAt.Valid {
+		value := processedAt.Time
+		transfer.ProcessedAt = &value
+	}
 	return nil
 }
 
@@ -541,7 +935,7 @@ func execRequiredRows(ctx context.Context, tx *sql.Tx, query string, args ...any
 		return 0, fmt.Errorf("read rows affected: %w", err)
 	}
 	if rows == 0 {
-		return 0, errors.New("transaction posting failed: record not found, inactive, or insufficient balance")
+		return 0, errPostingFailed
 	}
 	return rows, nil
 }
@@ -0,0 +1,178 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/lib/pq"
+)
+
+type TransferReviewRepository struct {
+	db *sql.DB
+}
+
+func NewTransferReviewRepository(db *sql.DB) *TransferReviewRepository {
+	return &TransferReviewRepository{db: db}
+}
+
+const transferReviewColumns = `id, transfer_id, reason, status, initiated_by, held_amount, decided_by, decided_at, created_at, updated_at`
+
+// GetByTransferID returns the review for a transfer together with its full
+// decision history, oldest first.
+func (r *TransferReviewRepository) GetByTransferID(ctx context.Context, transferID string) (domain.TransferReview, error) {
+	logger.Info("transfer review repository get by transfer id", logger.Fields{
+		"transferId": transferID,
+	})
+
+	const query = `
+SELECT ` + transferReviewColumns + `
+FROM transfer_reviews
+WHERE transfer_id = $1`
+
+	var review domain.TransferReview
+	if err := scanTransferReview(r.db.QueryRowContext(ctx, query, transferID), &review); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TransferReview{}, commons.ErrRecordNotFound
+		}
+		logger.Error("transfer review repository get by transfer id failed", err, logger.Fields{
+			"transferId": transferID,
+		})
+		return domain.TransferReview{}, fmt.Errorf("get transfer review: %w", err)
+	}
+
+	const decisionsQuery = `
+SELECT id, review_id, action, actor, comment, created_at
+FROM transfer_review_decisions
+WHERE review_id = $1
+ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, decisionsQuery, review.ID)
+	if err != nil {
+		logger.Error("transfer review repository get decisions failed", err, logger.Fields{
+			"reviewId": review.ID,
+		})
+		return domain.TransferReview{}, fmt.Errorf("get transfer review decisions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var decision domain.TransferReviewDecision
+		if err := rows.Scan(&decision.ID, &decision.ReviewID, &decision.Action, &decision.Actor, &decision.Comment, &decision.CreatedAt); err != nil {
+			return domain.TransferReview{}, fmt.Errorf("scan transfer review decision: %w", err)
+		}
+		review.Decisions = append(review.Decisions, decision)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.TransferReview{}, fmt.Errorf("iterate transfer review decisions: %w", err)
+	}
+
+	return review, nil
+}
+
+// GetByTransferIDs returns the reviews for the given transfers, without their
+// decision history.
+func (r *TransferReviewRepository) GetByTransferIDs(ctx context.Context, transferIDs []string) ([]domain.TransferReview, error) {
+	const query = `
+SELECT ` + transferReviewColumns + `
+FROM transfer_reviews
+WHERE transfer_id::text = ANY($1)`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(transferIDs))
+	if err != nil {
+		logger.Error("transfer review repository get by transfer ids failed", err, nil)
+		return nil, fmt.Errorf("get transfer reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]domain.TransferReview, 0, len(transferIDs))
+	for rows.Next() {
+		var review domain.TransferReview
+		if err := scanTransferReview(rows, &review); err != nil {
+			return nil, fmt.Errorf("scan transfer review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate transfer reviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+func insertTransferReview(ctx context.Context, tx *sql.Tx, review domain.TransferReview) (domain.TransferReview, error) {
+	const query = `
+INSERT INTO transfer_reviews (
+	transfer_id,
+	reason,
+	initiated_by,
+	held_amount
+) VALUES ($1, $2, $3, $4)
+RETURNING ` + transferReviewColumns
+
+	var created domain.TransferReview
+	if err := scanTransferReview(tx.QueryRowContext(ctx, query, review.TransferID, review.Reason, review.InitiatedBy, review.HeldAmount), &created); err != nil {
+		return domain.TransferReview{}, fmt.Errorf("insert transfer review: %w", err)
+	}
+
+	hold := domain.TransferReviewDecision{
+		ReviewID: created.ID,
+		Action:   domain.TransferReviewActionHold,
+		Actor:    domain.TransferReviewSystemActor,
+		Comment:  created.Reason,
+	}
+	if err := insertTransferReviewDecision(ctx, tx, hold); err != nil {
+		return domain.TransferReview{}, err
+	}
+
+	return created, nil
+}
+
+func insertTransferReviewDecision(ctx context.Context, tx *sql.Tx, decision domain.TransferReviewDecision) error {
+	const query = `
+INSERT INTO transfer_review_decisions (
+	review_id,
+	action,
+	actor,
+	comment
+) VALUES ($1, $2, $3, $4)`
+
+	if _, err := tx.ExecContext(ctx, query, decision.ReviewID, decision.Action, decision.Actor, decision.Comment); err != nil {
+		return fmt.Errorf("insert transfer review decision: %w", err)
+	}
+	return nil
+}
+
+func scanTransferReview(row rowScanner, review *domain.TransferReview) error {
+	var (
+		decidedBy sql.NullString
+		decidedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&review.ID,
+		&review.TransferID,
+		&review.Reason,
+		&review.Status,
+		&review.InitiatedBy,
+		&review.HeldAmount,
+		&decidedBy,
+		&decidedAt,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if decidedBy.Valid {
+		value := decidedBy.String
+		review.DecidedBy = &value
+	}
+	if decidedAt.Valid {
+		value := decidedAt.Time
+		review.DecidedAt = &value
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
 	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
 	"github.com/shopspring/decimal"
 )
@@ -75,6 +77,41 @@ ON CONFLICT (account_number) DO NOTHING`
 	return nil
 }
 
+// EnsureCurrencyGLAccounts creates one GL account per currency, described as
+// "<label> <currency> GL Account", for each entry of accountNumbers that does not
+// exist yet.
+func (r *TransientAccountRepository) EnsureCurrencyGLAccounts(ctx context.Context, label string, accountNumbers map[string]string) error {
+	logger.Info("transient account repository ensure currency gl accounts", logger.Fields{
+		"label":          label,
+		"accountNumbers": accountNumbers,
+	})
+
+	const query = `
+INSERT INTO transient_accounts (
+	account_number,
+	account_description,
+	currency,
+	available_balance
+) VALUES ($1, $2, $3, 0.00)
+ON CONFLICT (account_number) DO NOTHING`
+
+	for currency, accountNumber := range accountNumbers {
+		description := fmt.Sprintf("%s %s GL Account", label, currency)
+		if _, err := r.db.ExecContext(ctx, query, accountNumber, description, currency); err != nil {
+			logger.Error("transient account repository ensure currency gl account failed", err, logger.Fields{
+				"accountNumber": accountNumber,
+				"currency":      currency,
+			})
+			return fmt.Errorf("ensure %s gl account: %w", strings.ToLower(label), err)
+		}
+	}
+
+	logger.Info("transient account repository ensure currency gl accounts success", logger.Fields{
+		"label": label,
+	})
+	return nil
+}
+
 func (r *TransientAccountRepository) DebitSuspenseAccount(ctx context.Context, suspenseAccountNumber string, currency string, amount decimal.Decimal) error {
 	logger.Info("transient account repository debit", logger.Fields{
 		"accountNumber": suspenseAccountNumber,
@@ -194,8 +231,12 @@ WHERE account_number = $1
 	return nil
 }
 
+// SettleFromSuspenseToFees moves the fees of a posted transfer from suspense to
+// the charges and VAT accounts and marks the transfer CLOSED, with its
+// TRANSFER_CLOSED outbox event, in the same transaction.
 func (r *TransientAccountRepository) SettleFromSuspenseToFees(
 	ctx context.Context,
+	transferID string,
 	suspenseAccountNumber string,
 	chargeAmount decimal.Decimal,
 	vatAmount decimal.Decimal,
@@ -205,6 +246,7 @@ func (r *TransientAccountRepository) SettleFromSuspenseToFees(
 	vatUSD decimal.Decimal,
 ) error {
 	logger.Info("transient account repository settle from suspense to fees", logger.Fields{
+		"transferId":            transferID,
 		"suspenseAccountNumber": suspenseAccountNumber,
 		"chargeAmount":          chargeAmount,
 		"vatAmount":             vatAmount,
@@ -283,6 +325,10 @@ WHERE account_number = $1
 		return err
 	}
 
+	if _, err = setTransferStatus(ctx, tx, transferID, domain.TransferStatusClosed); err != nil {
+		return err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("commit settlement transaction: %w", err)
 	}
@@ -5,22 +5,27 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/encryption"
 	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
 	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
 	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
 )
 
+// UserRepository stores customers with their date of birth, phone number and
+// ID number encrypted by cipher, searchable only through blind indexes.
 type UserRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	cipher *encryption.FieldCipher
 }
 
 type rowScanner interface {
 	Scan(dest ...any) error
 }
 
-func NewUserRepository(db *sql.DB) *UserRepository {
-	return &UserRepository{db: db}
+func NewUserRepository(db *sql.DB, cipher *encryption.FieldCipher) *UserRepository {
+	return &UserRepository{db: db, cipher: cipher}
 }
 
 func (r *UserRepository) Create(ctx context.Context, user domain.User) (domain.User, error) {
@@ -30,36 +35,66 @@ func (r *UserRepository) Create(ctx context.Context, user domain.User) (domain.U
 		"lastName":   user.LastName,
 	})
 
+	pii, err := encryptUserPII(r.cipher, user)
+	if err != nil {
+		logger.Error("user repository encrypt pii failed", err, logger.Fields{
+			"customerId": user.CustomerID,
+		})
+		return domain.User{}, err
+	}
+
 	const query = `
 INSERT INTO users (
 	customer_id,
 	first_name,
 	middle_name,
 	last_name,
-	dob,
+	dob_encrypted,
 	phone_number,
+	email,
+	address,
 	id_type,
 	id_number,
 	kyc_level,
-	transaction_pin_hash
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-RETURNING id, customer_id, first_name, middle_name, last_name, dob, phone_number, id_type, id_number, kyc_level, transaction_pin_hash, created_at, updated_at`
+	transaction_pin_hash,
+	status,
+	identity_key,
+	dob_index,
+	phone_index,
+	pii_key_version
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, COALESCE(NULLIF($13, ''), 'ACTIVE'), $14, $15, NULLIF($16, ''), $17)
+ON CONFLICT (identity_key) DO NOTHING
+RETURNING ` + userColumns
 
 	var created domain.User
-	if err := scanUser(r.db.QueryRowContext(
+	if err := r.scanUser(r.db.QueryRowContext(
 		ctx,
 		query,
 		user.CustomerID,
 		user.FirstName,
 		user.MiddleName,
 		user.LastName,
-		user.DOB,
-		user.PhoneNumber,
+		pii.dob,
+		pii.phoneNumber,
+		user.Email,
+		user.Address,
 		user.IDType,
-		user.IDNumber,
+		pii.idNumber,
 		user.KYCLevel,
 		user.TransactionPinHash,
+		string(user.Status),
+		pii.identityKey,
+		pii.dobIndex,
+		pii.phoneIndex,
+		pii.keyVersion,
 	), &created); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("user repository create duplicate identity", logger.Fields{
+				"customerId": user.CustomerID,
+				"idType":     user.IDType,
+			})
+			return domain.User{}, commons.ErrDuplicateIdentity
+		}
 		logger.Error("user repository create failed", err, logger.Fields{
 			"customerId": user.CustomerID,
 		})
@@ -81,12 +116,12 @@ func (r *UserRepository) GetByID(ctx context.Context, id string) (domain.User, e
 	})
 
 	const query = `
-SELECT id, customer_id, first_name, middle_name, last_name, dob, phone_number, id_type, id_number, kyc_level, transaction_pin_hash, created_at, updated_at
+SELECT ` + userColumns + `
 FROM users
 WHERE id = $1`
 
 	var user domain.User
-	if err := scanUser(r.db.QueryRowContext(ctx, query, id), &user); err != nil {
+	if err := r.scanUser(r.db.QueryRowContext(ctx, query, id), &user); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("user repository record not found", logger.Fields{
 				"userId": id,
@@ -113,12 +148,12 @@ func (r *UserRepository) GetByCustomerID(ctx context.Context, customerID string)
 	})
 
 	const query = `
-SELECT id, customer_id, first_name, middle_name, last_name, dob, phone_number, id_type, id_number, kyc_level, transaction_pin_hash, created_at, updated_at
+SELECT ` + userColumns + `
 FROM users
 WHERE customer_id = $1`
 
 	var user domain.User
-	if err := scanUser(r.db.QueryRowContext(ctx, query, customerID), &user); err != nil {
+	if err := r.scanUser(r.db.QueryRowContext(ctx, query, customerID), &user); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("user repository record not found", logger.Fields{
 				"customerId": customerID,
@@ -145,24 +180,40 @@ func (r *UserRepository) Update(ctx context.Context, user domain.User) (domain.U
 		"customerId": user.CustomerID,
 	})
 
+	pii, err := encryptUserPII(r.cipher, user)
+	if err != nil {
+		logger.Error("user repository encrypt pii failed", err, logger.Fields{
+			"userId": user.ID,
+		})
+		return domain.User{}, err
+	}
+
 	const query = `
 UPDATE users
 SET customer_id = $2,
 	first_name = $3,
 	middle_name = $4,
 	last_name = $5,
-	dob = $6,
+	dob = NULL,
+	dob_encrypted = $6,
 	phone_number = $7,
-	id_type = $8,
-	id_number = $9,
-	kyc_level = $10,
-	transaction_pin_hash = $11,
+	email = $8,
+	address = $9,
+	id_type = $10,
+	id_number = $11,
+	kyc_level = $12,
+	transaction_pin_hash = $13,
+	status = COALESCE(NULLIF($14, ''), status),
+	identity_key = CASE WHEN identity_key IS NULL THEN NULL ELSE $15 END,
+	dob_index = $16,
+	phone_index = NULLIF($17, ''),
+	pii_key_version = $18,
 	updated_at = NOW()
 WHERE id = $1
-RETURNING id, customer_id, first_name, middle_name, last_name, dob, phone_number, id_type, id_number, kyc_level, transaction_pin_hash, created_at, updated_at`
+RETURNING ` + userColumns
 
 	var updated domain.User
-	if err := scanUser(r.db.QueryRowContext(
+	if err := r.scanUser(r.db.QueryRowContext(
 		ctx,
 		query,
 		user.ID,
@@ -170,12 +221,19 @@ RETURNING id, customer_id, first_name, middle_name, last_name, dob, phone_number
 		user.FirstName,
 		user.MiddleName,
 		user.LastName,
-		user.DOB,
-		user.PhoneNumber,
+		pii.dob,
+		pii.phoneNumber,
+		user.Email,
+		user.Address,
 		user.IDType,
-		user.IDNumber,
+		pii.idNumber,
 		user.KYCLevel,
 		user.TransactionPinHash,
+		string(user.Status),
+		pii.identityKey,
+		pii.dobIndex,
+		pii.phoneIndex,
+		pii.keyVersion,
 	), &updated); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("user repository record not found for update", logger.Fields{
@@ -197,6 +255,168 @@ RETURNING id, customer_id, first_name, middle_name, last_name, dob, phone_number
 	return updated, nil
 }
 
+// UpdateContactDetails replaces the customer's contact details and records
+// each changed field in customer_profile_changes, with CUSTOMER_PROFILE_UPDATED
+// in the outbox, in one transaction. The old values are read under the row
+// lock, so concurrent updates each audit what they actually replaced. It
+// returns no changes, and writes nothing, when the details are unchanged.
+func (r *UserRepository) UpdateContactDetails(ctx context.Context, customerID string, details domain.ContactDetails, changedBy string) (domain.User, []domain.ProfileChange, error) {
+	logger.Info("user repository update contact details", logger.Fields{
+		"customerId": customerID,
+		"changedBy":  changedBy,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("user repository begin contact details tx failed", err, nil)
+		return domain.User{}, nil, fmt.Errorf("begin contact details transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const selectQuery = `
+SELECT ` + userColumns + `
+FROM users
+WHERE customer_id = $1
+FOR UPDATE`
+
+	var current domain.User
+	if err = r.scanUser(tx.QueryRowContext(ctx, selectQuery, customerID), &current); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.User{}, nil, err
+		}
+		logger.Error("user repository lock user failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return domain.User{}, nil, fmt.Errorf("lock user: %w", err)
+	}
+
+	changes := current.ContactDetails().Changes(details)
+	if len(changes) == 0 {
+		_ = tx.Rollback()
+		return current, changes, nil
+	}
+
+	// The whole row is re-encrypted under the current key, so a row still in
+	// plaintext does not end up half encrypted.
+	current.PhoneNumber = details.PhoneNumber
+	var pii userPII
+	if pii, err = encryptUserPII(r.cipher, current); err != nil {
+		return domain.User{}, nil, err
+	}
+
+	const updateQuery = `
+UPDATE users
+SET phone_number = $2,
+    email = NULLIF($3, ''),
+    address = NULLIF($4, ''),
+    phone_index = NULLIF($5, ''),
+    dob = NULL,
+    dob_encrypted = $6,
+    id_number = $7,
+    dob_index = $8,
+    identity_key = CASE WHEN identity_key IS NULL THEN NULL ELSE $9 END,
+    pii_key_version = $10,
+    updated_at = NOW()
+WHERE customer_id = $1
+RETURNING ` + userColumns
+
+	var updated domain.User
+	if err = r.scanUser(tx.QueryRowContext(ctx, updateQuery, customerID, pii.phoneNumber, details.Email, details.Address, pii.phoneIndex, pii.dob, pii.idNumber, pii.dobIndex, pii.identityKey, pii.keyVersion), &updated); err != nil {
+		logger.Error("user repository update contact details failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return domain.User{}, nil, fmt.Errorf("update contact details: %w", err)
+	}
+
+	const insertChangeQuery = `
+INSERT INTO customer_profile_changes (customer_id, field, old_value, new_value, changed_by, key_version)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at`
+
+	changedFields := make([]string, 0, len(changes))
+	for i := range changes {
+		changes[i].CustomerID = customerID
+		changes[i].ChangedBy = changedBy
+
+		var oldValue, newValue string
+		if oldValue, err = r.cipher.Encrypt(changes[i].OldValue); err != nil {
+			return domain.User{}, nil, fmt.Errorf("encrypt profile change: %w", err)
+		}
+		if newValue, err = r.cipher.Encrypt(changes[i].NewValue); err != nil {
+			return domain.User{}, nil, fmt.Errorf("encrypt profile change: %w", err)
+		}
+		if err = tx.QueryRowContext(ctx, insertChangeQuery, customerID, changes[i].Field, oldValue, newValue, changedBy, r.cipher.CurrentVersion()).Scan(&changes[i].ID, &changes[i].CreatedAt); err != nil {
+			logger.Error("user repository insert profile change failed", err, logger.Fields{
+				"customerId": customerID,
+				"field":      changes[i].Field,
+			})
+			return domain.User{}, nil, fmt.Errorf("insert profile change: %w", err)
+		}
+		changedFields = append(changedFields, string(changes[i].Field))
+	}
+
+	var event domain.OutboxEvent
+	if event, err = domain.NewCustomerOutboxEvent(domain.CustomerEventProfileUpdated, domain.CustomerEventData{
+		CustomerID:    customerID,
+		Actor:         changedBy,
+		ChangedFields: changedFields,
+	}); err != nil {
+		return domain.User{}, nil, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.User{}, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("user repository commit contact details tx failed", err, nil)
+		return domain.User{}, nil, fmt.Errorf("commit contact details transaction: %w", err)
+	}
+
+	logger.Info("user repository update contact details success", logger.Fields{
+		"customerId":    customerID,
+		"changedFields": changedFields,
+	})
+	return updated, changes, nil
+}
+
+// GetProfileChanges returns up to limit contact detail changes of a customer,
+// newest first.
+func (r *UserRepository) GetProfileChanges(ctx context.Context, customerID string, limit int) ([]domain.ProfileChange, error) {
+	const query = `
+SELECT ` + profileChangeColumns + `
+FROM customer_profile_changes
+WHERE customer_id = $1
+ORDER BY created_at DESC
+LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID, limit)
+	if err != nil {
+		logger.Error("user repository get profile changes failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return nil, fmt.Errorf("get profile changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := make([]domain.ProfileChange, 0)
+	for rows.Next() {
+		var change domain.ProfileChange
+		if _, err := r.scanProfileChange(rows, &change); err != nil {
+			return nil, fmt.Errorf("scan profile change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate profile changes: %w", err)
+	}
+	return changes, nil
+}
+
 func (r *UserRepository) GetTransactionPinHashByCustomerID(ctx context.Context, customerID string) (string, error) {
 	logger.Info("user repository get pin hash by customer id", logger.Fields{
 		"customerId": customerID,
@@ -228,20 +448,554 @@ WHERE customer_id = $1`
 	return transactionPinHash, nil
 }
 
-func scanUser(row rowScanner, user *domain.User) error {
+func (r *UserRepository) GetPinState(ctx context.Context, customerID string) (domain.PinState, error) {
+	const query = `
+SELECT ` + pinStateColumns + `
+FROM users
+WHERE customer_id = $1`
+
+	var state domain.PinState
+	if err := scanPinState(r.db.QueryRowContext(ctx, query, customerID), &state); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.PinState{}, commons.ErrRecordNotFound
+		}
+		logger.Error("user repository get pin state failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return domain.PinState{}, fmt.Errorf("get pin state: %w", err)
+	}
+
+	return state, nil
+}
+
+func (r *UserRepository) RecordFailedPinAttempt(ctx context.Context, customerID string, maxAttempts int, lockedUntil time.Time) (domain.PinState, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("user repository begin failed pin attempt tx failed", err, nil)
+		return domain.PinState{}, fmt.Errorf("begin failed pin attempt transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// The counter restarts once the PIN locks, so a timed lock that lapses on
+	// its own hands the customer a fresh set of attempts.
+	const query = `
+UPDATE users u
+SET pin_failed_attempts = CASE WHEN p.attempts >= $2 THEN 0 ELSE p.attempts END,
+    pin_locked_until = CASE WHEN p.attempts >= $2 THEN $3 ELSE u.pin_locked_until END,
+    updated_at = NOW()
+FROM (
+	SELECT pin_failed_attempts + 1 AS attempts
+	FROM users
+	WHERE customer_id = $1
+	FOR UPDATE
+) p
+WHERE u.customer_id = $1
+RETURNING ` + pinStateColumns + `, p.attempts >= $2`
+
+	var (
+		state  domain.PinState
+		locked bool
+	)
+	if err = tx.QueryRowContext(ctx, query, customerID, maxAttempts, lockedUntil).Scan(
+		&state.CustomerID,
+		&state.PinHash,
+		&state.FailedAttempts,
+		&state.LockedUntil,
+		&state.PinChangedAt,
+		&locked,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.PinState{}, err
+		}
+		logger.Error("user repository record failed pin attempt failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return domain.PinState{}, fmt.Errorf("record failed pin attempt: %w", err)
+	}
+
+	if locked {
+		var event domain.OutboxEvent
+		event, err = domain.NewCustomerOutboxEvent(domain.CustomerEventPinLocked, domain.CustomerEventData{
+			CustomerID:     customerID,
+			FailedAttempts: maxAttempts,
+			LockedUntil:    state.LockedUntil,
+		})
+		if err != nil {
+			return domain.PinState{}, err
+		}
+		if err = insertOutboxEvents(ctx, tx, event); err != nil {
+			return domain.PinState{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("user repository commit failed pin attempt tx failed", err, nil)
+		return domain.PinState{}, fmt.Errorf("commit failed pin attempt transaction: %w", err)
+	}
+
+	if locked {
+		logger.Info("user repository pin locked", logger.Fields{
+			"customerId":  customerID,
+			"lockedUntil": state.LockedUntil,
+		})
+	}
+	return state, nil
+}
+
+func (r *UserRepository) ClearFailedPinAttempts(ctx context.Context, customerID string) error {
+	const query = `
+UPDATE users
+SET pin_failed_attempts = 0,
+    updated_at = NOW()
+WHERE customer_id = $1
+  AND pin_failed_attempts > 0`
+
+	if _, err := r.db.ExecContext(ctx, query, customerID); err != nil {
+		logger.Error("user repository clear failed pin attempts failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return fmt.Errorf("clear failed pin attempts: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) UpdatePinHash(ctx context.Context, customerID string, pinHash string, reason domain.PinChangeReason) (domain.PinState, error) {
+	logger.Info("user repository update pin hash", logger.Fields{
+		"customerId": customerID,
+		"reason":     reason,
+	})
+
+	const query = `
+UPDATE users
+SET transaction_pin_hash = $2,
+    pin_failed_attempts = 0,
+    pin_locked_until = NULL,
+    pin_changed_at = NOW(),
+    updated_at = NOW()
+WHERE customer_id = $1
+RETURNING ` + pinStateColumns
+
+	return r.updatePinState(ctx, query, domain.CustomerEventPinChanged, domain.CustomerEventData{
+		CustomerID: customerID,
+		Reason:     string(reason),
+	}, customerID, pinHash)
+}
+
+func (r *UserRepository) UnlockPin(ctx context.Context, customerID string, actor string, comment string) (domain.PinState, error) {
+	logger.Info("user repository unlock pin", logger.Fields{
+		"customerId": customerID,
+		"actor":      actor,
+	})
+
+	const query = `
+UPDATE users
+SET pin_failed_attempts = 0,
+    pin_locked_until = NULL,
+    updated_at = NOW()
+WHERE customer_id = $1
+  AND pin_locked_until > NOW()
+RETURNING ` + pinStateColumns
+
+	return r.updatePinState(ctx, query, domain.CustomerEventPinUnlocked, domain.CustomerEventData{
+		CustomerID: customerID,
+		Actor:      actor,
+		Comment:    comment,
+	}, customerID)
+}
+
+func (r *UserRepository) ResolveComplianceHold(ctx context.Context, customerID string, status domain.UserStatus, actor string, comment string) (domain.User, error) {
+	logger.Info("user repository resolve compliance hold", logger.Fields{
+		"customerId": customerID,
+		"status":     status,
+		"actor":      actor,
+	})
+
+	eventType := domain.CustomerEventComplianceCleared
+	if status == domain.UserStatusRejected {
+		eventType = domain.CustomerEventComplianceRejected
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("user repository begin compliance hold tx failed", err, nil)
+		return domain.User{}, fmt.Errorf("begin compliance hold transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const query = `
+UPDATE users
+SET status = $2,
+    updated_at = NOW()
+WHERE customer_id = $1
+  AND status = 'HELD'
+RETURNING ` + userColumns
+
+	var user domain.User
+	if err = r.scanUser(tx.QueryRowContext(ctx, query, customerID, string(status)), &user); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.User{}, err
+		}
+		logger.Error("user repository resolve compliance hold failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return domain.User{}, fmt.Errorf("resolve compliance hold: %w", err)
+	}
+
+	var event domain.OutboxEvent
+	if event, err = domain.NewCustomerOutboxEvent(eventType, domain.CustomerEventData{
+		CustomerID: customerID,
+		Actor:      actor,
+		Comment:    comment,
+	}); err != nil {
+		return domain.User{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.User{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("user repository commit compliance hold tx failed", err, nil)
+		return domain.User{}, fmt.Errorf("commit compliance hold transaction: %w", err)
+	}
+
+	return user, nil
+}
+
+// updatePinState runs a single-row PIN update and records its customer event
+// in the same transaction.
+func (r *UserRepository) updatePinState(ctx context.Context, query string, eventType domain.CustomerEventType, data domain.CustomerEventData, args ...any) (domain.PinState, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("user repository begin pin update tx failed", err, nil)
+		return domain.PinState{}, fmt.Errorf("begin pin update transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var state domain.PinState
+	if err = scanPinState(tx.QueryRowContext(ctx, query, args...), &state); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = commons.ErrRecordNotFound
+			return domain.PinState{}, err
+		}
+		logger.Error("user repository pin update failed", err, logger.Fields{
+			"customerId": data.CustomerID,
+			"eventType":  eventType,
+		})
+		return domain.PinState{}, fmt.Errorf("update pin state: %w", err)
+	}
+
+	var event domain.OutboxEvent
+	if event, err = domain.NewCustomerOutboxEvent(eventType, data); err != nil {
+		return domain.PinState{}, err
+	}
+	if err = insertOutboxEvents(ctx, tx, event); err != nil {
+		return domain.PinState{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("user repository commit pin update tx failed", err, nil)
+		return domain.PinState{}, fmt.Errorf("commit pin update transaction: %w", err)
+	}
+
+	return state, nil
+}
+
+const userColumns = `id, customer_id, first_name, middle_name, last_name, dob, dob_encrypted, phone_number, email, address, id_type, id_number, kyc_level, transaction_pin_hash, status, merged_into_customer_id, pii_key_version, created_at, updated_at`
+
+const profileChangeColumns = `id, customer_id, field, old_value, new_value, changed_by, key_version, created_at`
+
+const pinStateColumns = `customer_id, transaction_pin_hash, pin_failed_attempts, pin_locked_until, pin_changed_at`
+
+func scanPinState(row rowScanner, state *domain.PinState) error {
 	return row.Scan(
+		&state.CustomerID,
+		&state.PinHash,
+		&state.FailedAttempts,
+		&state.LockedUntil,
+		&state.PinChangedAt,
+	)
+}
+
+// scanUser reads a userColumns row and decrypts its PII. Rows with
+// pii_key_version 0 predate encryption and are read as plaintext.
+func (r *UserRepository) scanUser(row rowScanner, user *domain.User) error {
+	return scanUser(row, user, r.cipher)
+}
+
+func scanUser(row rowScanner, user *domain.User, cipher *encryption.FieldCipher) error {
+	var (
+		dob          sql.NullTime
+		dobEncrypted sql.NullString
+		keyVersion   int
+	)
+	if err := row.Scan(
 		&user.ID,
 		&user.CustomerID,
 		&user.FirstName,
 		&user.MiddleName,
 		&user.LastName,
-		&user.DOB,
+		&dob,
+		&dobEncrypted,
 		&user.PhoneNumber,
+		&user.Email,
+		&user.Address,
 		&user.IDType,
 		&user.IDNumber,
 		&user.KYCLevel,
 		&user.TransactionPinHash,
+		&user.Status,
+		&user.MergedIntoCustomerID,
+		&keyVersion,
 		&user.CreatedAt,
 		&user.UpdatedAt,
-	)
+	); err != nil {
+		return err
+	}
+
+	if keyVersion == 0 {
+		user.DOB = dob.Time
+		return nil
+	}
+
+	var err error
+	if user.PhoneNumber, err = cipher.Decrypt(user.PhoneNumber); err != nil {
+		return fmt.Errorf("decrypt phone number: %w", err)
+	}
+	if user.IDNumber, err = cipher.Decrypt(user.IDNumber); err != nil {
+		return fmt.Errorf("decrypt id number: %w", err)
+	}
+	rawDOB, err := cipher.Decrypt(dobEncrypted.String)
+	if err != nil {
+		return fmt.Errorf("decrypt dob: %w", err)
+	}
+	if user.DOB, err = time.Parse(time.DateOnly, rawDOB); err != nil {
+		return fmt.Errorf("parse dob: %w", err)
+	}
+	return nil
+}
+
+// scanProfileChange reads a profileChangeColumns row, decrypts its values and
+// returns the key version they were stored under.
+func (r *UserRepository) scanProfileChange(row rowScanner, change *domain.ProfileChange) (int, error) {
+	var keyVersion int
+	if err := row.Scan(&change.ID, &change.CustomerID, &change.Field, &change.OldValue, &change.NewValue, &change.ChangedBy, &keyVersion, &change.CreatedAt); err != nil {
+		return 0, err
+	}
+	if keyVersion == 0 {
+		return keyVersion, nil
+	}
+
+	var err error
+	if change.OldValue, err = r.cipher.Decrypt(change.OldValue); err != nil {
+		return 0, fmt.Errorf("decrypt old value: %w", err)
+	}
+	if change.NewValue, err = r.cipher.Decrypt(change.NewValue); err != nil {
+		return 0, fmt.Errorf("decrypt new value: %w", err)
+	}
+	return keyVersion, nil
+}
+
+// userPII is the stored form of a customer's PII: the ciphertexts, the blind
+// indexes searched instead of them and the key version they were written with.
+type userPII struct {
+	dob         string
+	phoneNumber string
+	idNumber    string
+	identityKey string
+	dobIndex    string
+	phoneIndex  string
+	keyVersion  int
+}
+
+func encryptUserPII(cipher *encryption.FieldCipher, user domain.User) (userPII, error) {
+	pii := userPII{
+		identityKey: identityIndex(cipher, domain.IdentityKey(user.IDType, user.IDNumber)),
+		dobIndex:    dobIndex(cipher, user.DOB),
+		phoneIndex:  phoneIndex(cipher, domain.PhoneKey(user.PhoneNumber)),
+		keyVersion:  cipher.CurrentVersion(),
+	}
+
+	var err error
+	if pii.dob, err = cipher.Encrypt(user.DOB.Format(time.DateOnly)); err != nil {
+		return userPII{}, fmt.Errorf("encrypt dob: %w", err)
+	}
+	if pii.phoneNumber, err = cipher.Encrypt(user.PhoneNumber); err != nil {
+		return userPII{}, fmt.Errorf("encrypt phone number: %w", err)
+	}
+	if pii.idNumber, err = cipher.Encrypt(user.IDNumber); err != nil {
+		return userPII{}, fmt.Errorf("encrypt id number: %w", err)
+	}
+	return pii, nil
+}
+
+// identityIndex is the blind index of a domain.IdentityKey, stored in
+// users.identity_key.
+func identityIndex(cipher *encryption.FieldCipher, identityKey string) string {
+	return cipher.BlindIndex("identity", identityKey)
+}
+
+func dobIndex(cipher *encryption.FieldCipher, dob time.Time) string {
+	return cipher.BlindIndex("dob", dob.Format(time.DateOnly))
+}
+
+// phoneIndex is the blind index of a domain.PhoneKey. It is empty for a number
+// without digits, which is stored as NULL so it never matches.
+func phoneIndex(cipher *encryption.FieldCipher, phoneKey string) string {
+	if phoneKey == "" {
+		return ""
+	}
+	return cipher.BlindIndex("phone", phoneKey)
+}
+
+// ReencryptUsers rewrites the PII of up to limit customers not encrypted under
+// the current key version, including rows still in plaintext, and returns how
+// many it rewrote. Erased customers have nothing left to encrypt. Rows locked by another writer are skipped for a later batch.
+func (r *UserRepository) ReencryptUsers(ctx context.Context, limit int) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin user re-encryption transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const selectQuery = `
+SELECT ` + userColumns + `
+FROM users
+WHERE pii_key_version <> $1
+  AND status <> 'ERASED'
+ORDER BY id
+LIMIT $2
+FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, selectQuery, r.cipher.CurrentVersion(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("select users to re-encrypt: %w", err)
+	}
+	users := make([]domain.User, 0, limit)
+	for rows.Next() {
+		var user domain.User
+		if err = r.scanUser(rows, &user); err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("scan user to re-encrypt: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err = rows.Err(); err != nil {
+		_ = rows.Close()
+		return 0, fmt.Errorf("iterate users to re-encrypt: %w", err)
+	}
+	_ = rows.Close()
+
+	const updateQuery = `
+UPDATE users
+SET dob = NULL,
+    dob_encrypted = $2,
+    phone_number = $3,
+    id_number = $4,
+    identity_key = CASE WHEN identity_key IS NULL THEN NULL ELSE $5 END,
+    dob_index = $6,
+    phone_index = NULLIF($7, ''),
+    pii_key_version = $8
+WHERE id = $1`
+
+	for _, user := range users {
+		var pii userPII
+		if pii, err = encryptUserPII(r.cipher, user); err != nil {
+			return 0, err
+		}
+		if _, err = tx.ExecContext(ctx, updateQuery, user.ID, pii.dob, pii.phoneNumber, pii.idNumber, pii.identityKey, pii.dobIndex, pii.phoneIndex, pii.keyVersion); err != nil {
+			logger.Error("user repository re-encrypt user failed", err, logger.Fields{
+				"customerId": user.CustomerID,
+			})
+			return 0, fmt.Errorf("re-encrypt user: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit user re-encryption transaction: %w", err)
+	}
+	return len(users), nil
+}
+
+// ReencryptProfileChanges does the same as ReencryptUsers for the old and new
+// values of customer_profile_changes.
+func (r *UserRepository) ReencryptProfileChanges(ctx context.Context, limit int) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin profile change re-encryption transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const selectQuery = `
+SELECT ` + profileChangeColumns + `
+FROM customer_profile_changes
+WHERE key_version <> $1
+ORDER BY id
+LIMIT $2
+FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, selectQuery, r.cipher.CurrentVersion(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("select profile changes to re-encrypt: %w", err)
+	}
+	changes := make([]domain.ProfileChange, 0, limit)
+	for rows.Next() {
+		var change domain.ProfileChange
+		if _, err = r.scanProfileChange(rows, &change); err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("scan profile change to re-encrypt: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	if err = rows.Err(); err != nil {
+		_ = rows.Close()
+		return 0, fmt.Errorf("iterate profile changes to re-encrypt: %w", err)
+	}
+	_ = rows.Close()
+
+	const updateQuery = `
+UPDATE customer_profile_changes
+SET old_value = $2,
+    new_value = $3,
+    key_version = $4
+WHERE id = $1`
+
+	for _, change := range changes {
+		var oldValue, newValue string
+		if oldValue, err = r.cipher.Encrypt(change.OldValue); err != nil {
+			return 0, fmt.Errorf("encrypt profile change: %w", err)
+		}
+		if newValue, err = r.cipher.Encrypt(change.NewValue); err != nil {
+			return 0, fmt.Errorf("encrypt profile change: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, updateQuery, change.ID, oldValue, newValue, r.cipher.CurrentVersion()); err != nil {
+			return 0, fmt.Errorf("re-encrypt profile change: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit profile change re-encryption transaction: %w", err)
+	}
+	return len(changes), nil
 }
@@ -0,0 +1,412 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/lib/pq"
+)
+
+type WebhookRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+const webhookSubscriptionColumns = `id, channel_id, url, secret, event_types, status, created_at, updated_at`
+
+const webhookDeliveryColumns = `id, subscription_id, event_id, event_type, transfer_id, payload, status, attempts, next_attempt_at, last_attempt_at, last_response_code, last_error, redelivery_of, created_at, updated_at`
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, subscription domain.WebhookSubscription) (domain.WebhookSubscription, error) {
+	logger.Info("webhook repository create subscription", logger.Fields{
+		"channelId":  subscription.ChannelID,
+		"url":        subscription.URL,
+		"eventTypes": subscription.EventTypes,
+	})
+
+	const query = `
+INSERT INTO webhook_subscriptions (
+	channel_id,
+	url,
+	secret,
+	event_types,
+	status
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING ` + webhookSubscriptionColumns
+
+	var created domain.WebhookSubscription
+	if err := scanWebhookSubscription(r.db.QueryRowContext(
+		ctx,
+		query,
+		subscription.ChannelID,
+		subscription.URL,
+		subscription.Secret,
+		pq.Array(eventTypesToStrings(subscription.EventTypes)),
+		subscription.Status,
+	), &created); err != nil {
+		logger.Error("webhook repository create subscription failed", err, logger.Fields{
+			"channelId": subscription.ChannelID,
+		})
+		return domain.WebhookSubscription{}, fmt.Errorf("create webhook subscription: %w", err)
+	}
+
+	logger.Info("webhook repository create subscription success", logger.Fields{
+		"subscriptionId": created.ID,
+		"channelId":      created.ChannelID,
+	})
+
+	return created, nil
+}
+
+func (r *WebhookRepository) GetSubscriptionsByChannelID(ctx context.Context, channelID string) ([]domain.WebhookSubscription, error) {
+	logger.Info("webhook repository get subscriptions by channel id", logger.Fields{
+		"channelId": channelID,
+	})
+
+	const query = `
+SELECT ` + webhookSubscriptionColumns + `
+FROM webhook_subscriptions
+WHERE channel_id = $1
+ORDER BY created_at DESC`
+
+	return r.querySubscriptions(ctx, query, channelID)
+}
+
+func (r *WebhookRepository) GetActiveSubscriptionsForEvent(ctx context.Context, channelID string, eventType domain.TransferEventType) ([]domain.WebhookSubscription, error) {
+	logger.Info("webhook repository get active subscriptions for event", logger.Fields{
+		"channelId": channelID,
+		"eventType": eventType,
+	})
+
+	const query = `
+SELECT ` + webhookSubscriptionColumns + `
+FROM webhook_subscriptions
+WHERE status = 'ACTIVE'
+  AND $1 = ANY(event_types)
+  AND channel_id = $2`
+
+	return r.querySubscriptions(ctx, query, string(eventType), channelID)
+}
+
+func (r *WebhookRepository) GetSubscriptionByID(ctx context.Context, id string) (domain.WebhookSubscription, error) {
+	logger.Info("webhook repository get subscription by id", logger.Fields{
+		"subscriptionId": id,
+	})
+
+	const query = `
+SELECT ` + webhookSubscriptionColumns + `
+FROM webhook_subscriptions
+WHERE id = $1`
+
+	var subscription domain.WebhookSubscription
+	if err := scanWebhookSubscription(r.db.QueryRowContext(ctx, query, id), &subscription); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("webhook repository subscription not found", logger.Fields{
+				"subscriptionId": id,
+			})
+			return domain.WebhookSubscription{}, commons.ErrRecordNotFound
+		}
+		logger.Error("webhook repository get subscription failed", err, logger.Fields{
+			"subscriptionId": id,
+		})
+		return domain.WebhookSubscription{}, fmt.Errorf("get webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	logger.Info("webhook repository create delivery", logger.Fields{
+		"subscriptionId": delivery.SubscriptionID,
+		"eventId":        delivery.EventID,
+		"eventType":      delivery.EventType,
+	})
+
+	const query = `
+INSERT INTO webhook_deliveries (
+	subscription_id,
+	event_id,
+	event_type,
+	transfer_id,
+	payload,
+	status,
+	redelivery_of
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING ` + webhookDeliveryColumns
+
+	var created domain.WebhookDelivery
+	if err := scanWebhookDelivery(r.db.QueryRowContext(
+		ctx,
+		query,
+		delivery.SubscriptionID,
+		delivery.EventID,
+		delivery.EventType,
+		delivery.TransferID,
+		delivery.Payload,
+		domain.WebhookDeliveryPending,
+		delivery.RedeliveryOf,
+	), &created); err != nil {
+		logger.Error("webhook repository create delivery failed", err, logger.Fields{
+			"subscriptionId": delivery.SubscriptionID,
+			"eventId":        delivery.EventID,
+		})
+		return domain.WebhookDelivery{}, fmt.Errorf("create webhook delivery: %w", err)
+	}
+
+	logger.Info("webhook repository create delivery success", logger.Fields{
+		"deliveryId": created.ID,
+	})
+
+	return created, nil
+}
+
+func (r *WebhookRepository) GetDeliveryByID(ctx context.Context, id string) (domain.WebhookDelivery, error) {
+	logger.Info("webhook repository get delivery by id", logger.Fields{
+		"deliveryId": id,
+	})
+
+	const query = `
+SELECT ` + webhookDeliveryColumns + `
+FROM webhook_deliveries
+WHERE id = $1`
+
+	var delivery domain.WebhookDelivery
+	if err := scanWebhookDelivery(r.db.QueryRowContext(ctx, query, id), &delivery); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("webhook repository delivery not found", logger.Fields{
+				"deliveryId": id,
+			})
+			return domain.WebhookDelivery{}, commons.ErrRecordNotFound
+		}
+		logger.Error("webhook repository get delivery failed", err, logger.Fields{
+			"deliveryId": id,
+		})
+		return domain.WebhookDelivery{}, fmt.Errorf("get webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (r *WebhookRepository) GetDeliveriesBySubscriptionID(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error) {
+	logger.Info("webhook repository get deliveries by subscription id", logger.Fields{
+		"subscriptionId": subscriptionID,
+		"limit":          limit,
+	})
+
+	const query = `
+SELECT ` + webhookDeliveryColumns + `
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC
+LIMIT $2`
+
+	return r.queryDeliveries(ctx, query, subscriptionID, limit)
+}
+
+// ClaimDueDeliveries leases pending deliveries whose next attempt is due by pushing
+// next_attempt_at forward, so concurrent dispatchers do not pick up the same rows.
+func (r *WebhookRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	const query = `
+UPDATE webhook_deliveries
+SET next_attempt_at = NOW() + ($2::bigint * INTERVAL '1 millisecond'),
+    updated_at = NOW()
+WHERE id IN (
+	SELECT id
+	FROM webhook_deliveries
+	WHERE status = 'PENDING'
+	  AND next_attempt_at <= NOW()
+	ORDER BY next_attempt_at ASC
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + webhookDeliveryColumns
+
+	return r.queryDeliveries(ctx, query, limit, lease.Milliseconds())
+}
+
+func (r *WebhookRepository) UpdateDeliveryAttempt(ctx context.Context, delivery domain.WebhookDelivery) error {
+	logger.Info("webhook repository update delivery attempt", logger.Fields{
+		"deliveryId": delivery.ID,
+		"status":     delivery.Status,
+		"attempts":   delivery.Attempts,
+	})
+
+	const query = `
+UPDATE webhook_deliveries
+SET status = $2,
+    attempts = $3,
+    next_attempt_at = $4,
+    last_attempt_at = $5,
+    last_response_code = $6,
+    last_error = $7,
+    updated_at = NOW()
+WHERE id = $1`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastAttemptAt,
+		delivery.LastResponseCode,
+		delivery.LastError,
+	)
+	if err != nil {
+		logger.Error("webhook repository update delivery attempt failed", err, logger.Fields{
+			"deliveryId": delivery.ID,
+		})
+		return fmt.Errorf("update webhook delivery: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update webhook delivery rows affected: %w", err)
+	}
+	if rows == 0 {
+		return commons.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) querySubscriptions(ctx context.Context, query string, args ...any) ([]domain.WebhookSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("webhook repository query subscriptions failed", err, nil)
+		return nil, fmt.Errorf("query webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := make([]domain.WebhookSubscription, 0)
+	for rows.Next() {
+		var subscription domain.WebhookSubscription
+		if err := scanWebhookSubscription(rows, &subscription); err != nil {
+			logger.Error("webhook repository scan subscription failed", err, nil)
+			return nil, fmt.Errorf("scan webhook subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (r *WebhookRepository) queryDeliveries(ctx context.Context, query string, args ...any) ([]domain.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("webhook repository query deliveries failed", err, nil)
+		return nil, fmt.Errorf("query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+		if err := scanWebhookDelivery(rows, &delivery); err != nil {
+			logger.Error("webhook repository scan delivery failed", err, nil)
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func scanWebhookSubscription(row rowScanner, subscription *domain.WebhookSubscription) error {
+	var eventTypes []string
+	if err := row.Scan(
+		&subscription.ID,
+		&subscription.ChannelID,
+		&subscription.URL,
+		&subscription.Secret,
+		pq.Array(&eventTypes),
+		&subscription.Status,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	subscription.EventTypes = make([]domain.TransferEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		subscription.EventTypes = append(subscription.EventTypes, domain.TransferEventType(eventType))
+	}
+	return nil
+}
+
+func scanWebhookDelivery(row rowScanner, delivery *domain.WebhookDelivery) error {
+	var (
+		transferID       sql.NullString
+		lastAttemptAt    sql.NullTime
+		lastResponseCode sql.NullInt64
+		lastError        sql.NullString
+		redeliveryOf     sql.NullString
+	)
+
+	if err := row.Scan(
+		&delivery.ID,
+		&delivery.SubscriptionID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&transferID,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&lastAttemptAt,
+		&lastResponseCode,
+		&lastError,
+		&redeliveryOf,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	if transferID.Valid {
+		value := transferID.String
+		delivery.TransferID = &value
+	}
+	if lastAttemptAt.Valid {
+		value := lastAttemptAt.Time
+		delivery.LastAttemptAt = &value
+	}
+	if lastResponseCode.Valid {
+		value := int(lastResponseCode.Int64)
+		delivery.LastResponseCode = &value
+	}
+	if lastError.Valid {
+		value := lastError.String
+		delivery.LastError = &value
+	}
+	if redeliveryOf.Valid {
+		value := redeliveryOf.String
+		delivery.RedeliveryOf = &value
+	}
+	return nil
+}
+
+func eventTypesToStrings(eventTypes []domain.TransferEventType) []string {
+	out := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		out = append(out, string(eventType))
+	}
+	return out
+}
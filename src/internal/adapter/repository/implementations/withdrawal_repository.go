@@ -0,0 +1,200 @@
+package implementations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/shopspring/decimal"
+)
+
+type WithdrawalRepository struct {
+	db *sql.DB
+}
+
+func NewWithdrawalRepository(db *sql.DB) *WithdrawalRepository {
+	return &WithdrawalRepository{db: db}
+}
+
+// Create records the withdrawal and posts it in one transaction: the customer is
+// debited the total, the suspense account passes the amount to the cash GL
+// account and the fees to the charges and VAT accounts, and every leg is
+// journaled. It returns commons.ErrInsufficientBalance when the account cannot
+// cover the total.
+func (r *WithdrawalRepository) Create(ctx context.Context, withdrawal domain.Withdrawal, posting domain.WithdrawalPosting) (domain.Withdrawal, error) {
+	logger.Info("withdrawal repository create", logger.Fields{
+		"reference":           withdrawal.Reference,
+		"accountNumber":       withdrawal.AccountNumber,
+		"currency":            withdrawal.Currency,
+		"amount":              withdrawal.Amount,
+		"totalDebit":          withdrawal.TotalDebit,
+		"cashGLAccountNumber": withdrawal.CashGLAccountNumber,
+	})
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("withdrawal repository begin tx failed", err, nil)
+		return domain.Withdrawal{}, fmt.Errorf("begin withdrawal transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const insertQuery = `
+INSERT INTO withdrawals (
+	reference,
+	account_number,
+	currency,
+	amount,
+	charge_amount,
+	vat_amount,
+	total_debit,
+	cash_gl_account_number,
+	narration,
+	channel
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), NULLIF($10, ''))
+RETURNING id, created_at`
+
+	if err = tx.QueryRowContext(
+		ctx,
+		insertQuery,
+		withdrawal.Reference,
+		withdrawal.AccountNumber,
+		withdrawal.Currency,
+		withdrawal.Amount,
+		withdrawal.ChargeAmount,
+		withdrawal.VATAmount,
+		withdrawal.TotalDebit,
+		withdrawal.CashGLAccountNumber,
+		withdrawal.Narration,
+		withdrawal.Channel,
+	).Scan(&withdrawal.ID, &withdrawal.CreatedAt); err != nil {
+		logger.Error("withdrawal repository insert failed", err, logger.Fields{
+			"reference": withdrawal.Reference,
+		})
+		return domain.Withdrawal{}, fmt.Errorf("create withdrawal: %w", err)
+	}
+
+	if err = postAccountBalance(ctx, tx, debitAccountBalanceQuery, domain.AccountEventDebited, withdrawal.AccountNumber, withdrawal.TotalDebit, ""); err != nil {
+		if errors.Is(err, errPostingFailed) {
+			err = r.accountPostingError(ctx, withdrawal.AccountNumber)
+			return domain.Withdrawal{}, err
+		}
+		logger.Error("withdrawal repository debit account failed", err, logger.Fields{
+			"accountNumber": withdrawal.AccountNumber,
+		})
+		return domain.Withdrawal{}, err
+	}
+
+	if err = postWithdrawalLegs(ctx, tx, withdrawal, posting); err != nil {
+		logger.Error("withdrawal repository post legs failed", err, logger.Fields{
+			"reference": withdrawal.Reference,
+		})
+		return domain.Withdrawal{}, err
+	}
+
+	if withdrawal.JournalEntries, err = insertJournalEntries(ctx, tx, withdrawalJournalEntries(withdrawal, posting)); err != nil {
+		logger.Error("withdrawal repository journal failed", err, logger.Fields{
+			"reference": withdrawal.Reference,
+		})
+		return domain.Withdrawal{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("withdrawal repository commit tx failed", err, nil)
+		return domain.Withdrawal{}, fmt.Errorf("commit withdrawal transaction: %w", err)
+	}
+
+	logger.Info("withdrawal repository create success", logger.Fields{
+		"withdrawalId": withdrawal.ID,
+		"reference":    withdrawal.Reference,
+	})
+	return withdrawal, nil
+}
+
+func (r *WithdrawalRepository) accountPostingError(ctx context.Context, accountNumber string) error {
+	const query = `SELECT status FROM accounts WHERE account_number = $1`
+
+	var status domain.AccountStatus
+	if err := r.db.QueryRowContext(ctx, query, accountNumber).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return commons.ErrRecordNotFound
+		}
+		return fmt.Errorf("get account status: %w", err)
+	}
+	if status != domain.AccountStatusActive {
+		return fmt.Errorf("account is not active")
+	}
+	return commons.ErrInsufficientBalance
+}
+
+// postWithdrawalLegs moves the debited total through the suspense account into
+// the cash GL account and the fee accounts.
+func postWithdrawalLegs(ctx context.Context, tx *sql.Tx, withdrawal domain.Withdrawal, posting domain.WithdrawalPosting) error {
+	const creditSuspenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1`
+	if _, err := execRequiredRows(ctx, tx, creditSuspenseQuery, posting.SuspenseAccountNumber, withdrawal.TotalDebit); err != nil {
+		return err
+	}
+
+	const debitSuspenseQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance - $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND available_balance >= $2::numeric`
+	if _, err := execRequiredRows(ctx, tx, debitSuspenseQuery, posting.SuspenseAccountNumber, withdrawal.TotalDebit); err != nil {
+		return err
+	}
+
+	const creditGLQuery = `
+UPDATE transient_accounts
+SET available_balance = available_balance + $2::numeric,
+    updated_at = NOW()
+WHERE account_number = $1
+  AND UPPER(currency) = UPPER($3)`
+	if _, err := execRequiredRows(ctx, tx, creditGLQuery, withdrawal.CashGLAccountNumber, withdrawal.Amount, withdrawal.Currency); err != nil {
+		return err
+	}
+	if _, err := execRequiredRows(ctx, tx, creditGLQuery, posting.ChargesAccountNumber, posting.ChargeUSD, "USD"); err != nil {
+		return err
+	}
+	if _, err := execRequiredRows(ctx, tx, creditGLQuery, posting.VATAccountNumber, posting.VATUSD, "USD"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func withdrawalJournalEntries(withdrawal domain.Withdrawal, posting domain.WithdrawalPosting) []domain.JournalEntry {
+	entry := func(accountNumber string, entryType domain.LedgerEntryType, currency string, amount decimal.Decimal) domain.JournalEntry {
+		return domain.JournalEntry{
+			ReferenceType: domain.JournalReferenceWithdrawal,
+			ReferenceID:   withdrawal.ID,
+			Reference:     withdrawal.Reference,
+			AccountNumber: accountNumber,
+			EntryType:     entryType,
+			Currency:      currency,
+			Amount:        amount,
+		}
+	}
+	fees := withdrawal.ChargeAmount.Add(withdrawal.VATAmount)
+
+	return []domain.JournalEntry{
+		entry(withdrawal.AccountNumber, domain.LedgerEntryDebit, withdrawal.Currency, withdrawal.TotalDebit),
+		entry(posting.SuspenseAccountNumber, domain.LedgerEntryCredit, withdrawal.Currency, withdrawal.TotalDebit),
+		entry(posting.SuspenseAccountNumber, domain.LedgerEntryDebit, withdrawal.Currency, withdrawal.Amount),
+		entry(withdrawal.CashGLAccountNumber, domain.LedgerEntryCredit, withdrawal.Currency, withdrawal.Amount),
+		entry(posting.SuspenseAccountNumber, domain.LedgerEntryDebit, withdrawal.Currency, fees),
+		entry(posting.ChargesAccountNumber, domain.LedgerEntryCredit, "USD", posting.ChargeUSD),
+		entry(posting.VATAccountNumber, domain.LedgerEntryCredit, "USD", posting.VATUSD),
+	}
+}
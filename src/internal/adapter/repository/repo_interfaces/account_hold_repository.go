@@ -0,0 +1,19 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+type AccountHoldRepository interface {
+	PlaceHold(ctx context.Context, hold domain.AccountHold) (domain.AccountHold, error)
+	ReleaseHold(ctx context.Context, accountNumber string, reference string, status domain.AccountHoldStatus) (domain.AccountHold, error)
+	// CaptureHold returns commons.ErrAccountHoldExpired for an active hold
+	// past its expiry that has not been released yet.
+	CaptureHold(ctx context.Context, accountNumber string, reference string, amount decimal.Decimal, suspenseAccountNumber string) (domain.AccountHold, error)
+	GetByReference(ctx context.Context, accountNumber string, reference string) (domain.AccountHold, error)
+	GetByAccountNumber(ctx context.Context, accountNumber string) ([]domain.AccountHold, error)
+	GetExpired(ctx context.Context, limit int) ([]domain.AccountHold, error)
+}
@@ -9,9 +9,16 @@ import (
 
 type AccountRepository interface {
 	Create(ctx context.Context, account domain.Account) (domain.Account, error)
+	NextAccountSerial(ctx context.Context) (int64, error)
 	GetByAccountNumber(ctx context.Context, accountNumber string) (domain.Account, error)
+	GetByCustomerID(ctx context.Context, customerID string) ([]domain.Account, error)
 	HasAccountForCustomerIDAndCurrency(ctx context.Context, customerID string, currency string) (bool, error)
 	DebitInternalAccount(ctx context.Context, accountNumber string, amount decimal.Decimal) error
 	CreditInternalAccount(ctx context.Context, accountNumber string, amount decimal.Decimal) error
-	DepositFunds(ctx context.Context, accountNumber string, amount decimal.Decimal) error
+	DepositFunds(ctx context.Context, deposit domain.Deposit) (domain.Deposit, error)
+	GetDeposit(ctx context.Context, reference string) (domain.Deposit, error)
+	GetDepositByExternalReference(ctx context.Context, source domain.DepositSource, externalReference string) (domain.Deposit, error)
+	ChangeStatus(ctx context.Context, accountNumber string, from domain.AccountStatus, event domain.AccountStatusEvent) (domain.Account, error)
+	CloseAccount(ctx context.Context, accountNumber string, sweep *domain.AccountSweep, event domain.AccountStatusEvent) (domain.Account, error)
+	GetStatusHistory(ctx context.Context, accountNumber string) ([]domain.AccountStatusEvent, error)
 }
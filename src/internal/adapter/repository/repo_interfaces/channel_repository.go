@@ -0,0 +1,22 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type ChannelRepository interface {
+	// Create stores the channel with its first key. It returns
+	// commons.ErrDuplicateChannel when the channel ID is taken.
+	Create(ctx context.Context, channel domain.Channel, key domain.ChannelKey) (domain.Channel, error)
+	// GetByChannelID returns the channel with the keys not yet expired at now.
+	GetByChannelID(ctx context.Context, channelID string, now time.Time) (domain.Channel, error)
+	GetAll(ctx context.Context) ([]domain.Channel, error)
+	// Update replaces the name, scopes, allowed ranges and status.
+	Update(ctx context.Context, channel domain.Channel) (domain.Channel, error)
+	// RotateKey adds key and moves the expiry of every other key still valid
+	// after retireAt to retireAt, in one transaction.
+	RotateKey(ctx context.Context, key domain.ChannelKey, retireAt time.Time) (domain.Channel, error)
+}
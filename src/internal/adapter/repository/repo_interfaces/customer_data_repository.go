@@ -0,0 +1,22 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type CustomerDataRepository interface {
+	// GetTransfersByCustomerID returns every transfer debiting or crediting an
+	// account the customer holds, oldest first.
+	GetTransfersByCustomerID(ctx context.Context, customerID string) ([]domain.Transfer, error)
+	// RecordExport stores an EXPORT request.
+	RecordExport(ctx context.Context, request domain.DataSubjectRequest) (domain.DataSubjectRequest, error)
+	// Erase pseudonymizes the customer, removes domain.AuditPayloadPIIKeys from
+	// the audit payloads of their transfers, blanks their profile change
+	// values, dismisses their OPEN duplicate candidates and stores the ERASURE
+	// request with CUSTOMER_ERASED, in one transaction. It returns
+	// commons.ErrCustomerHasOpenAccounts while any account is not CLOSED and
+	// commons.ErrCustomerErased when the customer was already erased.
+	Erase(ctx context.Context, request domain.DataSubjectRequest) (domain.DataSubjectRequest, error)
+}
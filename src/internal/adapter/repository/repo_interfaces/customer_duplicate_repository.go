@@ -0,0 +1,31 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type CustomerDuplicateRepository interface {
+	// FindPotentialDuplicates returns the customers, other than MERGED ones,
+	// sharing the identity key, the date of birth or the phone key.
+	FindPotentialDuplicates(ctx context.Context, identityKey string, dob time.Time, phoneKey string) ([]domain.User, error)
+	// Flag stores OPEN candidates with one CUSTOMER_DUPLICATE_FLAGGED event for
+	// the new customer. A pair flagged before is left as it is.
+	Flag(ctx context.Context, customerID string, candidates []domain.DuplicateCandidate) ([]domain.DuplicateCandidate, error)
+	GetByID(ctx context.Context, id string) (domain.DuplicateCandidate, error)
+	GetByStatus(ctx context.Context, status domain.DuplicateCandidateStatus, limit int) ([]domain.DuplicateCandidate, error)
+	GetByCustomerID(ctx context.Context, customerID string) ([]domain.DuplicateCandidate, error)
+	// Dismiss closes an OPEN candidate as not a duplicate. It returns
+	// commons.ErrRecordNotFound when the candidate is no longer OPEN.
+	Dismiss(ctx context.Context, id string, reviewedBy string, comment string) (domain.DuplicateCandidate, error)
+	// Merge moves every account of merge.MergedCustomerID to
+	// merge.SurvivingCustomerID, marks the merged customer MERGED, closes the
+	// candidate as MERGED (and dismisses the merged customer's other OPEN
+	// candidates) and records CUSTOMER_MERGED, in one transaction. It returns
+	// commons.ErrCustomerNotMergeable unless both customers are ACTIVE, and
+	// commons.ErrCustomerAccountConflict when both customers hold a non-closed
+	// account in the same currency.
+	Merge(ctx context.Context, merge domain.CustomerMerge) (domain.CustomerMerge, error)
+}
@@ -0,0 +1,14 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type DormancyRepository interface {
+	MarkDormant(ctx context.Context, inactiveSince time.Time, limit int) ([]domain.Account, error)
+	Reactivate(ctx context.Context, accountNumber string, event domain.AccountStatusEvent) (domain.Account, error)
+	GetReport(ctx context.Context) ([]domain.DormancyReportLine, error)
+}
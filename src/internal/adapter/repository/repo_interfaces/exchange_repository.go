@@ -0,0 +1,11 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type ExchangeRepository interface {
+	Create(ctx context.Context, exchange domain.Exchange, posting domain.ExchangePosting) (domain.Exchange, error)
+}
@@ -0,0 +1,18 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type InterestRepository interface {
+	GetRateTiers(ctx context.Context) ([]domain.InterestRateTier, error)
+	GetInterestBearingAccounts(ctx context.Context, date time.Time) ([]domain.Account, error)
+	GetLastBatchRunDate(ctx context.Context) (*time.Time, error)
+	RecordBatchRun(ctx context.Context, date time.Time) error
+	RecordAccruals(ctx context.Context, accruals []domain.InterestAccrual) (int, error)
+	GetOpenAccrualTotals(ctx context.Context, periodEnd time.Time) ([]domain.InterestCapitalization, error)
+	Capitalize(ctx context.Context, capitalization domain.InterestCapitalization) (domain.InterestCapitalization, error)
+}
@@ -0,0 +1,23 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type KYCRepository interface {
+	// Submit stores a PENDING upgrade request with CUSTOMER_KYC_UPGRADE_SUBMITTED.
+	// A second PENDING request for the customer fails with a unique violation.
+	Submit(ctx context.Context, request domain.KYCUpgradeRequest) (domain.KYCUpgradeRequest, error)
+	GetByID(ctx context.Context, id string) (domain.KYCUpgradeRequest, error)
+	GetByStatus(ctx context.Context, status domain.KYCUpgradeStatus, limit int) ([]domain.KYCUpgradeRequest, error)
+	GetByCustomerID(ctx context.Context, customerID string) ([]domain.KYCUpgradeRequest, error)
+	// Approve marks a PENDING request APPROVED and raises the customer's KYC
+	// level to the requested level with CUSTOMER_KYC_UPGRADED. It returns
+	// commons.ErrRecordNotFound when the request is no longer PENDING.
+	Approve(ctx context.Context, id string, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error)
+	// Reject marks a PENDING request REJECTED with
+	// CUSTOMER_KYC_UPGRADE_REJECTED, leaving the KYC level unchanged.
+	Reject(ctx context.Context, id string, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error)
+}
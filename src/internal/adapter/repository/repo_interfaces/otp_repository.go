@@ -0,0 +1,17 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type OTPRepository interface {
+	Create(ctx context.Context, challenge domain.OTPChallenge) (domain.OTPChallenge, error)
+	GetByID(ctx context.Context, id string) (domain.OTPChallenge, error)
+	RecordFailedAttempt(ctx context.Context, id string) (domain.OTPChallenge, error)
+	// Consume marks a live challenge as used. It returns
+	// commons.ErrRecordNotFound when the challenge is already consumed, expired
+	// or out of attempts.
+	Consume(ctx context.Context, id string) (domain.OTPChallenge, error)
+}
@@ -0,0 +1,16 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// OutboxRepository exposes the relay side of the outbox. Events are written by
+// the owning repositories inside their own transactions.
+type OutboxRepository interface {
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, event domain.OutboxEvent) error
+}
@@ -0,0 +1,17 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type OverdraftRepository interface {
+	Approve(ctx context.Context, facility domain.OverdraftFacility) (domain.OverdraftFacility, error)
+	GetByAccountNumber(ctx context.Context, accountNumber string) (domain.OverdraftFacility, error)
+	SuspendExpired(ctx context.Context, now time.Time) ([]domain.OverdraftFacility, error)
+	GetOverdrawnPositions(ctx context.Context) ([]domain.OverdraftPosition, error)
+	GetLimitBreaches(ctx context.Context) ([]domain.OverdraftPosition, error)
+	RecordInterestAccruals(ctx context.Context, accruals []domain.OverdraftInterestAccrual) (int, error)
+}
@@ -0,0 +1,12 @@
+package repo_interfaces
+
+import "context"
+
+// PIIKeyRotationRepository re-encrypts stored customer PII under the current
+// key-encryption-key version, a batch at a time.
+type PIIKeyRotationRepository interface {
+	// ReencryptUsers returns how many customers it re-encrypted; fewer than
+	// limit means none are left.
+	ReencryptUsers(ctx context.Context, limit int) (int, error)
+	ReencryptProfileChanges(ctx context.Context, limit int) (int, error)
+}
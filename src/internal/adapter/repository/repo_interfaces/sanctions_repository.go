@@ -0,0 +1,17 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// SanctionsListSource loads the current sanctions/watchlist snapshot.
+type SanctionsListSource interface {
+	LoadList(ctx context.Context) (domain.SanctionsList, error)
+}
+
+type SanctionsScreeningRepository interface {
+	SaveScreening(ctx context.Context, screening domain.SanctionsScreening) (domain.SanctionsScreening, error)
+	GetLatestBySubject(ctx context.Context, subjectType domain.SanctionsSubjectType, subjectID string) (domain.SanctionsScreening, error)
+}
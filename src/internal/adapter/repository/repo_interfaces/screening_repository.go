@@ -0,0 +1,25 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// ScreeningRuleSource supplies the current screening rule set. It is implemented
+// by the database repository and by the JSON file loader.
+type ScreeningRuleSource interface {
+	LoadRules(ctx context.Context) ([]domain.ScreeningRule, error)
+}
+
+type ScreeningRepository interface {
+	GetOutgoingTransferStats(ctx context.Context, accountNumber string, since time.Time, excludeTransferID string) (int, decimal.Decimal, error)
+	CountRoundAmountTransfers(ctx context.Context, accountNumber string, since time.Time, roundUnit decimal.Decimal, excludeTransferID string) (int, error)
+	HasPriorTransferToBeneficiary(ctx context.Context, accountNumber string, beneficiaryAccountNumber string, beneficiaryBankCode string, excludeTransferID string) (bool, error)
+	GetInboundCreditTotal(ctx context.Context, accountNumber string, since time.Time) (decimal.Decimal, error)
+	GetLastOutgoingTransferAt(ctx context.Context, accountNumber string, excludeTransferID string) (*time.Time, error)
+	SaveScreening(ctx context.Context, screening domain.TransferScreening) (domain.TransferScreening, error)
+	GetScreeningByTransferID(ctx context.Context, transferID string) (domain.TransferScreening, error)
+}
@@ -11,9 +11,10 @@ type TransferRepository interface {
 	Create(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error)
 	Update(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error)
 	Get(ctx context.Context, id string, transactionReference string, externalRefernece string) (domain.Transfer, error)
-	ProcessInternalTransfer(ctx context.Context, debitAccountNumber string, debitAmount decimal.Decimal, suspenseAccountNumber string, debitSuspenseAccountAmount decimal.Decimal, creditAccountNumber string, creditAmount decimal.Decimal) error
+	ProcessInternalTransfer(ctx context.Context, transferID string, debitAccountNumber string, debitAmount decimal.Decimal, suspenseAccountNumber string, debitSuspenseAccountAmount decimal.Decimal, creditAccountNumber string, creditAmount decimal.Decimal) error
 	ProcessExternalTransfer(
 		ctx context.Context,
+		transferID string,
 		debitAccountNumber string,
 		totalDebitAmount decimal.Decimal,
 		suspenseAccountNumber string,
@@ -23,4 +24,15 @@ type TransferRepository interface {
 		externalAccountCurrency string,
 	) error
 	UpdateStatus(ctx context.Context, transferID string, status domain.TransferStatus) error
+	TransitionStatus(ctx context.Context, transferID string, from domain.TransferStatus, to domain.TransferStatus) error
+	GetByStatus(ctx context.Context, status domain.TransferStatus, limit int) ([]domain.Transfer, error)
+	HoldTransfer(ctx context.Context, review domain.TransferReview, debitAccountNumber string) (domain.TransferReview, error)
+	ResolveHold(
+		ctx context.Context,
+		review domain.TransferReview,
+		debitAccountNumber string,
+		outcome domain.TransferReviewStatus,
+		status domain.TransferStatus,
+		decision domain.TransferReviewDecision,
+	) (domain.Transfer, error)
 }
@@ -0,0 +1,12 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type TransferReviewRepository interface {
+	GetByTransferID(ctx context.Context, transferID string) (domain.TransferReview, error)
+	GetByTransferIDs(ctx context.Context, transferIDs []string) ([]domain.TransferReview, error)
+}
@@ -11,6 +11,7 @@ type TransientAccountRepository interface {
 	CreditSuspenseAccount(ctx context.Context, suspenseAccountNumber string, currency string, amount decimal.Decimal) error
 	SettleFromSuspenseToFees(
 		ctx context.Context,
+		transferID string,
 		suspenseAccountNumber string,
 		chargeAmount decimal.Decimal,
 		vatAmount decimal.Decimal,
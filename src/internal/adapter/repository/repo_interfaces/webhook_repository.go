@@ -0,0 +1,20 @@
+package repo_interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription domain.WebhookSubscription) (domain.WebhookSubscription, error)
+	GetSubscriptionsByChannelID(ctx context.Context, channelID string) ([]domain.WebhookSubscription, error)
+	GetActiveSubscriptionsForEvent(ctx context.Context, channelID string, eventType domain.TransferEventType) ([]domain.WebhookSubscription, error)
+	GetSubscriptionByID(ctx context.Context, id string) (domain.WebhookSubscription, error)
+	CreateDelivery(ctx context.Context, delivery domain.WebhookDelivery) (domain.WebhookDelivery, error)
+	GetDeliveryByID(ctx context.Context, id string) (domain.WebhookDelivery, error)
+	GetDeliveriesBySubscriptionID(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error)
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookDelivery, error)
+	UpdateDeliveryAttempt(ctx context.Context, delivery domain.WebhookDelivery) error
+}
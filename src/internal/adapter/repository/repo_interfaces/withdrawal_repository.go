@@ -0,0 +1,11 @@
+package repo_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type WithdrawalRepository interface {
+	Create(ctx context.Context, withdrawal domain.Withdrawal, posting domain.WithdrawalPosting) (domain.Withdrawal, error)
+}
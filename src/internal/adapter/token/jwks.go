@@ -0,0 +1,102 @@
+package token
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// Key is one verification key from a JWKS document: an RSA public key for
+// RS256 or a shared secret for HS256.
+type Key struct {
+	ID     string
+	RSA    *rsa.PublicKey
+	Secret []byte
+}
+
+// KeySet is the verification keys by key ID.
+type KeySet map[string]Key
+
+type jwksDocument struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Alg string `json:"alg"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		K   string `json:"k"`
+	} `json:"keys"`
+}
+
+// LoadJWKSFile reads a JWKS document from path.
+func LoadJWKSFile(path string) (KeySet, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks file: %w", err)
+	}
+	return ParseJWKS(raw)
+}
+
+// ParseJWKS parses "RSA" keys (n, e) and "oct" keys (k). Every key needs a
+// distinct kid; keys with "use" other than "sig" are skipped.
+func ParseJWKS(raw []byte) (KeySet, error) {
+	var document jwksDocument
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+
+	keys := make(KeySet, len(document.Keys))
+	for _, jwk := range document.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if jwk.Kid == "" {
+			return nil, errors.New("jwks key without kid")
+		}
+		if _, ok := keys[jwk.Kid]; ok {
+			return nil, fmt.Errorf("jwks key %q is listed twice", jwk.Kid)
+		}
+
+		key := Key{ID: jwk.Kid}
+		switch jwk.Kty {
+		case "RSA":
+			if jwk.Alg != "" && jwk.Alg != algRS256 {
+				return nil, fmt.Errorf("jwks key %q: unsupported alg %s", jwk.Kid, jwk.Alg)
+			}
+			n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+			if err != nil || len(n) < 256 {
+				return nil, fmt.Errorf("jwks key %q: n must be a base64url modulus of at least 2048 bits", jwk.Kid)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+			if err != nil || len(e) == 0 || len(e) > 4 {
+				return nil, fmt.Errorf("jwks key %q: invalid exponent", jwk.Kid)
+			}
+			key.RSA = &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			}
+		case "oct":
+			if jwk.Alg != "" && jwk.Alg != algHS256 {
+				return nil, fmt.Errorf("jwks key %q: unsupported alg %s", jwk.Kid, jwk.Alg)
+			}
+			secret, err := base64.RawURLEncoding.DecodeString(jwk.K)
+			if err != nil || len(secret) < 32 {
+				return nil, fmt.Errorf("jwks key %q: k must be a base64url secret of at least 32 bytes", jwk.Kid)
+			}
+			key.Secret = secret
+		default:
+			return nil, fmt.Errorf("jwks key %q: unsupported kty %s", jwk.Kid, jwk.Kty)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("jwks has no signing keys")
+	}
+	return keys, nil
+}
@@ -0,0 +1,199 @@
+package token
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+
+	// clockSkew is the leeway allowed on exp and nbf.
+	clockSkew = time.Minute
+)
+
+// ErrInvalidToken is returned for any token that fails verification.
+var ErrInvalidToken = errors.New("invalid token")
+
+// Verifier checks compact JWS tokens signed with HS256 or RS256 against a
+// KeySet and turns their claims into a commons.Principal. Customer tokens
+// carry the customer in "customer_id"; service tokens carry none and need
+// serviceScope in their space-separated "scope" claim.
+type Verifier struct {
+	keys         KeySet
+	issuer       string
+	audience     string
+	serviceScope string
+	now          func() time.Time
+}
+
+// NewVerifier builds a verifier. audience may be empty to accept any "aud".
+func NewVerifier(keys KeySet, issuer string, audience string, serviceScope string) *Verifier {
+	return &Verifier{
+		keys:         keys,
+		issuer:       issuer,
+		audience:     audience,
+		serviceScope: serviceScope,
+		now:          time.Now,
+	}
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+type claims struct {
+	Issuer     string   `json:"iss"`
+	Subject    string   `json:"sub"`
+	Audience   audience `json:"aud"`
+	ExpiresAt  *int64   `json:"exp"`
+	NotBefore  *int64   `json:"nbf"`
+	CustomerID string   `json:"customer_id"`
+	Scope      string   `json:"scope"`
+}
+
+// audience accepts the single string and array forms of "aud".
+type audience []string
+
+func (a *audience) UnmarshalJSON(raw []byte) error {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(raw, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+func (v *Verifier) Verify(token string) (commons.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return commons.Principal{}, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return commons.Principal{}, fmt.Errorf("%w: header: %v", ErrInvalidToken, err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return commons.Principal{}, fmt.Errorf("%w: signature encoding", ErrInvalidToken)
+	}
+	if err := v.verifySignature(h, parts[0]+"."+parts[1], signature); err != nil {
+		return commons.Principal{}, err
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return commons.Principal{}, fmt.Errorf("%w: claims: %v", ErrInvalidToken, err)
+	}
+	if err := v.checkClaims(c); err != nil {
+		return commons.Principal{}, err
+	}
+
+	principal := commons.Principal{
+		Subject:    c.Subject,
+		CustomerID: strings.TrimSpace(c.CustomerID),
+		Scopes:     strings.Fields(c.Scope),
+	}
+	if principal.IsService() && !principal.HasScope(v.serviceScope) {
+		return commons.Principal{}, fmt.Errorf("%w: token has neither customer_id nor the %s scope", ErrInvalidToken, v.serviceScope)
+	}
+	return principal, nil
+}
+
+// verifySignature picks the key by kid, or the only key when the token has
+// none, and requires the key type to match alg so an RSA public key can never
+// be used as an HMAC secret.
+func (v *Verifier) verifySignature(h header, signingInput string, signature []byte) error {
+	var key Key
+	if h.Kid != "" {
+		found, ok := v.keys[h.Kid]
+		if !ok {
+			return fmt.Errorf("%w: unknown kid %q", ErrInvalidToken, h.Kid)
+		}
+		key = found
+	} else if len(v.keys) == 1 {
+		for _, only := range v.keys {
+			key = only
+		}
+	} else {
+		return fmt.Errorf("%w: kid is required", ErrInvalidToken)
+	}
+
+	switch h.Alg {
+	case algHS256:
+		if key.Secret == nil {
+			return fmt.Errorf("%w: alg does not match key", ErrInvalidToken)
+		}
+		mac := hmac.New(sha256.New, key.Secret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+	case algRS256:
+		if key.RSA == nil {
+			return fmt.Errorf("%w: alg does not match key", ErrInvalidToken)
+		}
+		digest := sha256.Sum256([]byte(signingInput))
+		if err := rsa.VerifyPKCS1v15(key.RSA, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported alg %q", ErrInvalidToken, h.Alg)
+	}
+	return nil
+}
+
+func (v *Verifier) checkClaims(c claims) error {
+	now := v.now()
+	if c.ExpiresAt == nil {
+		return fmt.Errorf("%w: exp is required", ErrInvalidToken)
+	}
+	if now.After(time.Unix(*c.ExpiresAt, 0).Add(clockSkew)) {
+		return fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+	if c.NotBefore != nil && now.Add(clockSkew).Before(time.Unix(*c.NotBefore, 0)) {
+		return fmt.Errorf("%w: not yet valid", ErrInvalidToken)
+	}
+	if v.issuer != "" && c.Issuer != v.issuer {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+	if v.audience != "" {
+		matched := false
+		for _, aud := range c.Audience {
+			if aud == v.audience {
+				matched = true
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+		}
+	}
+	return nil
+}
+
+func decodeSegment(segment string, target any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, target)
+}
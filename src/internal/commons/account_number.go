@@ -0,0 +1,54 @@
+package commons
+
+import "fmt"
+
+// Account numbers follow the NUBAN layout: a 9-digit serial followed by a check
+// digit computed over the 6-digit bank code and the serial, so a mistyped digit
+// or an account number paired with the wrong bank is caught before lookup.
+
+const maxAccountNumberSerial = 999_999_999
+
+var nubanWeights = [15]int{3, 7, 3, 3, 7, 3, 3, 7, 3, 3, 7, 3, 3, 7, 3}
+
+// BuildAccountNumber returns the 10-digit account number for serial at bankCode.
+func BuildAccountNumber(bankCode string, serial int64) (string, error) {
+	if len(bankCode) != 6 || !isDigits(bankCode) {
+		return "", fmt.Errorf("bank code must be exactly 6 digits")
+	}
+	if serial <= 0 || serial > maxAccountNumberSerial {
+		return "", fmt.Errorf("account number serial %d is out of range", serial)
+	}
+
+	base := fmt.Sprintf("%09d", serial)
+	return base + string(checkDigit(bankCode, base)), nil
+}
+
+// IsValidAccountNumber reports whether accountNumber is 10 digits and its check
+// digit matches bankCode.
+func IsValidAccountNumber(bankCode string, accountNumber string) bool {
+	if len(bankCode) != 6 || !isDigits(bankCode) {
+		return false
+	}
+	if len(accountNumber) != 10 || !isDigits(accountNumber) {
+		return false
+	}
+	return checkDigit(bankCode, accountNumber[:9]) == accountNumber[9]
+}
+
+func checkDigit(bankCode string, serial string) byte {
+	digits := bankCode + serial
+	sum := 0
+	for i := range digits {
+		sum += int(digits[i]-'0') * nubanWeights[i]
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(value string) bool {
+	for _, ch := range value {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package commons
+
+import "context"
+
+type channelIDKey struct{}
+
+// WithChannelID returns a copy of ctx carrying the authenticated channel ID.
+func WithChannelID(ctx context.Context, channelID string) context.Context {
+	return context.WithValue(ctx, channelIDKey{}, channelID)
+}
+
+// ChannelIDFromContext returns the channel ID set by WithChannelID, or "" when
+// the request did not come through channel authentication.
+func ChannelIDFromContext(ctx context.Context) string {
+	channelID, _ := ctx.Value(channelIDKey{}).(string)
+	return channelID
+}
+
+type channelScopesKey struct{}
+
+// WithChannelScopes returns a copy of ctx carrying the scopes granted to the
+// authenticated channel.
+func WithChannelScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, channelScopesKey{}, scopes)
+}
+
+// ChannelScopesFromContext returns the scopes set by WithChannelScopes, or nil
+// when the request did not come through channel authentication.
+func ChannelScopesFromContext(ctx context.Context) []string {
+	scopes, _ := ctx.Value(channelScopesKey{}).([]string)
+	return scopes
+}
@@ -4,3 +4,27 @@ import "errors"
 
 var ErrRecordNotFound = errors.New("Record not found")
 var ErrInsufficientBalance = errors.New("Insufficient balance")
+var ErrTransferQueueFull = errors.New("Transfer queue is full")
+var ErrTransferBlocked = errors.New("Transfer blocked by screening")
+var ErrMakerCheckerViolation = errors.New("Reviewer must differ from initiator")
+var ErrAccountBalanceNotZero = errors.New("Account balance must be zero")
+var ErrAccountHasActiveHolds = errors.New("Account has active holds")
+var ErrFrozenAccountSweep = errors.New("Frozen account balance cannot be swept")
+var ErrAccountHoldExpired = errors.New("Account hold has expired")
+var ErrDuplicateExternalReference = errors.New("Duplicate external reference")
+var ErrInterestAlreadyCapitalized = errors.New("Interest already capitalized")
+var ErrPinLocked = errors.New("Transaction PIN locked")
+var ErrInvalidPin = errors.New("Invalid transaction PIN")
+var ErrInvalidOTP = errors.New("Invalid or expired OTP")
+var ErrTransferLimitExceeded = errors.New("Transfer limit exceeded")
+var ErrDuplicateIdentity = errors.New("Customer with this ID already exists")
+var ErrCustomerAccountConflict = errors.New("Customers hold accounts in the same currency")
+var ErrCustomerNotMergeable = errors.New("Only ACTIVE customers can be merged")
+var ErrCustomerHasOpenAccounts = errors.New("Customer has open accounts")
+var ErrCustomerErased = errors.New("Customer data already erased")
+var ErrInvalidChannelCredentials = errors.New("Invalid channel credentials")
+var ErrChannelDisabled = errors.New("Channel is disabled")
+var ErrChannelIPNotAllowed = errors.New("Channel not allowed from this address")
+var ErrDuplicateChannel = errors.New("Channel already exists")
+var ErrAccountAccessDenied = errors.New("Account does not belong to the authenticated customer")
+var ErrBackOfficeRequired = errors.New("Back-office access required")
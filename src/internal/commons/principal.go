@@ -0,0 +1,50 @@
+package commons
+
+import "context"
+
+type principalKey struct{}
+
+// Principal is the caller named by a verified customer or service token.
+// CustomerID is empty for service tokens.
+type Principal struct {
+	Subject    string
+	CustomerID string
+	Scopes     []string
+}
+
+// IsService reports whether the token was issued to a service rather than a
+// customer.
+func (p Principal) IsService() bool {
+	return p.CustomerID == ""
+}
+
+func (p Principal) HasScope(scope string) bool {
+	for _, granted := range p.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// WithPrincipal returns a copy of ctx carrying the token principal.
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal set by WithPrincipal; ok is false
+// when the request carried no customer or service token.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// ActorFromContext names the authenticated caller for audit and maker-checker
+// purposes: the token subject when a customer or service token was verified,
+// otherwise the channel ID. It is empty outside an authenticated request.
+func ActorFromContext(ctx context.Context) string {
+	if principal, ok := PrincipalFromContext(ctx); ok && principal.Subject != "" {
+		return principal.Subject
+	}
+	return ChannelIDFromContext(ctx)
+}
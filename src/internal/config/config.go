@@ -1,10 +1,13 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
@@ -24,24 +27,177 @@ const defaultExternalUSDGLAccountNumber = "0125548977"
 const defaultExternalGBPGLAccountNumber = "0125548978"
 const defaultExternalEURGLAccountNumber = "0125548979"
 const defaultExternalNGNGLAccountNumber = "0125548980"
+const defaultWebhookMaxAttempts = 8
+const defaultWebhookRetryBaseSeconds = 30
+const defaultWebhookTimeoutSeconds = 10
+const defaultWebhookDispatchIntervalSeconds = 5
+const defaultEventPublisher = "log"
+const defaultKafkaTopicPrefix = "fcy-payment-processor"
+const defaultKafkaTimeoutSeconds = 10
+const defaultOutboxRelayIntervalSeconds = 2
+const defaultOutboxBatchSize = 100
+const defaultOutboxRetryBaseSeconds = 5
+const defaultTransferProcessingMode = "sync"
+const defaultTransferWorkers = 8
+const defaultTransferQueueSize = 100
+const defaultScreeningRulesSource = "db"
+const defaultScreeningRulesRefreshSeconds = 30
+const defaultScreeningReviewScore = 50
+const defaultScreeningBlockScore = 100
+const defaultSanctionsMatchThreshold = "0.92"
+const defaultSanctionsRefreshSeconds = 3600
+const defaultDuplicateNameMatchThreshold = "0.90"
+const defaultAccountHoldExpiryIntervalSeconds = 60
+const defaultReferenceNodeID = 1
+const maxReferenceNodeID = 999
+const defaultExternalReferenceScheme = "EXT"
+const defaultWithdrawalChargePercent = "0.5"
+const defaultWithdrawalChargeMinAmount = "1"
+const defaultWithdrawalChargeMaxAmount = "10"
+const defaultExchangeSpreadPercent = "1"
+const defaultExchangeChargePercent = "0"
+const defaultExchangeChargeMinAmount = "0"
+const defaultExchangeChargeMaxAmount = "10"
+const defaultWithholdingTaxPercent = "10"
+const defaultInterestBatchIntervalSeconds = 3600
+const defaultOverdraftWorkerIntervalSeconds = 3600
+const defaultDormancyPeriodDays = 365
+const defaultDormancyWorkerIntervalSeconds = 3600
+const defaultPinMaxFailedAttempts = 3
+const defaultPinLockoutMinutes = 30
+const defaultOTPTTLSeconds = 300
+const defaultOTPMaxAttempts = 3
+const defaultTransferStepUpThreshold = "10000"
+const defaultTransferStepUpCurrency = "USD"
+const defaultKYCTransferLimits = "1=1000,2=10000,3=0"
+const defaultKYCTransferLimitCurrency = "USD"
+const defaultPIIReencryptionIntervalSeconds = 3600
+const defaultChannelKeyGracePeriodSeconds = 86400
+
+// defaultCashGLAccountNumbers are the cash/teller GL accounts withdrawals are
+// paid out against, keyed by currency.
+var defaultCashGLAccountNumbers = map[string]string{
+	"USD": "0125548981",
+	"GBP": "0125548982",
+	"EUR": "0125548983",
+	"NGN": "0125548984",
+}
+
+// defaultFundingGLAccountNumbers are the funding GL accounts that carry the
+// contra entry of deposits, keyed by currency.
+var defaultFundingGLAccountNumbers = map[string]string{
+	"USD": "0125548985",
+	"GBP": "0125548986",
+	"EUR": "0125548987",
+	"NGN": "0125548988",
+}
+
+// defaultFXIncomeGLAccountNumbers are the GL accounts the spread earned on
+// own-account exchanges is booked to, keyed by the bought currency.
+var defaultFXIncomeGLAccountNumbers = map[string]string{
+	"USD": "0125548989",
+	"GBP": "0125548990",
+	"EUR": "0125548991",
+	"NGN": "0125548992",
+}
+
+// defaultInterestExpenseGLAccountNumbers are the GL accounts capitalized
+// interest is paid from, keyed by currency.
+var defaultInterestExpenseGLAccountNumbers = map[string]string{
+	"USD": "0125548993",
+	"GBP": "0125548994",
+	"EUR": "0125548995",
+	"NGN": "0125548996",
+}
+
+// defaultWithholdingTaxGLAccountNumbers are the GL accounts the tax withheld
+// from capitalized interest is credited to, keyed by currency.
+var defaultWithholdingTaxGLAccountNumbers = map[string]string{
+	"USD": "0125548997",
+	"GBP": "0125548998",
+	"EUR": "0125548999",
+	"NGN": "0125549000",
+}
 
 type Config struct {
-	DatabaseDSN                    string
-	MigrationsDir                  string
-	ChannelID                      string
-	ChannelKey                     string
-	GreyBankCode                   string
-	ChargePercent                  decimal.Decimal
-	VATPercent                     decimal.Decimal
-	ChargeMinAmount                decimal.Decimal
-	ChargeMaxAmount                decimal.Decimal
-	InternalTransientAccountNumber string
-	InternalChargesAccountNumber   string
-	InternalVATAccountNumber       string
-	ExternalUSDGLAccountNumber     string
-	ExternalGBPGLAccountNumber     string
-	ExternalEURGLAccountNumber     string
-	ExternalNGNGLAccountNumber     string
+	DatabaseDSN                     string
+	MigrationsDir                   string
+	ChannelID                       string
+	ChannelKey                      string
+	GreyBankCode                    string
+	ChargePercent                   decimal.Decimal
+	VATPercent                      decimal.Decimal
+	ChargeMinAmount                 decimal.Decimal
+	ChargeMaxAmount                 decimal.Decimal
+	InternalTransientAccountNumber  string
+	InternalChargesAccountNumber    string
+	InternalVATAccountNumber        string
+	ExternalUSDGLAccountNumber      string
+	ExternalGBPGLAccountNumber      string
+	ExternalEURGLAccountNumber      string
+	ExternalNGNGLAccountNumber      string
+	WebhookMaxAttempts              int
+	WebhookRetryBackoff             time.Duration
+	WebhookRequestTimeout           time.Duration
+	WebhookDispatchInterval         time.Duration
+	EventPublisher                  string
+	EventLogFile                    string
+	KafkaRESTProxyURL               string
+	KafkaTopicPrefix                string
+	KafkaTimeout                    time.Duration
+	OutboxRelayInterval             time.Duration
+	OutboxBatchSize                 int
+	OutboxRetryBackoff              time.Duration
+	TransferProcessingMode          string
+	TransferWorkers                 int
+	TransferQueueSize               int
+	ScreeningRulesSource            string
+	ScreeningRulesFile              string
+	ScreeningRulesRefresh           time.Duration
+	ScreeningReviewScore            int
+	ScreeningBlockScore             int
+	SanctionsListFiles              []string
+	SanctionsMatchThreshold         float64
+	SanctionsRefresh                time.Duration
+	DuplicateNameMatchThreshold     float64
+	AccountHoldExpiryInterval       time.Duration
+	ReferenceNodeID                 int
+	ReferenceChannelPrefixes        map[string]string
+	ExternalReferenceScheme         string
+	WithdrawalChargePercent         decimal.Decimal
+	WithdrawalChargeMinAmount       decimal.Decimal
+	WithdrawalChargeMaxAmount       decimal.Decimal
+	CashGLAccountNumbers            map[string]string
+	FundingGLAccountNumbers         map[string]string
+	ExchangeSpreadPercent           decimal.Decimal
+	ExchangeChargePercent           decimal.Decimal
+	ExchangeChargeMinAmount         decimal.Decimal
+	ExchangeChargeMaxAmount         decimal.Decimal
+	FXIncomeGLAccountNumbers        map[string]string
+	WithholdingTaxPercent           decimal.Decimal
+	InterestBatchInterval           time.Duration
+	InterestExpenseGLAccountNumbers map[string]string
+	WithholdingTaxGLAccountNumbers  map[string]string
+	OverdraftWorkerInterval         time.Duration
+	DormancyPeriodDays              int
+	DormancyWorkerInterval          time.Duration
+	PinMaxFailedAttempts            int
+	PinLockDuration                 time.Duration
+	OTPTTL                          time.Duration
+	OTPMaxAttempts                  int
+	OTPLogFile                      string
+	TransferStepUpThreshold         decimal.Decimal
+	TransferStepUpCurrency          string
+	KYCTransferLimits               map[int]decimal.Decimal
+	KYCTransferLimitCurrency        string
+	PIIEncryptionKeys               map[int][]byte
+	PIIEncryptionKeyVersion         int
+	PIIBlindIndexKey                []byte
+	PIIReencryptionInterval         time.Duration
+	ChannelKeyGracePeriod           time.Duration
+	JWTJWKSFile                     string
+	JWTIssuer                       string
+	JWTAudience                     string
 }
 
 func Load() (Config, error) {
@@ -120,23 +276,400 @@ func Load() (Config, error) {
 		externalNGNGLAccountNumber = defaultExternalNGNGLAccountNumber
 	}
 
+	webhookMaxAttempts, err := parseIntEnv("WEBHOOK_MAX_ATTEMPTS", defaultWebhookMaxAttempts)
+	if err != nil {
+		return Config{}, err
+	}
+
+	webhookRetryBaseSeconds, err := parseIntEnv("WEBHOOK_RETRY_BASE_SECONDS", defaultWebhookRetryBaseSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	webhookTimeoutSeconds, err := parseIntEnv("WEBHOOK_TIMEOUT_SECONDS", defaultWebhookTimeoutSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	webhookDispatchIntervalSeconds, err := parseIntEnv("WEBHOOK_DISPATCH_INTERVAL_SECONDS", defaultWebhookDispatchIntervalSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	eventPublisher := strings.ToLower(strings.TrimSpace(os.Getenv("EVENT_PUBLISHER")))
+	if eventPublisher == "" {
+		eventPublisher = defaultEventPublisher
+	}
+	if eventPublisher != "memory" && eventPublisher != "log" && eventPublisher != "kafka" {
+		return Config{}, fmt.Errorf("EVENT_PUBLISHER must be one of memory, log, kafka")
+	}
+
+	kafkaRESTProxyURL := strings.TrimSpace(os.Getenv("KAFKA_REST_PROXY_URL"))
+	if eventPublisher == "kafka" && kafkaRESTProxyURL == "" {
+		return Config{}, fmt.Errorf("KAFKA_REST_PROXY_URL is required when EVENT_PUBLISHER is kafka")
+	}
+
+	kafkaTopicPrefix := strings.TrimSpace(os.Getenv("KAFKA_TOPIC_PREFIX"))
+	if kafkaTopicPrefix == "" {
+		kafkaTopicPrefix = defaultKafkaTopicPrefix
+	}
+
+	kafkaTimeoutSeconds, err := parseIntEnv("KAFKA_TIMEOUT_SECONDS", defaultKafkaTimeoutSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	outboxRelayIntervalSeconds, err := parseIntEnv("OUTBOX_RELAY_INTERVAL_SECONDS", defaultOutboxRelayIntervalSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	outboxBatchSize, err := parseIntEnv("OUTBOX_BATCH_SIZE", defaultOutboxBatchSize)
+	if err != nil {
+		return Config{}, err
+	}
+
+	outboxRetryBaseSeconds, err := parseIntEnv("OUTBOX_RETRY_BASE_SECONDS", defaultOutboxRetryBaseSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	transferProcessingMode := strings.ToLower(strings.TrimSpace(os.Getenv("TRANSFER_PROCESSING_MODE")))
+	if transferProcessingMode == "" {
+		transferProcessingMode = defaultTransferProcessingMode
+	}
+	if transferProcessingMode != "sync" && transferProcessingMode != "async" {
+		return Config{}, fmt.Errorf("TRANSFER_PROCESSING_MODE must be sync or async")
+	}
+
+	transferWorkers, err := parseIntEnv("TRANSFER_WORKERS", defaultTransferWorkers)
+	if err != nil {
+		return Config{}, err
+	}
+
+	transferQueueSize, err := parseIntEnv("TRANSFER_QUEUE_SIZE", defaultTransferQueueSize)
+	if err != nil {
+		return Config{}, err
+	}
+
+	screeningRulesSource := strings.ToLower(strings.TrimSpace(os.Getenv("SCREENING_RULES_SOURCE")))
+	if screeningRulesSource == "" {
+		screeningRulesSource = defaultScreeningRulesSource
+	}
+	if screeningRulesSource != "db" && screeningRulesSource != "file" {
+		return Config{}, fmt.Errorf("SCREENING_RULES_SOURCE must be db or file")
+	}
+
+	screeningRulesFile := strings.TrimSpace(os.Getenv("SCREENING_RULES_FILE"))
+	if screeningRulesSource == "file" && screeningRulesFile == "" {
+		return Config{}, fmt.Errorf("SCREENING_RULES_FILE is required when SCREENING_RULES_SOURCE is file")
+	}
+
+	screeningRulesRefreshSeconds, err := parseIntEnv("SCREENING_RULES_REFRESH_SECONDS", defaultScreeningRulesRefreshSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	screeningReviewScore, err := parseIntEnv("SCREENING_REVIEW_SCORE", defaultScreeningReviewScore)
+	if err != nil {
+		return Config{}, err
+	}
+
+	screeningBlockScore, err := parseIntEnv("SCREENING_BLOCK_SCORE", defaultScreeningBlockScore)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var sanctionsListFiles []string
+	for _, path := range strings.Split(os.Getenv("SANCTIONS_LIST_FILES"), ",") {
+		if trimmed := strings.TrimSpace(path); trimmed != "" {
+			sanctionsListFiles = append(sanctionsListFiles, trimmed)
+		}
+	}
+
+	sanctionsMatchThreshold, err := parseDecimalEnv("SANCTIONS_MATCH_THRESHOLD", defaultSanctionsMatchThreshold)
+	if err != nil {
+		return Config{}, err
+	}
+	if sanctionsMatchThreshold.IsZero() || sanctionsMatchThreshold.GreaterThan(decimal.NewFromInt(1)) {
+		return Config{}, fmt.Errorf("SANCTIONS_MATCH_THRESHOLD must be greater than 0 and at most 1")
+	}
+
+	sanctionsRefreshSeconds, err := parseIntEnv("SANCTIONS_REFRESH_SECONDS", defaultSanctionsRefreshSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	duplicateNameMatchThreshold, err := parseDecimalEnv("DUPLICATE_NAME_MATCH_THRESHOLD", defaultDuplicateNameMatchThreshold)
+	if err != nil {
+		return Config{}, err
+	}
+	if duplicateNameMatchThreshold.IsZero() || duplicateNameMatchThreshold.GreaterThan(decimal.NewFromInt(1)) {
+		return Config{}, fmt.Errorf("DUPLICATE_NAME_MATCH_THRESHOLD must be greater than 0 and at most 1")
+	}
+
+	accountHoldExpiryIntervalSeconds, err := parseIntEnv("ACCOUNT_HOLD_EXPIRY_INTERVAL_SECONDS", defaultAccountHoldExpiryIntervalSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	referenceNodeID, err := parseIntEnv("REFERENCE_NODE_ID", defaultReferenceNodeID)
+	if err != nil {
+		return Config{}, err
+	}
+	if referenceNodeID > maxReferenceNodeID {
+		return Config{}, fmt.Errorf("REFERENCE_NODE_ID must be at most %d", maxReferenceNodeID)
+	}
+
+	referenceChannelPrefixes, err := parseReferenceChannelPrefixes(os.Getenv("REFERENCE_CHANNEL_PREFIXES"))
+	if err != nil {
+		return Config{}, err
+	}
+
+	externalReferenceScheme := strings.ToUpper(strings.TrimSpace(os.Getenv("EXTERNAL_REFERENCE_SCHEME")))
+	if externalReferenceScheme == "" {
+		externalReferenceScheme = defaultExternalReferenceScheme
+	}
+	if externalReferenceScheme != "EXT" && externalReferenceScheme != "NIP" {
+		return Config{}, fmt.Errorf("EXTERNAL_REFERENCE_SCHEME must be EXT or NIP")
+	}
+
+	withdrawalChargePercent, err := parseDecimalEnv("WITHDRAWAL_CHARGE_PERCENT", defaultWithdrawalChargePercent)
+	if err != nil {
+		return Config{}, err
+	}
+
+	withdrawalChargeMin, err := parseDecimalEnv("WITHDRAWAL_CHARGE_MIN_AMOUNT", defaultWithdrawalChargeMinAmount)
+	if err != nil {
+		return Config{}, err
+	}
+
+	withdrawalChargeMax, err := parseDecimalEnv("WITHDRAWAL_CHARGE_MAX_AMOUNT", defaultWithdrawalChargeMaxAmount)
+	if err != nil {
+		return Config{}, err
+	}
+
+	exchangeSpreadPercent, err := parseDecimalEnv("EXCHANGE_SPREAD_PERCENT", defaultExchangeSpreadPercent)
+	if err != nil {
+		return Config{}, err
+	}
+	if exchangeSpreadPercent.IsNegative() || exchangeSpreadPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return Config{}, fmt.Errorf("EXCHANGE_SPREAD_PERCENT must be at least 0 and below 100")
+	}
+
+	exchangeChargePercent, err := parseDecimalEnv("EXCHANGE_CHARGE_PERCENT", defaultExchangeChargePercent)
+	if err != nil {
+		return Config{}, err
+	}
+
+	exchangeChargeMin, err := parseDecimalEnv("EXCHANGE_CHARGE_MIN_AMOUNT", defaultExchangeChargeMinAmount)
+	if err != nil {
+		return Config{}, err
+	}
+
+	exchangeChargeMax, err := parseDecimalEnv("EXCHANGE_CHARGE_MAX_AMOUNT", defaultExchangeChargeMaxAmount)
+	if err != nil {
+		return Config{}, err
+	}
+
+	withholdingTaxPercent, err := parseDecimalEnv("WITHHOLDING_TAX_PERCENT", defaultWithholdingTaxPercent)
+	if err != nil {
+		return Config{}, err
+	}
+	if withholdingTaxPercent.IsNegative() || withholdingTaxPercent.GreaterThan(decimal.NewFromInt(100)) {
+		return Config{}, fmt.Errorf("WITHHOLDING_TAX_PERCENT must be between 0 and 100")
+	}
+
+	interestBatchIntervalSeconds, err := parseIntEnv("INTEREST_BATCH_INTERVAL_SECONDS", defaultInterestBatchIntervalSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	overdraftWorkerIntervalSeconds, err := parseIntEnv("OVERDRAFT_WORKER_INTERVAL_SECONDS", defaultOverdraftWorkerIntervalSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	dormancyPeriodDays, err := parseIntEnv("DORMANCY_PERIOD_DAYS", defaultDormancyPeriodDays)
+	if err != nil {
+		return Config{}, err
+	}
+
+	dormancyWorkerIntervalSeconds, err := parseIntEnv("DORMANCY_WORKER_INTERVAL_SECONDS", defaultDormancyWorkerIntervalSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	pinMaxFailedAttempts, err := parseIntEnv("PIN_MAX_FAILED_ATTEMPTS", defaultPinMaxFailedAttempts)
+	if err != nil {
+		return Config{}, err
+	}
+
+	pinLockoutMinutes, err := parseIntEnv("PIN_LOCKOUT_MINUTES", defaultPinLockoutMinutes)
+	if err != nil {
+		return Config{}, err
+	}
+
+	otpTTLSeconds, err := parseIntEnv("OTP_TTL_SECONDS", defaultOTPTTLSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	otpMaxAttempts, err := parseIntEnv("OTP_MAX_ATTEMPTS", defaultOTPMaxAttempts)
+	if err != nil {
+		return Config{}, err
+	}
+
+	transferStepUpThreshold, err := parseDecimalEnv("TRANSFER_STEP_UP_THRESHOLD", defaultTransferStepUpThreshold)
+	if err != nil {
+		return Config{}, err
+	}
+	if transferStepUpThreshold.IsNegative() {
+		return Config{}, fmt.Errorf("TRANSFER_STEP_UP_THRESHOLD must not be negative")
+	}
+
+	transferStepUpCurrency := strings.ToUpper(strings.TrimSpace(os.Getenv("TRANSFER_STEP_UP_CURRENCY")))
+	if transferStepUpCurrency == "" {
+		transferStepUpCurrency = defaultTransferStepUpCurrency
+	}
+
+	rawKYCTransferLimits := strings.TrimSpace(os.Getenv("KYC_TRANSFER_LIMITS"))
+	if rawKYCTransferLimits == "" {
+		rawKYCTransferLimits = defaultKYCTransferLimits
+	}
+	kycTransferLimits, err := parseKYCTransferLimits(rawKYCTransferLimits)
+	if err != nil {
+		return Config{}, err
+	}
+
+	kycTransferLimitCurrency := strings.ToUpper(strings.TrimSpace(os.Getenv("KYC_TRANSFER_LIMIT_CURRENCY")))
+	if kycTransferLimitCurrency == "" {
+		kycTransferLimitCurrency = defaultKYCTransferLimitCurrency
+	}
+
+	piiEncryptionKeys, err := parsePIIEncryptionKeys(os.Getenv("PII_ENCRYPTION_KEYS"))
+	if err != nil {
+		return Config{}, err
+	}
+	if len(piiEncryptionKeys) == 0 {
+		return Config{}, fmt.Errorf("PII_ENCRYPTION_KEYS is required")
+	}
+
+	piiEncryptionKeyVersion := 0
+	if strings.TrimSpace(os.Getenv("PII_ENCRYPTION_KEY_VERSION")) == "" {
+		for version := range piiEncryptionKeys {
+			piiEncryptionKeyVersion = max(piiEncryptionKeyVersion, version)
+		}
+	} else {
+		piiEncryptionKeyVersion, err = parseIntEnv("PII_ENCRYPTION_KEY_VERSION", 0)
+		if err != nil {
+			return Config{}, err
+		}
+		if _, ok := piiEncryptionKeys[piiEncryptionKeyVersion]; !ok {
+			return Config{}, fmt.Errorf("PII_ENCRYPTION_KEY_VERSION %d is not in PII_ENCRYPTION_KEYS", piiEncryptionKeyVersion)
+		}
+	}
+
+	piiBlindIndexKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(os.Getenv("PII_BLIND_INDEX_KEY")))
+	if err != nil || len(piiBlindIndexKey) < 32 {
+		return Config{}, fmt.Errorf("PII_BLIND_INDEX_KEY must be a base64 key of at least 32 bytes")
+	}
+
+	piiReencryptionIntervalSeconds, err := parseIntEnv("PII_REENCRYPTION_INTERVAL_SECONDS", defaultPIIReencryptionIntervalSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	channelKeyGracePeriodSeconds, err := parseIntEnv("CHANNEL_KEY_GRACE_PERIOD_SECONDS", defaultChannelKeyGracePeriodSeconds)
+	if err != nil {
+		return Config{}, err
+	}
+
+	jwtJWKSFile := strings.TrimSpace(os.Getenv("JWT_JWKS_FILE"))
+	jwtIssuer := strings.TrimSpace(os.Getenv("JWT_ISSUER"))
+	if jwtJWKSFile != "" && jwtIssuer == "" {
+		return Config{}, fmt.Errorf("JWT_ISSUER is required when JWT_JWKS_FILE is set")
+	}
+
 	return Config{
-		DatabaseDSN:                    normalizeConnectionString(conn),
-		MigrationsDir:                  filepath.Join("src", "migrations"),
-		ChannelID:                      channelID,
-		ChannelKey:                     channelKey,
-		GreyBankCode:                   greyBankCode,
-		ChargePercent:                  chargePercent,
-		VATPercent:                     vatPercent,
-		ChargeMinAmount:                chargeMin,
-		ChargeMaxAmount:                chargeMax, // For now, we set max charge amount same as min to disable it
-		InternalTransientAccountNumber: internalTransientAccountNumber,
-		InternalChargesAccountNumber:   internalChargesAccountNumber,
-		InternalVATAccountNumber:       internalVATAccountNumber,
-		ExternalUSDGLAccountNumber:     externalUSDGLAccountNumber,
-		ExternalGBPGLAccountNumber:     externalGBPGLAccountNumber,
-		ExternalEURGLAccountNumber:     externalEURGLAccountNumber,
-		ExternalNGNGLAccountNumber:     externalNGNGLAccountNumber,
+		DatabaseDSN:                     normalizeConnectionString(conn),
+		MigrationsDir:                   filepath.Join("src", "migrations"),
+		ChannelID:                       channelID,
+		ChannelKey:                      channelKey,
+		GreyBankCode:                    greyBankCode,
+		ChargePercent:                   chargePercent,
+		VATPercent:                      vatPercent,
+		ChargeMinAmount:                 chargeMin,
+		ChargeMaxAmount:                 chargeMax, // For now, we set max charge amount same as min to disable it
+		InternalTransientAccountNumber:  internalTransientAccountNumber,
+		InternalChargesAccountNumber:    internalChargesAccountNumber,
+		InternalVATAccountNumber:        internalVATAccountNumber,
+		ExternalUSDGLAccountNumber:      externalUSDGLAccountNumber,
+		ExternalGBPGLAccountNumber:      externalGBPGLAccountNumber,
+		ExternalEURGLAccountNumber:      externalEURGLAccountNumber,
+		ExternalNGNGLAccountNumber:      externalNGNGLAccountNumber,
+		WebhookMaxAttempts:              webhookMaxAttempts,
+		WebhookRetryBackoff:             time.Duration(webhookRetryBaseSeconds) * time.Second,
+		WebhookRequestTimeout:           time.Duration(webhookTimeoutSeconds) * time.Second,
+		WebhookDispatchInterval:         time.Duration(webhookDispatchIntervalSeconds) * time.Second,
+		EventPublisher:                  eventPublisher,
+		EventLogFile:                    strings.TrimSpace(os.Getenv("EVENT_LOG_FILE")),
+		KafkaRESTProxyURL:               kafkaRESTProxyURL,
+		KafkaTopicPrefix:                kafkaTopicPrefix,
+		KafkaTimeout:                    time.Duration(kafkaTimeoutSeconds) * time.Second,
+		OutboxRelayInterval:             time.Duration(outboxRelayIntervalSeconds) * time.Second,
+		OutboxBatchSize:                 outboxBatchSize,
+		OutboxRetryBackoff:              time.Duration(outboxRetryBaseSeconds) * time.Second,
+		TransferProcessingMode:          transferProcessingMode,
+		TransferWorkers:                 transferWorkers,
+		TransferQueueSize:               transferQueueSize,
+		ScreeningRulesSource:            screeningRulesSource,
+		ScreeningRulesFile:              screeningRulesFile,
+		ScreeningRulesRefresh:           time.Duration(screeningRulesRefreshSeconds) * time.Second,
+		ScreeningReviewScore:            screeningReviewScore,
+		ScreeningBlockScore:             screeningBlockScore,
+		SanctionsListFiles:              sanctionsListFiles,
+		SanctionsMatchThreshold:         sanctionsMatchThreshold.InexactFloat64(),
+		SanctionsRefresh:                time.Duration(sanctionsRefreshSeconds) * time.Second,
+		DuplicateNameMatchThreshold:     duplicateNameMatchThreshold.InexactFloat64(),
+		AccountHoldExpiryInterval:       time.Duration(accountHoldExpiryIntervalSeconds) * time.Second,
+		ReferenceNodeID:                 referenceNodeID,
+		ReferenceChannelPrefixes:        referenceChannelPrefixes,
+		ExternalReferenceScheme:         externalReferenceScheme,
+		WithdrawalChargePercent:         withdrawalChargePercent,
+		WithdrawalChargeMinAmount:       withdrawalChargeMin,
+		WithdrawalChargeMaxAmount:       withdrawalChargeMax,
+		CashGLAccountNumbers:            loadCurrencyAccountNumbers("CASH_%s_GL_ACCOUNT_NUMBER", defaultCashGLAccountNumbers),
+		FundingGLAccountNumbers:         loadCurrencyAccountNumbers("FUNDING_%s_GL_ACCOUNT_NUMBER", defaultFundingGLAccountNumbers),
+		ExchangeSpreadPercent:           exchangeSpreadPercent,
+		ExchangeChargePercent:           exchangeChargePercent,
+		ExchangeChargeMinAmount:         exchangeChargeMin,
+		ExchangeChargeMaxAmount:         exchangeChargeMax,
+		FXIncomeGLAccountNumbers:        loadCurrencyAccountNumbers("FX_INCOME_%s_GL_ACCOUNT_NUMBER", defaultFXIncomeGLAccountNumbers),
+		WithholdingTaxPercent:           withholdingTaxPercent,
+		InterestBatchInterval:           time.Duration(interestBatchIntervalSeconds) * time.Second,
+		InterestExpenseGLAccountNumbers: loadCurrencyAccountNumbers("INTEREST_EXPENSE_%s_GL_ACCOUNT_NUMBER", defaultInterestExpenseGLAccountNumbers),
+		WithholdingTaxGLAccountNumbers:  loadCurrencyAccountNumbers("WITHHOLDING_TAX_%s_GL_ACCOUNT_NUMBER", defaultWithholdingTaxGLAccountNumbers),
+		OverdraftWorkerInterval:         time.Duration(overdraftWorkerIntervalSeconds) * time.Second,
+		DormancyPeriodDays:              dormancyPeriodDays,
+		DormancyWorkerInterval:          time.Duration(dormancyWorkerIntervalSeconds) * time.Second,
+		PinMaxFailedAttempts:            pinMaxFailedAttempts,
+		PinLockDuration:                 time.Duration(pinLockoutMinutes) * time.Minute,
+		OTPTTL:                          time.Duration(otpTTLSeconds) * time.Second,
+		OTPMaxAttempts:                  otpMaxAttempts,
+		OTPLogFile:                      strings.TrimSpace(os.Getenv("OTP_LOG_FILE")),
+		TransferStepUpThreshold:         transferStepUpThreshold,
+		TransferStepUpCurrency:          transferStepUpCurrency,
+		KYCTransferLimits:               kycTransferLimits,
+		KYCTransferLimitCurrency:        kycTransferLimitCurrency,
+		PIIEncryptionKeys:               piiEncryptionKeys,
+		PIIEncryptionKeyVersion:         piiEncryptionKeyVersion,
+		PIIBlindIndexKey:                piiBlindIndexKey,
+		PIIReencryptionInterval:         time.Duration(piiReencryptionIntervalSeconds) * time.Second,
+		ChannelKeyGracePeriod:           time.Duration(channelKeyGracePeriodSeconds) * time.Second,
+		JWTJWKSFile:                     jwtJWKSFile,
+		JWTIssuer:                       jwtIssuer,
+		JWTAudience:                     strings.TrimSpace(os.Getenv("JWT_AUDIENCE")),
 	}, nil
 }
 
@@ -157,6 +690,117 @@ func parseDecimalEnv(key string, fallback string) (decimal.Decimal, error) {
 	return value, nil
 }
 
+func parseIntEnv(key string, fallback int) (int, error) {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return fallback, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	if value <= 0 {
+		return 0, fmt.Errorf("%s must be greater than zero", key)
+	}
+
+	return value, nil
+}
+
+// parseReferenceChannelPrefixes parses "CHANNEL=PREFIX,..." into a map keyed by
+// channel ID. Prefixes are 1-8 uppercase letters.
+func parseReferenceChannelPrefixes(raw string) (map[string]string, error) {
+	prefixes := make(map[string]string)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		channel, prefix, ok := strings.Cut(pair, "=")
+		channel = strings.TrimSpace(channel)
+		prefix = strings.ToUpper(strings.TrimSpace(prefix))
+		if !ok || channel == "" || prefix == "" || len(prefix) > 8 {
+			return nil, fmt.Errorf("REFERENCE_CHANNEL_PREFIXES entry %q must be CHANNEL=PREFIX with a prefix of 1-8 letters", pair)
+		}
+		for _, ch := range prefix {
+			if ch < 'A' || ch > 'Z' {
+				return nil, fmt.Errorf("REFERENCE_CHANNEL_PREFIXES entry %q must be CHANNEL=PREFIX with a prefix of 1-8 letters", pair)
+			}
+		}
+		prefixes[channel] = prefix
+	}
+	return prefixes, nil
+}
+
+// parseKYCTransferLimits parses "LEVEL=AMOUNT,..." into per-transfer limits keyed
+// by KYC level. An amount of 0 leaves that level uncapped.
+func parseKYCTransferLimits(raw string) (map[int]decimal.Decimal, error) {
+	limits := make(map[int]decimal.Decimal)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		rawLevel, rawAmount, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("KYC_TRANSFER_LIMITS entry %q must be LEVEL=AMOUNT", pair)
+		}
+		level, err := strconv.Atoi(strings.TrimSpace(rawLevel))
+		if err != nil || level < 1 {
+			return nil, fmt.Errorf("KYC_TRANSFER_LIMITS entry %q must have a positive KYC level", pair)
+		}
+		amount, err := decimal.NewFromString(strings.TrimSpace(rawAmount))
+		if err != nil || amount.IsNegative() {
+			return nil, fmt.Errorf("KYC_TRANSFER_LIMITS entry %q must have a non-negative amount", pair)
+		}
+		limits[level] = amount
+	}
+	return limits, nil
+}
+
+// parsePIIEncryptionKeys parses "VERSION=BASE64KEY,..." into AES-256
+// key-encryption keys by version.
+func parsePIIEncryptionKeys(raw string) (map[int][]byte, error) {
+	keys := make(map[int][]byte)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		rawVersion, rawKey, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("PII_ENCRYPTION_KEYS entries must be VERSION=BASE64KEY")
+		}
+		version, err := strconv.Atoi(strings.TrimSpace(rawVersion))
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("PII_ENCRYPTION_KEYS versions must be positive integers")
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(rawKey))
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("PII_ENCRYPTION_KEYS key version %d must be 32 bytes, base64 encoded", version)
+		}
+		keys[version] = key
+	}
+	return keys, nil
+}
+
+// loadCurrencyAccountNumbers reads one account number per currency of defaults
+// from the environment variable named by keyPattern, falling back to the default.
+func loadCurrencyAccountNumbers(keyPattern string, defaults map[string]string) map[string]string {
+	accountNumbers := make(map[string]string, len(defaults))
+	for currency, fallback := range defaults {
+		accountNumber := strings.TrimSpace(os.Getenv(fmt.Sprintf(keyPattern, currency)))
+		if accountNumber == "" {
+			accountNumber = fallback
+		}
+		accountNumbers[currency] = accountNumber
+	}
+	return accountNumbers
+}
+
 func normalizeConnectionString(raw string) string {
 	parts := strings.Split(raw, ";")
 	out := make([]string, 0, len(parts))
@@ -11,7 +11,25 @@ type AccountStatus string
 const (
 	AccountStatusActive AccountStatus = "ACTIVE"
 	AccountStatusFrozen AccountStatus = "FROZEN"
-	AccountStatusClosed AccountStatus = "CLOSED"
+	// AccountStatusDormant is set by the dormancy batch on accounts whose
+	// LastActivityAt (last customer-initiated debit, opening or reactivation) is
+	// older than the dormancy period. Dormant accounts accept credits but no
+	// debits until they are reactivated.
+	AccountStatusDormant AccountStatus = "DORMANT"
+	AccountStatusClosed  AccountStatus = "CLOSED"
+)
+
+// AcceptsCredits reports whether an account in this status can be credited.
+func (s AccountStatus) AcceptsCredits() bool {
+	return s == AccountStatusActive || s == AccountStatusDormant
+}
+
+// AccountProduct selects the interest rate table that applies to an account.
+type AccountProduct string
+
+const (
+	AccountProductStandard AccountProduct = "STANDARD"
+	AccountProductSavings  AccountProduct = "SAVINGS"
 )
 
 type Account struct {
@@ -19,9 +37,12 @@ type Account struct {
 	CustomerID       string
 	AccountNumber    string
 	Currency         string
+	Product          AccountProduct
 	AvailableBalance decimal.Decimal
 	LedgerBalance    decimal.Decimal
+	OverdraftLimit   decimal.Decimal
 	Status           AccountStatus
+	LastActivityAt   time.Time
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccountHoldType string
+
+const (
+	// AccountHoldTypeAuthorization reserves funds for a later capture, card-style.
+	AccountHoldTypeAuthorization AccountHoldType = "AUTHORIZATION"
+	// AccountHoldTypeLien is a legal or court-ordered lien. It is placed even when
+	// it exceeds the available balance, which may then go negative.
+	AccountHoldTypeLien AccountHoldType = "LIEN"
+	// AccountHoldTypeTransfer reserves the debit of a transfer held for review.
+	AccountHoldTypeTransfer AccountHoldType = "TRANSFER"
+)
+
+var AccountHoldTypes = []AccountHoldType{
+	AccountHoldTypeAuthorization,
+	AccountHoldTypeLien,
+	AccountHoldTypeTransfer,
+}
+
+type AccountHoldStatus string
+
+const (
+	AccountHoldStatusActive   AccountHoldStatus = "ACTIVE"
+	AccountHoldStatusReleased AccountHoldStatus = "RELEASED"
+	AccountHoldStatusCaptured AccountHoldStatus = "CAPTURED"
+	AccountHoldStatusExpired  AccountHoldStatus = "EXPIRED"
+)
+
+// AccountHold reserves part of an account's balance. While a hold is ACTIVE its
+// amount is excluded from the available balance, so for every account
+// available_balance = ledger_balance - sum(active holds). Reference is unique per
+// account and lets callers address the hold idempotently.
+type AccountHold struct {
+	ID             string
+	AccountNumber  string
+	HoldType       AccountHoldType
+	Amount         decimal.Decimal
+	CapturedAmount decimal.Decimal
+	Reason         string
+	Reference      string
+	Status         AccountHoldStatus
+	ExpiresAt      *time.Time
+	ResolvedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
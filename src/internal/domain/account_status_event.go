@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccountStatusReason string
+
+const (
+	AccountStatusReasonFraud           AccountStatusReason = "FRAUD"
+	AccountStatusReasonCourtOrder      AccountStatusReason = "COURT_ORDER"
+	AccountStatusReasonCustomerRequest AccountStatusReason = "CUSTOMER_REQUEST"
+	AccountStatusReasonCompliance      AccountStatusReason = "COMPLIANCE"
+	AccountStatusReasonOther           AccountStatusReason = "OTHER"
+	// AccountStatusReasonInactivity and AccountStatusReasonKYCReverified are
+	// recorded by the dormancy workflow and cannot be given on requests.
+	AccountStatusReasonInactivity    AccountStatusReason = "INACTIVITY"
+	AccountStatusReasonKYCReverified AccountStatusReason = "KYC_REVERIFIED"
+)
+
+// AccountStatusSystemActor is the actor recorded on status changes made by
+// batch jobs.
+const AccountStatusSystemActor = "system"
+
+var AccountStatusReasons = []AccountStatusReason{
+	AccountStatusReasonFraud,
+	AccountStatusReasonCourtOrder,
+	AccountStatusReasonCustomerRequest,
+	AccountStatusReasonCompliance,
+	AccountStatusReasonOther,
+}
+
+// AccountStatusEvent is one append-only entry in an account's status history.
+// SweepAccountNumber and SweptAmount are set when a closure moved the remaining
+// balance to another account.
+type AccountStatusEvent struct {
+	ID                 string
+	AccountNumber      string
+	FromStatus         AccountStatus
+	ToStatus           AccountStatus
+	Reason             AccountStatusReason
+	Comment            string
+	Actor              string
+	SweepAccountNumber *string
+	SweptAmount        *decimal.Decimal
+	CreatedAt          time.Time
+}
+
+// AccountSweep moves the whole balance of a closing account to another account.
+// Rate converts from the closing account's currency to the destination's.
+type AccountSweep struct {
+	DestinationAccountNumber string
+	Rate                     decimal.Decimal
+	SuspenseAccountNumber    string
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"net"
+	"time"
+)
+
+type ChannelStatus string
+
+const (
+	ChannelActive   ChannelStatus = "ACTIVE"
+	ChannelDisabled ChannelStatus = "DISABLED"
+)
+
+// ChannelScope names a group of routes a channel may call.
+type ChannelScope string
+
+const (
+	// ChannelScopeAll grants every route, including channel administration.
+	ChannelScopeAll            ChannelScope = "*"
+	ChannelScopeRatesRead      ChannelScope = "rates:read"
+	ChannelScopeCustomersRead  ChannelScope = "customers:read"
+	ChannelScopeCustomersWrite ChannelScope = "customers:write"
+	ChannelScopeAccountsRead   ChannelScope = "accounts:read"
+	ChannelScopeAccountsWrite  ChannelScope = "accounts:write"
+	ChannelScopeTransfersRead  ChannelScope = "transfers:read"
+	ChannelScopeTransfersWrite ChannelScope = "transfers:write"
+	ChannelScopeWebhooks       ChannelScope = "webhooks"
+	ChannelScopeBackOffice     ChannelScope = "backoffice"
+	ChannelScopeChannelsAdmin  ChannelScope = "channels:admin"
+)
+
+var ChannelScopes = []ChannelScope{
+	ChannelScopeAll,
+	ChannelScopeRatesRead,
+	ChannelScopeCustomersRead,
+	ChannelScopeCustomersWrite,
+	ChannelScopeAccountsRead,
+	ChannelScopeAccountsWrite,
+	ChannelScopeTransfersRead,
+	ChannelScopeTransfersWrite,
+	ChannelScopeWebhooks,
+	ChannelScopeBackOffice,
+	ChannelScopeChannelsAdmin,
+}
+
+// Channel is a client application calling the API with its own credentials.
+// AllowedCIDRs is empty when the channel may call from any address.
+type Channel struct {
+	ChannelID    string
+	Name         string
+	Scopes       []ChannelScope
+	AllowedCIDRs []string
+	Status       ChannelStatus
+	CreatedBy    string
+	UpdatedBy    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Keys         []ChannelKey
+}
+
+// ChannelKey is one API key of a channel. Only the SHA-256 hash of the key is
+// stored. A rotated-out key keeps working until ExpiresAt.
+type ChannelKey struct {
+	ID        string
+	ChannelID string
+	KeyHash   string `json:"-"`
+	CreatedBy string
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+}
+
+// ValidAt reports whether the key may still be used at t.
+func (k ChannelKey) ValidAt(t time.Time) bool {
+	return k.ExpiresAt == nil || t.Before(*k.ExpiresAt)
+}
+
+// HasScope reports whether the channel was granted scope, directly or through
+// ChannelScopeAll.
+func (c Channel) HasScope(scope ChannelScope) bool {
+	for _, granted := range c.Scopes {
+		if granted == ChannelScopeAll || granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsIP reports whether ip is inside one of the channel's allowed ranges.
+func (c Channel) AllowsIP(ip net.IP) bool {
+	if len(c.AllowedCIDRs) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range c.AllowedCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func IsChannelScope(value string) bool {
+	for _, scope := range ChannelScopes {
+		if string(scope) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// DataSubjectRequestType is what a customer asked us to do with their data.
+type DataSubjectRequestType string
+
+const (
+	DataSubjectRequestExport  DataSubjectRequestType = "EXPORT"
+	DataSubjectRequestErasure DataSubjectRequestType = "ERASURE"
+)
+
+// DataSubjectRequest records a fulfilled export or erasure: who asked, why,
+// and for erasures how many retained records were scrubbed.
+type DataSubjectRequest struct {
+	ID                     string
+	CustomerID             string
+	Type                   DataSubjectRequestType
+	RequestedBy            string
+	Reason                 string
+	TransfersScrubbed      int
+	ProfileChangesScrubbed int
+	CreatedAt              time.Time
+}
+
+// ErasedName replaces the first and last name of an erased customer.
+const ErasedName = "ERASED"
+
+// AuditPayloadPIIKeys are the top-level keys removed from
+// transfers.audit_payload when a party to the transfer is erased. Account
+// numbers, amounts, currencies and references stay: they are part of the
+// financial record.
+var AuditPayloadPIIKeys = []string{
+	"beneficiaryName",
+	"firstName",
+	"middleName",
+	"lastName",
+	"dob",
+	"phoneNumber",
+	"email",
+	"address",
+	"idNumber",
+}
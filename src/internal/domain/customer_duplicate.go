@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// DuplicateSignal is one attribute on which two customers agree.
+type DuplicateSignal string
+
+const (
+	DuplicateSignalName     DuplicateSignal = "NAME"
+	DuplicateSignalDOB      DuplicateSignal = "DOB"
+	DuplicateSignalPhone    DuplicateSignal = "PHONE"
+	DuplicateSignalIDNumber DuplicateSignal = "ID_NUMBER"
+)
+
+type DuplicateCandidateStatus string
+
+const (
+	DuplicateCandidateOpen      DuplicateCandidateStatus = "OPEN"
+	DuplicateCandidateDismissed DuplicateCandidateStatus = "DISMISSED"
+	DuplicateCandidateMerged    DuplicateCandidateStatus = "MERGED"
+)
+
+// DuplicateCandidate flags CustomerID as a possible duplicate of the existing
+// MatchedCustomerID. It stays OPEN until a reviewer dismisses it or merges the
+// two customers.
+type DuplicateCandidate struct {
+	ID                string
+	CustomerID        string
+	MatchedCustomerID string
+	MatchedOn         []DuplicateSignal
+	NameScore         float64
+	Status            DuplicateCandidateStatus
+	ReviewedBy        *string
+	ReviewComment     string
+	ReviewedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Involves reports whether customerID is either side of the candidate.
+func (c DuplicateCandidate) Involves(customerID string) bool {
+	return c.CustomerID == customerID || c.MatchedCustomerID == customerID
+}
+
+// CustomerMerge records the accounts of MergedCustomerID moved to
+// SurvivingCustomerID. The merged customer is kept with status MERGED.
+type CustomerMerge struct {
+	ID                  string
+	CandidateID         string
+	SurvivingCustomerID string
+	MergedCustomerID    string
+	AccountNumbers      []string
+	MergedBy            string
+	Comment             string
+	CreatedAt           time.Time
+}
+
+// IdentityKey is the value users.identity_key holds unique: the ID type and the
+// upper-cased letters and digits of the ID number, so "a12-345 67" and
+// "A1234567" are the same document.
+func IdentityKey(idType IDType, idNumber string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(idNumber) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return string(idType) + ":" + b.String()
+}
+
+// PhoneKey is the last 10 digits of a phone number, so local and international
+// forms of the same number compare equal.
+func PhoneKey(phoneNumber string) string {
+	var b strings.Builder
+	for _, r := range phoneNumber {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	digits := b.String()
+	if len(digits) > 10 {
+		return digits[len(digits)-10:]
+	}
+	return digits
+}
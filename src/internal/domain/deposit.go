@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type DepositSource string
+
+const (
+	DepositSourceCash         DepositSource = "CASH"
+	DepositSourceCard         DepositSource = "CARD"
+	DepositSourceExternalBank DepositSource = "EXTERNAL_BANK"
+)
+
+var DepositSources = []DepositSource{
+	DepositSourceCash,
+	DepositSourceCard,
+	DepositSourceExternalBank,
+}
+
+// Deposit is money paid into an account from a funding provider. The funding GL
+// account of its currency carries the contra entry. ExternalReference is the
+// provider's reference and is unique per Source, so a replayed notification
+// cannot credit the account twice.
+type Deposit struct {
+	ID                     string
+	Reference              string
+	AccountNumber          string
+	Currency               string
+	Amount                 decimal.Decimal
+	Source                 DepositSource
+	ExternalReference      string
+	FundingGLAccountNumber string
+	Narration              string
+	Channel                string
+	JournalEntries         []JournalEntry
+	CreatedAt              time.Time
+}
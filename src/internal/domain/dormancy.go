@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DormancyReportLine totals the DORMANT accounts of one currency for the
+// regulatory dormant-account return.
+type DormancyReportLine struct {
+	Currency         string
+	AccountCount     int
+	AvailableBalance decimal.Decimal
+	LedgerBalance    decimal.Decimal
+	OldestActivityAt time.Time
+}
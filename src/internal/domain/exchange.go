@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Exchange is a currency conversion between two accounts of the same customer.
+// SourceAmount is sold at QuotedRate, which is MidRate less SpreadPercent, and
+// DestinationAmount is bought; FXIncomeAmount is the spread earned, in the
+// destination currency. TotalDebit is SourceAmount plus the fees.
+type Exchange struct {
+	ID                       string
+	Reference                string
+	CustomerID               string
+	SourceAccountNumber      string
+	DestinationAccountNumber string
+	SourceCurrency           string
+	DestinationCurrency      string
+	SourceAmount             decimal.Decimal
+	DestinationAmount        decimal.Decimal
+	MidRate                  decimal.Decimal
+	QuotedRate               decimal.Decimal
+	SpreadPercent            decimal.Decimal
+	FXIncomeAmount           decimal.Decimal
+	ChargeAmount             decimal.Decimal
+	VATAmount                decimal.Decimal
+	TotalDebit               decimal.Decimal
+	FXIncomeGLAccountNumber  string
+	Narration                string
+	Channel                  string
+	JournalEntries           []JournalEntry
+	CreatedAt                time.Time
+}
+
+// ExchangePosting routes an exchange through the suspense account and settles
+// its fees, converted to USD, into the charges and VAT accounts.
+type ExchangePosting struct {
+	SuspenseAccountNumber string
+	ChargesAccountNumber  string
+	VATAccountNumber      string
+	ChargeUSD             decimal.Decimal
+	VATUSD                decimal.Decimal
+}
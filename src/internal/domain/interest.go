@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type DayCountConvention string
+
+const (
+	DayCountActual365 DayCountConvention = "ACT/365"
+	DayCountActual360 DayCountConvention = "ACT/360"
+)
+
+// DaysInYear returns the day-count denominator of the convention.
+func (c DayCountConvention) DaysInYear() int64 {
+	if c == DayCountActual360 {
+		return 360
+	}
+	return 365
+}
+
+// InterestRateTier is one row of a rate table. For a currency and product the
+// tier with the highest MinBalance not above the balance applies to the whole
+// balance.
+type InterestRateTier struct {
+	ID                int64
+	Currency          string
+	Product           AccountProduct
+	MinBalance        decimal.Decimal
+	AnnualRatePercent decimal.Decimal
+	DayCount          DayCountConvention
+}
+
+// InterestAccrual is the interest earned by an account for one day. Amount
+// keeps eight decimal places; rounding happens once, at capitalization.
+type InterestAccrual struct {
+	ID                string
+	AccountNumber     string
+	AccrualDate       time.Time
+	Currency          string
+	Product           AccountProduct
+	Balance           decimal.Decimal
+	AnnualRatePercent decimal.Decimal
+	DayCount          DayCountConvention
+	Amount            decimal.Decimal
+	CapitalizationID  string
+	CreatedAt         time.Time
+}
+
+// InterestCapitalization pays the open accruals of an account up to PeriodEnd.
+// GrossAmount is debited from the interest expense GL account, TaxAmount is
+// withheld to the tax GL account and NetAmount is credited to the customer.
+type InterestCapitalization struct {
+	ID                     string
+	Reference              string
+	AccountNumber          string
+	Currency               string
+	PeriodStart            time.Time
+	PeriodEnd              time.Time
+	AccruedAmount          decimal.Decimal
+	GrossAmount            decimal.Decimal
+	TaxAmount              decimal.Decimal
+	NetAmount              decimal.Decimal
+	WithholdingTaxPercent  decimal.Decimal
+	ExpenseGLAccountNumber string
+	TaxGLAccountNumber     string
+	JournalEntries         []JournalEntry
+	CreatedAt              time.Time
+}
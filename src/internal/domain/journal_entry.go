@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type JournalReferenceType string
+
+const (
+	JournalReferenceWithdrawal JournalReferenceType = "WITHDRAWAL"
+	JournalReferenceDeposit    JournalReferenceType = "DEPOSIT"
+	JournalReferenceExchange   JournalReferenceType = "EXCHANGE"
+	JournalReferenceInterest   JournalReferenceType = "INTEREST"
+)
+
+// JournalEntry is one leg of a posting that does not belong to a transfer. The
+// legs of a posting share ReferenceType and ReferenceID; AccountNumber is either
+// a customer account or an internal account in transient_accounts.
+type JournalEntry struct {
+	ID            string
+	ReferenceType JournalReferenceType
+	ReferenceID   string
+	Reference     string
+	AccountNumber string
+	EntryType     LedgerEntryType
+	Currency      string
+	Amount        decimal.Decimal
+	CreatedAt     time.Time
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// MaxKYCLevel is the highest KYC tier a customer can be upgraded to.
+const MaxKYCLevel = 3
+
+type KYCDocumentType string
+
+const (
+	KYCDocumentPassport    KYCDocumentType = "Passport"
+	KYCDocumentDL          KYCDocumentType = "DL"
+	KYCDocumentNationalID  KYCDocumentType = "NationalID"
+	KYCDocumentUtilityBill KYCDocumentType = "UtilityBill"
+)
+
+func (t KYCDocumentType) Valid() bool {
+	switch t {
+	case KYCDocumentPassport, KYCDocumentDL, KYCDocumentNationalID, KYCDocumentUtilityBill:
+		return true
+	default:
+		return false
+	}
+}
+
+type KYCUpgradeStatus string
+
+const (
+	KYCUpgradePending  KYCUpgradeStatus = "PENDING"
+	KYCUpgradeApproved KYCUpgradeStatus = "APPROVED"
+	KYCUpgradeRejected KYCUpgradeStatus = "REJECTED"
+)
+
+// KYCUpgradeRequest asks for a customer's KYC level to be raised on the
+// strength of an identity document. Only the document's metadata is kept; the
+// document itself lives in the store DocumentReference points to. The level is
+// raised when a reviewer other than the submitter approves the request.
+type KYCUpgradeRequest struct {
+	ID                string
+	CustomerID        string
+	CurrentLevel      int
+	RequestedLevel    int
+	DocumentType      KYCDocumentType
+	DocumentNumber    string
+	IssuingCountry    string
+	DocumentExpiresAt *time.Time
+	DocumentReference string
+	Status            KYCUpgradeStatus
+	SubmittedBy       string
+	ReviewedBy        *string
+	ReviewComment     string
+	ReviewedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type OTPPurpose string
+
+const (
+	OTPPurposePinReset         OTPPurpose = "PIN_RESET"
+	OTPPurposeTransferStepUp   OTPPurpose = "TRANSFER_STEP_UP"
+	OTPPurposeWithdrawalStepUp OTPPurpose = "WITHDRAWAL_STEP_UP"
+)
+
+// OTPChallenge is a one-time code sent to the customer's phone number. Only
+// the bcrypt hash of the code is stored; a challenge is spent by its first
+// successful verification, by expiry or by MaxAttempts wrong codes. Payload
+// carries the JSON of the operation the code authorizes, when there is one.
+type OTPChallenge struct {
+	ID          string
+	CustomerID  string
+	Purpose     OTPPurpose
+	CodeHash    string
+	Payload     string
+	Attempts    int
+	MaxAttempts int
+	ExpiresAt   time.Time
+	ConsumedAt  *time.Time
+	CreatedAt   time.Time
+}
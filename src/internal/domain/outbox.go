@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// EventSchemaVersion is bumped whenever a published event payload changes shape.
+// Consumers should branch on the envelope schemaVersion rather than sniffing fields.
+const EventSchemaVersion = 1
+
+type AggregateType string
+
+const (
+	AggregateTypeTransfer AggregateType = "transfer"
+	AggregateTypeAccount  AggregateType = "account"
+	AggregateTypeCustomer AggregateType = "customer"
+)
+
+type AccountEventType string
+
+const (
+	AccountEventOpened   AccountEventType = "ACCOUNT_OPENED"
+	AccountEventDebited  AccountEventType = "ACCOUNT_DEBITED"
+	AccountEventCredited AccountEventType = "ACCOUNT_CREDITED"
+
+	AccountEventHoldPlaced   AccountEventType = "ACCOUNT_HOLD_PLACED"
+	AccountEventHoldReleased AccountEventType = "ACCOUNT_HOLD_RELEASED"
+
+	AccountEventStatusChanged AccountEventType = "ACCOUNT_STATUS_CHANGED"
+)
+
+type CustomerEventType string
+
+const (
+	CustomerEventPinLocked   CustomerEventType = "CUSTOMER_PIN_LOCKED"
+	CustomerEventPinUnlocked CustomerEventType = "CUSTOMER_PIN_UNLOCKED"
+	CustomerEventPinChanged  CustomerEventType = "CUSTOMER_PIN_CHANGED"
+
+	CustomerEventProfileUpdated      CustomerEventType = "CUSTOMER_PROFILE_UPDATED"
+	CustomerEventKYCUpgradeSubmitted CustomerEventType = "CUSTOMER_KYC_UPGRADE_SUBMITTED"
+	CustomerEventKYCUpgraded         CustomerEventType = "CUSTOMER_KYC_UPGRADED"
+	CustomerEventKYCUpgradeRejected  CustomerEventType = "CUSTOMER_KYC_UPGRADE_REJECTED"
+
+	CustomerEventDuplicateFlagged CustomerEventType = "CUSTOMER_DUPLICATE_FLAGGED"
+	CustomerEventMerged           CustomerEventType = "CUSTOMER_MERGED"
+
+	CustomerEventErased CustomerEventType = "CUSTOMER_ERASED"
+
+	CustomerEventComplianceCleared  CustomerEventType = "CUSTOMER_COMPLIANCE_CLEARED"
+	CustomerEventComplianceRejected CustomerEventType = "CUSTOMER_COMPLIANCE_REJECTED"
+)
+
+// OutboxEvent is a domain event written in the same database transaction as the
+// state change it describes. AggregateVersion is assigned on insert and is
+// strictly increasing per aggregate, so (AggregateType, AggregateID,
+// AggregateVersion) identifies an event for consumer-side deduplication.
+type OutboxEvent struct {
+	ID               string
+	AggregateType    AggregateType
+	AggregateID      string
+	AggregateVersion int64
+	EventType        string
+	SchemaVersion    int
+	Payload          string
+	Sequence         int64
+	Attempts         int
+	NextAttemptAt    time.Time
+	PublishedAt      *time.Time
+	LastError        *string
+	CreatedAt        time.Time
+}
+
+// DedupKey is stable across redeliveries of the same event.
+func (e OutboxEvent) DedupKey() string {
+	return fmt.Sprintf("%s:%s:%d", e.AggregateType, e.AggregateID, e.AggregateVersion)
+}
+
+// EventEnvelope is the published JSON shape shared by every event type.
+type EventEnvelope struct {
+	EventID          string          `json:"eventId"`
+	EventType        string          `json:"eventType"`
+	SchemaVersion    int             `json:"schemaVersion"`
+	AggregateType    string          `json:"aggregateType"`
+	AggregateID      string          `json:"aggregateId"`
+	AggregateVersion int64           `json:"aggregateVersion"`
+	DedupKey         string          `json:"dedupKey"`
+	OccurredAt       time.Time       `json:"occurredAt"`
+	Data             json.RawMessage `json:"data"`
+}
+
+func (e OutboxEvent) Envelope() EventEnvelope {
+	return EventEnvelope{
+		EventID:          e.ID,
+		EventType:        e.EventType,
+		SchemaVersion:    e.SchemaVersion,
+		AggregateType:    string(e.AggregateType),
+		AggregateID:      e.AggregateID,
+		AggregateVersion: e.AggregateVersion,
+		DedupKey:         e.DedupKey(),
+		OccurredAt:       e.CreatedAt.UTC(),
+		Data:             json.RawMessage(e.Payload),
+	}
+}
+
+// TransferEventData is schema version 1 of the data block for TRANSFER_* events.
+type TransferEventData struct {
+	TransferID           string          `json:"transferId"`
+	TransactionReference string          `json:"transactionReference,omitempty"`
+	ExternalReference    string          `json:"externalReference,omitempty"`
+	DebitAccountNumber   string          `json:"debitAccountNumber"`
+	CreditAccountNumber  string          `json:"creditAccountNumber,omitempty"`
+	BeneficiaryBankCode  string          `json:"beneficiaryBankCode,omitempty"`
+	DebitCurrency        string          `json:"debitCurrency"`
+	CreditCurrency       string          `json:"creditCurrency"`
+	DebitAmount          decimal.Decimal `json:"debitAmount"`
+	CreditAmount         decimal.Decimal `json:"creditAmount"`
+	FCYRate              decimal.Decimal `json:"fcyRate"`
+	ChargeAmount         decimal.Decimal `json:"chargeAmount"`
+	VATAmount            decimal.Decimal `json:"vatAmount"`
+	Narration            string          `json:"narration,omitempty"`
+	ChannelID            string          `json:"channelId,omitempty"`
+	Status               TransferStatus  `json:"status"`
+}
+
+// AccountEventData is schema version 1 of the data block for ACCOUNT_* events.
+type AccountEventData struct {
+	AccountNumber    string          `json:"accountNumber"`
+	CustomerID       string          `json:"customerId,omitempty"`
+	Currency         string          `json:"currency"`
+	Amount           decimal.Decimal `json:"amount"`
+	AvailableBalance decimal.Decimal `json:"availableBalance"`
+	LedgerBalance    decimal.Decimal `json:"ledgerBalance"`
+	TransferID       string          `json:"transferId,omitempty"`
+	HoldReference    string          `json:"holdReference,omitempty"`
+	Status           AccountStatus   `json:"status,omitempty"`
+	StatusReason     string          `json:"statusReason,omitempty"`
+}
+
+// CustomerEventData is schema version 1 of the data block for CUSTOMER_* events.
+type CustomerEventData struct {
+	CustomerID           string     `json:"customerId"`
+	FailedAttempts       int        `json:"failedAttempts,omitempty"`
+	LockedUntil          *time.Time `json:"lockedUntil,omitempty"`
+	Reason               string     `json:"reason,omitempty"`
+	Actor                string     `json:"actor,omitempty"`
+	Comment              string     `json:"comment,omitempty"`
+	ChangedFields        []string   `json:"changedFields,omitempty"`
+	KYCRequestID         string     `json:"kycRequestId,omitempty"`
+	KYCLevel             int        `json:"kycLevel,omitempty"`
+	MatchedCustomerIDs   []string   `json:"matchedCustomerIds,omitempty"`
+	MergedIntoCustomerID string     `json:"mergedIntoCustomerId,omitempty"`
+	AccountNumbers       []string   `json:"accountNumbers,omitempty"`
+	DataRequestID        string     `json:"dataRequestId,omitempty"`
+}
+
+func NewTransferOutboxEvent(eventType TransferEventType, transfer Transfer) (OutboxEvent, error) {
+	data := TransferEventData{
+		TransferID:         transfer.ID,
+		DebitAccountNumber: transfer.DebitAccountNumber,
+		DebitCurrency:      transfer.DebitCurrency,
+		CreditCurrency:     transfer.CreditCurrency,
+		DebitAmount:        transfer.DebitAmount,
+		CreditAmount:       transfer.CreditAmount,
+		FCYRate:            transfer.FCYRate,
+		ChargeAmount:       transfer.ChargeAmount,
+		VATAmount:          transfer.VATAmount,
+		Status:             transfer.Status,
+	}
+	if transfer.TransactionReference != nil {
+		data.TransactionReference = *transfer.TransactionReference
+	}
+	if transfer.ExternalRefernece != nil {
+		data.ExternalReference = *transfer.ExternalRefernece
+	}
+	if transfer.CreditAccountNumber != nil {
+		data.CreditAccountNumber = *transfer.CreditAccountNumber
+	}
+	if transfer.BeneficiaryBankCode != nil {
+		data.BeneficiaryBankCode = *transfer.BeneficiaryBankCode
+	}
+	if transfer.Narration != nil {
+		data.Narration = *transfer.Narration
+	}
+	if transfer.ChannelID != nil {
+		data.ChannelID = *transfer.ChannelID
+	}
+
+	return newOutboxEvent(AggregateTypeTransfer, transfer.ID, string(eventType), data)
+}
+
+func NewAccountOutboxEvent(eventType AccountEventType, data AccountEventData) (OutboxEvent, error) {
+	return newOutboxEvent(AggregateTypeAccount, data.AccountNumber, string(eventType), data)
+}
+
+func NewCustomerOutboxEvent(eventType CustomerEventType, data CustomerEventData) (OutboxEvent, error) {
+	return newOutboxEvent(AggregateTypeCustomer, data.CustomerID, string(eventType), data)
+}
+
+func newOutboxEvent(aggregateType AggregateType, aggregateID string, eventType string, data any) (OutboxEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("marshal %s event payload: %w", eventType, err)
+	}
+
+	return OutboxEvent{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		SchemaVersion: EventSchemaVersion,
+		Payload:       string(payload),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type OverdraftFacilityStatus string
+
+const (
+	OverdraftFacilityStatusActive    OverdraftFacilityStatus = "ACTIVE"
+	OverdraftFacilityStatusSuspended OverdraftFacilityStatus = "SUSPENDED"
+)
+
+// OverdraftSuspensionExpired is the suspension reason recorded when a facility
+// reaches its expiry.
+const OverdraftSuspensionExpired = "EXPIRED"
+
+// OverdraftFacility is the approved overdraft of an account. While it is ACTIVE
+// the account's overdraft_limit equals Limit and debits may take the available
+// balance down to -Limit; a SUSPENDED facility leaves the limit at zero.
+type OverdraftFacility struct {
+	ID                string
+	AccountNumber     string
+	Currency          string
+	Limit             decimal.Decimal
+	AnnualRatePercent decimal.Decimal
+	DayCount          DayCountConvention
+	Status            OverdraftFacilityStatus
+	ApprovedBy        string
+	ExpiresAt         time.Time
+	SuspendedAt       *time.Time
+	SuspensionReason  string
+	AccruedInterest   decimal.Decimal
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// OverdraftPosition is an overdrawn account with the terms of its facility.
+// FacilityStatus is empty when the account has no facility.
+type OverdraftPosition struct {
+	AccountNumber     string
+	Currency          string
+	AvailableBalance  decimal.Decimal
+	LedgerBalance     decimal.Decimal
+	OverdraftLimit    decimal.Decimal
+	FacilityStatus    OverdraftFacilityStatus
+	AnnualRatePercent decimal.Decimal
+	DayCount          DayCountConvention
+	ExpiresAt         *time.Time
+}
+
+// OverdraftInterestAccrual is the interest charged on an overdrawn ledger
+// balance for one day.
+type OverdraftInterestAccrual struct {
+	ID                string
+	AccountNumber     string
+	AccrualDate       time.Time
+	Currency          string
+	OverdrawnAmount   decimal.Decimal
+	AnnualRatePercent decimal.Decimal
+	DayCount          DayCountConvention
+	Amount            decimal.Decimal
+	CreatedAt         time.Time
+}
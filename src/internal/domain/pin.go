@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PinPolicy bounds guessing of a customer's transaction PIN: after
+// MaxFailedAttempts consecutive misses the PIN is locked for LockDuration, or
+// until an operator unlocks it.
+type PinPolicy struct {
+	MaxFailedAttempts int
+	LockDuration      time.Duration
+}
+
+// PinState is the verification state of a customer's transaction PIN. The
+// failed-attempt counter restarts after every lock, successful verification
+// and PIN change.
+type PinState struct {
+	CustomerID     string
+	PinHash        string `json:"-"`
+	FailedAttempts int
+	LockedUntil    *time.Time
+	PinChangedAt   *time.Time
+}
+
+// LockedAt reports whether the PIN is still locked at now. Timed locks lapse
+// on their own; nothing has to clear them.
+func (s PinState) LockedAt(now time.Time) bool {
+	return s.LockedUntil != nil && s.LockedUntil.After(now)
+}
+
+// PinChangeReason records how a PIN was replaced on the CUSTOMER_PIN_CHANGED
+// event.
+type PinChangeReason string
+
+const (
+	PinChangeReasonChange PinChangeReason = "CHANGE"
+	PinChangeReasonReset  PinChangeReason = "RESET"
+)
+
+const (
+	MinPinLength = 4
+	MaxPinLength = 6
+)
+
+// ValidatePinStrength rejects PINs that are easy to guess: anything other than
+// 4 to 6 digits, a single repeated digit (1111), a run of consecutive digits
+// in either direction (1234, 9876) and a repeated block (1212, 123123).
+func ValidatePinStrength(pin string) error {
+	if len(pin) < MinPinLength || len(pin) > MaxPinLength {
+		return fmt.Errorf("pin must be %d to %d digits", MinPinLength, MaxPinLength)
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return errors.New("pin must contain digits only")
+		}
+	}
+
+	ascending, descending := true, true
+	for i := 1; i < len(pin); i++ {
+		step := int(pin[i]) - int(pin[i-1])
+		ascending = ascending && step == 1
+		descending = descending && step == -1
+	}
+	if ascending || descending {
+		return errors.New("pin must not be a sequence of consecutive digits")
+	}
+
+	for size := 1; size <= len(pin)/2; size++ {
+		if len(pin)%size == 0 && strings.Repeat(pin[:size], len(pin)/size) == pin {
+			return errors.New("pin must not repeat the same digit or pattern")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+type SanctionsSubjectType string
+
+const (
+	SanctionsSubjectUser     SanctionsSubjectType = "USER"
+	SanctionsSubjectTransfer SanctionsSubjectType = "TRANSFER"
+)
+
+type SanctionsDecision string
+
+const (
+	SanctionsDecisionClear          SanctionsDecision = "CLEAR"
+	SanctionsDecisionPotentialMatch SanctionsDecision = "POTENTIAL_MATCH"
+)
+
+// SanctionsEntry is one listed party. Names holds the primary name followed by
+// any aliases, as published by the list owner.
+type SanctionsEntry struct {
+	ID        string
+	Source    string
+	EntryType string
+	Names     []string
+}
+
+// SanctionsList is a loaded watchlist snapshot. Version identifies the exact
+// publication the entries came from so every decision can be traced back to it.
+type SanctionsList struct {
+	Version  string
+	Entries  []SanctionsEntry
+	LoadedAt time.Time
+}
+
+type SanctionsScreening struct {
+	ID             string
+	SubjectType    SanctionsSubjectType
+	SubjectID      string
+	ScreenedName   string
+	Decision       SanctionsDecision
+	ListVersion    string
+	MatchedEntryID *string
+	MatchedName    *string
+	MatchScore     float64
+	CreatedAt      time.Time
+}
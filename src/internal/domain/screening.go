@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type ScreeningDecision string
+
+const (
+	ScreeningDecisionAllow  ScreeningDecision = "ALLOW"
+	ScreeningDecisionReview ScreeningDecision = "REVIEW"
+	ScreeningDecisionBlock  ScreeningDecision = "BLOCK"
+)
+
+// Severity orders decisions so the strictest result across rules wins.
+func (d ScreeningDecision) Severity() int {
+	switch d {
+	case ScreeningDecisionBlock:
+		return 2
+	case ScreeningDecisionReview:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type ScreeningRuleType string
+
+const (
+	ScreeningRuleVelocity               ScreeningRuleType = "VELOCITY"
+	ScreeningRuleNewBeneficiaryLarge    ScreeningRuleType = "NEW_BENEFICIARY_LARGE_AMOUNT"
+	ScreeningRuleRoundAmountStructuring ScreeningRuleType = "ROUND_AMOUNT_STRUCTURING"
+	ScreeningRuleRapidInOut             ScreeningRuleType = "RAPID_IN_OUT"
+	ScreeningRuleDormantReactivation    ScreeningRuleType = "DORMANT_REACTIVATION"
+)
+
+// ScreeningRuleParams holds the thresholds for every rule type; each rule only
+// reads the fields relevant to it. USD fields are compared against the
+// USD-equivalent of the transfer amount.
+type ScreeningRuleParams struct {
+	WindowMinutes int             `json:"windowMinutes,omitempty"`
+	MaxCount      int             `json:"maxCount,omitempty"`
+	MaxAmountUSD  decimal.Decimal `json:"maxAmountUsd,omitempty"`
+	ThresholdUSD  decimal.Decimal `json:"thresholdUsd,omitempty"`
+	RoundUnit     decimal.Decimal `json:"roundUnit,omitempty"`
+	MinCount      int             `json:"minCount,omitempty"`
+	InOutRatio    decimal.Decimal `json:"inOutRatio,omitempty"`
+	DormantDays   int             `json:"dormantDays,omitempty"`
+}
+
+type ScreeningRule struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	Type    ScreeningRuleType   `json:"type"`
+	Enabled bool                `json:"enabled"`
+	Action  ScreeningDecision   `json:"action"`
+	Score   int                 `json:"score"`
+	Params  ScreeningRuleParams `json:"params"`
+}
+
+type ScreeningResult struct {
+	RuleID   string            `json:"ruleId"`
+	RuleType ScreeningRuleType `json:"ruleType"`
+	Decision ScreeningDecision `json:"decision"`
+	Score    int               `json:"score"`
+	Reason   string            `json:"reason"`
+}
+
+// ScreeningInput is the transfer context the rules are evaluated against.
+type ScreeningInput struct {
+	TransferID          string
+	DebitAccountNumber  string
+	CreditAccountNumber string
+	BeneficiaryBankCode string
+	DebitCurrency       string
+	DebitAmount         decimal.Decimal
+	AccountOpenedAt     time.Time
+}
+
+type TransferScreening struct {
+	ID         string
+	TransferID string
+	Decision   ScreeningDecision
+	TotalScore int
+	Results    []ScreeningResult
+	CreatedAt  time.Time
+}
@@ -9,10 +9,12 @@ import (
 type TransferStatus string
 
 const (
-	TransferStatusPending TransferStatus = "PENDING"
-	TransferStatusSuccess TransferStatus = "SUCCESS"
-	TransferStatusFailed  TransferStatus = "FAILED"
-	TransferStatusClosed  TransferStatus = "CLOSED"
+	TransferStatusAccepted TransferStatus = "ACCEPTED"
+	TransferStatusPending  TransferStatus = "PENDING"
+	TransferStatusSuccess  TransferStatus = "SUCCESS"
+	TransferStatusFailed   TransferStatus = "FAILED"
+	TransferStatusClosed   TransferStatus = "CLOSED"
+	TransferStatusHeld     TransferStatus = "HELD"
 )
 
 type Transfer struct {
@@ -34,7 +36,46 @@ type Transfer struct {
 	Narration            *string
 	Status               TransferStatus
 	AuditPayload         string
+	ChannelID            *string
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	ProcessedAt          *time.Time
 }
+
+// TransferStatusOTPRequired is reported on transfer responses that wait for a
+// step-up code. Nothing is persisted in the transfers table until the code is
+// confirmed, so it never appears on a stored transfer.
+const TransferStatusOTPRequired TransferStatus = "OTP_REQUIRED"
+
+// TransferStepUpPolicy asks for a one-time code before a transfer is posted
+// when its debit amount, converted into Currency at the current rate, is above
+// Threshold. A zero threshold turns step-up off.
+type TransferStepUpPolicy struct {
+	Currency  string
+	Threshold decimal.Decimal
+}
+
+// TransferLimitPolicy caps a single transfer by the customer's KYC level. The
+// debit amount is converted into Currency at the current rate and compared
+// with the limit of the highest level in PerTransfer not above the customer's.
+// Levels without an applicable entry, and entries of zero, are not capped.
+type TransferLimitPolicy struct {
+	Currency    string
+	PerTransfer map[int]decimal.Decimal
+}
+
+// LimitFor returns the per-transfer limit for kycLevel, and false when
+// transfers at that level are not capped.
+func (p TransferLimitPolicy) LimitFor(kycLevel int) (decimal.Decimal, bool) {
+	level := 0
+	for configured := range p.PerTransfer {
+		if configured <= kycLevel && configured > level {
+			level = configured
+		}
+	}
+	limit, ok := p.PerTransfer[level]
+	if !ok || !limit.IsPositive() {
+		return decimal.Zero, false
+	}
+	return limit, true
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type TransferReviewStatus string
+
+const (
+	TransferReviewPending  TransferReviewStatus = "PENDING"
+	TransferReviewApproved TransferReviewStatus = "APPROVED"
+	TransferReviewRejected TransferReviewStatus = "REJECTED"
+)
+
+type TransferReviewAction string
+
+const (
+	TransferReviewActionHold    TransferReviewAction = "HOLD"
+	TransferReviewActionApprove TransferReviewAction = "APPROVE"
+	TransferReviewActionReject  TransferReviewAction = "REJECT"
+)
+
+// TransferReviewSystemActor is recorded as the actor of automatic holds.
+const TransferReviewSystemActor = "system"
+
+// TransferReview is the manual review case opened when a transfer is parked in
+// HELD. HeldAmount is the amount reserved from the debit account's available
+// balance while the case is open.
+type TransferReview struct {
+	ID          string
+	TransferID  string
+	Reason      string
+	Status      TransferReviewStatus
+	InitiatedBy string
+	HeldAmount  decimal.Decimal
+	DecidedBy   *string
+	DecidedAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Decisions   []TransferReviewDecision
+}
+
+// TransferReviewDecision is one append-only audit entry for a review.
+type TransferReviewDecision struct {
+	ID        string
+	ReviewID  string
+	Action    TransferReviewAction
+	Actor     string
+	Comment   string
+	CreatedAt time.Time
+}
@@ -9,18 +9,96 @@ const (
 	IDTypeDL       IDType = "DL"
 )
 
+type UserStatus string
+
+const (
+	UserStatusActive UserStatus = "ACTIVE"
+	UserStatusHeld   UserStatus = "HELD"
+	// UserStatusRejected marks a customer compliance declined after reviewing
+	// a potential sanctions match. The customer stays unusable.
+	UserStatusRejected UserStatus = "REJECTED"
+	// UserStatusMerged marks a duplicate whose accounts were moved to
+	// MergedIntoCustomerID.
+	UserStatusMerged UserStatus = "MERGED"
+	// UserStatusErased marks a customer whose personal data was erased on
+	// request. The customer ID and financial records remain.
+	UserStatusErased UserStatus = "ERASED"
+)
+
 type User struct {
-	ID                 string
-	CustomerID         string
-	FirstName          string
-	MiddleName         *string
-	LastName           string
-	DOB                time.Time
-	PhoneNumber        string
-	IDType             IDType
-	IDNumber           string
-	KYCLevel           int
-	TransactionPinHash string
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
+	ID                   string
+	CustomerID           string
+	FirstName            string
+	MiddleName           *string
+	LastName             string
+	DOB                  time.Time
+	PhoneNumber          string
+	Email                *string
+	Address              *string
+	IDType               IDType
+	IDNumber             string
+	KYCLevel             int
+	TransactionPinHash   string `json:"-"`
+	Status               UserStatus
+	MergedIntoCustomerID *string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// ContactDetails are the fields a customer can change after onboarding. Every
+// change is recorded as a ProfileChange.
+type ContactDetails struct {
+	PhoneNumber string
+	Email       string
+	Address     string
+}
+
+// ContactDetails returns the user's current contact details.
+func (u User) ContactDetails() ContactDetails {
+	details := ContactDetails{PhoneNumber: u.PhoneNumber}
+	if u.Email != nil {
+		details.Email = *u.Email
+	}
+	if u.Address != nil {
+		details.Address = *u.Address
+	}
+	return details
+}
+
+// Changes lists the fields that differ in next, with the values from d as the
+// old values.
+func (d ContactDetails) Changes(next ContactDetails) []ProfileChange {
+	changes := make([]ProfileChange, 0, 3)
+	for _, field := range []struct {
+		name     ProfileField
+		old, new string
+	}{
+		{ProfileFieldPhoneNumber, d.PhoneNumber, next.PhoneNumber},
+		{ProfileFieldEmail, d.Email, next.Email},
+		{ProfileFieldAddress, d.Address, next.Address},
+	} {
+		if field.old != field.new {
+			changes = append(changes, ProfileChange{Field: field.name, OldValue: field.old, NewValue: field.new})
+		}
+	}
+	return changes
+}
+
+type ProfileField string
+
+const (
+	ProfileFieldPhoneNumber ProfileField = "phoneNumber"
+	ProfileFieldEmail       ProfileField = "email"
+	ProfileFieldAddress     ProfileField = "address"
+)
+
+// ProfileChange is one audited change to a customer's contact details.
+type ProfileChange struct {
+	ID         string
+	CustomerID string
+	Field      ProfileField
+	OldValue   string
+	NewValue   string
+	ChangedBy  string
+	CreatedAt  time.Time
 }
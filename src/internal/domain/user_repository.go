@@ -1,11 +1,33 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type UserRepository interface {
 	Create(ctx context.Context, user User) (User, error)
 	GetByID(ctx context.Context, id string) (User, error)
 	GetByCustomerID(ctx context.Context, customerID string) (User, error)
 	Update(ctx context.Context, user User) (User, error)
+	// UpdateContactDetails replaces the contact details and records every
+	// changed field with CUSTOMER_PROFILE_UPDATED. It returns the changes.
+	UpdateContactDetails(ctx context.Context, customerID string, details ContactDetails, changedBy string) (User, []ProfileChange, error)
+	GetProfileChanges(ctx context.Context, customerID string, limit int) ([]ProfileChange, error)
 	GetTransactionPinHashByCustomerID(ctx context.Context, customerID string) (string, error)
+	GetPinState(ctx context.Context, customerID string) (PinState, error)
+	// RecordFailedPinAttempt counts a wrong PIN and, once maxAttempts is
+	// reached, locks the PIN until lockedUntil and records CUSTOMER_PIN_LOCKED.
+	RecordFailedPinAttempt(ctx context.Context, customerID string, maxAttempts int, lockedUntil time.Time) (PinState, error)
+	ClearFailedPinAttempts(ctx context.Context, customerID string) error
+	// UpdatePinHash replaces the PIN, clears any lock and records
+	// CUSTOMER_PIN_CHANGED.
+	UpdatePinHash(ctx context.Context, customerID string, pinHash string, reason PinChangeReason) (PinState, error)
+	// UnlockPin lifts a current lock and records CUSTOMER_PIN_UNLOCKED. It
+	// returns commons.ErrRecordNotFound when the PIN is not locked.
+	UnlockPin(ctx context.Context, customerID string, actor string, comment string) (PinState, error)
+	// ResolveComplianceHold moves a HELD customer to ACTIVE or REJECTED and
+	// records CUSTOMER_COMPLIANCE_CLEARED or CUSTOMER_COMPLIANCE_REJECTED. It
+	// returns commons.ErrRecordNotFound when the customer is not HELD.
+	ResolveComplianceHold(ctx context.Context, customerID string, status UserStatus, actor string, comment string) (User, error)
 }
@@ -0,0 +1,67 @@
+package domain
+
+import "time"
+
+type TransferEventType string
+
+const (
+	TransferEventCreated   TransferEventType = "TRANSFER_CREATED"
+	TransferEventSucceeded TransferEventType = "TRANSFER_SUCCEEDED"
+	TransferEventFailed    TransferEventType = "TRANSFER_FAILED"
+	TransferEventClosed    TransferEventType = "TRANSFER_CLOSED"
+	TransferEventReversed  TransferEventType = "TRANSFER_REVERSED"
+	TransferEventHeld      TransferEventType = "TRANSFER_HELD"
+)
+
+var TransferEventTypes = []TransferEventType{
+	TransferEventCreated,
+	TransferEventSucceeded,
+	TransferEventFailed,
+	TransferEventClosed,
+	TransferEventReversed,
+	TransferEventHeld,
+}
+
+type WebhookSubscriptionStatus string
+
+const (
+	WebhookSubscriptionActive   WebhookSubscriptionStatus = "ACTIVE"
+	WebhookSubscriptionDisabled WebhookSubscriptionStatus = "DISABLED"
+)
+
+type WebhookSubscription struct {
+	ID         string
+	ChannelID  string
+	URL        string
+	Secret     string
+	EventTypes []TransferEventType
+	Status     WebhookSubscriptionStatus
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySuccess WebhookDeliveryStatus = "SUCCESS"
+	WebhookDeliveryFailed  WebhookDeliveryStatus = "FAILED"
+)
+
+type WebhookDelivery struct {
+	ID               string
+	SubscriptionID   string
+	EventID          string
+	EventType        TransferEventType
+	TransferID       *string
+	Payload          string
+	Status           WebhookDeliveryStatus
+	Attempts         int
+	NextAttemptAt    time.Time
+	LastAttemptAt    *time.Time
+	LastResponseCode *int
+	LastError        *string
+	RedeliveryOf     *string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Withdrawal is cash paid out of a customer account against the cash GL account
+// of its currency. TotalDebit is Amount plus the fees; JournalEntries holds the
+// legs posted for it.
+type Withdrawal struct {
+	ID                  string
+	Reference           string
+	AccountNumber       string
+	Currency            string
+	Amount              decimal.Decimal
+	ChargeAmount        decimal.Decimal
+	VATAmount           decimal.Decimal
+	TotalDebit          decimal.Decimal
+	CashGLAccountNumber string
+	Narration           string
+	Channel             string
+	JournalEntries      []JournalEntry
+	CreatedAt           time.Time
+}
+
+// WithdrawalPosting routes a withdrawal through the suspense account and settles
+// its fees, converted to USD, into the charges and VAT accounts.
+type WithdrawalPosting struct {
+	SuspenseAccountNumber string
+	ChargesAccountNumber  string
+	VATAccountNumber      string
+	ChargeUSD             decimal.Decimal
+	VATUSD                decimal.Decimal
+}
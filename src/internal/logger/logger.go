@@ -14,6 +14,17 @@ var sensitiveKeys = map[string]struct{}{
 	"transaction_pin":      {},
 	"transactionpinhash":   {},
 	"transaction_pin_hash": {},
+	"oldpin":               {},
+	"newpin":               {},
+	"otp":                  {},
+	"secret":               {},
+	"apikey":               {},
+	"api_key":              {},
+	"dob":                  {},
+	"idnumber":             {},
+	"id_number":            {},
+	"phonenumber":          {},
+	"phone_number":         {},
 }
 
 func Info(message string, fields Fields) {
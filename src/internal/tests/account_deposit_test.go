@@ -0,0 +1,127 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type depositingAccountRepoStub struct {
+	lifecycleAccountRepoStub
+	deposits map[string]domain.Deposit
+	postings int
+}
+
+func (s *depositingAccountRepoStub) DepositFunds(_ context.Context, deposit domain.Deposit) (domain.Deposit, error) {
+	key := string(deposit.Source) + "|" + deposit.ExternalReference
+	if _, ok := s.deposits[key]; ok {
+		return domain.Deposit{}, commons.ErrDuplicateExternalReference
+	}
+	deposit.ID = "d-1"
+	deposit.JournalEntries = []domain.JournalEntry{
+		{AccountNumber: deposit.FundingGLAccountNumber, EntryType: domain.LedgerEntryDebit, Currency: deposit.Currency, Amount: deposit.Amount},
+		{AccountNumber: deposit.AccountNumber, EntryType: domain.LedgerEntryCredit, Currency: deposit.Currency, Amount: deposit.Amount},
+	}
+	s.deposits[key] = deposit
+	s.postings++
+	return deposit, nil
+}
+
+func (s *depositingAccountRepoStub) GetDepositByExternalReference(_ context.Context, source domain.DepositSource, externalReference string) (domain.Deposit, error) {
+	deposit, ok := s.deposits[string(source)+"|"+externalReference]
+	if !ok {
+		return domain.Deposit{}, commons.ErrRecordNotFound
+	}
+	return deposit, nil
+}
+
+func newDepositAccountService() (*services.AccountService, *depositingAccountRepoStub) {
+	repo := &depositingAccountRepoStub{
+		lifecycleAccountRepoStub: lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+			"0000000028": {CustomerID: "C1", AccountNumber: "0000000028", Currency: "NGN", Status: domain.AccountStatusActive},
+		}},
+		deposits: map[string]domain.Deposit{},
+	}
+	svc := services.NewAccountService(repo, nil, nil, nil, nil, nil, nil, nil, "100100", "0123456890", "", "", nil, map[string]string{
+		"NGN": "0125548988",
+	})
+	return svc, repo
+}
+
+func TestDepositFunds_PostsAgainstFundingGLOfAccountCurrency(t *testing.T) {
+	svc, repo := newDepositAccountService()
+
+	resp, err := svc.DepositFunds(context.Background(), models.DepositFundsRequest{
+		AccountNumber:     "0000000028",
+		Amount:            decimal.NewFromInt(5000),
+		Source:            "external_bank",
+		ExternalReference: "NIP-0001",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+
+	deposit := repo.deposits["EXTERNAL_BANK|NIP-0001"]
+	if deposit.FundingGLAccountNumber != "0125548988" || deposit.Currency != "NGN" {
+		t.Fatalf("expected NGN funding GL contra entry, got %q in %s", deposit.FundingGLAccountNumber, deposit.Currency)
+	}
+	if deposit.Reference == "" || resp.Data == nil || resp.Data.Reference != deposit.Reference {
+		t.Fatalf("expected generated reference in response, got %+v", resp.Data)
+	}
+	if len(resp.Data.JournalEntries) != 2 || resp.Data.JournalEntries[0].EntryType != "DEBIT" {
+		t.Fatalf("expected funding GL debit and account credit, got %+v", resp.Data.JournalEntries)
+	}
+}
+
+func TestDepositFunds_ReplayReturnsOriginalDeposit(t *testing.T) {
+	svc, repo := newDepositAccountService()
+	req := models.DepositFundsRequest{
+		AccountNumber:     "0000000028",
+		Amount:            decimal.NewFromInt(5000),
+		Source:            "CARD",
+		ExternalReference: "AUTH-77",
+	}
+
+	first, err := svc.DepositFunds(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	replay, err := svc.DepositFunds(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected replay to succeed, got %v (%s)", err, replay.Message)
+	}
+
+	if repo.postings != 1 {
+		t.Fatalf("expected a single posting, got %d", repo.postings)
+	}
+	if replay.Message != "deposit already recorded" || replay.Data.Reference != first.Data.Reference {
+		t.Fatalf("expected original deposit on replay, got %q (%+v)", replay.Message, replay.Data)
+	}
+}
+
+func TestDepositFunds_RejectsReusedExternalReference(t *testing.T) {
+	svc, repo := newDepositAccountService()
+	req := models.DepositFundsRequest{
+		AccountNumber:     "0000000028",
+		Amount:            decimal.NewFromInt(5000),
+		Source:            "CASH",
+		ExternalReference: "TELLER-1",
+	}
+	if _, err := svc.DepositFunds(context.Background(), req); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	req.Amount = decimal.NewFromInt(7000)
+	resp, err := svc.DepositFunds(context.Background(), req)
+	if err == nil || resp.Message != "Duplicate external reference" {
+		t.Fatalf("expected duplicate external reference, got %v (%s)", err, resp.Message)
+	}
+	if repo.postings != 1 {
+		t.Fatalf("expected a single posting, got %d", repo.postings)
+	}
+}
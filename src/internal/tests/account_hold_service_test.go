@@ -0,0 +1,259 @@
+package services_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type accountHoldRepoStub struct {
+	holds         map[string]domain.AccountHold
+	expired       []domain.AccountHold
+	captureAmount decimal.Decimal
+	released      []domain.AccountHoldStatus
+}
+
+func (s *accountHoldRepoStub) PlaceHold(_ context.Context, hold domain.AccountHold) (domain.AccountHold, error) {
+	hold.Status = domain.AccountHoldStatusActive
+	s.holds[hold.Reference] = hold
+	return hold, nil
+}
+
+func (s *accountHoldRepoStub) ReleaseHold(_ context.Context, _ string, reference string, status domain.AccountHoldStatus) (domain.AccountHold, error) {
+	hold, ok := s.holds[reference]
+	if !ok || hold.Status != domain.AccountHoldStatusActive {
+		return domain.AccountHold{}, commons.ErrRecordNotFound
+	}
+	hold.Status = status
+	s.holds[reference] = hold
+	s.released = append(s.released, status)
+	return hold, nil
+}
+
+func (s *accountHoldRepoStub) CaptureHold(_ context.Context, _ string, reference string, amount decimal.Decimal, _ string) (domain.AccountHold, error) {
+	hold := s.holds[reference]
+	if hold.ExpiresAt != nil && !hold.ExpiresAt.After(time.Now()) {
+		return domain.AccountHold{}, commons.ErrAccountHoldExpired
+	}
+	hold.Status = domain.AccountHoldStatusCaptured
+	hold.CapturedAmount = amount
+	s.captureAmount = amount
+	return hold, nil
+}
+
+func (s *accountHoldRepoStub) GetByReference(_ context.Context, _ string, reference string) (domain.AccountHold, error) {
+	hold, ok := s.holds[reference]
+	if !ok {
+		return domain.AccountHold{}, commons.ErrRecordNotFound
+	}
+	return hold, nil
+}
+
+func (s *accountHoldRepoStub) GetByAccountNumber(context.Context, string) ([]domain.AccountHold, error) {
+	return nil, nil
+}
+
+func (s *accountHoldRepoStub) GetExpired(context.Context, int) ([]domain.AccountHold, error) {
+	expired := s.expired
+	s.expired = nil
+	return expired, nil
+}
+
+func newAccountHoldRepoStub(holds ...domain.AccountHold) *accountHoldRepoStub {
+	repo := &accountHoldRepoStub{holds: map[string]domain.AccountHold{}}
+	for _, hold := range holds {
+		repo.holds[hold.Reference] = hold
+	}
+	return repo
+}
+
+func TestAccountHoldServicePlaceHoldRejectsTransferType(t *testing.T) {
+	svc := services.NewAccountHoldService(newAccountHoldRepoStub(), nil, "0123456789")
+
+	response, err := svc.PlaceHold(context.Background(), models.PlaceAccountHoldRequest{
+		AccountNumber: "0000000011",
+		HoldType:      "TRANSFER",
+		Amount:        decimal.NewFromInt(10),
+		Reason:        "manual",
+		Reference:     "H-1",
+	})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %v (%s)", err, response.Message)
+	}
+}
+
+func TestAccountHoldServiceCaptureDefaultsToFullAmount(t *testing.T) {
+	repo := newAccountHoldRepoStub(domain.AccountHold{
+		AccountNumber: "0000000011",
+		HoldType:      domain.AccountHoldTypeAuthorization,
+		Amount:        decimal.NewFromInt(25),
+		Reference:     "AUTH-1",
+		Status:        domain.AccountHoldStatusActive,
+	})
+	svc := services.NewAccountHoldService(repo, nil, "0123456789")
+
+	response, err := svc.CaptureHold(context.Background(), models.CaptureAccountHoldRequest{AccountNumber: "0000000011", Reference: "AUTH-1"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !repo.captureAmount.Equal(decimal.NewFromInt(25)) || response.Data.Status != string(domain.AccountHoldStatusCaptured) {
+		t.Fatalf("expected full capture, got %s (%s)", repo.captureAmount, response.Data.Status)
+	}
+}
+
+func TestAccountHoldServiceCaptureRejectsAmountAboveHold(t *testing.T) {
+	repo := newAccountHoldRepoStub(domain.AccountHold{
+		AccountNumber: "0000000011",
+		HoldType:      domain.AccountHoldTypeAuthorization,
+		Amount:        decimal.NewFromInt(25),
+		Reference:     "AUTH-1",
+		Status:        domain.AccountHoldStatusActive,
+	})
+	svc := services.NewAccountHoldService(repo, nil, "0123456789")
+
+	amount := decimal.NewFromInt(30)
+	response, err := svc.CaptureHold(context.Background(), models.CaptureAccountHoldRequest{AccountNumber: "0000000011", Reference: "AUTH-1", Amount: &amount})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %v (%s)", err, response.Message)
+	}
+	if !repo.captureAmount.IsZero() {
+		t.Fatal("expected no capture")
+	}
+}
+
+func TestAccountHoldServiceReleaseRejectsTransferHold(t *testing.T) {
+	repo := newAccountHoldRepoStub(domain.AccountHold{
+		AccountNumber: "0000000011",
+		HoldType:      domain.AccountHoldTypeTransfer,
+		Amount:        decimal.NewFromInt(25),
+		Reference:     "tr-1",
+		Status:        domain.AccountHoldStatusActive,
+	})
+	svc := services.NewAccountHoldService(repo, nil, "0123456789")
+
+	_, err := svc.ReleaseHold(context.Background(), models.ReleaseAccountHoldRequest{AccountNumber: "0000000011", Reference: "tr-1"})
+	if err == nil || len(repo.released) != 0 {
+		t.Fatalf("expected transfer hold to stay active, got err=%v released=%v", err, repo.released)
+	}
+}
+
+func TestAccountHoldServiceLienNeedsBackOffice(t *testing.T) {
+	lien := domain.AccountHold{
+		AccountNumber: "0000000011",
+		HoldType:      domain.AccountHoldTypeLien,
+		Amount:        decimal.NewFromInt(25),
+		Reference:     "LIEN-1",
+		Status:        domain.AccountHoldStatusActive,
+	}
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {AccountNumber: "0000000011", CustomerID: "C1", Currency: "USD", Status: domain.AccountStatusActive},
+	}}
+	accountsChannel := commons.WithChannelScopes(commons.WithChannelID(context.Background(), "GreyApp"), []string{string(domain.ChannelScopeAccountsWrite)})
+	backOfficeChannel := commons.WithChannelScopes(commons.WithChannelID(context.Background(), "Console"), []string{string(domain.ChannelScopeBackOffice)})
+	// A customer token is refused even through a back-office channel.
+	customerViaBackOffice := commons.WithPrincipal(backOfficeChannel, commons.Principal{Subject: "user-C1", CustomerID: "C1"})
+
+	svc := services.NewAccountHoldService(newAccountHoldRepoStub(), accounts, "0123456789")
+	_, err := svc.PlaceHold(customerContext("C1"), models.PlaceAccountHoldRequest{
+		AccountNumber: "0000000011",
+		HoldType:      "LIEN",
+		Amount:        decimal.NewFromInt(10),
+		Reason:        "court order",
+		Reference:     "LIEN-2",
+	})
+	if !errors.Is(err, commons.ErrBackOfficeRequired) {
+		t.Fatalf("expected a customer to be refused a lien, got %v", err)
+	}
+
+	for name, ctx := range map[string]context.Context{
+		"customer token":               customerContext("C1"),
+		"accounts channel":             accountsChannel,
+		"customer through back office": customerViaBackOffice,
+	} {
+		repo := newAccountHoldRepoStub(lien)
+		svc := services.NewAccountHoldService(repo, accounts, "0123456789")
+		if _, err := svc.ReleaseHold(ctx, models.ReleaseAccountHoldRequest{AccountNumber: "0000000011", Reference: "LIEN-1"}); !errors.Is(err, commons.ErrBackOfficeRequired) {
+			t.Fatalf("%s: expected release to be refused, got %v", name, err)
+		}
+		if _, err := svc.CaptureHold(ctx, models.CaptureAccountHoldRequest{AccountNumber: "0000000011", Reference: "LIEN-1"}); !errors.Is(err, commons.ErrBackOfficeRequired) {
+			t.Fatalf("%s: expected capture to be refused, got %v", name, err)
+		}
+		if len(repo.released) != 0 || !repo.captureAmount.IsZero() {
+			t.Fatalf("%s: expected the lien to stay active", name)
+		}
+	}
+
+	repo := newAccountHoldRepoStub(lien)
+	svc = services.NewAccountHoldService(repo, accounts, "0123456789")
+	if response, err := svc.ReleaseHold(backOfficeChannel, models.ReleaseAccountHoldRequest{AccountNumber: "0000000011", Reference: "LIEN-1"}); err != nil {
+		t.Fatalf("expected the back office to release the lien, got %v (%s)", err, response.Message)
+	}
+}
+
+func TestAccountHoldServiceExpireHoldsSkipsResolvedHolds(t *testing.T) {
+	due := domain.AccountHold{AccountNumber: "0000000011", Reference: "AUTH-1", Status: domain.AccountHoldStatusActive}
+	repo := newAccountHoldRepoStub(due)
+	repo.expired = []domain.AccountHold{due, {AccountNumber: "0000000011", Reference: "AUTH-gone"}}
+	svc := services.NewAccountHoldService(repo, nil, "0123456789")
+
+	expired, err := svc.ExpireHolds(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if expired != 1 || len(repo.released) != 1 || repo.released[0] != domain.AccountHoldStatusExpired {
+		t.Fatalf("expected one EXPIRED release, got %d %v", expired, repo.released)
+	}
+}
+
+func TestAccountHoldServiceCaptureRefusesExpiredHold(t *testing.T) {
+	expiredAt := time.Now().Add(-time.Minute)
+	repo := newAccountHoldRepoStub(domain.AccountHold{
+		AccountNumber: "0000000011",
+		HoldType:      domain.AccountHoldTypeAuthorization,
+		Amount:        decimal.NewFromInt(25),
+		Reference:     "AUTH-1",
+		Status:        domain.AccountHoldStatusActive,
+		ExpiresAt:     &expiredAt,
+	})
+	svc := services.NewAccountHoldService(repo, nil, "0123456789")
+
+	response, err := svc.CaptureHold(context.Background(), models.CaptureAccountHoldRequest{AccountNumber: "0000000011", Reference: "AUTH-1"})
+	if !errors.Is(err, commons.ErrAccountHoldExpired) || response.Message != "Account hold has expired" {
+		t.Fatalf("expected expired hold refusal, got %v (%s)", err, response.Message)
+	}
+	if !repo.captureAmount.IsZero() {
+		t.Fatal("expected no capture")
+	}
+}
+
+func TestAccountHoldRepositoryCaptureSkipsExpiredHold(t *testing.T) {
+	db, recorder := openRecordingDB(t, func(query string) *recordingRows {
+		if strings.Contains(query, "SELECT EXISTS") {
+			return &recordingRows{columns: []string{"exists"}, values: [][]driver.Value{{true}}}
+		}
+		return nil
+	})
+	repo := implementations.NewAccountHoldRepository(db)
+
+	_, err := repo.CaptureHold(context.Background(), "0000000011", "AUTH-1", decimal.NewFromInt(25), "0123456789")
+	if !errors.Is(err, commons.ErrAccountHoldExpired) {
+		t.Fatalf("expected ErrAccountHoldExpired, got %v", err)
+	}
+	capture, ok := recorder.find("SET status = 'CAPTURED'")
+	if !ok || !strings.Contains(capture.query, "AND (expires_at IS NULL OR expires_at > NOW())") {
+		t.Fatalf("expected the capture to exclude expired holds, got %q", capture.query)
+	}
+	if recorder.committed {
+		t.Fatal("expected nothing to be committed")
+	}
+}
@@ -0,0 +1,308 @@
+package services_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type lifecycleAccountRepoStub struct {
+	accounts  map[string]domain.Account
+	closeErr  error
+	sweep     *domain.AccountSweep
+	lastEvent domain.AccountStatusEvent
+}
+
+func (s *lifecycleAccountRepoStub) Create(context.Context, domain.Account) (domain.Account, error) {
+	return domain.Account{}, nil
+}
+
+func (s *lifecycleAccountRepoStub) NextAccountSerial(context.Context) (int64, error) {
+	return 1, nil
+}
+
+func (s *lifecycleAccountRepoStub) GetByAccountNumber(_ context.Context, accountNumber string) (domain.Account, error) {
+	account, ok := s.accounts[accountNumber]
+	if !ok {
+		return domain.Account{}, commons.ErrRecordNotFound
+	}
+	return account, nil
+}
+
+func (s *lifecycleAccountRepoStub) GetByCustomerID(_ context.Context, customerID string) ([]domain.Account, error) {
+	accounts := make([]domain.Account, 0)
+	for _, account := range s.accounts {
+		if account.CustomerID == customerID {
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].AccountNumber < accounts[j].AccountNumber })
+	return accounts, nil
+}
+
+func (s *lifecycleAccountRepoStub) HasAccountForCustomerIDAndCurrency(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (s *lifecycleAccountRepoStub) DebitInternalAccount(context.Context, string, decimal.Decimal) error {
+	return nil
+}
+
+func (s *lifecycleAccountRepoStub) CreditInternalAccount(context.Context, string, decimal.Decimal) error {
+	return nil
+}
+
+func (s *lifecycleAccountRepoStub) DepositFunds(_ context.Context, deposit domain.Deposit) (domain.Deposit, error) {
+	return deposit, nil
+}
+
+func (s *lifecycleAccountRepoStub) GetDeposit(context.Context, string) (domain.Deposit, error) {
+	return domain.Deposit{}, commons.ErrRecordNotFound
+}
+
+func (s *lifecycleAccountRepoStub) GetDepositByExternalReference(context.Context, domain.DepositSource, string) (domain.Deposit, error) {
+	return domain.Deposit{}, commons.ErrRecordNotFound
+}
+
+func (s *lifecycleAccountRepoStub) ChangeStatus(_ context.Context, accountNumber string, from domain.AccountStatus, event domain.AccountStatusEvent) (domain.Account, error) {
+	account, ok := s.accounts[accountNumber]
+	if !ok || account.Status != from {
+		return domain.Account{}, commons.ErrRecordNotFound
+	}
+	account.Status = event.ToStatus
+	s.accounts[accountNumber] = account
+	s.lastEvent = event
+	return account, nil
+}
+
+func (s *lifecycleAccountRepoStub) CloseAccount(_ context.Context, accountNumber string, sweep *domain.AccountSweep, event domain.AccountStatusEvent) (domain.Account, error) {
+	if s.closeErr != nil {
+		return domain.Account{}, s.closeErr
+	}
+	s.sweep = sweep
+	s.lastEvent = event
+	account := s.accounts[accountNumber]
+	account.Status = domain.AccountStatusClosed
+	account.AvailableBalance = decimal.Zero
+	account.LedgerBalance = decimal.Zero
+	return account, nil
+}
+
+func (s *lifecycleAccountRepoStub) GetStatusHistory(context.Context, string) ([]domain.AccountStatusEvent, error) {
+	return nil, nil
+}
+
+type lifecycleRateRepoStub struct{}
+
+func (lifecycleRateRepoStub) GetRates(context.Context) ([]domain.Rate, error) {
+	return nil, nil
+}
+
+func (lifecycleRateRepoStub) GetRate(_ context.Context, from string, to string) (domain.Rate, error) {
+	return domain.Rate{FromCurrency: from, ToCurrency: to, Rate: decimal.RequireFromString("1500")}, nil
+}
+
+func newLifecycleAccountService() (*services.AccountService, *lifecycleAccountRepoStub) {
+	repo := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", LedgerBalance: decimal.NewFromInt(10), Status: domain.AccountStatusActive},
+		"0000000028": {CustomerID: "C1", AccountNumber: "0000000028", Currency: "NGN", Status: domain.AccountStatusActive},
+		"0000000035": {CustomerID: "C2", AccountNumber: "0000000035", Currency: "USD", Status: domain.AccountStatusActive},
+	}}
+	svc := services.NewAccountService(repo, nil, nil, lifecycleRateRepoStub{}, nil, nil, nil, nil, "100100", "0123456789", "", "", nil, nil)
+	return svc, repo
+}
+
+func opsOfficerContext() context.Context {
+	return commons.WithPrincipal(context.Background(), commons.Principal{Subject: "ops-1", Scopes: []string{string(domain.ChannelScopeBackOffice)}})
+}
+
+func TestAccountLifecycleFreezeAndUnfreeze(t *testing.T) {
+	svc, repo := newLifecycleAccountService()
+	req := models.AccountStatusChangeRequest{
+		AccountNumber: "0000000011",
+		ReasonCode:    "court_order",
+	}
+
+	response, err := svc.FreezeAccount(opsOfficerContext(), req)
+	if err != nil || response.Data == nil || response.Data.Status != string(domain.AccountStatusFrozen) {
+		t.Fatalf("expected frozen account, got %v (%+v)", err, response.Data)
+	}
+	if repo.lastEvent.Reason != domain.AccountStatusReasonCourtOrder || repo.lastEvent.Actor != "ops-1" {
+		t.Fatalf("unexpected status event %+v", repo.lastEvent)
+	}
+
+	response, err = svc.FreezeAccount(opsOfficerContext(), req)
+	if err == nil || response.Message != "Account status does not allow this change" {
+		t.Fatalf("expected status conflict on second freeze, got %v (%s)", err, response.Message)
+	}
+
+	response, err = svc.UnfreezeAccount(opsOfficerContext(), req)
+	if err != nil || response.Data.Status != string(domain.AccountStatusActive) {
+		t.Fatalf("expected active account, got %v (%s)", err, response.Message)
+	}
+}
+
+func TestAccountLifecycleFreezeRejectsUnknownReason(t *testing.T) {
+	svc, _ := newLifecycleAccountService()
+
+	response, err := svc.FreezeAccount(opsOfficerContext(), models.AccountStatusChangeRequest{
+		AccountNumber: "0000000011",
+		ReasonCode:    "BORED",
+	})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected validation error, got %v (%s)", err, response.Message)
+	}
+}
+
+func TestAccountLifecycleCloseSweepsWithConversion(t *testing.T) {
+	svc, repo := newLifecycleAccountService()
+
+	response, err := svc.CloseAccount(opsOfficerContext(), models.CloseAccountRequest{
+		AccountNumber:      "0000000011",
+		ReasonCode:         "CUSTOMER_REQUEST",
+		SweepAccountNumber: "0000000028",
+	})
+	if err != nil || response.Data.Status != string(domain.AccountStatusClosed) {
+		t.Fatalf("expected closed account, got %v (%s)", err, response.Message)
+	}
+	if repo.sweep == nil || repo.sweep.DestinationAccountNumber != "0000000028" || !repo.sweep.Rate.Equal(decimal.NewFromInt(1500)) || repo.sweep.SuspenseAccountNumber != "0123456789" {
+		t.Fatalf("unexpected sweep %+v", repo.sweep)
+	}
+}
+
+func TestAccountLifecycleCloseRejectsOtherCustomersSweepAccount(t *testing.T) {
+	svc, repo := newLifecycleAccountService()
+
+	response, err := svc.CloseAccount(opsOfficerContext(), models.CloseAccountRequest{
+		AccountNumber:      "0000000011",
+		ReasonCode:         "CUSTOMER_REQUEST",
+		SweepAccountNumber: "0000000035",
+	})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected validation error, got %v (%s)", err, response.Message)
+	}
+	if repo.sweep != nil {
+		t.Fatal("expected no closure attempt")
+	}
+}
+
+func TestAccountLifecycleCloseRequiresZeroBalanceWithoutSweep(t *testing.T) {
+	svc, repo := newLifecycleAccountService()
+	repo.closeErr = commons.ErrAccountBalanceNotZero
+
+	response, err := svc.CloseAccount(opsOfficerContext(), models.CloseAccountRequest{
+		AccountNumber: "0000000011",
+		ReasonCode:    "CUSTOMER_REQUEST",
+	})
+	if !errors.Is(err, commons.ErrAccountBalanceNotZero) || response.Message != "Account balance must be zero" {
+		t.Fatalf("expected balance error, got %v (%s)", err, response.Message)
+	}
+}
+
+func TestAccountLifecycleRequiresAuthenticatedRequester(t *testing.T) {
+	svc, repo := newLifecycleAccountService()
+
+	response, err := svc.FreezeAccount(context.Background(), models.AccountStatusChangeRequest{
+		AccountNumber: "0000000011",
+		ReasonCode:    "FRAUD",
+	})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected validation error, got %v (%s)", err, response.Message)
+	}
+	if repo.accounts["0000000011"].Status != domain.AccountStatusActive {
+		t.Fatal("expected the account to stay active")
+	}
+}
+
+func TestAccountLifecycleCloseRefusesSweepOfFrozenAccount(t *testing.T) {
+	svc, repo := newLifecycleAccountService()
+	account := repo.accounts["0000000011"]
+	account.Status = domain.AccountStatusFrozen
+	repo.accounts["0000000011"] = account
+
+	response, err := svc.CloseAccount(opsOfficerContext(), models.CloseAccountRequest{
+		AccountNumber:      "0000000011",
+		ReasonCode:         "CUSTOMER_REQUEST",
+		SweepAccountNumber: "0000000028",
+	})
+	if !errors.Is(err, commons.ErrFrozenAccountSweep) || response.Message != "Frozen account balance cannot be swept" {
+		t.Fatalf("expected frozen sweep error, got %v (%s)", err, response.Message)
+	}
+	if repo.sweep != nil {
+		t.Fatal("expected no closure attempt")
+	}
+}
+
+const accountColumnNames = "id, customer_id, account_number, currency, product, available_balance, ledger_balance, overdraft_limit, status, last_activity_at, created_at, updated_at"
+
+func closingAccountRow(status domain.AccountStatus) []driver.Value {
+	now := time.Now()
+	return []driver.Value{"acc-1", "C1", "0000000011", "USD", "SAVINGS", "10", "10", "0", string(status), now, now, now}
+}
+
+func TestAccountRepositoryCloseSweepDebitsSuspenseInSourceCurrency(t *testing.T) {
+	db, recorder := openRecordingDB(t, func(query string) *recordingRows {
+		switch {
+		case strings.Contains(query, "FOR UPDATE"):
+			return &recordingRows{columns: strings.Split(accountColumnNames, ", "), values: [][]driver.Value{closingAccountRow(domain.AccountStatusActive)}}
+		case strings.Contains(query, "SELECT EXISTS"):
+			return &recordingRows{columns: []string{"exists"}, values: [][]driver.Value{{false}}}
+		case strings.Contains(query, "RETURNING customer_id, currency"):
+			return &recordingRows{columns: []string{"customer_id", "currency", "available_balance", "ledger_balance"}, values: [][]driver.Value{{"C1", "USD", "0", "0"}}}
+		case strings.Contains(query, "SET status = 'CLOSED'"):
+			return &recordingRows{columns: strings.Split(accountColumnNames, ", "), values: [][]driver.Value{closingAccountRow(domain.AccountStatusClosed)}}
+		}
+		return nil
+	})
+	repo := implementations.NewAccountRepository(db)
+
+	_, err := repo.CloseAccount(context.Background(), "0000000011", &domain.AccountSweep{
+		DestinationAccountNumber: "0000000028",
+		Rate:                     decimal.NewFromInt(1500),
+		SuspenseAccountNumber:    "0123456789",
+	}, domain.AccountStatusEvent{Reason: domain.AccountStatusReasonCustomerRequest, Actor: "ops-1"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	debit, ok := recorder.find("AND available_balance >= $2::numeric")
+	if !ok || fmt.Sprint(debit.args[1].Value) != "10" {
+		t.Fatalf("expected suspense to be debited with the source balance, got %+v", debit.args)
+	}
+	if !recorder.committed {
+		t.Fatal("expected the closure to commit")
+	}
+}
+
+func TestAccountRepositoryCloseRefusesSweepOfFrozenAccount(t *testing.T) {
+	db, recorder := openRecordingDB(t, func(query string) *recordingRows {
+		if strings.Contains(query, "FOR UPDATE") {
+			return &recordingRows{columns: strings.Split(accountColumnNames, ", "), values: [][]driver.Value{closingAccountRow(domain.AccountStatusFrozen)}}
+		}
+		return nil
+	})
+	repo := implementations.NewAccountRepository(db)
+
+	_, err := repo.CloseAccount(context.Background(), "0000000011", &domain.AccountSweep{
+		DestinationAccountNumber: "0000000028",
+		Rate:                     decimal.NewFromInt(1),
+		SuspenseAccountNumber:    "0123456789",
+	}, domain.AccountStatusEvent{Reason: domain.AccountStatusReasonCourtOrder, Actor: "ops-1"})
+	if !errors.Is(err, commons.ErrFrozenAccountSweep) {
+		t.Fatalf("expected ErrFrozenAccountSweep, got %v", err)
+	}
+	if _, swept := recorder.find("UPDATE transient_accounts"); swept || recorder.committed {
+		t.Fatal("expected no sweep to be posted")
+	}
+}
@@ -0,0 +1,144 @@
+package services_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type allocatingAccountRepoStub struct {
+	lifecycleAccountRepoStub
+	nextSerial int64
+	taken      map[string]bool
+	attempts   []string
+}
+
+func (s *allocatingAccountRepoStub) NextAccountSerial(context.Context) (int64, error) {
+	s.nextSerial++
+	return s.nextSerial, nil
+}
+
+func (s *allocatingAccountRepoStub) Create(_ context.Context, account domain.Account) (domain.Account, error) {
+	s.attempts = append(s.attempts, account.AccountNumber)
+	if s.taken[account.AccountNumber] {
+		return domain.Account{}, &pq.Error{Code: "23505"}
+	}
+	return account, nil
+}
+
+func TestAccountNumberCheckDigitRoundTrip(t *testing.T) {
+	accountNumber, err := commons.BuildAccountNumber("100100", 12345678)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if accountNumber != "0123456788" || !commons.IsValidAccountNumber("100100", accountNumber) {
+		t.Fatalf("unexpected account number %s", accountNumber)
+	}
+
+	typo := "0123457788"
+	if commons.IsValidAccountNumber("100100", typo) {
+		t.Fatalf("expected single-digit typo %s to fail the check digit", typo)
+	}
+	if commons.IsValidAccountNumber("100200", accountNumber) {
+		t.Fatal("expected account number to fail against another bank code")
+	}
+	if _, err := commons.BuildAccountNumber("100100", 1_000_000_000); err == nil {
+		t.Fatal("expected error for serial beyond 9 digits")
+	}
+}
+
+func TestTransferRequestRejectsInvalidCheckDigit(t *testing.T) {
+	req := models.InternalTransferRequest{
+		DebitAccountNumber:  "0000000011",
+		CreditAccountNumber: "0000000012",
+		BeneficiaryBankCode: "100100",
+		TransactionPIN:      "1234",
+		DebitBankName:       "Grey",
+		CreditBankName:      "Grey",
+		DebitCurrency:       "USD",
+		CreditCurrency:      "USD",
+		DebitAmount:         decimal.NewFromInt(10),
+		Narration:           "Salary",
+	}
+
+	err := req.Validate()
+	if err == nil || err.Error() != "creditAccountNumber has an invalid check digit" {
+		t.Fatalf("expected check digit error, got %v", err)
+	}
+
+	req.CreditAccountNumber = "0000000028"
+	if err := req.Validate(); err != nil {
+		t.Fatalf("expected valid request, got %v", err)
+	}
+}
+
+func TestWithdrawRequestAcceptsLegacyAccountNumber(t *testing.T) {
+	t.Cleanup(func() { models.SetLegacyAccountNumbers(nil) })
+	req := models.WithdrawFundsRequest{
+		AccountNumber:  "0000000012",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(10),
+		TransactionPIN: "1234",
+	}
+
+	if err := req.Validate(); err == nil || err.Error() != "accountNumber has an invalid check digit" {
+		t.Fatalf("expected check digit error, got %v", err)
+	}
+
+	models.SetLegacyAccountNumbers([]string{"0000000012"})
+	if err := req.Validate(); err != nil {
+		t.Fatalf("expected legacy account number to be accepted, got %v", err)
+	}
+
+	req.AccountNumber = "0000000013"
+	if err := req.Validate(); err == nil || err.Error() != "accountNumber has an invalid check digit" {
+		t.Fatalf("expected check digit error for an unknown number, got %v", err)
+	}
+}
+
+func TestAccountRepositoryGetLegacyAccountNumbersSkipsCheckDigitNumbers(t *testing.T) {
+	db, _ := openRecordingDB(t, func(query string) *recordingRows {
+		if strings.Contains(query, "SELECT account_number FROM accounts") {
+			return &recordingRows{columns: []string{"account_number"}, values: [][]driver.Value{{"0000000012"}, {"0000000028"}}}
+		}
+		return nil
+	})
+	repo := implementations.NewAccountRepository(db)
+
+	legacy, err := repo.GetLegacyAccountNumbers(context.Background(), "100100")
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(legacy) != 1 || legacy[0] != "0000000012" {
+		t.Fatalf("expected only the number without a check digit, got %v", legacy)
+	}
+}
+
+func TestAccountServiceCreateAccountRetriesTakenNumber(t *testing.T) {
+	taken, _ := commons.BuildAccountNumber("100100", 1)
+	repo := &allocatingAccountRepoStub{taken: map[string]bool{taken: true}}
+	svc := services.NewAccountService(repo, userRepoStub{}, nil, nil, nil, nil, nil, nil, "100100", "0123456789", "", "", nil, nil)
+
+	response, err := svc.CreateAccount(context.Background(), models.CreateAccountRequest{
+		CustomerID: "C1",
+		Currency:   "USD",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(repo.attempts) != 2 || response.Data.AccountNumber != repo.attempts[1] {
+		t.Fatalf("expected a second allocation, got attempts %v and %s", repo.attempts, response.Data.AccountNumber)
+	}
+	if !commons.IsValidAccountNumber("100100", response.Data.AccountNumber) {
+		t.Fatalf("expected a valid check digit on %s", response.Data.AccountNumber)
+	}
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func TestAccountServiceCreateAccountValidationError(t *testing.T) {
-	svc := services.NewAccountService(nil, nil, nil, "100100")
+	svc := services.NewAccountService(nil, nil, nil, nil, nil, nil, nil, nil, "100100", "0123456789", "", "", nil, nil)
 
 	_, err := svc.CreateAccount(context.Background(), models.CreateAccountRequest{})
 	if err == nil {
@@ -19,7 +19,7 @@ func TestAccountServiceCreateAccountValidationError(t *testing.T) {
 }
 
 func TestAccountServiceGetAccountValidationError(t *testing.T) {
-	svc := services.NewAccountService(nil, nil, nil, "100100")
+	svc := services.NewAccountService(nil, nil, nil, nil, nil, nil, nil, nil, "100100", "0123456789", "", "", nil, nil)
 
 	_, err := svc.GetAccount(context.Background(), "", "100100")
 	if err == nil {
@@ -28,7 +28,7 @@ func TestAccountServiceGetAccountValidationError(t *testing.T) {
 }
 
 func TestAccountServiceDepositFundsValidationError(t *testing.T) {
-	svc := services.NewAccountService(nil, nil, nil, "100100")
+	svc := services.NewAccountService(nil, nil, nil, nil, nil, nil, nil, nil, "100100", "0123456789", "", "", nil, nil)
 
 	_, err := svc.DepositFunds(context.Background(), models.DepositFundsRequest{
 		AccountNumber: "123",
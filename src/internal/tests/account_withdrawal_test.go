@@ -0,0 +1,335 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type withdrawalRepoStub struct {
+	created []domain.Withdrawal
+	posting domain.WithdrawalPosting
+}
+
+func (s *withdrawalRepoStub) Create(_ context.Context, withdrawal domain.Withdrawal, posting domain.WithdrawalPosting) (domain.Withdrawal, error) {
+	withdrawal.ID = "w-1"
+	withdrawal.JournalEntries = []domain.JournalEntry{
+		{AccountNumber: withdrawal.AccountNumber, EntryType: domain.LedgerEntryDebit, Currency: withdrawal.Currency, Amount: withdrawal.TotalDebit},
+		{AccountNumber: withdrawal.CashGLAccountNumber, EntryType: domain.LedgerEntryCredit, Currency: withdrawal.Currency, Amount: withdrawal.Amount},
+	}
+	s.created = append(s.created, withdrawal)
+	s.posting = posting
+	return withdrawal, nil
+}
+
+func newWithdrawalAccountService(t *testing.T, accounts map[string]domain.Account) (*services.AccountService, *withdrawalRepoStub) {
+	t.Helper()
+
+	pinHash, err := bcrypt.GenerateFromPassword([]byte("1234"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash pin: %v", err)
+	}
+	return newWithdrawalAccountServiceForUser(t, accounts, userRepoStub{
+		getTransactionPinHashByCustFn: func(context.Context, string) (string, error) {
+			return string(pinHash), nil
+		},
+	})
+}
+
+func newWithdrawalAccountServiceForUser(t *testing.T, accounts map[string]domain.Account, userRepo userRepoStub) (*services.AccountService, *withdrawalRepoStub) {
+	t.Helper()
+
+	userService := services.NewUserService(userRepo, nil, testPinPolicy)
+	chargesService := services.NewChargesService(
+		lifecycleRateRepoStub{},
+		decimal.NewFromInt(1),
+		decimal.NewFromFloat(7.5),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(20),
+		decimal.NewFromFloat(0.5),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(10),
+		decimal.Zero,
+		decimal.Zero,
+		decimal.Zero,
+	)
+	withdrawalRepo := &withdrawalRepoStub{}
+	svc := services.NewAccountService(
+		&lifecycleAccountRepoStub{accounts: accounts},
+		nil,
+		nil,
+		lifecycleRateRepoStub{},
+		withdrawalRepo,
+		userService,
+		chargesService,
+		nil,
+		"100100",
+		"0123456890",
+		"0123445521",
+		"0125548976",
+		map[string]string{"USD": "0125548981"},
+		nil,
+	)
+	return svc, withdrawalRepo
+}
+
+func TestWithdrawFunds_PostsAmountAndFeesAgainstCashGL(t *testing.T) {
+	svc, repo := newWithdrawalAccountService(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(100), Status: domain.AccountStatusActive},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "usd",
+		Amount:         decimal.NewFromInt(50),
+		TransactionPIN: "1234",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 1 {
+		t.Fatalf("expected one withdrawal posted, got %d", len(repo.created))
+	}
+
+	withdrawal := repo.created[0]
+	if withdrawal.CashGLAccountNumber != "0125548981" {
+		t.Fatalf("expected USD cash GL account, got %q", withdrawal.CashGLAccountNumber)
+	}
+	// 0.5% of 50 is below the 1 USD minimum; VAT is 7.5% of the fee.
+	if !withdrawal.ChargeAmount.Equal(decimal.NewFromInt(1)) || !withdrawal.VATAmount.Equal(decimal.RequireFromString("0.08")) {
+		t.Fatalf("expected fees 1 + 0.08, got %s + %s", withdrawal.ChargeAmount, withdrawal.VATAmount)
+	}
+	if !withdrawal.TotalDebit.Equal(decimal.RequireFromString("51.08")) {
+		t.Fatalf("expected total debit 51.08, got %s", withdrawal.TotalDebit)
+	}
+	if withdrawal.Reference == "" {
+		t.Fatal("expected a generated reference")
+	}
+	if repo.posting.SuspenseAccountNumber != "0123456890" || repo.posting.ChargesAccountNumber != "0123445521" || repo.posting.VATAccountNumber != "0125548976" {
+		t.Fatalf("unexpected posting accounts: %+v", repo.posting)
+	}
+	if resp.Data == nil || resp.Data.Reference != withdrawal.Reference || len(resp.Data.JournalEntries) != 2 {
+		t.Fatalf("expected receipt with reference and journal entries, got %+v", resp.Data)
+	}
+}
+
+func TestWithdrawFunds_RejectsInvalidPIN(t *testing.T) {
+	svc, repo := newWithdrawalAccountService(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(100), Status: domain.AccountStatusActive},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(50),
+		TransactionPIN: "9999",
+	})
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no posting for an invalid PIN")
+	}
+}
+
+func TestWithdrawFunds_RejectsWhenFeesExceedAvailableBalance(t *testing.T) {
+	svc, repo := newWithdrawalAccountService(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(50), Status: domain.AccountStatusActive},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(50),
+		TransactionPIN: "1234",
+	})
+	if err == nil || resp.Message != "Insufficient balance" {
+		t.Fatalf("expected insufficient balance, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no posting when the balance is short")
+	}
+}
+
+func TestWithdrawFunds_RejectsFrozenAccount(t *testing.T) {
+	svc, repo := newWithdrawalAccountService(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(100), Status: domain.AccountStatusFrozen},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(50),
+		TransactionPIN: "1234",
+	})
+	if err == nil || resp.Message != "Account is not active" {
+		t.Fatalf("expected inactive account error, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no posting for a frozen account")
+	}
+}
+
+func TestWithdrawFunds_DrawsOnOverdraftLimit(t *testing.T) {
+	svc, repo := newWithdrawalAccountService(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(50), OverdraftLimit: decimal.NewFromInt(100), Status: domain.AccountStatusActive},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(100),
+		TransactionPIN: "1234",
+	})
+	if err != nil {
+		t.Fatalf("expected the overdraft to cover the shortfall, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 1 {
+		t.Fatalf("expected one withdrawal posted, got %d", len(repo.created))
+	}
+}
+
+func TestWithdrawFunds_RejectsDormantAccountWithReactivationHint(t *testing.T) {
+	svc, repo := newWithdrawalAccountService(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(500), Status: domain.AccountStatusDormant},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(100),
+		TransactionPIN: "1234",
+	})
+	if err == nil || resp.Message != "Account is not active" {
+		t.Fatalf("expected dormant account to be rejected, got %v (%s)", err, resp.Message)
+	}
+	if len(resp.Errors) == 0 || !strings.Contains(resp.Errors[0], "dormant") {
+		t.Fatalf("expected the error to mention dormancy, got %+v", resp.Errors)
+	}
+	if len(repo.created) != 0 {
+		t.Fatalf("expected no withdrawal posted")
+	}
+}
+
+func TestWithdrawFunds_RejectsLockedPinDistinctly(t *testing.T) {
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	svc, repo := newWithdrawalAccountServiceForUser(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(100), Status: domain.AccountStatusActive},
+	}, userRepoStub{
+		getPinStateFn: func(_ context.Context, customerID string) (domain.PinState, error) {
+			return domain.PinState{CustomerID: customerID, LockedUntil: &lockedUntil}, nil
+		},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(10),
+		TransactionPIN: "1234",
+	})
+	if !errors.Is(err, commons.ErrPinLocked) || resp.Message != "pin locked" {
+		t.Fatalf("expected pin locked error, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no withdrawal while the pin is locked")
+	}
+}
+
+func TestWithdrawFunds_AboveKYCLimitIsRefused(t *testing.T) {
+	pinHash, err := bcrypt.GenerateFromPassword([]byte("1234"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash pin: %v", err)
+	}
+	userRepo := userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			return domain.User{CustomerID: customerID, KYCLevel: 1}, nil
+		},
+		getTransactionPinHashByCustFn: func(context.Context, string) (string, error) {
+			return string(pinHash), nil
+		},
+	}
+	svc, repo := newWithdrawalAccountServiceForUser(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(5000), Status: domain.AccountStatusActive},
+	}, userRepo)
+	svc.EnableKYCLimits(userRepo, nil, domain.TransferLimitPolicy{
+		Currency:    "USD",
+		PerTransfer: map[int]decimal.Decimal{1: decimal.NewFromInt(1000), 2: decimal.NewFromInt(10000)},
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(2500),
+		TransactionPIN: "1234",
+	})
+	if !errors.Is(err, commons.ErrTransferLimitExceeded) || resp.Message != "Transfer limit exceeded" {
+		t.Fatalf("expected transfer limit exceeded, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no posting above the KYC limit")
+	}
+
+	resp, err = svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(900),
+		TransactionPIN: "1234",
+	})
+	if err != nil {
+		t.Fatalf("expected a withdrawal within the limit to succeed, got %v (%s)", err, resp.Message)
+	}
+}
+
+func TestWithdrawFunds_AboveStepUpThresholdWaitsForOTP(t *testing.T) {
+	userRepo := lockingUserRepo(t, "7294", &domain.PinState{CustomerID: "C1"}, nil)
+	svc, repo := newWithdrawalAccountServiceForUser(t, map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(50000), Status: domain.AccountStatusActive},
+	}, userRepo)
+	sender := &otpSenderStub{}
+	otps := &otpRepoStub{}
+	svc.EnableStepUp(services.NewOTPService(otps, userRepo, sender, 5*time.Minute, 3), nil, domain.TransferStepUpPolicy{
+		Currency:  "USD",
+		Threshold: decimal.NewFromInt(10000),
+	})
+
+	resp, err := svc.WithdrawFunds(context.Background(), models.WithdrawFundsRequest{
+		AccountNumber:  "0000000011",
+		Currency:       "USD",
+		Amount:         decimal.NewFromInt(25000),
+		TransactionPIN: "7294",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data == nil || resp.Data.StepUpChallenge == nil {
+		t.Fatalf("expected a step-up challenge, got %+v", resp.Data)
+	}
+	if len(repo.created) != 0 || len(sender.codes) != 1 {
+		t.Fatalf("expected no withdrawal and one code to be sent, got %d withdrawals and %d codes", len(repo.created), len(sender.codes))
+	}
+	challenge := otps.challenges[resp.Data.StepUpChallenge.ChallengeID]
+	if challenge.Purpose != domain.OTPPurposeWithdrawalStepUp || strings.Contains(challenge.Payload, "7294") {
+		t.Fatalf("expected the PIN-less request to be stored with the challenge, got %+v", challenge)
+	}
+
+	confirmResp, err := svc.ConfirmWithdrawal(context.Background(), models.ConfirmWithdrawalRequest{
+		AccountNumber: "0000000011",
+		ChallengeID:   resp.Data.StepUpChallenge.ChallengeID,
+		OTP:           sender.codes[0],
+	})
+	if err != nil {
+		t.Fatalf("expected confirmed withdrawal, got %v (%s)", err, confirmResp.Message)
+	}
+	if len(repo.created) != 1 || !repo.created[0].Amount.Equal(decimal.NewFromInt(25000)) {
+		t.Fatalf("expected the stored withdrawal to be posted, got %+v", repo.created)
+	}
+}
@@ -0,0 +1,208 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+)
+
+type channelRepoStub struct {
+	channels map[string]domain.Channel
+}
+
+func newChannelRepoStub() *channelRepoStub {
+	return &channelRepoStub{channels: make(map[string]domain.Channel)}
+}
+
+func (s *channelRepoStub) Create(_ context.Context, channel domain.Channel, key domain.ChannelKey) (domain.Channel, error) {
+	if _, ok := s.channels[channel.ChannelID]; ok {
+		return domain.Channel{}, commons.ErrDuplicateChannel
+	}
+	key.ID = "key-1"
+	key.ChannelID = channel.ChannelID
+	channel.Keys = []domain.ChannelKey{key}
+	s.channels[channel.ChannelID] = channel
+	return channel, nil
+}
+
+func (s *channelRepoStub) GetByChannelID(_ context.Context, channelID string, now time.Time) (domain.Channel, error) {
+	channel, ok := s.channels[channelID]
+	if !ok {
+		return domain.Channel{}, commons.ErrRecordNotFound
+	}
+	keys := make([]domain.ChannelKey, 0, len(channel.Keys))
+	for _, key := range channel.Keys {
+		if key.ValidAt(now) {
+			keys = append(keys, key)
+		}
+	}
+	channel.Keys = keys
+	return channel, nil
+}
+
+func (s *channelRepoStub) GetAll(context.Context) ([]domain.Channel, error) {
+	channels := make([]domain.Channel, 0, len(s.channels))
+	for _, channel := range s.channels {
+		channels = append(channels, channel)
+	}
+	return channels, nil
+}
+
+func (s *channelRepoStub) Update(_ context.Context, channel domain.Channel) (domain.Channel, error) {
+	stored, ok := s.channels[channel.ChannelID]
+	if !ok {
+		return domain.Channel{}, commons.ErrRecordNotFound
+	}
+	channel.Keys = stored.Keys
+	s.channels[channel.ChannelID] = channel
+	return channel, nil
+}
+
+func (s *channelRepoStub) RotateKey(_ context.Context, key domain.ChannelKey, retireAt time.Time) (domain.Channel, error) {
+	channel, ok := s.channels[key.ChannelID]
+	if !ok {
+		return domain.Channel{}, commons.ErrRecordNotFound
+	}
+	for i := range channel.Keys {
+		if channel.Keys[i].ExpiresAt == nil || channel.Keys[i].ExpiresAt.After(retireAt) {
+			channel.Keys[i].ExpiresAt = &retireAt
+		}
+	}
+	key.ID = "key-2"
+	channel.Keys = append(channel.Keys, key)
+	s.channels[key.ChannelID] = channel
+	return channel, nil
+}
+
+func createTestChannel(t *testing.T, svc *services.ChannelService, allowedIPs []string) string {
+	t.Helper()
+	resp, err := svc.CreateChannel(context.Background(), models.CreateChannelRequest{
+		ChannelID:  "PartnerApp",
+		Name:       "Partner app",
+		Scopes:     []string{"rates:read", "transfers:write"},
+		AllowedIPs: allowedIPs,
+		CreatedBy:  "admin-1",
+	})
+	if err != nil {
+		t.Fatalf("create channel: %v", err)
+	}
+	if resp.Data.APIKey == "" {
+		t.Fatal("expected the issued key in the response")
+	}
+	return resp.Data.APIKey
+}
+
+func TestChannelServiceAuthenticatesIssuedKey(t *testing.T) {
+	repo := newChannelRepoStub()
+	svc := services.NewChannelService(repo, time.Hour)
+	apiKey := createTestChannel(t, svc, []string{"10.0.0.0/8", "192.168.1.5"})
+
+	if repo.channels["PartnerApp"].Keys[0].KeyHash == apiKey {
+		t.Fatal("key must be stored hashed")
+	}
+	if got := repo.channels["PartnerApp"].AllowedCIDRs; len(got) != 2 || got[1] != "192.168.1.5/32" {
+		t.Fatalf("expected normalized ranges, got %v", got)
+	}
+
+	channel, err := svc.Authenticate(context.Background(), "PartnerApp", apiKey, "10.1.2.3")
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	if !channel.HasScope(domain.ChannelScopeTransfersWrite) || channel.HasScope(domain.ChannelScopeChannelsAdmin) {
+		t.Fatalf("unexpected scopes %v", channel.Scopes)
+	}
+
+	if _, err := svc.Authenticate(context.Background(), "PartnerApp", "wrong", "10.1.2.3"); !errors.Is(err, commons.ErrInvalidChannelCredentials) {
+		t.Fatalf("expected invalid credentials, got %v", err)
+	}
+	if _, err := svc.Authenticate(context.Background(), "Unknown", apiKey, "10.1.2.3"); !errors.Is(err, commons.ErrInvalidChannelCredentials) {
+		t.Fatalf("expected invalid credentials for unknown channel, got %v", err)
+	}
+	if _, err := svc.Authenticate(context.Background(), "PartnerApp", apiKey, "172.16.0.1"); !errors.Is(err, commons.ErrChannelIPNotAllowed) {
+		t.Fatalf("expected address refusal, got %v", err)
+	}
+}
+
+func TestChannelServiceRotationKeepsOldKeyDuringGracePeriod(t *testing.T) {
+	repo := newChannelRepoStub()
+	svc := services.NewChannelService(repo, time.Hour)
+	oldKey := createTestChannel(t, svc, nil)
+
+	resp, err := svc.RotateChannelKey(context.Background(), models.RotateChannelKeyRequest{ChannelID: "PartnerApp", RotatedBy: "admin-1"})
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	newKey := resp.Data.APIKey
+	if newKey == "" || newKey == oldKey {
+		t.Fatal("expected a fresh key")
+	}
+	for _, key := range []string{oldKey, newKey} {
+		if _, err := svc.Authenticate(context.Background(), "PartnerApp", key, "127.0.0.1"); err != nil {
+			t.Fatalf("expected both keys valid during grace period: %v", err)
+		}
+	}
+
+	zero := 0
+	if _, err := svc.RotateChannelKey(context.Background(), models.RotateChannelKeyRequest{ChannelID: "PartnerApp", RotatedBy: "admin-1", GracePeriodSeconds: &zero}); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	for _, key := range []string{oldKey, newKey} {
+		if _, err := svc.Authenticate(context.Background(), "PartnerApp", key, "127.0.0.1"); !errors.Is(err, commons.ErrInvalidChannelCredentials) {
+			t.Fatalf("expected key revoked by immediate rotation, got %v", err)
+		}
+	}
+}
+
+func TestChannelServiceDisabledChannelIsRefused(t *testing.T) {
+	repo := newChannelRepoStub()
+	svc := services.NewChannelService(repo, time.Hour)
+	apiKey := createTestChannel(t, svc, nil)
+
+	if _, err := svc.UpdateChannel(context.Background(), models.UpdateChannelRequest{ChannelID: "PartnerApp", Status: "disabled", UpdatedBy: "admin-1"}); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+	if _, err := svc.Authenticate(context.Background(), "PartnerApp", apiKey, "127.0.0.1"); !errors.Is(err, commons.ErrChannelDisabled) {
+		t.Fatalf("expected disabled channel, got %v", err)
+	}
+}
+
+func TestChannelServiceCannotDisableItself(t *testing.T) {
+	repo := newChannelRepoStub()
+	svc := services.NewChannelService(repo, time.Hour)
+	createTestChannel(t, svc, nil)
+
+	ctx := commons.WithChannelID(context.Background(), "PartnerApp")
+	resp, err := svc.UpdateChannel(ctx, models.UpdateChannelRequest{ChannelID: "PartnerApp", Status: "DISABLED", UpdatedBy: "admin-1"})
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %q", resp.Message)
+	}
+}
+
+func TestChannelServiceBootstrapSeedsOnce(t *testing.T) {
+	repo := newChannelRepoStub()
+	svc := services.NewChannelService(repo, time.Hour)
+
+	if err := svc.Bootstrap(context.Background(), "GreyApp", "GreyHoundKey001"); err != nil {
+		t.Fatalf("bootstrap: %v", err)
+	}
+	channel, err := svc.Authenticate(context.Background(), "GreyApp", "GreyHoundKey001", "127.0.0.1")
+	if err != nil || !channel.HasScope(domain.ChannelScopeChannelsAdmin) {
+		t.Fatalf("expected bootstrap channel with every scope, got %v (%v)", channel.Scopes, err)
+	}
+
+	if _, err := svc.RotateChannelKey(context.Background(), models.RotateChannelKeyRequest{ChannelID: "GreyApp", RotatedBy: "admin-1", GracePeriodSeconds: new(int)}); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	if err := svc.Bootstrap(context.Background(), "GreyApp", "GreyHoundKey001"); err != nil {
+		t.Fatalf("bootstrap: %v", err)
+	}
+	if _, err := svc.Authenticate(context.Background(), "GreyApp", "GreyHoundKey001", "127.0.0.1"); !errors.Is(err, commons.ErrInvalidChannelCredentials) {
+		t.Fatalf("expected rotated-out bootstrap key to stay revoked, got %v", err)
+	}
+}
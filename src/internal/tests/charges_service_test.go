@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
 	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
 	"github.com/shopspring/decimal"
 )
@@ -16,6 +18,12 @@ func TestChargesServiceGetChargesSummarySuccessUSD(t *testing.T) {
 		decimal.NewFromFloat(7.5),
 		decimal.NewFromInt(2),
 		decimal.NewFromInt(20),
+		decimal.NewFromFloat(0.5),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(10),
+		decimal.Zero,
+		decimal.Zero,
+		decimal.Zero,
 	)
 
 	resp, err := svc.GetChargesSummary(context.Background(), models.GetChargesRequest{
@@ -46,6 +54,12 @@ func TestChargesServiceGetChargesValidationError(t *testing.T) {
 		decimal.NewFromFloat(7.5),
 		decimal.NewFromInt(2),
 		decimal.NewFromInt(20),
+		decimal.NewFromFloat(0.5),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(10),
+		decimal.Zero,
+		decimal.Zero,
+		decimal.Zero,
 	)
 
 	_, _, _, _, _, err := svc.GetCharges(context.Background(), decimal.Zero, "")
@@ -54,3 +68,38 @@ func TestChargesServiceGetChargesValidationError(t *testing.T) {
 	}
 }
 
+
+func TestChargesServiceGetWithdrawalChargesConvertsCappedFee(t *testing.T) {
+	svc := services.NewChargesService(
+		rateRepoStub{
+			getRateFn: func(_ context.Context, from string, to string) (domain.Rate, error) {
+				if from == "USD" && to == "NGN" {
+					return domain.Rate{FromCurrency: from, ToCurrency: to, Rate: decimal.NewFromInt(1500)}, nil
+				}
+				return domain.Rate{}, commons.ErrRecordNotFound
+			},
+		},
+		decimal.NewFromInt(1),
+		decimal.NewFromFloat(7.5),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(20),
+		decimal.NewFromFloat(0.5),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(10),
+		decimal.Zero,
+		decimal.Zero,
+		decimal.Zero,
+	)
+
+	charge, vat, chargeUSD, vatUSD, err := svc.GetWithdrawalCharges(context.Background(), decimal.NewFromInt(3000000), "NGN")
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	// 3,000,000 NGN is 2,000 USD; 0.5% is 10 USD, the cap.
+	if !chargeUSD.Equal(decimal.NewFromInt(10)) || !vatUSD.Equal(decimal.NewFromFloat(0.75)) {
+		t.Fatalf("expected USD fees 10 + 0.75, got %s + %s", chargeUSD, vatUSD)
+	}
+	if !charge.Equal(decimal.NewFromInt(15000)) || !vat.Equal(decimal.NewFromInt(1125)) {
+		t.Fatalf("expected NGN fees 15000 + 1125, got %s + %s", charge, vat)
+	}
+}
@@ -0,0 +1,340 @@
+package services_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/token"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+var testHMACSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func encodeSegment(t *testing.T, value any) string {
+	t.Helper()
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("encode token segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func signHS256(t *testing.T, kid string, claims map[string]any) string {
+	t.Helper()
+
+	input := encodeSegment(t, map[string]string{"alg": "HS256", "kid": kid}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, testHMACSecret)
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+
+	input := encodeSegment(t, map[string]string{"alg": "RS256", "kid": kid}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func customerClaims(customerID string) map[string]any {
+	return map[string]any{
+		"iss":         "https://idp.grey.test",
+		"aud":         []string{"payments"},
+		"sub":         "user-" + customerID,
+		"customer_id": customerID,
+		"exp":         time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func newTestVerifier(t *testing.T, rsaKey *rsa.PublicKey) *token.Verifier {
+	t.Helper()
+
+	jwks := fmt.Sprintf(`{"keys":[
+		{"kty":"oct","kid":"hs-1","alg":"HS256","k":%q},
+		{"kty":"RSA","kid":"rs-1","alg":"RS256","n":%q,"e":%q}
+	]}`,
+		base64.RawURLEncoding.EncodeToString(testHMACSecret),
+		base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+	)
+	keys, err := token.ParseJWKS([]byte(jwks))
+	if err != nil {
+		t.Fatalf("parse jwks: %v", err)
+	}
+	return token.NewVerifier(keys, "https://idp.grey.test", "payments", string(domain.ChannelScopeBackOffice))
+}
+
+func TestVerifier_AcceptsHS256AndRS256CustomerTokens(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	verifier := newTestVerifier(t, &rsaKey.PublicKey)
+
+	for name, signed := range map[string]string{
+		"HS256": signHS256(t, "hs-1", customerClaims("C1")),
+		"RS256": signRS256(t, rsaKey, "rs-1", customerClaims("C1")),
+	} {
+		principal, err := verifier.Verify(signed)
+		if err != nil {
+			t.Fatalf("%s: expected token to verify, got %v", name, err)
+		}
+		if principal.CustomerID != "C1" || principal.Subject != "user-C1" || principal.IsService() {
+			t.Fatalf("%s: expected customer C1, got %+v", name, principal)
+		}
+	}
+}
+
+func TestVerifier_RejectsInvalidTokens(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	verifier := newTestVerifier(t, &rsaKey.PublicKey)
+
+	expired := customerClaims("C1")
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	wrongIssuer := customerClaims("C1")
+	wrongIssuer["iss"] = "https://other.test"
+	wrongAudience := customerClaims("C1")
+	wrongAudience["aud"] = "reports"
+	noCustomer := customerClaims("")
+	noCustomer["scope"] = "accounts:read"
+	valid := signHS256(t, "hs-1", customerClaims("C1"))
+	unsigned := encodeSegment(t, map[string]string{"alg": "none"}) + "." + encodeSegment(t, customerClaims("C1")) + "."
+
+	cases := map[string]string{
+		"expired":          signHS256(t, "hs-1", expired),
+		"wrong issuer":     signHS256(t, "hs-1", wrongIssuer),
+		"wrong audience":   signHS256(t, "hs-1", wrongAudience),
+		"no customer":      signHS256(t, "hs-1", noCustomer),
+		"alg none":         unsigned,
+		"alg key mismatch": signHS256(t, "rs-1", customerClaims("C1")),
+		"tampered":         valid[:len(valid)-2] + "AA",
+		"unknown kid":      signHS256(t, "hs-9", customerClaims("C1")),
+		"not a jws":        "abc.def",
+	}
+	for name, signed := range cases {
+		if _, err := verifier.Verify(signed); !errors.Is(err, token.ErrInvalidToken) {
+			t.Fatalf("%s: expected ErrInvalidToken, got %v", name, err)
+		}
+	}
+}
+
+func TestVerifier_AcceptsBackOfficeServiceToken(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	verifier := newTestVerifier(t, &rsaKey.PublicKey)
+
+	claims := customerClaims("")
+	claims["sub"] = "ops-console"
+	claims["scope"] = "accounts:read backoffice"
+	principal, err := verifier.Verify(signRS256(t, rsaKey, "rs-1", claims))
+	if err != nil {
+		t.Fatalf("expected service token to verify, got %v", err)
+	}
+	if !principal.IsService() || !principal.HasScope("backoffice") {
+		t.Fatalf("expected back-office service principal, got %+v", principal)
+	}
+}
+
+func customerContext(customerID string) context.Context {
+	return commons.WithPrincipal(context.Background(), commons.Principal{Subject: "user-" + customerID, CustomerID: customerID})
+}
+
+func TestTransferFunds_RefusesDebitAccountOfAnotherCustomer(t *testing.T) {
+	transfers := &stepUpTransferRepoStub{}
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {AccountNumber: "0000000011", CustomerID: "C1", Currency: "USD", Status: domain.AccountStatusActive},
+		"0000000028": {AccountNumber: "0000000028", CustomerID: "C2", Currency: "USD", Status: domain.AccountStatusActive},
+	}}
+	svc := services.NewTransferService(
+		transfers, accounts, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		"100100", "0123456789", "0123456790", "0123456791", "0123456792", "0123456793", "0123456794", "0123456795",
+	)
+
+	resp, err := svc.TransferFunds(customerContext("C2"), models.InternalTransferRequest{
+		DebitAccountNumber:  "0000000011",
+		CreditAccountNumber: "0000000028",
+		BeneficiaryBankCode: "100100",
+		DebitCurrency:       "USD",
+		CreditCurrency:      "USD",
+		DebitAmount:         decimal.NewFromInt(250),
+		DebitBankName:       "Grey",
+		CreditBankName:      "Grey",
+		Narration:           "Salary",
+		TransactionPIN:      "7294",
+	})
+	if !errors.Is(err, commons.ErrAccountAccessDenied) || resp.Message != commons.ErrAccountAccessDenied.Error() {
+		t.Fatalf("expected account access denied, got %v (%s)", err, resp.Message)
+	}
+	if transfers.created != 0 {
+		t.Fatalf("expected no transfer to be written, got %d", transfers.created)
+	}
+}
+
+func TestDepositFunds_EnforcesAccountOwnerUnlessBackOffice(t *testing.T) {
+	svc, repo := newDepositAccountService()
+	req := models.DepositFundsRequest{
+		AccountNumber:     "0000000028",
+		Amount:            decimal.NewFromInt(5000),
+		Source:            "external_bank",
+		ExternalReference: "NIP-0100",
+	}
+
+	resp, err := svc.DepositFunds(customerContext("C9"), req)
+	if !errors.Is(err, commons.ErrAccountAccessDenied) || repo.postings != 0 {
+		t.Fatalf("expected account access denied without posting, got %v (%s) and %d postings", err, resp.Message, repo.postings)
+	}
+
+	resp, err = svc.DepositFunds(commons.WithPrincipal(context.Background(), commons.Principal{Subject: "ops-console"}), req)
+	if !errors.Is(err, commons.ErrAccountAccessDenied) {
+		t.Fatalf("expected service token without back-office scope to be denied, got %v (%s)", err, resp.Message)
+	}
+
+	backOffice := commons.WithPrincipal(context.Background(), commons.Principal{
+		Subject: "ops-console",
+		Scopes:  []string{string(domain.ChannelScopeBackOffice)},
+	})
+	if resp, err = svc.DepositFunds(backOffice, req); err != nil {
+		t.Fatalf("expected back-office deposit to succeed, got %v (%s)", err, resp.Message)
+	}
+	if resp, err = svc.DepositFunds(customerContext("C1"), models.DepositFundsRequest{
+		AccountNumber:     "0000000028",
+		Amount:            decimal.NewFromInt(100),
+		Source:            "external_bank",
+		ExternalReference: "NIP-0101",
+	}); err != nil {
+		t.Fatalf("expected owner deposit to succeed, got %v (%s)", err, resp.Message)
+	}
+}
+
+func TestGetAccount_ReturnsNameEnquiryToOtherCustomers(t *testing.T) {
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000028": {ID: "a-1", AccountNumber: "0000000028", CustomerID: "C1", Currency: "USD", Status: domain.AccountStatusActive, AvailableBalance: decimal.NewFromInt(900)},
+	}}
+	users := userRepoStub{getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+		return domain.User{CustomerID: customerID, FirstName: "Ada", LastName: "Obi"}, nil
+	}}
+	svc := services.NewAccountService(accounts, users, nil, nil, nil, nil, nil, nil, "100100", "0123456890", "", "", nil, nil)
+
+	resp, err := svc.GetAccount(customerContext("C2"), "0000000028", "100100")
+	if err != nil {
+		t.Fatalf("expected name enquiry to succeed, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data.AccountName != "Ada Obi" || resp.Data.CustomerID != "" || !resp.Data.AvailableBalance.IsZero() {
+		t.Fatalf("expected name-only response for another customer, got %+v", resp.Data)
+	}
+
+	resp, err = svc.GetAccount(customerContext("C1"), "0000000028", "100100")
+	if err != nil {
+		t.Fatalf("expected owner lookup to succeed, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data.CustomerID != "C1" || !resp.Data.AvailableBalance.Equal(decimal.NewFromInt(900)) {
+		t.Fatalf("expected full account for its owner, got %+v", resp.Data)
+	}
+}
+
+func TestAccountRoutes_RefuseAnotherCustomersToken(t *testing.T) {
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(100), Status: domain.AccountStatusActive},
+		"0000000028": {CustomerID: "C1", AccountNumber: "0000000028", Currency: "NGN", Status: domain.AccountStatusActive},
+		"0000000001": {CustomerID: "C1", AccountNumber: "0000000001", Currency: "USD", Status: domain.AccountStatusActive},
+	}}
+	accountService := services.NewAccountService(accounts, userRepoStub{}, nil, nil, nil, nil, nil, nil, "100100", "0123456890", "", "", nil, nil)
+	accountService.EnableStepUp(services.NewOTPService(&otpRepoStub{}, userRepoStub{}, &otpSenderStub{}, 5*time.Minute, 3), nil, domain.TransferStepUpPolicy{Currency: "USD", Threshold: decimal.NewFromInt(10000)})
+	exchangeService := services.NewExchangeService(accounts, lifecycleRateRepoStub{}, &exchangeRepoStub{}, nil, nil, nil, decimal.NewFromInt(1), "0123456890", "0123445521", "0125548976", nil)
+	holdService := services.NewAccountHoldService(newAccountHoldRepoStub(), accounts, "0123456789")
+	portfolioService := services.NewPortfolioService(userRepoStub{}, accounts, portfolioHoldRepoStub{}, nil)
+	transferRepo := &heldTransferRepoStub{transfer: domain.Transfer{
+		ID:                   "tr-1",
+		TransactionReference: strPtr("REF-1"),
+		DebitAccountNumber:   "0000000001",
+		Status:               domain.TransferStatusSuccess,
+	}}
+	transferService := services.NewTransferService(
+		transferRepo, accounts, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		"100100", "0123456789", "0123456790", "0123456791", "0123456792", "0123456793", "0123456794", "0123456795",
+	)
+	depositService, _ := newDepositAccountService()
+	if resp, err := depositService.DepositFunds(customerContext("C1"), models.DepositFundsRequest{
+		AccountNumber:     "0000000028",
+		Amount:            decimal.NewFromInt(10),
+		Source:            "external_bank",
+		ExternalReference: "NIP-0200",
+	}); err != nil {
+		t.Fatalf("expected owner deposit to succeed, got %v (%s)", err, resp.Message)
+	}
+
+	ctx := customerContext("C2")
+	routes := map[string]func() (string, error){
+		"/withdraw-funds": func() (string, error) {
+			resp, err := accountService.WithdrawFunds(ctx, models.WithdrawFundsRequest{AccountNumber: "0000000011", Currency: "USD", Amount: decimal.NewFromInt(10), TransactionPIN: "7294"})
+			return resp.Message, err
+		},
+		"/confirm-withdrawal": func() (string, error) {
+			resp, err := accountService.ConfirmWithdrawal(ctx, models.ConfirmWithdrawalRequest{AccountNumber: "0000000011", ChallengeID: "challenge-1", OTP: "123456"})
+			return resp.Message, err
+		},
+		"/exchange": func() (string, error) {
+			resp, err := exchangeService.Exchange(ctx, models.ExchangeRequest{SourceAccountNumber: "0000000011", DestinationAccountNumber: "0000000028", Amount: decimal.NewFromInt(10), TransactionPIN: "7294"})
+			return resp.Message, err
+		},
+		"/place-account-hold": func() (string, error) {
+			resp, err := holdService.PlaceHold(ctx, models.PlaceAccountHoldRequest{AccountNumber: "0000000011", HoldType: "AUTHORIZATION", Amount: decimal.NewFromInt(10), Reason: "card", Reference: "LIEN-9"})
+			return resp.Message, err
+		},
+		"/release-account-hold": func() (string, error) {
+			resp, err := holdService.ReleaseHold(ctx, models.ReleaseAccountHoldRequest{AccountNumber: "0000000011", Reference: "LIEN-9"})
+			return resp.Message, err
+		},
+		"/capture-account-hold": func() (string, error) {
+			resp, err := holdService.CaptureHold(ctx, models.CaptureAccountHoldRequest{AccountNumber: "0000000011", Reference: "LIEN-9"})
+			return resp.Message, err
+		},
+		"/get-account-holds": func() (string, error) {
+			resp, err := holdService.GetHolds(ctx, "0000000011")
+			return resp.Message, err
+		},
+		"/get-transfer": func() (string, error) {
+			resp, err := transferService.GetTransfer(ctx, "REF-1")
+			return resp.Message, err
+		},
+		"/get-deposit": func() (string, error) {
+			resp, err := depositService.GetDeposit(ctx, models.GetDepositRequest{Source: "EXTERNAL_BANK", ExternalReference: "NIP-0200"})
+			return resp.Message, err
+		},
+		"/customers/{customerId}/accounts": func() (string, error) {
+			resp, err := portfolioService.GetCustomerAccounts(ctx, models.GetCustomerAccountsRequest{CustomerID: "C1"})
+			return resp.Message, err
+		},
+	}
+	for route, call := range routes {
+		message, err := call()
+		if !errors.Is(err, commons.ErrAccountAccessDenied) || message != commons.ErrAccountAccessDenied.Error() {
+			t.Errorf("%s: expected account access denied, got %v (%s)", route, err, message)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// statementRecorder collects every statement run through the "recording"
+// database/sql driver. Queries are answered by answer; without one, or when it
+// returns nil, they return no rows.
+type statementRecorder struct {
+	mu         sync.Mutex
+	statements []recordedStatement
+	committed  bool
+	answer     func(query string) *recordingRows
+}
+
+type recordedStatement struct {
+	query string
+	args  []driver.NamedValue
+}
+
+var (
+	recordingDriverRegistered sync.Once
+	statementRecorders        = map[string]*statementRecorder{}
+	statementRecordersMu      sync.Mutex
+)
+
+func openRecordingDB(t *testing.T, answer func(query string) *recordingRows) (*sql.DB, *statementRecorder) {
+	t.Helper()
+
+	recordingDriverRegistered.Do(func() {
+		sql.Register("recording", recordingConnector{})
+	})
+	recorder := &statementRecorder{answer: answer}
+	statementRecordersMu.Lock()
+	statementRecorders[t.Name()] = recorder
+	statementRecordersMu.Unlock()
+
+	db, err := sql.Open("recording", t.Name())
+	if err != nil {
+		t.Fatalf("open recording db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db, recorder
+}
+
+func (d *statementRecorder) find(fragment string) (recordedStatement, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, statement := range d.statements {
+		if strings.Contains(statement.query, fragment) {
+			return statement, true
+		}
+	}
+	return recordedStatement{}, false
+}
+
+type recordingConnector struct{}
+
+func (recordingConnector) Open(name string) (driver.Conn, error) {
+	statementRecordersMu.Lock()
+	defer statementRecordersMu.Unlock()
+	return &recordingConn{recorder: statementRecorders[name]}, nil
+}
+
+type recordingConn struct {
+	recorder *statementRecorder
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *recordingConn) Close() error { return nil }
+
+func (c *recordingConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *recordingConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) { return c, nil }
+
+func (c *recordingConn) Commit() error {
+	c.recorder.mu.Lock()
+	c.recorder.committed = true
+	c.recorder.mu.Unlock()
+	return nil
+}
+
+func (c *recordingConn) Rollback() error { return nil }
+
+func (c *recordingConn) record(query string, args []driver.NamedValue) {
+	c.recorder.mu.Lock()
+	c.recorder.statements = append(c.recorder.statements, recordedStatement{query: query, args: args})
+	c.recorder.mu.Unlock()
+}
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.record(query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *recordingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.record(query, args)
+	if c.recorder.answer != nil {
+		if rows := c.recorder.answer(query); rows != nil {
+			return rows, nil
+		}
+	}
+	return &recordingRows{}, nil
+}
+
+type recordingRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *recordingRows) Columns() []string { return r.columns }
+
+func (r *recordingRows) Close() error { return nil }
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestCustomerDataRepositoryEraseScrubsKYCDocumentsAndScreenedNames(t *testing.T) {
+	db, recorder := openRecordingDB(t, func(query string) *recordingRows {
+		switch {
+		case strings.Contains(query, "SELECT status"):
+			return &recordingRows{columns: []string{"status"}, values: [][]driver.Value{{string(domain.UserStatusActive)}}}
+		case strings.Contains(query, "INSERT INTO customer_data_requests"):
+			return &recordingRows{columns: []string{"id", "created_at"}, values: [][]driver.Value{{"dsr-1", time.Now()}}}
+		}
+		return nil
+	})
+	repo := implementations.NewCustomerDataRepository(db)
+
+	if _, err := repo.Erase(context.Background(), domain.DataSubjectRequest{
+		CustomerID:  "CUST-1",
+		RequestedBy: "dpo",
+		Reason:      "Article 17 request",
+	}); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !recorder.committed {
+		t.Fatal("expected the erasure transaction to commit")
+	}
+
+	kyc, ok := recorder.find("UPDATE kyc_upgrade_requests")
+	if !ok {
+		t.Fatal("expected kyc upgrade requests to be scrubbed")
+	}
+	if !strings.Contains(kyc.query, "document_number = ''") || !strings.Contains(kyc.query, "document_reference = ''") {
+		t.Fatalf("expected document number and reference to be blanked, got %s", kyc.query)
+	}
+	if len(kyc.args) != 1 || kyc.args[0].Value != "CUST-1" {
+		t.Fatalf("expected the scrub to target CUST-1, got %+v", kyc.args)
+	}
+
+	candidates, ok := recorder.find("UPDATE customer_duplicate_candidates")
+	if !ok {
+		t.Fatal("expected open duplicate candidates to be dismissed")
+	}
+	if len(candidates.args) != 2 || candidates.args[1].Value != "dpo" {
+		t.Fatalf("expected the requester recorded as reviewer, got %+v", candidates.args)
+	}
+
+	screenings, ok := recorder.find("UPDATE sanctions_screenings")
+	if !ok {
+		t.Fatal("expected sanctions screenings to be scrubbed")
+	}
+	if !strings.Contains(screenings.query, "screened_name = $2") || !strings.Contains(screenings.query, "subject_type = 'USER' AND subject_id = $1") {
+		t.Fatalf("expected the customer's screened names to be replaced, got %s", screenings.query)
+	}
+	if len(screenings.args) != 2 || screenings.args[0].Value != "CUST-1" || screenings.args[1].Value != domain.ErasedName {
+		t.Fatalf("expected screened names of CUST-1 to become %s, got %+v", domain.ErasedName, screenings.args)
+	}
+}
@@ -0,0 +1,182 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type customerDataRepoStub struct {
+	transfers []domain.Transfer
+	recorded  []domain.DataSubjectRequest
+	eraseErr  error
+}
+
+func (s *customerDataRepoStub) GetTransfersByCustomerID(context.Context, string) ([]domain.Transfer, error) {
+	return s.transfers, nil
+}
+
+func (s *customerDataRepoStub) RecordExport(_ context.Context, request domain.DataSubjectRequest) (domain.DataSubjectRequest, error) {
+	request.ID = "dsr-export"
+	request.Type = domain.DataSubjectRequestExport
+	request.CreatedAt = time.Now()
+	s.recorded = append(s.recorded, request)
+	return request, nil
+}
+
+func (s *customerDataRepoStub) Erase(_ context.Context, request domain.DataSubjectRequest) (domain.DataSubjectRequest, error) {
+	if s.eraseErr != nil {
+		return domain.DataSubjectRequest{}, s.eraseErr
+	}
+	request.ID = "dsr-erase"
+	request.Type = domain.DataSubjectRequestErasure
+	request.TransfersScrubbed = 2
+	request.ProfileChangesScrubbed = 1
+	request.CreatedAt = time.Now()
+	s.recorded = append(s.recorded, request)
+	return request, nil
+}
+
+func newCustomerDataService(dataRepo *customerDataRepoStub) *services.CustomerDataService {
+	userRepo := userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			if customerID != "CUST-1" {
+				return domain.User{}, commons.ErrRecordNotFound
+			}
+			return domain.User{
+				CustomerID: "CUST-1",
+				FirstName:  "Ada",
+				LastName:   "Lovelace",
+				DOB:        time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+				Status:     domain.UserStatusActive,
+			}, nil
+		},
+	}
+	accountRepo := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0123456789": {CustomerID: "CUST-1", AccountNumber: "0123456789", Currency: "USD", Status: domain.AccountStatusActive, AvailableBalance: decimal.NewFromInt(50)},
+	}}
+	return services.NewCustomerDataService(dataRepo, userRepo, accountRepo, &kycRepoStub{})
+}
+
+func customerDataTestRequest(customerID string) models.CustomerDataRequest {
+	return models.CustomerDataRequest{CustomerID: customerID, Reason: "subject request"}
+}
+
+func dataProtectionOfficerContext() context.Context {
+	return kycOfficerContext("dpo-1")
+}
+
+func TestExportCustomerDataReturnsArchiveAndRecordsRequest(t *testing.T) {
+	narration := "rent"
+	dataRepo := &customerDataRepoStub{transfers: []domain.Transfer{
+		{ID: "t-1", DebitAccountNumber: "0123456789", Narration: &narration, AuditPayload: `{"beneficiaryName":"Grace Hopper"}`, Status: domain.TransferStatusSuccess},
+		{ID: "t-2", DebitAccountNumber: "0123456789", AuditPayload: "not json", Status: domain.TransferStatusSuccess},
+	}}
+	svc := newCustomerDataService(dataRepo)
+
+	resp, err := svc.ExportCustomerData(dataProtectionOfficerContext(), customerDataTestRequest("CUST-1"))
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if resp.Data.RequestID != "dsr-export" || resp.Data.Profile.FirstName != "Ada" {
+		t.Fatalf("unexpected export %+v", resp.Data)
+	}
+	if len(resp.Data.Accounts) != 1 || len(resp.Data.Transfers) != 2 {
+		t.Fatalf("expected 1 account and 2 transfers, got %d and %d", len(resp.Data.Accounts), len(resp.Data.Transfers))
+	}
+	if string(resp.Data.Transfers[0].AuditPayload) != `{"beneficiaryName":"Grace Hopper"}` {
+		t.Fatalf("expected audit payload in export, got %s", resp.Data.Transfers[0].AuditPayload)
+	}
+	if _, err := json.Marshal(resp.Data); err != nil {
+		t.Fatalf("export with an invalid audit payload must still marshal: %v", err)
+	}
+	if len(dataRepo.recorded) != 1 || dataRepo.recorded[0].RequestedBy != "dpo-1" {
+		t.Fatalf("expected export to be recorded, got %+v", dataRepo.recorded)
+	}
+}
+
+func TestExportCustomerDataUnknownCustomer(t *testing.T) {
+	dataRepo := &customerDataRepoStub{}
+	svc := newCustomerDataService(dataRepo)
+
+	resp, err := svc.ExportCustomerData(dataProtectionOfficerContext(), customerDataTestRequest("CUST-404"))
+	if err == nil || resp.Message != "User not found" {
+		t.Fatalf("expected User not found, got %q (%v)", resp.Message, err)
+	}
+	if len(dataRepo.recorded) != 0 {
+		t.Fatal("failed export must not be recorded")
+	}
+}
+
+func TestEraseCustomerData(t *testing.T) {
+	svc := newCustomerDataService(&customerDataRepoStub{})
+
+	resp, err := svc.EraseCustomerData(dataProtectionOfficerContext(), customerDataTestRequest("CUST-1"))
+	if err != nil {
+		t.Fatalf("erase: %v", err)
+	}
+	if resp.Data.RequestID != "dsr-erase" || resp.Data.TransfersScrubbed != 2 || resp.Data.ProfileChangesScrubbed != 1 {
+		t.Fatalf("unexpected erasure %+v", resp.Data)
+	}
+}
+
+func TestEraseCustomerDataRefusals(t *testing.T) {
+	cases := []struct {
+		err     error
+		message string
+	}{
+		{commons.ErrCustomerHasOpenAccounts, "Customer has open accounts"},
+		{commons.ErrCustomerErased, "Customer data already erased"},
+		{commons.ErrRecordNotFound, "User not found"},
+	}
+	for _, tc := range cases {
+		svc := newCustomerDataService(&customerDataRepoStub{eraseErr: tc.err})
+		resp, err := svc.EraseCustomerData(dataProtectionOfficerContext(), customerDataTestRequest("CUST-1"))
+		if !errors.Is(err, tc.err) || resp.Message != tc.message {
+			t.Fatalf("expected %q, got %q (%v)", tc.message, resp.Message, err)
+		}
+	}
+}
+
+func TestEraseCustomerDataRequiresReason(t *testing.T) {
+	svc := newCustomerDataService(&customerDataRepoStub{})
+
+	req := customerDataTestRequest("CUST-1")
+	req.Reason = " "
+	resp, err := svc.EraseCustomerData(dataProtectionOfficerContext(), req)
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %q", resp.Message)
+	}
+}
+
+func TestCustomerDataRequestsRequireAuthenticatedRequester(t *testing.T) {
+	dataRepo := &customerDataRepoStub{}
+	svc := newCustomerDataService(dataRepo)
+
+	exportResp, err := svc.ExportCustomerData(context.Background(), customerDataTestRequest("CUST-1"))
+	if err == nil || exportResp.Message != "validation failed" {
+		t.Fatalf("expected export without a requester to fail validation, got %q (%v)", exportResp.Message, err)
+	}
+	eraseResp, err := svc.EraseCustomerData(context.Background(), customerDataTestRequest("CUST-1"))
+	if err == nil || eraseResp.Message != "validation failed" {
+		t.Fatalf("expected erasure without a requester to fail validation, got %q (%v)", eraseResp.Message, err)
+	}
+	if len(dataRepo.recorded) != 0 {
+		t.Fatalf("expected nothing recorded, got %+v", dataRepo.recorded)
+	}
+
+	if _, err := svc.EraseCustomerData(dataProtectionOfficerContext(), customerDataTestRequest("CUST-1")); err != nil {
+		t.Fatalf("erase: %v", err)
+	}
+	if len(dataRepo.recorded) != 1 || dataRepo.recorded[0].RequestedBy != "dpo-1" {
+		t.Fatalf("expected the erasure recorded against the authenticated caller, got %+v", dataRepo.recorded)
+	}
+}
@@ -0,0 +1,327 @@
+package services_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+)
+
+type customerDuplicateRepoStub struct {
+	existing   []domain.User
+	flagged    []domain.DuplicateCandidate
+	getFn      func(ctx context.Context, id string) (domain.DuplicateCandidate, error)
+	mergeFn    func(ctx context.Context, merge domain.CustomerMerge) (domain.CustomerMerge, error)
+	reviewedBy string
+}
+
+func (s *customerDuplicateRepoStub) FindPotentialDuplicates(context.Context, string, time.Time, string) ([]domain.User, error) {
+	return s.existing, nil
+}
+
+func (s *customerDuplicateRepoStub) Flag(_ context.Context, customerID string, candidates []domain.DuplicateCandidate) ([]domain.DuplicateCandidate, error) {
+	for i := range candidates {
+		candidates[i].ID = "cand-" + candidates[i].MatchedCustomerID
+		candidates[i].CustomerID = customerID
+		candidates[i].Status = domain.DuplicateCandidateOpen
+	}
+	s.flagged = append(s.flagged, candidates...)
+	return candidates, nil
+}
+
+func (s *customerDuplicateRepoStub) GetByID(ctx context.Context, id string) (domain.DuplicateCandidate, error) {
+	if s.getFn != nil {
+		return s.getFn(ctx, id)
+	}
+	return domain.DuplicateCandidate{}, commons.ErrRecordNotFound
+}
+
+func (s *customerDuplicateRepoStub) GetByStatus(context.Context, domain.DuplicateCandidateStatus, int) ([]domain.DuplicateCandidate, error) {
+	return nil, nil
+}
+
+func (s *customerDuplicateRepoStub) GetByCustomerID(context.Context, string) ([]domain.DuplicateCandidate, error) {
+	return nil, nil
+}
+
+func (s *customerDuplicateRepoStub) Dismiss(_ context.Context, id string, reviewedBy string, _ string) (domain.DuplicateCandidate, error) {
+	s.reviewedBy = reviewedBy
+	return domain.DuplicateCandidate{ID: id, Status: domain.DuplicateCandidateDismissed, ReviewedBy: &reviewedBy}, nil
+}
+
+func (s *customerDuplicateRepoStub) Merge(ctx context.Context, merge domain.CustomerMerge) (domain.CustomerMerge, error) {
+	if s.mergeFn != nil {
+		return s.mergeFn(ctx, merge)
+	}
+	return merge, nil
+}
+
+func duplicateTestCreateRequest() models.CreateUserRequest {
+	return models.CreateUserRequest{
+		FirstName:      "Ada",
+		LastName:       "Lovelace",
+		DOB:            "1990-01-01",
+		PhoneNumber:    "08010000000",
+		IDType:         "Passport",
+		IDNumber:       "A123456",
+		KYCLevel:       1,
+		TransactionPin: "2580",
+	}
+}
+
+func duplicateTestUserService(dupRepo *customerDuplicateRepoStub, created *bool) *services.UserService {
+	svc := services.NewUserService(userRepoStub{
+		createFn: func(_ context.Context, user domain.User) (domain.User, error) {
+			*created = true
+			user.ID = "u-new"
+			return user, nil
+		},
+	}, nil, testPinPolicy)
+	svc.EnableDuplicateDetection(dupRepo, 0.9)
+	return svc
+}
+
+func TestCreateUser_FlagsCustomerMatchingNameAndDOB(t *testing.T) {
+	dupRepo := &customerDuplicateRepoStub{existing: []domain.User{{
+		CustomerID:  "CUST-OLD",
+		FirstName:   "Ada",
+		LastName:    "Lovelace",
+		DOB:         time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "08099999999",
+		IDType:      domain.IDTypePassport,
+		IDNumber:    "B999999",
+	}}}
+	created := false
+	svc := duplicateTestUserService(dupRepo, &created)
+
+	resp, err := svc.CreateUser(context.Background(), duplicateTestCreateRequest())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !created {
+		t.Fatal("expected the customer to be created while flagged")
+	}
+	if len(dupRepo.flagged) != 1 || dupRepo.flagged[0].MatchedCustomerID != "CUST-OLD" {
+		t.Fatalf("expected one candidate against CUST-OLD, got %+v", dupRepo.flagged)
+	}
+	signals := dupRepo.flagged[0].MatchedOn
+	if len(signals) != 2 || signals[0] != domain.DuplicateSignalName || signals[1] != domain.DuplicateSignalDOB {
+		t.Fatalf("expected NAME and DOB signals, got %v", signals)
+	}
+	if len(resp.Data.PotentialDuplicateOf) != 1 || resp.Data.PotentialDuplicateOf[0] != "CUST-OLD" {
+		t.Fatalf("expected potentialDuplicateOf CUST-OLD, got %v", resp.Data.PotentialDuplicateOf)
+	}
+}
+
+func TestCreateUser_SingleSignalIsNotFlagged(t *testing.T) {
+	dupRepo := &customerDuplicateRepoStub{existing: []domain.User{{
+		CustomerID:  "CUST-OLD",
+		FirstName:   "Grace",
+		LastName:    "Hopper",
+		DOB:         time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "08099999999",
+		IDType:      domain.IDTypePassport,
+		IDNumber:    "B999999",
+	}}}
+	created := false
+	svc := duplicateTestUserService(dupRepo, &created)
+
+	resp, err := svc.CreateUser(context.Background(), duplicateTestCreateRequest())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(dupRepo.flagged) != 0 || len(resp.Data.PotentialDuplicateOf) != 0 {
+		t.Fatalf("expected no candidates for a shared date of birth only, got %+v", dupRepo.flagged)
+	}
+}
+
+func TestCreateUser_RejectsExistingIDDocument(t *testing.T) {
+	dupRepo := &customerDuplicateRepoStub{existing: []domain.User{{
+		CustomerID: "CUST-OLD",
+		FirstName:  "Someone",
+		LastName:   "Else",
+		DOB:        time.Date(1970, 5, 5, 0, 0, 0, 0, time.UTC),
+		IDType:     domain.IDTypePassport,
+		IDNumber:   "a12-3456",
+	}}}
+	created := false
+	svc := duplicateTestUserService(dupRepo, &created)
+
+	resp, err := svc.CreateUser(context.Background(), duplicateTestCreateRequest())
+	if !errors.Is(err, commons.ErrDuplicateIdentity) {
+		t.Fatalf("expected ErrDuplicateIdentity, got %v", err)
+	}
+	if resp.Message != "Customer with this ID already exists" {
+		t.Fatalf("unexpected message %q", resp.Message)
+	}
+	if created {
+		t.Fatal("expected no customer to be created")
+	}
+}
+
+func TestMergeCustomers_MergesOtherSideIntoSurvivor(t *testing.T) {
+	var merged domain.CustomerMerge
+	dupRepo := &customerDuplicateRepoStub{
+		getFn: func(context.Context, string) (domain.DuplicateCandidate, error) {
+			return domain.DuplicateCandidate{ID: "cand-1", CustomerID: "CUST-NEW", MatchedCustomerID: "CUST-OLD", Status: domain.DuplicateCandidateOpen}, nil
+		},
+		mergeFn: func(_ context.Context, merge domain.CustomerMerge) (domain.CustomerMerge, error) {
+			merged = merge
+			merge.ID = "merge-1"
+			merge.AccountNumbers = []string{"1000000001"}
+			return merge, nil
+		},
+	}
+	svc := services.NewCustomerDuplicateService(dupRepo)
+
+	resp, err := svc.MergeCustomers(kycOfficerContext("compliance-officer-1"), models.MergeCustomersRequest{
+		CandidateID:         "cand-1",
+		SurvivingCustomerID: "CUST-OLD",
+		Comment:             "same person",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if merged.MergedCustomerID != "CUST-NEW" || merged.SurvivingCustomerID != "CUST-OLD" {
+		t.Fatalf("expected CUST-NEW merged into CUST-OLD, got %+v", merged)
+	}
+	if merged.MergedBy != "compliance-officer-1" {
+		t.Fatalf("expected the merge recorded against the authenticated caller, got %q", merged.MergedBy)
+	}
+	if resp.Data == nil || len(resp.Data.AccountNumbers) != 1 {
+		t.Fatalf("expected moved account numbers in response, got %+v", resp.Data)
+	}
+}
+
+func TestMergeCustomers_RejectsSurvivorNotOnCandidate(t *testing.T) {
+	dupRepo := &customerDuplicateRepoStub{
+		getFn: func(context.Context, string) (domain.DuplicateCandidate, error) {
+			return domain.DuplicateCandidate{ID: "cand-1", CustomerID: "CUST-NEW", MatchedCustomerID: "CUST-OLD", Status: domain.DuplicateCandidateOpen}, nil
+		},
+		mergeFn: func(context.Context, domain.CustomerMerge) (domain.CustomerMerge, error) {
+			t.Fatal("merge must not be attempted")
+			return domain.CustomerMerge{}, nil
+		},
+	}
+	svc := services.NewCustomerDuplicateService(dupRepo)
+
+	resp, err := svc.MergeCustomers(kycOfficerContext("compliance-officer-1"), models.MergeCustomersRequest{
+		CandidateID:         "cand-1",
+		SurvivingCustomerID: "CUST-OTHER",
+		Comment:             "same person",
+	})
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %q %v", resp.Message, err)
+	}
+}
+
+func TestMergeCustomers_CurrencyConflict(t *testing.T) {
+	dupRepo := &customerDuplicateRepoStub{
+		getFn: func(context.Context, string) (domain.DuplicateCandidate, error) {
+			return domain.DuplicateCandidate{ID: "cand-1", CustomerID: "CUST-NEW", MatchedCustomerID: "CUST-OLD", Status: domain.DuplicateCandidateOpen}, nil
+		},
+		mergeFn: func(context.Context, domain.CustomerMerge) (domain.CustomerMerge, error) {
+			return domain.CustomerMerge{}, commons.ErrCustomerAccountConflict
+		},
+	}
+	svc := services.NewCustomerDuplicateService(dupRepo)
+
+	resp, err := svc.MergeCustomers(kycOfficerContext("compliance-officer-1"), models.MergeCustomersRequest{
+		CandidateID:         "cand-1",
+		SurvivingCustomerID: "CUST-NEW",
+		Comment:             "same person",
+	})
+	if !errors.Is(err, commons.ErrCustomerAccountConflict) || resp.Message != "Customers hold accounts in the same currency" {
+		t.Fatalf("expected currency conflict, got %q %v", resp.Message, err)
+	}
+}
+
+func TestMergeCustomers_RejectsCustomerNotActive(t *testing.T) {
+	dupRepo := &customerDuplicateRepoStub{
+		getFn: func(context.Context, string) (domain.DuplicateCandidate, error) {
+			return domain.DuplicateCandidate{ID: "cand-1", CustomerID: "CUST-NEW", MatchedCustomerID: "CUST-OLD", Status: domain.DuplicateCandidateOpen}, nil
+		},
+		mergeFn: func(context.Context, domain.CustomerMerge) (domain.CustomerMerge, error) {
+			return domain.CustomerMerge{}, commons.ErrCustomerNotMergeable
+		},
+	}
+	svc := services.NewCustomerDuplicateService(dupRepo)
+
+	resp, err := svc.MergeCustomers(kycOfficerContext("compliance-officer-1"), models.MergeCustomersRequest{
+		CandidateID:         "cand-1",
+		SurvivingCustomerID: "CUST-OLD",
+		Comment:             "same person",
+	})
+	if !errors.Is(err, commons.ErrCustomerNotMergeable) || resp.Message != "Only ACTIVE customers can be merged" {
+		t.Fatalf("expected not mergeable, got %q %v", resp.Message, err)
+	}
+}
+
+func TestDuplicateReviewRequiresAuthenticatedReviewer(t *testing.T) {
+	dupRepo := &customerDuplicateRepoStub{
+		getFn: func(context.Context, string) (domain.DuplicateCandidate, error) {
+			return domain.DuplicateCandidate{ID: "cand-1", CustomerID: "CUST-NEW", MatchedCustomerID: "CUST-OLD", Status: domain.DuplicateCandidateOpen}, nil
+		},
+		mergeFn: func(context.Context, domain.CustomerMerge) (domain.CustomerMerge, error) {
+			t.Fatal("merge must not be attempted")
+			return domain.CustomerMerge{}, nil
+		},
+	}
+	svc := services.NewCustomerDuplicateService(dupRepo)
+
+	mergeResp, err := svc.MergeCustomers(context.Background(), models.MergeCustomersRequest{
+		CandidateID:         "cand-1",
+		SurvivingCustomerID: "CUST-OLD",
+		Comment:             "same person",
+	})
+	if err == nil || mergeResp.Message != "validation failed" {
+		t.Fatalf("expected merge without a reviewer to fail validation, got %q %v", mergeResp.Message, err)
+	}
+
+	dismissReq := models.DismissDuplicateCandidateRequest{CandidateID: "cand-1", Comment: "different people"}
+	dismissResp, err := svc.DismissDuplicateCandidate(context.Background(), dismissReq)
+	if err == nil || dismissResp.Message != "validation failed" || dupRepo.reviewedBy != "" {
+		t.Fatalf("expected dismiss without a reviewer to fail validation, got %q %v", dismissResp.Message, err)
+	}
+
+	if _, err := svc.DismissDuplicateCandidate(kycOfficerContext("compliance-officer-2"), dismissReq); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if dupRepo.reviewedBy != "compliance-officer-2" {
+		t.Fatalf("expected the dismissal recorded against the authenticated caller, got %q", dupRepo.reviewedBy)
+	}
+}
+
+func TestCustomerDuplicateRepositoryMergeRejectsHeldCustomer(t *testing.T) {
+	db, recorder := openRecordingDB(t, func(query string) *recordingRows {
+		switch {
+		case strings.Contains(query, "FROM customer_duplicate_candidates"):
+			return &recordingRows{columns: []string{"id"}, values: [][]driver.Value{{"cand-1"}}}
+		case strings.Contains(query, "FROM users"):
+			return &recordingRows{columns: []string{"customer_id", "status"}, values: [][]driver.Value{{"CUST-NEW", "ACTIVE"}, {"CUST-OLD", "HELD"}}}
+		}
+		return nil
+	})
+	repo := implementations.NewCustomerDuplicateRepository(db, newTestFieldCipher(t, map[int][]byte{1: testKEKv1}, 1))
+
+	_, err := repo.Merge(context.Background(), domain.CustomerMerge{
+		CandidateID:         "cand-1",
+		SurvivingCustomerID: "CUST-OLD",
+		MergedCustomerID:    "CUST-NEW",
+		MergedBy:            "compliance-officer-1",
+	})
+	if !errors.Is(err, commons.ErrCustomerNotMergeable) {
+		t.Fatalf("expected ErrCustomerNotMergeable, got %v", err)
+	}
+	if _, ok := recorder.find("UPDATE accounts"); ok || recorder.committed {
+		t.Fatal("expected no accounts moved and nothing committed")
+	}
+}
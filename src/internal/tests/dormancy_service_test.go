@@ -0,0 +1,139 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type dormancyRepoStub struct {
+	batches       [][]domain.Account
+	inactiveSince []time.Time
+	reactivated   []domain.AccountStatusEvent
+	accounts      map[string]domain.Account
+	report        []domain.DormancyReportLine
+}
+
+func (s *dormancyRepoStub) MarkDormant(_ context.Context, inactiveSince time.Time, _ int) ([]domain.Account, error) {
+	s.inactiveSince = append(s.inactiveSince, inactiveSince)
+	if len(s.batches) == 0 {
+		return nil, nil
+	}
+	batch := s.batches[0]
+	s.batches = s.batches[1:]
+	return batch, nil
+}
+
+func (s *dormancyRepoStub) Reactivate(_ context.Context, accountNumber string, event domain.AccountStatusEvent) (domain.Account, error) {
+	account, ok := s.accounts[accountNumber]
+	if !ok || account.Status != domain.AccountStatusDormant {
+		return domain.Account{}, commons.ErrRecordNotFound
+	}
+	account.Status = domain.AccountStatusActive
+	s.accounts[accountNumber] = account
+	s.reactivated = append(s.reactivated, event)
+	return account, nil
+}
+
+func (s *dormancyRepoStub) GetReport(context.Context) ([]domain.DormancyReportLine, error) {
+	return s.report, nil
+}
+
+func newDormancyFixture(status domain.AccountStatus) (*services.DormancyService, *dormancyRepoStub) {
+	account := domain.Account{
+		AccountNumber:    "0000000011",
+		CustomerID:       "CUST-1",
+		Currency:         "USD",
+		AvailableBalance: decimal.NewFromInt(120),
+		LedgerBalance:    decimal.NewFromInt(120),
+		Status:           status,
+	}
+	accounts := map[string]domain.Account{account.AccountNumber: account}
+	dormancyRepo := &dormancyRepoStub{accounts: accounts}
+	accountRepo := &lifecycleAccountRepoStub{accounts: accounts}
+	userRepo := userRepoStub{
+		getByCustomerIDFn: func(context.Context, string) (domain.User, error) {
+			return domain.User{
+				CustomerID: "CUST-1",
+				DOB:        time.Date(1990, 4, 12, 0, 0, 0, 0, time.UTC),
+				IDType:     domain.IDTypePassport,
+				IDNumber:   "A01234567",
+				Status:     domain.UserStatusActive,
+			}, nil
+		},
+	}
+	return services.NewDormancyService(dormancyRepo, accountRepo, userRepo, 365), dormancyRepo
+}
+
+func TestDormancyService_ReactivateRequiresMatchingKYCDetails(t *testing.T) {
+	svc, repo := newDormancyFixture(domain.AccountStatusDormant)
+	req := models.ReactivateAccountRequest{
+		AccountNumber: "0000000011",
+		IDType:        "Passport",
+		IDNumber:      "A07654321",
+		DOB:           "1990-04-12",
+	}
+
+	resp, err := svc.ReactivateAccount(context.Background(), req)
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected reactivation without an authenticated verifier to fail validation, got %v (%s)", err, resp.Message)
+	}
+
+	resp, err = svc.ReactivateAccount(kycOfficerContext("branch-officer-7"), req)
+	if err == nil || resp.Message != "KYC verification failed" {
+		t.Fatalf("expected KYC verification failure, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.reactivated) != 0 {
+		t.Fatalf("expected account to stay dormant")
+	}
+
+	req.IDNumber = "a01234567"
+	resp, err = svc.ReactivateAccount(kycOfficerContext("branch-officer-7"), req)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data.Status != string(domain.AccountStatusActive) {
+		t.Fatalf("expected ACTIVE account, got %s", resp.Data.Status)
+	}
+	if len(repo.reactivated) != 1 || repo.reactivated[0].Reason != domain.AccountStatusReasonKYCReverified || repo.reactivated[0].Actor != "branch-officer-7" {
+		t.Fatalf("expected a KYC_REVERIFIED event by the verifier, got %+v", repo.reactivated)
+	}
+}
+
+func TestDormancyService_ReactivateRejectsAccountThatIsNotDormant(t *testing.T) {
+	svc, _ := newDormancyFixture(domain.AccountStatusFrozen)
+
+	resp, err := svc.ReactivateAccount(kycOfficerContext("branch-officer-7"), models.ReactivateAccountRequest{
+		AccountNumber: "0000000011",
+		IDType:        "Passport",
+		IDNumber:      "A01234567",
+		DOB:           "1990-04-12",
+	})
+	if err == nil || resp.Message != "Account status does not allow this change" {
+		t.Fatalf("expected status conflict, got %v (%s)", err, resp.Message)
+	}
+}
+
+func TestDormancyService_MarksInactiveAccountsInBatches(t *testing.T) {
+	svc, repo := newDormancyFixture(domain.AccountStatusActive)
+	full := make([]domain.Account, 500)
+	repo.batches = [][]domain.Account{full, make([]domain.Account, 3)}
+
+	marked, err := svc.MarkDormantAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if marked != 503 || len(repo.inactiveSince) != 2 {
+		t.Fatalf("expected 503 accounts over 2 batches, got %d over %d", marked, len(repo.inactiveSince))
+	}
+	expected := time.Now().UTC().AddDate(0, 0, -365)
+	if diff := repo.inactiveSince[0].Sub(expected); diff < -time.Minute || diff > time.Minute {
+		t.Fatalf("expected cutoff near %s, got %s", expected, repo.inactiveSince[0])
+	}
+}
@@ -0,0 +1,95 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/events"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+func sampleOutboxEvent() domain.OutboxEvent {
+	return domain.OutboxEvent{
+		ID:               "evt-1",
+		AggregateType:    domain.AggregateTypeTransfer,
+		AggregateID:      "tr-1",
+		AggregateVersion: 3,
+		EventType:        string(domain.TransferEventClosed),
+		SchemaVersion:    domain.EventSchemaVersion,
+		Payload:          `{"transferId":"tr-1"}`,
+		CreatedAt:        time.Now(),
+	}
+}
+
+func TestKafkaPublisherProducesKeyedRecord(t *testing.T) {
+	var (
+		gotPath        string
+		gotContentType string
+		gotBody        struct {
+			Records []struct {
+				Key   string               `json:"key"`
+				Value domain.EventEnvelope `json:"value"`
+			} `json:"records"`
+		}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotContentType = r.Header.Get("Content-Type")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/vnd.kafka.v2+json")
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":7}]}`))
+	}))
+	defer server.Close()
+
+	publisher := events.NewKafkaPublisher(server.URL, "payments", time.Second)
+	if err := publisher.Publish(context.Background(), sampleOutboxEvent()); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	if gotPath != "/topics/payments.transfer" {
+		t.Fatalf("expected topic path /topics/payments.transfer, got %s", gotPath)
+	}
+	if gotContentType != "application/vnd.kafka.json.v2+json" {
+		t.Fatalf("unexpected content type %s", gotContentType)
+	}
+	if len(gotBody.Records) != 1 || gotBody.Records[0].Key != "tr-1" {
+		t.Fatalf("expected one record keyed by aggregate id, got %+v", gotBody.Records)
+	}
+	if gotBody.Records[0].Value.DedupKey != "transfer:tr-1:3" || gotBody.Records[0].Value.SchemaVersion != 1 {
+		t.Fatalf("unexpected envelope %+v", gotBody.Records[0].Value)
+	}
+}
+
+func TestKafkaPublisherReturnsRecordErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"leader not available"}]}`))
+	}))
+	defer server.Close()
+
+	publisher := events.NewKafkaPublisher(server.URL, "payments", time.Second)
+	if err := publisher.Publish(context.Background(), sampleOutboxEvent()); err == nil {
+		t.Fatal("expected record error to be returned")
+	}
+}
+
+func TestLogPublisherWritesJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := events.NewLogPublisher(&buf)
+
+	if err := publisher.Publish(context.Background(), sampleOutboxEvent()); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	var envelope domain.EventEnvelope
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &envelope); err != nil {
+		t.Fatalf("expected one JSON line, got %q", buf.String())
+	}
+	if envelope.EventID != "evt-1" || string(envelope.Data) != `{"transferId":"tr-1"}` {
+		t.Fatalf("unexpected envelope %+v", envelope)
+	}
+}
@@ -0,0 +1,181 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type exchangeRepoStub struct {
+	created []domain.Exchange
+	posting domain.ExchangePosting
+}
+
+func (s *exchangeRepoStub) Create(_ context.Context, exchange domain.Exchange, posting domain.ExchangePosting) (domain.Exchange, error) {
+	exchange.ID = "x-1"
+	exchange.JournalEntries = []domain.JournalEntry{
+		{AccountNumber: exchange.SourceAccountNumber, EntryType: domain.LedgerEntryDebit, Currency: exchange.SourceCurrency, Amount: exchange.TotalDebit},
+		{AccountNumber: exchange.DestinationAccountNumber, EntryType: domain.LedgerEntryCredit, Currency: exchange.DestinationCurrency, Amount: exchange.DestinationAmount},
+		{AccountNumber: exchange.FXIncomeGLAccountNumber, EntryType: domain.LedgerEntryCredit, Currency: exchange.DestinationCurrency, Amount: exchange.FXIncomeAmount},
+	}
+	s.created = append(s.created, exchange)
+	s.posting = posting
+	return exchange, nil
+}
+
+func newExchangeService(t *testing.T, exchangeChargePercent decimal.Decimal) (*services.ExchangeService, *exchangeRepoStub) {
+	t.Helper()
+
+	pinHash, err := bcrypt.GenerateFromPassword([]byte("1234"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash pin: %v", err)
+	}
+	userService := services.NewUserService(userRepoStub{
+		getTransactionPinHashByCustFn: func(context.Context, string) (string, error) {
+			return string(pinHash), nil
+		},
+	}, nil, testPinPolicy)
+	chargesService := services.NewChargesService(
+		lifecycleRateRepoStub{},
+		decimal.NewFromInt(1),
+		decimal.NewFromFloat(7.5),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(20),
+		decimal.NewFromFloat(0.5),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(10),
+		exchangeChargePercent,
+		decimal.Zero,
+		decimal.NewFromInt(10),
+	)
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(100), Status: domain.AccountStatusActive},
+		"0000000028": {CustomerID: "C1", AccountNumber: "0000000028", Currency: "NGN", Status: domain.AccountStatusActive},
+		"0000000035": {CustomerID: "C2", AccountNumber: "0000000035", Currency: "NGN", Status: domain.AccountStatusActive},
+		"0123456788": {CustomerID: "C1", AccountNumber: "0123456788", Currency: "USD", Status: domain.AccountStatusActive},
+	}}
+	exchangeRepo := &exchangeRepoStub{}
+	svc := services.NewExchangeService(
+		accounts,
+		lifecycleRateRepoStub{},
+		exchangeRepo,
+		userService,
+		chargesService,
+		nil,
+		decimal.NewFromInt(1),
+		"0123456890",
+		"0123445521",
+		"0125548976",
+		map[string]string{"NGN": "0125548992", "USD": "0125548989"},
+	)
+	return svc, exchangeRepo
+}
+
+func TestExchange_ConvertsAtQuotedRateAndBooksSpread(t *testing.T) {
+	svc, repo := newExchangeService(t, decimal.Zero)
+
+	resp, err := svc.Exchange(context.Background(), models.ExchangeRequest{
+		SourceAccountNumber:      "0000000011",
+		DestinationAccountNumber: "0000000028",
+		Amount:                   decimal.NewFromInt(100),
+		TransactionPIN:           "1234",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 1 {
+		t.Fatalf("expected one exchange posted, got %d", len(repo.created))
+	}
+
+	exchange := repo.created[0]
+	// 1500 less a 1% spread quotes 1485; the 1500 NGN difference is FX income.
+	if !exchange.QuotedRate.Equal(decimal.NewFromInt(1485)) || !exchange.DestinationAmount.Equal(decimal.NewFromInt(148500)) {
+		t.Fatalf("expected 148500 NGN at 1485, got %s at %s", exchange.DestinationAmount, exchange.QuotedRate)
+	}
+	if !exchange.FXIncomeAmount.Equal(decimal.NewFromInt(1500)) || exchange.FXIncomeGLAccountNumber != "0125548992" {
+		t.Fatalf("expected 1500 NGN to the NGN FX income GL, got %s to %q", exchange.FXIncomeAmount, exchange.FXIncomeGLAccountNumber)
+	}
+	if !exchange.ChargeAmount.IsZero() || !exchange.TotalDebit.Equal(decimal.NewFromInt(100)) {
+		t.Fatalf("expected a fee-free debit of 100, got charge %s total %s", exchange.ChargeAmount, exchange.TotalDebit)
+	}
+	if exchange.CustomerID != "C1" || exchange.Reference == "" {
+		t.Fatalf("expected customer and reference on the exchange, got %+v", exchange)
+	}
+	if resp.Data == nil || resp.Data.Reference != exchange.Reference || len(resp.Data.JournalEntries) != 3 {
+		t.Fatalf("expected receipt with reference and journal entries, got %+v", resp.Data)
+	}
+}
+
+func TestExchange_AppliesExchangeFeeSchedule(t *testing.T) {
+	svc, repo := newExchangeService(t, decimal.NewFromInt(1))
+
+	resp, err := svc.Exchange(context.Background(), models.ExchangeRequest{
+		SourceAccountNumber:      "0000000011",
+		DestinationAccountNumber: "0000000028",
+		Amount:                   decimal.NewFromInt(100),
+		TransactionPIN:           "1234",
+	})
+	// 1% of 100 USD plus VAT exceeds the 100 USD balance.
+	if err == nil || resp.Message != "Insufficient balance" {
+		t.Fatalf("expected insufficient balance, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no posting when fees exceed the balance")
+	}
+
+	resp, err = svc.Exchange(context.Background(), models.ExchangeRequest{
+		SourceAccountNumber:      "0000000011",
+		DestinationAccountNumber: "0000000028",
+		Amount:                   decimal.NewFromInt(90),
+		TransactionPIN:           "1234",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	exchange := repo.created[0]
+	if !exchange.ChargeAmount.Equal(decimal.RequireFromString("0.9")) || !exchange.VATAmount.Equal(decimal.RequireFromString("0.07")) {
+		t.Fatalf("expected fees 0.9 + 0.07, got %s + %s", exchange.ChargeAmount, exchange.VATAmount)
+	}
+	if !repo.posting.ChargeUSD.Equal(decimal.RequireFromString("0.9")) || repo.posting.ChargesAccountNumber != "0123445521" {
+		t.Fatalf("expected USD fee posting to the charges account, got %+v", repo.posting)
+	}
+}
+
+func TestExchange_RejectsAccountsOfDifferentCustomers(t *testing.T) {
+	svc, repo := newExchangeService(t, decimal.Zero)
+
+	resp, err := svc.Exchange(context.Background(), models.ExchangeRequest{
+		SourceAccountNumber:      "0000000011",
+		DestinationAccountNumber: "0000000035",
+		Amount:                   decimal.NewFromInt(50),
+		TransactionPIN:           "1234",
+	})
+	if err == nil || resp.Message != "Accounts belong to different customers" {
+		t.Fatalf("expected different customers error, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no posting across customers")
+	}
+}
+
+func TestExchange_RejectsSameCurrencyAccounts(t *testing.T) {
+	svc, repo := newExchangeService(t, decimal.Zero)
+
+	resp, err := svc.Exchange(context.Background(), models.ExchangeRequest{
+		SourceAccountNumber:      "0000000011",
+		DestinationAccountNumber: "0123456788",
+		Amount:                   decimal.NewFromInt(50),
+		TransactionPIN:           "1234",
+	})
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.created) != 0 {
+		t.Fatal("expected no posting between same-currency accounts")
+	}
+}
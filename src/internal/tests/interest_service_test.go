@@ -0,0 +1,264 @@
+package services_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type interestRepoStub struct {
+	accounts        []domain.Account
+	accruals        map[string]domain.InterestAccrual
+	capitalizations map[string]domain.InterestCapitalization
+	evaluatedDates  []string
+	batchRuns       []time.Time
+}
+
+func newInterestRepoStub(accounts ...domain.Account) *interestRepoStub {
+	return &interestRepoStub{
+		accounts:        accounts,
+		accruals:        make(map[string]domain.InterestAccrual),
+		capitalizations: make(map[string]domain.InterestCapitalization),
+	}
+}
+
+func (s *interestRepoStub) GetRateTiers(context.Context) ([]domain.InterestRateTier, error) {
+	return []domain.InterestRateTier{
+		{Currency: "NGN", Product: domain.AccountProductSavings, MinBalance: decimal.Zero, AnnualRatePercent: decimal.NewFromInt(4), DayCount: domain.DayCountActual365},
+		{Currency: "NGN", Product: domain.AccountProductSavings, MinBalance: decimal.NewFromInt(1000000), AnnualRatePercent: decimal.NewFromInt(6), DayCount: domain.DayCountActual365},
+		{Currency: "USD", Product: domain.AccountProductSavings, MinBalance: decimal.Zero, AnnualRatePercent: decimal.NewFromInt(1), DayCount: domain.DayCountActual360},
+		{Currency: "USD", Product: domain.AccountProductStandard, MinBalance: decimal.Zero, AnnualRatePercent: decimal.Zero, DayCount: domain.DayCountActual360},
+	}, nil
+}
+
+func (s *interestRepoStub) GetInterestBearingAccounts(_ context.Context, date time.Time) ([]domain.Account, error) {
+	s.evaluatedDates = append(s.evaluatedDates, date.Format(time.DateOnly))
+	return s.accounts, nil
+}
+
+func (s *interestRepoStub) GetLastBatchRunDate(context.Context) (*time.Time, error) {
+	if len(s.batchRuns) == 0 {
+		return nil, nil
+	}
+	last := s.batchRuns[len(s.batchRuns)-1]
+	return &last, nil
+}
+
+func (s *interestRepoStub) RecordBatchRun(_ context.Context, date time.Time) error {
+	s.batchRuns = append(s.batchRuns, date)
+	return nil
+}
+
+func (s *interestRepoStub) RecordAccruals(_ context.Context, accruals []domain.InterestAccrual) (int, error) {
+	inserted := 0
+	for _, accrual := range accruals {
+		key := accrual.AccountNumber + "|" + accrual.AccrualDate.Format(time.DateOnly)
+		if _, ok := s.accruals[key]; ok {
+			continue
+		}
+		s.accruals[key] = accrual
+		inserted++
+	}
+	return inserted, nil
+}
+
+func (s *interestRepoStub) GetOpenAccrualTotals(_ context.Context, periodEnd time.Time) ([]domain.InterestCapitalization, error) {
+	totals := make(map[string]domain.InterestCapitalization)
+	for _, accrual := range s.accruals {
+		if accrual.CapitalizationID != "" || accrual.AccrualDate.After(periodEnd) {
+			continue
+		}
+		total, ok := totals[accrual.AccountNumber]
+		if !ok {
+			total = domain.InterestCapitalization{AccountNumber: accrual.AccountNumber, Currency: accrual.Currency, PeriodStart: accrual.AccrualDate, PeriodEnd: periodEnd}
+		}
+		if accrual.AccrualDate.Before(total.PeriodStart) {
+			total.PeriodStart = accrual.AccrualDate
+		}
+		total.AccruedAmount = total.AccruedAmount.Add(accrual.Amount)
+		totals[accrual.AccountNumber] = total
+	}
+
+	result := make([]domain.InterestCapitalization, 0, len(totals))
+	for _, total := range totals {
+		result = append(result, total)
+	}
+	return result, nil
+}
+
+func (s *interestRepoStub) Capitalize(_ context.Context, capitalization domain.InterestCapitalization) (domain.InterestCapitalization, error) {
+	key := capitalization.AccountNumber + "|" + capitalization.PeriodEnd.Format(time.DateOnly)
+	if _, ok := s.capitalizations[key]; ok {
+		return domain.InterestCapitalization{}, commons.ErrInterestAlreadyCapitalized
+	}
+	capitalization.ID = "cap-" + capitalization.AccountNumber
+	for accrualKey, accrual := range s.accruals {
+		if accrual.AccountNumber == capitalization.AccountNumber && accrual.CapitalizationID == "" && !accrual.AccrualDate.After(capitalization.PeriodEnd) {
+			accrual.CapitalizationID = capitalization.ID
+			s.accruals[accrualKey] = accrual
+		}
+	}
+	s.capitalizations[key] = capitalization
+	return capitalization, nil
+}
+
+func newInterestService(repo *interestRepoStub) *services.InterestService {
+	return services.NewInterestService(
+		repo,
+		nil,
+		decimal.NewFromInt(10),
+		map[string]string{"NGN": "0125548996", "USD": "0125548993"},
+		map[string]string{"NGN": "0125549000", "USD": "0125548997"},
+	)
+}
+
+func TestInterestService_AccruesFromTierAndDayCountOnce(t *testing.T) {
+	repo := newInterestRepoStub(
+		domain.Account{AccountNumber: "0000000011", Currency: "NGN", Product: domain.AccountProductSavings, LedgerBalance: decimal.NewFromInt(1460000)},
+		domain.Account{AccountNumber: "0000000028", Currency: "USD", Product: domain.AccountProductSavings, LedgerBalance: decimal.NewFromInt(36000)},
+		domain.Account{AccountNumber: "0000000035", Currency: "USD", Product: domain.AccountProductStandard, LedgerBalance: decimal.NewFromInt(5000)},
+	)
+	svc := newInterestService(repo)
+
+	resp, err := svc.RunBatch(context.Background(), models.RunInterestBatchRequest{Date: "2026-03-10"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data.AccountsEvaluated != 3 || resp.Data.AccrualsRecorded != 2 || resp.Data.MonthEnd {
+		t.Fatalf("expected two accruals mid-month, got %+v", resp.Data)
+	}
+	// 1,460,000 NGN sits in the 6% tier: 1,460,000 x 6% / 365 = 240.
+	if ngn := repo.accruals["0000000011|2026-03-10"]; !ngn.Amount.Equal(decimal.NewFromInt(240)) || ngn.DayCount != domain.DayCountActual365 {
+		t.Fatalf("expected 240 NGN on ACT/365, got %s on %s", ngn.Amount, ngn.DayCount)
+	}
+	// 36,000 USD at 1% / 360 = 1.
+	if usd := repo.accruals["0000000028|2026-03-10"]; !usd.Amount.Equal(decimal.NewFromInt(1)) {
+		t.Fatalf("expected 1 USD on ACT/360, got %s", usd.Amount)
+	}
+
+	resp, err = svc.RunBatch(context.Background(), models.RunInterestBatchRequest{Date: "2026-03-10"})
+	if err != nil {
+		t.Fatalf("expected nil error on re-run, got %v", err)
+	}
+	if resp.Data.AccrualsRecorded != 0 || resp.Data.AccrualsSkipped != 2 || len(repo.accruals) != 2 {
+		t.Fatalf("expected re-run to record nothing, got %+v", resp.Data)
+	}
+}
+
+func TestInterestService_CapitalizesAtMonthEndNetOfTaxOnce(t *testing.T) {
+	repo := newInterestRepoStub(
+		domain.Account{AccountNumber: "0000000011", Currency: "NGN", Product: domain.AccountProductSavings, LedgerBalance: decimal.NewFromInt(1460000)},
+	)
+	svc := newInterestService(repo)
+
+	for _, date := range []string{"2026-04-29", "2026-04-30"} {
+		if resp, err := svc.RunBatch(context.Background(), models.RunInterestBatchRequest{Date: date}); err != nil {
+			t.Fatalf("expected nil error for %s, got %v (%s)", date, err, resp.Message)
+		}
+	}
+
+	capitalization, ok := repo.capitalizations["0000000011|2026-04-30"]
+	if !ok {
+		t.Fatal("expected a capitalization at month end")
+	}
+	if !capitalization.GrossAmount.Equal(decimal.NewFromInt(480)) || !capitalization.TaxAmount.Equal(decimal.NewFromInt(48)) || !capitalization.NetAmount.Equal(decimal.NewFromInt(432)) {
+		t.Fatalf("expected 480 gross, 48 tax, 432 net, got %s/%s/%s", capitalization.GrossAmount, capitalization.TaxAmount, capitalization.NetAmount)
+	}
+	if capitalization.ExpenseGLAccountNumber != "0125548996" || capitalization.TaxGLAccountNumber != "0125549000" {
+		t.Fatalf("expected NGN interest GL accounts, got %+v", capitalization)
+	}
+	if capitalization.PeriodStart.Format(time.DateOnly) != "2026-04-29" || capitalization.Reference == "" {
+		t.Fatalf("expected period from the first open accrual with a reference, got %+v", capitalization)
+	}
+
+	resp, err := svc.RunBatch(context.Background(), models.RunInterestBatchRequest{Date: "2026-04-30"})
+	if err != nil {
+		t.Fatalf("expected nil error on re-run, got %v", err)
+	}
+	if len(resp.Data.Capitalizations) != 0 || len(repo.capitalizations) != 1 {
+		t.Fatalf("expected re-run to post nothing, got %+v", resp.Data)
+	}
+}
+
+func TestInterestService_RejectsDateNotInThePast(t *testing.T) {
+	svc := newInterestService(newInterestRepoStub())
+
+	today := time.Now().UTC().Format(time.DateOnly)
+	resp, err := svc.RunBatch(context.Background(), models.RunInterestBatchRequest{Date: today})
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected validation failure for today, got %v (%s)", err, resp.Message)
+	}
+}
+
+func TestInterestService_BatchRunnerCatchesUpFromLastRun(t *testing.T) {
+	repo := newInterestRepoStub()
+	yesterday := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	repo.batchRuns = []time.Time{yesterday.AddDate(0, 0, -3)}
+	svc := newInterestService(repo)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	svc.StartBatchRunner(ctx, time.Hour)
+
+	want := []string{
+		yesterday.AddDate(0, 0, -2).Format(time.DateOnly),
+		yesterday.AddDate(0, 0, -1).Format(time.DateOnly),
+		yesterday.Format(time.DateOnly),
+	}
+	if strings.Join(repo.evaluatedDates, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected the runner to run %v in order, got %v", want, repo.evaluatedDates)
+	}
+	if len(repo.batchRuns) != 4 || !repo.batchRuns[3].Equal(yesterday) {
+		t.Fatalf("expected each caught-up day recorded, got %v", repo.batchRuns)
+	}
+
+	repo.evaluatedDates = nil
+	svc.StartBatchRunner(ctx, time.Hour)
+	if len(repo.evaluatedDates) != 0 {
+		t.Fatalf("expected nothing left to run, got %v", repo.evaluatedDates)
+	}
+}
+
+func TestInterestRepositoryReadsEndOfDayLedgerBalanceFromAccountEvents(t *testing.T) {
+	db, recorder := openRecordingDB(t, func(query string) *recordingRows {
+		if strings.Contains(query, "FROM accounts a") {
+			now := time.Now()
+			return &recordingRows{
+				columns: strings.Split(accountColumnNames, ", "),
+				values:  [][]driver.Value{{"acc-1", "C1", "0000000011", "NGN", "SAVINGS", "50", "1460000", "0", "ACTIVE", now, now, now}},
+			}
+		}
+		return nil
+	})
+	repo := implementations.NewInterestRepository(db)
+
+	accounts, err := repo.GetInterestBearingAccounts(context.Background(), time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(accounts) != 1 || !accounts[0].LedgerBalance.Equal(decimal.NewFromInt(1460000)) {
+		t.Fatalf("expected the end-of-day ledger balance, got %+v", accounts)
+	}
+
+	statement, ok := recorder.find("FROM accounts a")
+	if !ok {
+		t.Fatal("expected the interest bearing accounts query")
+	}
+	for _, fragment := range []string{"payload::jsonb ->> 'ledgerBalance'", "o.created_at < ($1::date + 1)", "ORDER BY o.aggregate_version DESC"} {
+		if !strings.Contains(statement.query, fragment) {
+			t.Fatalf("expected the balance to come from the last account event before the end of the date (%q), got %s", fragment, statement.query)
+		}
+	}
+	if len(statement.args) != 1 || statement.args[0].Value != "2026-03-10" {
+		t.Fatalf("expected the date argument, got %+v", statement.args)
+	}
+}
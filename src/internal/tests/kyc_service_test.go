@@ -0,0 +1,224 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+)
+
+// kycRepoStub keeps one upgrade request and records how it was resolved.
+type kycRepoStub struct {
+	request    domain.KYCUpgradeRequest
+	submitted  []domain.KYCUpgradeRequest
+	resolvedAs domain.KYCUpgradeStatus
+}
+
+func (s *kycRepoStub) Submit(_ context.Context, request domain.KYCUpgradeRequest) (domain.KYCUpgradeRequest, error) {
+	request.ID = "kyc-1"
+	request.Status = domain.KYCUpgradePending
+	s.submitted = append(s.submitted, request)
+	return request, nil
+}
+
+func (s *kycRepoStub) GetByID(_ context.Context, id string) (domain.KYCUpgradeRequest, error) {
+	if id != s.request.ID {
+		return domain.KYCUpgradeRequest{}, commons.ErrRecordNotFound
+	}
+	return s.request, nil
+}
+
+func (s *kycRepoStub) GetByStatus(context.Context, domain.KYCUpgradeStatus, int) ([]domain.KYCUpgradeRequest, error) {
+	return []domain.KYCUpgradeRequest{s.request}, nil
+}
+
+func (s *kycRepoStub) GetByCustomerID(context.Context, string) ([]domain.KYCUpgradeRequest, error) {
+	return []domain.KYCUpgradeRequest{s.request}, nil
+}
+
+func (s *kycRepoStub) Approve(_ context.Context, _ string, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error) {
+	return s.resolve(domain.KYCUpgradeApproved, reviewedBy, comment)
+}
+
+func (s *kycRepoStub) Reject(_ context.Context, _ string, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error) {
+	return s.resolve(domain.KYCUpgradeRejected, reviewedBy, comment)
+}
+
+func (s *kycRepoStub) resolve(status domain.KYCUpgradeStatus, reviewedBy string, comment string) (domain.KYCUpgradeRequest, error) {
+	s.resolvedAs = status
+	s.request.Status = status
+	s.request.ReviewedBy = &reviewedBy
+	s.request.ReviewComment = comment
+	return s.request, nil
+}
+
+func kycUserRepo(level int) userRepoStub {
+	return userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			return domain.User{CustomerID: customerID, KYCLevel: level, Status: domain.UserStatusActive}, nil
+		},
+	}
+}
+
+func pendingKYCRepo() *kycRepoStub {
+	return &kycRepoStub{request: domain.KYCUpgradeRequest{
+		ID:             "kyc-1",
+		CustomerID:     "C1",
+		CurrentLevel:   1,
+		RequestedLevel: 2,
+		Status:         domain.KYCUpgradePending,
+		SubmittedBy:    "maker",
+	}}
+}
+
+// kycOfficerContext authenticates subject as a back-office operator token.
+func kycOfficerContext(subject string) context.Context {
+	return commons.WithPrincipal(context.Background(), commons.Principal{Subject: subject, Scopes: []string{string(domain.ChannelScopeBackOffice)}})
+}
+
+func TestKYCServiceSubmitRequiresHigherLevel(t *testing.T) {
+	repo := &kycRepoStub{}
+	svc := services.NewKYCService(repo, kycUserRepo(2))
+
+	response, err := svc.SubmitKYCUpgrade(kycOfficerContext("maker"), models.SubmitKYCUpgradeRequest{
+		CustomerID:        "C1",
+		RequestedLevel:    2,
+		DocumentType:      "Passport",
+		DocumentNumber:    "A1234567",
+		IssuingCountry:    "ng",
+		DocumentReference: "docs/kyc/C1/passport.pdf",
+	})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %v (%s)", err, response.Message)
+	}
+	if len(repo.submitted) != 0 {
+		t.Fatalf("expected nothing to be submitted, got %d", len(repo.submitted))
+	}
+}
+
+func TestKYCServiceSubmitRejectsExpiredDocument(t *testing.T) {
+	repo := &kycRepoStub{}
+	svc := services.NewKYCService(repo, kycUserRepo(1))
+
+	response, err := svc.SubmitKYCUpgrade(kycOfficerContext("maker"), models.SubmitKYCUpgradeRequest{
+		CustomerID:        "C1",
+		RequestedLevel:    2,
+		DocumentType:      "Passport",
+		DocumentNumber:    "A1234567",
+		IssuingCountry:    "NG",
+		DocumentExpiresAt: "2001-01-01",
+		DocumentReference: "docs/kyc/C1/passport.pdf",
+	})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected expired document to be rejected, got %v (%s)", err, response.Message)
+	}
+	if len(repo.submitted) != 0 {
+		t.Fatalf("expected nothing to be submitted, got %d", len(repo.submitted))
+	}
+}
+
+func TestKYCServiceSubmitStoresCurrentLevel(t *testing.T) {
+	repo := &kycRepoStub{}
+	svc := services.NewKYCService(repo, kycUserRepo(1))
+
+	response, err := svc.SubmitKYCUpgrade(kycOfficerContext("maker"), models.SubmitKYCUpgradeRequest{
+		CustomerID:        "C1",
+		RequestedLevel:    3,
+		DocumentType:      "NationalID",
+		DocumentNumber:    "12345678901",
+		IssuingCountry:    "ng",
+		DocumentReference: "docs/kyc/C1/nin.pdf",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, response.Message)
+	}
+	if len(repo.submitted) != 1 || repo.submitted[0].CurrentLevel != 1 || repo.submitted[0].IssuingCountry != "NG" {
+		t.Fatalf("expected one request from level 1 with the country upper-cased, got %+v", repo.submitted)
+	}
+	if repo.submitted[0].SubmittedBy != "maker" {
+		t.Fatalf("expected the authenticated caller as submitter, got %q", repo.submitted[0].SubmittedBy)
+	}
+	if response.Data.Status != string(domain.KYCUpgradePending) {
+		t.Fatalf("expected PENDING, got %s", response.Data.Status)
+	}
+}
+
+func TestKYCServiceRejectsDecisionBySubmitter(t *testing.T) {
+	repo := pendingKYCRepo()
+	svc := services.NewKYCService(repo, kycUserRepo(1))
+
+	response, err := svc.ApproveKYCUpgrade(kycOfficerContext("Maker"), models.KYCUpgradeDecisionRequest{
+		RequestID: "kyc-1",
+		Comment:   "documents verified",
+	})
+	if !errors.Is(err, commons.ErrMakerCheckerViolation) {
+		t.Fatalf("expected maker-checker violation, got %v (%s)", err, response.Message)
+	}
+	if repo.resolvedAs != "" {
+		t.Fatalf("expected request to stay pending, got %s", repo.resolvedAs)
+	}
+}
+
+func TestKYCServiceDecisionRequiresAuthenticatedReviewer(t *testing.T) {
+	repo := pendingKYCRepo()
+	svc := services.NewKYCService(repo, kycUserRepo(1))
+
+	response, err := svc.ApproveKYCUpgrade(context.Background(), models.KYCUpgradeDecisionRequest{
+		RequestID: "kyc-1",
+		Comment:   "documents verified",
+	})
+	if err == nil || response.Message != "validation failed" {
+		t.Fatalf("expected an unauthenticated decision to fail validation, got %v (%s)", err, response.Message)
+	}
+	if repo.resolvedAs != "" {
+		t.Fatalf("expected request to stay pending, got %s", repo.resolvedAs)
+	}
+}
+
+func TestKYCServiceApproveAndRejectResolveRequest(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		decide func(*services.KYCService, context.Context, models.KYCUpgradeDecisionRequest) (commons.Response[models.KYCUpgradeResponse], error)
+		want   domain.KYCUpgradeStatus
+	}{
+		{"approve", (*services.KYCService).ApproveKYCUpgrade, domain.KYCUpgradeApproved},
+		{"reject", (*services.KYCService).RejectKYCUpgrade, domain.KYCUpgradeRejected},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := pendingKYCRepo()
+			svc := services.NewKYCService(repo, kycUserRepo(1))
+
+			response, err := tc.decide(svc, kycOfficerContext("checker"), models.KYCUpgradeDecisionRequest{
+				RequestID: "kyc-1",
+				Comment:   "reviewed",
+			})
+			if err != nil {
+				t.Fatalf("expected nil error, got %v (%s)", err, response.Message)
+			}
+			if repo.resolvedAs != tc.want || response.Data.ReviewedBy != "checker" {
+				t.Fatalf("expected %s by checker, got %s by %s", tc.want, repo.resolvedAs, response.Data.ReviewedBy)
+			}
+		})
+	}
+}
+
+func TestKYCServiceDecisionRequiresPendingRequest(t *testing.T) {
+	repo := pendingKYCRepo()
+	repo.request.Status = domain.KYCUpgradeApproved
+	svc := services.NewKYCService(repo, kycUserRepo(2))
+
+	response, err := svc.RejectKYCUpgrade(kycOfficerContext("checker"), models.KYCUpgradeDecisionRequest{
+		RequestID: "kyc-1",
+		Comment:   "too late",
+	})
+	if err == nil || response.Message != "KYC upgrade is not pending" {
+		t.Fatalf("expected not pending, got %v (%s)", err, response.Message)
+	}
+	if repo.resolvedAs != "" {
+		t.Fatalf("expected no resolution, got %s", repo.resolvedAs)
+	}
+}
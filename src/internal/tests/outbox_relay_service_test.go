@@ -0,0 +1,168 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/events"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+)
+
+type outboxRepoStub struct {
+	pending   []domain.OutboxEvent
+	published []string
+	failed    []domain.OutboxEvent
+}
+
+// ClaimPending mirrors the repository contract: only the oldest unpublished event
+// of each aggregate is handed out.
+func (s *outboxRepoStub) ClaimPending(_ context.Context, limit int, _ time.Duration) ([]domain.OutboxEvent, error) {
+	seen := map[string]bool{}
+	var claimed []domain.OutboxEvent
+	for _, event := range s.pending {
+		key := string(event.AggregateType) + event.AggregateID
+		if seen[key] || len(claimed) == limit {
+			continue
+		}
+		seen[key] = true
+		if event.NextAttemptAt.After(time.Now()) {
+			continue
+		}
+		claimed = append(claimed, event)
+	}
+	return claimed, nil
+}
+
+func (s *outboxRepoStub) MarkPublished(_ context.Context, id string) error {
+	s.published = append(s.published, id)
+	for i, event := range s.pending {
+		if event.ID == id {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (s *outboxRepoStub) MarkFailed(_ context.Context, event domain.OutboxEvent) error {
+	s.failed = append(s.failed, event)
+	for i := range s.pending {
+		if s.pending[i].ID == event.ID {
+			s.pending[i] = event
+		}
+	}
+	return nil
+}
+
+type failingPublisher struct{}
+
+func (failingPublisher) Publish(context.Context, domain.OutboxEvent) error {
+	return errors.New("broker unavailable")
+}
+
+func TestOutboxRelayPublishesInAggregateOrder(t *testing.T) {
+	repo := &outboxRepoStub{pending: []domain.OutboxEvent{
+		{ID: "e1", Sequence: 1, AggregateType: domain.AggregateTypeTransfer, AggregateID: "t1", AggregateVersion: 1, EventType: "TRANSFER_CREATED", Payload: `{}`},
+		{ID: "e2", Sequence: 2, AggregateType: domain.AggregateTypeAccount, AggregateID: "a1", AggregateVersion: 1, EventType: "ACCOUNT_DEBITED", Payload: `{}`},
+		{ID: "e3", Sequence: 3, AggregateType: domain.AggregateTypeTransfer, AggregateID: "t1", AggregateVersion: 2, EventType: "TRANSFER_SUCCEEDED", Payload: `{}`},
+	}}
+	publisher := events.NewMemoryPublisher()
+	svc := services.NewOutboxRelayService(repo, publisher, 10, time.Second)
+
+	published, err := svc.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if published != 3 {
+		t.Fatalf("expected 3 events published, got %d", published)
+	}
+
+	var transferEvents []string
+	for _, envelope := range publisher.Events() {
+		if envelope.AggregateID == "t1" {
+			transferEvents = append(transferEvents, envelope.EventType)
+		}
+	}
+	if len(transferEvents) != 2 || transferEvents[0] != "TRANSFER_CREATED" || transferEvents[1] != "TRANSFER_SUCCEEDED" {
+		t.Fatalf("expected transfer events in order, got %v", transferEvents)
+	}
+	if got := publisher.Events()[0].DedupKey; got != "transfer:t1:1" {
+		t.Fatalf("expected dedup key transfer:t1:1, got %s", got)
+	}
+}
+
+func TestOutboxRelayBacksOffAndHoldsLaterEventsOnFailure(t *testing.T) {
+	repo := &outboxRepoStub{pending: []domain.OutboxEvent{
+		{ID: "e1", Sequence: 1, AggregateType: domain.AggregateTypeAccount, AggregateID: "a1", AggregateVersion: 1, Payload: `{}`},
+		{ID: "e2", Sequence: 2, AggregateType: domain.AggregateTypeAccount, AggregateID: "a1", AggregateVersion: 2, Payload: `{}`},
+	}}
+	svc := services.NewOutboxRelayService(repo, failingPublisher{}, 10, time.Minute)
+
+	published, err := svc.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if published != 0 {
+		t.Fatalf("expected nothing published, got %d", published)
+	}
+	if len(repo.failed) != 1 || repo.failed[0].ID != "e1" {
+		t.Fatalf("expected only the head event to be attempted, got %+v", repo.failed)
+	}
+	failed := repo.failed[0]
+	if failed.Attempts != 1 || failed.LastError == nil || !failed.NextAttemptAt.After(time.Now().Add(30*time.Second)) {
+		t.Fatalf("expected backoff to be recorded, got %+v", failed)
+	}
+}
+
+type transferEventNotifierStub struct {
+	err      error
+	notified []string
+}
+
+func (s *transferEventNotifierStub) NotifyTransferEvent(_ context.Context, event domain.OutboxEvent) error {
+	s.notified = append(s.notified, event.ID)
+	return s.err
+}
+
+func TestOutboxRelayFeedsTransferEventsToWebhooks(t *testing.T) {
+	repo := &outboxRepoStub{pending: []domain.OutboxEvent{
+		{ID: "e1", Sequence: 1, AggregateType: domain.AggregateTypeTransfer, AggregateID: "t1", AggregateVersion: 1, EventType: "TRANSFER_CREATED", Payload: `{}`},
+		{ID: "e2", Sequence: 2, AggregateType: domain.AggregateTypeAccount, AggregateID: "a1", AggregateVersion: 1, EventType: "ACCOUNT_DEBITED", Payload: `{}`},
+	}}
+	notifier := &transferEventNotifierStub{}
+	svc := services.NewOutboxRelayService(repo, events.NewMemoryPublisher(), 10, time.Second)
+	svc.EnableWebhooks(notifier)
+
+	published, err := svc.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if published != 2 {
+		t.Fatalf("expected 2 events published, got %d", published)
+	}
+	if len(notifier.notified) != 1 || notifier.notified[0] != "e1" {
+		t.Fatalf("expected only the transfer event to reach webhooks, got %v", notifier.notified)
+	}
+}
+
+func TestOutboxRelayRetriesEventWhenWebhookRecordingFails(t *testing.T) {
+	repo := &outboxRepoStub{pending: []domain.OutboxEvent{
+		{ID: "e1", Sequence: 1, AggregateType: domain.AggregateTypeTransfer, AggregateID: "t1", AggregateVersion: 1, EventType: "TRANSFER_SUCCEEDED", Payload: `{}`},
+	}}
+	svc := services.NewOutboxRelayService(repo, events.NewMemoryPublisher(), 10, time.Minute)
+	svc.EnableWebhooks(&transferEventNotifierStub{err: errors.New("database unavailable")})
+
+	published, err := svc.RelayPending(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if published != 0 || len(repo.published) != 0 {
+		t.Fatalf("expected the event to stay unpublished, got %d published", published)
+	}
+	if len(repo.failed) != 1 || repo.failed[0].ID != "e1" || repo.failed[0].LastError == nil {
+		t.Fatalf("expected the event to be retried later, got %+v", repo.failed)
+	}
+}
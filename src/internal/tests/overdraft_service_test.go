@@ -0,0 +1,137 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type overdraftRepoStub struct {
+	approveErr error
+	approved   []domain.OverdraftFacility
+	positions  []domain.OverdraftPosition
+	breaches   []domain.OverdraftPosition
+	accruals   map[string]domain.OverdraftInterestAccrual
+}
+
+func (s *overdraftRepoStub) Approve(_ context.Context, facility domain.OverdraftFacility) (domain.OverdraftFacility, error) {
+	if s.approveErr != nil {
+		return domain.OverdraftFacility{}, s.approveErr
+	}
+	facility.Status = domain.OverdraftFacilityStatusActive
+	s.approved = append(s.approved, facility)
+	return facility, nil
+}
+
+func (s *overdraftRepoStub) GetByAccountNumber(context.Context, string) (domain.OverdraftFacility, error) {
+	return domain.OverdraftFacility{}, nil
+}
+
+func (s *overdraftRepoStub) SuspendExpired(context.Context, time.Time) ([]domain.OverdraftFacility, error) {
+	return nil, nil
+}
+
+func (s *overdraftRepoStub) GetOverdrawnPositions(context.Context) ([]domain.OverdraftPosition, error) {
+	return s.positions, nil
+}
+
+func (s *overdraftRepoStub) GetLimitBreaches(context.Context) ([]domain.OverdraftPosition, error) {
+	return s.breaches, nil
+}
+
+func (s *overdraftRepoStub) RecordInterestAccruals(_ context.Context, accruals []domain.OverdraftInterestAccrual) (int, error) {
+	if s.accruals == nil {
+		s.accruals = make(map[string]domain.OverdraftInterestAccrual)
+	}
+	inserted := 0
+	for _, accrual := range accruals {
+		key := accrual.AccountNumber + "|" + accrual.AccrualDate.Format(time.DateOnly)
+		if _, ok := s.accruals[key]; ok {
+			continue
+		}
+		s.accruals[key] = accrual
+		inserted++
+	}
+	return inserted, nil
+}
+
+func TestOverdraftService_AccruesInterestOnOverdrawnBalancesOnce(t *testing.T) {
+	repo := &overdraftRepoStub{positions: []domain.OverdraftPosition{
+		{AccountNumber: "0000000011", Currency: "NGN", LedgerBalance: decimal.NewFromInt(-73000), FacilityStatus: domain.OverdraftFacilityStatusSuspended, AnnualRatePercent: decimal.NewFromInt(20), DayCount: domain.DayCountActual365},
+		{AccountNumber: "0000000028", Currency: "USD", LedgerBalance: decimal.NewFromInt(-40)},
+	}}
+	svc := services.NewOverdraftService(repo)
+	date := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	recorded, err := svc.AccrueInterest(context.Background(), date)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if recorded != 1 {
+		t.Fatalf("expected one accrual for the account with a facility, got %d", recorded)
+	}
+	// 73,000 NGN x 20% / 365 = 40.
+	if accrual := repo.accruals["0000000011|2026-10-17"]; !accrual.Amount.Equal(decimal.NewFromInt(40)) || !accrual.OverdrawnAmount.Equal(decimal.NewFromInt(73000)) {
+		t.Fatalf("expected 40 NGN on 73000 overdrawn, got %s on %s", accrual.Amount, accrual.OverdrawnAmount)
+	}
+
+	if recorded, err = svc.AccrueInterest(context.Background(), date); err != nil || recorded != 0 {
+		t.Fatalf("expected re-run to record nothing, got %d (%v)", recorded, err)
+	}
+}
+
+func TestOverdraftService_ApproveDefaultsDayCountAndMapsInactiveAccount(t *testing.T) {
+	repo := &overdraftRepoStub{}
+	svc := services.NewOverdraftService(repo)
+	expiresAt := time.Now().Add(90 * 24 * time.Hour)
+	req := models.ApproveOverdraftRequest{
+		AccountNumber:     "0000000011",
+		Limit:             decimal.NewFromInt(500),
+		AnnualRatePercent: decimal.NewFromInt(18),
+		ExpiresAt:         &expiresAt,
+	}
+
+	resp, err := svc.ApproveOverdraft(context.Background(), req)
+	if err == nil || resp.Message != "validation failed" || len(repo.approved) != 0 {
+		t.Fatalf("expected approval without an authenticated approver to fail validation, got %v (%s)", err, resp.Message)
+	}
+
+	ctx := kycOfficerContext("credit-officer-1")
+	resp, err = svc.ApproveOverdraft(ctx, req)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if len(repo.approved) != 1 || repo.approved[0].DayCount != domain.DayCountActual365 || resp.Data.Status != "ACTIVE" {
+		t.Fatalf("expected an ACTIVE ACT/365 facility, got %+v", repo.approved)
+	}
+	if repo.approved[0].ApprovedBy != "credit-officer-1" {
+		t.Fatalf("expected the authenticated caller as approver, got %q", repo.approved[0].ApprovedBy)
+	}
+
+	repo.approveErr = fmt.Errorf("account is not active")
+	resp, err = svc.ApproveOverdraft(ctx, req)
+	if err == nil || resp.Message != "Account is not active" {
+		t.Fatalf("expected inactive account error, got %v (%s)", err, resp.Message)
+	}
+}
+
+func TestOverdraftService_ReportsExcessOverLimit(t *testing.T) {
+	repo := &overdraftRepoStub{breaches: []domain.OverdraftPosition{
+		{AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(-650), LedgerBalance: decimal.NewFromInt(-600), OverdraftLimit: decimal.NewFromInt(500), FacilityStatus: domain.OverdraftFacilityStatusActive},
+	}}
+	svc := services.NewOverdraftService(repo)
+
+	resp, err := svc.GetLimitBreaches(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if resp.Data == nil || len(*resp.Data) != 1 || !(*resp.Data)[0].ExcessAmount.Equal(decimal.NewFromInt(150)) {
+		t.Fatalf("expected an excess of 150, got %+v", resp.Data)
+	}
+}
@@ -0,0 +1,166 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/encryption"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+)
+
+var (
+	testKEKv1       = bytes.Repeat([]byte{1}, 32)
+	testKEKv2       = bytes.Repeat([]byte{2}, 32)
+	testIndexKey    = bytes.Repeat([]byte{9}, 32)
+	testPhoneNumber = "08010000000"
+)
+
+func newTestFieldCipher(t *testing.T, keys map[int][]byte, current int) *encryption.FieldCipher {
+	t.Helper()
+	provider, err := encryption.NewLocalKeyProvider(keys, current)
+	if err != nil {
+		t.Fatalf("new key provider: %v", err)
+	}
+	cipher, err := encryption.NewFieldCipher(provider, testIndexKey)
+	if err != nil {
+		t.Fatalf("new field cipher: %v", err)
+	}
+	return cipher
+}
+
+func TestFieldCipher_RoundTripHidesPlaintext(t *testing.T) {
+	cipher := newTestFieldCipher(t, map[int][]byte{1: testKEKv1}, 1)
+
+	first, err := cipher.Encrypt(testPhoneNumber)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	second, err := cipher.Encrypt(testPhoneNumber)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	if strings.Contains(first, testPhoneNumber) || !strings.HasPrefix(first, "v1.") {
+		t.Fatalf("unexpected ciphertext %q", first)
+	}
+	if first == second {
+		t.Fatal("expected a fresh data key and nonce per value")
+	}
+
+	plaintext, err := cipher.Decrypt(first)
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	if plaintext != testPhoneNumber {
+		t.Fatalf("expected %q, got %q", testPhoneNumber, plaintext)
+	}
+}
+
+func TestFieldCipher_DecryptsValuesUnderRetiredKey(t *testing.T) {
+	old := newTestFieldCipher(t, map[int][]byte{1: testKEKv1}, 1)
+	ciphertext, err := old.Encrypt(testPhoneNumber)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+
+	rotated := newTestFieldCipher(t, map[int][]byte{1: testKEKv1, 2: testKEKv2}, 2)
+	plaintext, err := rotated.Decrypt(ciphertext)
+	if err != nil || plaintext != testPhoneNumber {
+		t.Fatalf("expected old value to decrypt after rotation, got %q %v", plaintext, err)
+	}
+	reencrypted, err := rotated.Encrypt(plaintext)
+	if err != nil || !strings.HasPrefix(reencrypted, "v2.") {
+		t.Fatalf("expected value under key version 2, got %q %v", reencrypted, err)
+	}
+
+	retired := newTestFieldCipher(t, map[int][]byte{2: testKEKv2}, 2)
+	if _, err := retired.Decrypt(ciphertext); !errors.Is(err, encryption.ErrUnknownKeyVersion) {
+		t.Fatalf("expected ErrUnknownKeyVersion once version 1 is removed, got %v", err)
+	}
+}
+
+func TestFieldCipher_RejectsTamperedCiphertext(t *testing.T) {
+	cipher := newTestFieldCipher(t, map[int][]byte{1: testKEKv1}, 1)
+	ciphertext, err := cipher.Encrypt(testPhoneNumber)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+
+	i := len(ciphertext) - 10
+	replacement := "A"
+	if ciphertext[i] == 'A' {
+		replacement = "B"
+	}
+	tampered := ciphertext[:i] + replacement + ciphertext[i+1:]
+	if _, err := cipher.Decrypt(tampered); err == nil {
+		t.Fatal("expected tampered ciphertext to fail authentication")
+	}
+	if _, err := cipher.Decrypt(testPhoneNumber); !errors.Is(err, encryption.ErrMalformedCiphertext) {
+		t.Fatalf("expected ErrMalformedCiphertext for plaintext, got %v", err)
+	}
+}
+
+func TestFieldCipher_BlindIndexIsStableAcrossKeyRotation(t *testing.T) {
+	v1 := newTestFieldCipher(t, map[int][]byte{1: testKEKv1}, 1)
+	v2 := newTestFieldCipher(t, map[int][]byte{1: testKEKv1, 2: testKEKv2}, 2)
+
+	if v1.BlindIndex("phone", testPhoneNumber) != v2.BlindIndex("phone", testPhoneNumber) {
+		t.Fatal("expected blind index to survive a KEK rotation")
+	}
+	if v1.BlindIndex("phone", testPhoneNumber) == v1.BlindIndex("dob", testPhoneNumber) {
+		t.Fatal("expected different fields to produce different indexes")
+	}
+	if strings.Contains(v1.BlindIndex("phone", testPhoneNumber), testPhoneNumber) {
+		t.Fatal("expected blind index not to contain the plaintext")
+	}
+}
+
+func TestNewLocalKeyProvider_Validation(t *testing.T) {
+	if _, err := encryption.NewLocalKeyProvider(map[int][]byte{1: testKEKv1}, 2); err == nil {
+		t.Fatal("expected error when the current version is not configured")
+	}
+	if _, err := encryption.NewLocalKeyProvider(map[int][]byte{1: []byte("short")}, 1); err == nil {
+		t.Fatal("expected error for a key that is not 32 bytes")
+	}
+}
+
+type piiKeyRotationRepoStub struct {
+	usersLeft   int
+	changesLeft int
+	calls       []string
+}
+
+func (s *piiKeyRotationRepoStub) ReencryptUsers(_ context.Context, limit int) (int, error) {
+	s.calls = append(s.calls, "users")
+	count := min(limit, s.usersLeft)
+	s.usersLeft -= count
+	return count, nil
+}
+
+func (s *piiKeyRotationRepoStub) ReencryptProfileChanges(_ context.Context, limit int) (int, error) {
+	s.calls = append(s.calls, "changes")
+	count := min(limit, s.changesLeft)
+	s.changesLeft -= count
+	return count, nil
+}
+
+func TestPIIKeyRotationService_ReencryptsUntilNoneLeft(t *testing.T) {
+	repo := &piiKeyRotationRepoStub{usersLeft: 450, changesLeft: 3}
+	svc := services.NewPIIKeyRotationService(repo)
+
+	total, err := svc.ReencryptStale(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if total != 453 {
+		t.Fatalf("expected 453 rows re-encrypted, got %d", total)
+	}
+	if repo.usersLeft != 0 || repo.changesLeft != 0 {
+		t.Fatalf("expected nothing left, got %+v", repo)
+	}
+	if strings.Join(repo.calls, ",") != "users,users,users,changes" {
+		t.Fatalf("unexpected batches %v", repo.calls)
+	}
+}
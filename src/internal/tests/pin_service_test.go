@@ -0,0 +1,180 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type otpRepoStub struct {
+	challenges map[string]domain.OTPChallenge
+}
+
+func (s *otpRepoStub) Create(_ context.Context, challenge domain.OTPChallenge) (domain.OTPChallenge, error) {
+	if s.challenges == nil {
+		s.challenges = make(map[string]domain.OTPChallenge)
+	}
+	challenge.ID = "challenge-1"
+	s.challenges[challenge.ID] = challenge
+	return challenge, nil
+}
+
+func (s *otpRepoStub) GetByID(_ context.Context, id string) (domain.OTPChallenge, error) {
+	challenge, ok := s.challenges[id]
+	if !ok {
+		return domain.OTPChallenge{}, commons.ErrRecordNotFound
+	}
+	return challenge, nil
+}
+
+func (s *otpRepoStub) RecordFailedAttempt(_ context.Context, id string) (domain.OTPChallenge, error) {
+	challenge := s.challenges[id]
+	challenge.Attempts++
+	s.challenges[id] = challenge
+	return challenge, nil
+}
+
+func (s *otpRepoStub) Consume(_ context.Context, id string) (domain.OTPChallenge, error) {
+	challenge := s.challenges[id]
+	if challenge.ConsumedAt != nil {
+		return domain.OTPChallenge{}, commons.ErrRecordNotFound
+	}
+	now := time.Now()
+	challenge.ConsumedAt = &now
+	s.challenges[id] = challenge
+	return challenge, nil
+}
+
+type otpSenderStub struct {
+	codes []string
+}
+
+func (s *otpSenderStub) Send(_ context.Context, _ domain.OTPChallenge, _ string, code string) error {
+	s.codes = append(s.codes, code)
+	return nil
+}
+
+// lockingUserRepo keeps one customer's PIN state the way the user repository
+// does, so lockout can be exercised end to end.
+func lockingUserRepo(t *testing.T, pin string, state *domain.PinState, reasons *[]domain.PinChangeReason) userRepoStub {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash pin: %v", err)
+	}
+	state.PinHash = string(hash)
+
+	return userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			return domain.User{CustomerID: customerID, PhoneNumber: "08000000000"}, nil
+		},
+		getPinStateFn: func(_ context.Context, customerID string) (domain.PinState, error) {
+			state.CustomerID = customerID
+			return *state, nil
+		},
+		recordFailedPinAttemptFn: func(_ context.Context, _ string, maxAttempts int, lockedUntil time.Time) (domain.PinState, error) {
+			state.FailedAttempts++
+			if state.FailedAttempts >= maxAttempts {
+				state.FailedAttempts = 0
+				state.LockedUntil = &lockedUntil
+			}
+			return *state, nil
+		},
+		updatePinHashFn: func(_ context.Context, _ string, pinHash string, reason domain.PinChangeReason) (domain.PinState, error) {
+			state.PinHash = pinHash
+			state.FailedAttempts = 0
+			state.LockedUntil = nil
+			*reasons = append(*reasons, reason)
+			return *state, nil
+		},
+	}
+}
+
+func TestUserServiceVerifyUserPin_LocksAfterMaxFailedAttempts(t *testing.T) {
+	state := &domain.PinState{}
+	svc := services.NewUserService(lockingUserRepo(t, "4831", state, &[]domain.PinChangeReason{}), nil, testPinPolicy)
+
+	for attempt := 1; attempt < testPinPolicy.MaxFailedAttempts; attempt++ {
+		resp, err := svc.VerifyUserPin(context.Background(), "C1", "0000")
+		if err == nil || resp.Message != "invalid pin" {
+			t.Fatalf("attempt %d: expected invalid pin, got %v (%s)", attempt, err, resp.Message)
+		}
+	}
+
+	resp, err := svc.VerifyUserPin(context.Background(), "C1", "0000")
+	if !errors.Is(err, commons.ErrPinLocked) || resp.Message != "pin locked" {
+		t.Fatalf("expected the last allowed failure to lock the pin, got %v (%s)", err, resp.Message)
+	}
+
+	resp, err = svc.VerifyUserPin(context.Background(), "C1", "4831")
+	if !errors.Is(err, commons.ErrPinLocked) {
+		t.Fatalf("expected the correct pin to be refused while locked, got %v (%s)", err, resp.Message)
+	}
+
+	expired := time.Now().Add(-time.Minute)
+	state.LockedUntil = &expired
+	if resp, err = svc.VerifyUserPin(context.Background(), "C1", "4831"); err != nil {
+		t.Fatalf("expected the pin to verify once the lock lapsed, got %v (%s)", err, resp.Message)
+	}
+}
+
+func TestPinService_ResetPinWithOTPLiftsLockOnce(t *testing.T) {
+	lockedUntil := time.Now().Add(time.Hour)
+	state := &domain.PinState{LockedUntil: &lockedUntil}
+	var reasons []domain.PinChangeReason
+	userRepo := lockingUserRepo(t, "4831", state, &reasons)
+	userService := services.NewUserService(userRepo, nil, testPinPolicy)
+	sender := &otpSenderStub{}
+	otpService := services.NewOTPService(&otpRepoStub{}, userRepo, sender, 5*time.Minute, 3)
+	svc := services.NewPinService(userRepo, userService, otpService, testPinPolicy)
+
+	challengeResp, err := svc.RequestPinReset(context.Background(), models.RequestPinResetRequest{CustomerID: "C1"})
+	if err != nil || challengeResp.Data == nil || len(sender.codes) != 1 {
+		t.Fatalf("expected a reset code to be sent, got %v (%s)", err, challengeResp.Message)
+	}
+
+	req := models.ResetPinRequest{CustomerID: "C1", ChallengeID: challengeResp.Data.ChallengeID, OTP: "not-the-code", NewPin: "7294"}
+	resp, err := svc.ResetPin(context.Background(), req)
+	if !errors.Is(err, commons.ErrInvalidOTP) || resp.Message != "invalid otp" {
+		t.Fatalf("expected invalid otp, got %v (%s)", err, resp.Message)
+	}
+
+	req.OTP = sender.codes[0]
+	resp, err = svc.ResetPin(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data.Locked || len(reasons) != 1 || reasons[0] != domain.PinChangeReasonReset {
+		t.Fatalf("expected an unlocked pin replaced by RESET, got %+v (%v)", resp.Data, reasons)
+	}
+	if verifyResp, err := userService.VerifyUserPin(context.Background(), "C1", "7294"); err != nil {
+		t.Fatalf("expected the new pin to verify, got %v (%s)", err, verifyResp.Message)
+	}
+
+	if _, err = svc.ResetPin(context.Background(), req); !errors.Is(err, commons.ErrInvalidOTP) {
+		t.Fatalf("expected a spent challenge to be refused, got %v", err)
+	}
+}
+
+func TestChangePinRequest_RejectsWeakPins(t *testing.T) {
+	for _, pin := range []string{"1111", "1234", "9876", "0123", "1212", "123123", "12a4", "123", "1234567"} {
+		req := models.ChangePinRequest{CustomerID: "C1", OldPin: "4831", NewPin: pin}
+		if err := req.Validate(); err == nil {
+			t.Fatalf("expected %q to be rejected", pin)
+		}
+	}
+	for _, pin := range []string{"7294", "135790", "2580"} {
+		req := models.ChangePinRequest{CustomerID: "C1", OldPin: "4831", NewPin: pin}
+		if err := req.Validate(); err != nil {
+			t.Fatalf("expected %q to be accepted, got %v", pin, err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type portfolioHoldRepoStub struct {
+	holds map[string][]domain.AccountHold
+}
+
+func (s portfolioHoldRepoStub) PlaceHold(_ context.Context, hold domain.AccountHold) (domain.AccountHold, error) {
+	return hold, nil
+}
+
+func (s portfolioHoldRepoStub) ReleaseHold(context.Context, string, string, domain.AccountHoldStatus) (domain.AccountHold, error) {
+	return domain.AccountHold{}, commons.ErrRecordNotFound
+}
+
+func (s portfolioHoldRepoStub) CaptureHold(context.Context, string, string, decimal.Decimal, string) (domain.AccountHold, error) {
+	return domain.AccountHold{}, commons.ErrRecordNotFound
+}
+
+func (s portfolioHoldRepoStub) GetByReference(context.Context, string, string) (domain.AccountHold, error) {
+	return domain.AccountHold{}, commons.ErrRecordNotFound
+}
+
+func (s portfolioHoldRepoStub) GetByAccountNumber(_ context.Context, accountNumber string) ([]domain.AccountHold, error) {
+	return s.holds[accountNumber], nil
+}
+
+func (s portfolioHoldRepoStub) GetExpired(context.Context, int) ([]domain.AccountHold, error) {
+	return nil, nil
+}
+
+func newPortfolioService(users userRepoStub, rates rateRepoStub) *services.PortfolioService {
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {CustomerID: "C1", AccountNumber: "0000000011", Currency: "USD", AvailableBalance: decimal.NewFromInt(80), LedgerBalance: decimal.NewFromInt(100), Status: domain.AccountStatusActive},
+		"0000000028": {CustomerID: "C1", AccountNumber: "0000000028", Currency: "NGN", AvailableBalance: decimal.NewFromInt(150000), LedgerBalance: decimal.NewFromInt(150000), Status: domain.AccountStatusFrozen},
+		"0000000035": {CustomerID: "C2", AccountNumber: "0000000035", Currency: "USD", AvailableBalance: decimal.NewFromInt(999), LedgerBalance: decimal.NewFromInt(999), Status: domain.AccountStatusActive},
+	}}
+	holds := portfolioHoldRepoStub{holds: map[string][]domain.AccountHold{
+		"0000000011": {
+			{AccountNumber: "0000000011", HoldType: domain.AccountHoldTypeLien, Amount: decimal.NewFromInt(20), Reference: "LIEN-1", Status: domain.AccountHoldStatusActive, CreatedAt: time.Now()},
+			{AccountNumber: "0000000011", HoldType: domain.AccountHoldTypeLien, Amount: decimal.NewFromInt(5), Reference: "LIEN-0", Status: domain.AccountHoldStatusReleased, CreatedAt: time.Now()},
+		},
+	}}
+	return services.NewPortfolioService(users, accounts, holds, services.NewRateService(rates))
+}
+
+func TestPortfolioService_ConsolidatesBalancesInBaseCurrency(t *testing.T) {
+	svc := newPortfolioService(userRepoStub{}, rateRepoStub{
+		getRateFn: func(_ context.Context, from string, to string) (domain.Rate, error) {
+			if from == "NGN" && to == "USD" {
+				return domain.Rate{FromCurrency: from, ToCurrency: to, Rate: decimal.RequireFromString("0.0005"), RateDate: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)}, nil
+			}
+			return domain.Rate{}, commons.ErrRecordNotFound
+		},
+	})
+
+	resp, err := svc.GetCustomerAccounts(context.Background(), models.GetCustomerAccountsRequest{CustomerID: "C1"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data.BaseCurrency != "USD" || len(resp.Data.Accounts) != 2 {
+		t.Fatalf("expected two accounts in USD, got %+v", resp.Data)
+	}
+	// 80 USD plus 150,000 NGN at 0.0005.
+	if !resp.Data.TotalAvailableBalance.Equal(decimal.NewFromInt(155)) || !resp.Data.TotalLedgerBalance.Equal(decimal.NewFromInt(175)) {
+		t.Fatalf("expected totals 155/175, got %s/%s", resp.Data.TotalAvailableBalance, resp.Data.TotalLedgerBalance)
+	}
+
+	usd, ngn := resp.Data.Accounts[0], resp.Data.Accounts[1]
+	if !usd.ActiveHoldAmount.Equal(decimal.NewFromInt(20)) || len(usd.ActiveHolds) != 1 {
+		t.Fatalf("expected one active hold of 20, got %s across %d", usd.ActiveHoldAmount, len(usd.ActiveHolds))
+	}
+	if !ngn.Rate.Equal(decimal.RequireFromString("0.0005")) || ngn.RateDate != "2026-10-18" || ngn.Status != "FROZEN" {
+		t.Fatalf("expected NGN rate and date with status, got %+v", ngn)
+	}
+}
+
+func TestPortfolioService_ReturnsCustomerNotFound(t *testing.T) {
+	svc := newPortfolioService(userRepoStub{
+		getByCustomerIDFn: func(context.Context, string) (domain.User, error) {
+			return domain.User{}, commons.ErrRecordNotFound
+		},
+	}, rateRepoStub{})
+
+	resp, err := svc.GetCustomerAccounts(context.Background(), models.GetCustomerAccountsRequest{CustomerID: "C9"})
+	if err == nil || resp.Message != "Customer not found" {
+		t.Fatalf("expected customer not found, got %v (%s)", err, resp.Message)
+	}
+}
+
+func TestPortfolioService_RejectsUnsupportedBaseCurrency(t *testing.T) {
+	svc := newPortfolioService(userRepoStub{}, rateRepoStub{})
+
+	resp, err := svc.GetCustomerAccounts(context.Background(), models.GetCustomerAccountsRequest{CustomerID: "C1", BaseCurrency: "JPY"})
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected validation failure, got %v (%s)", err, resp.Message)
+	}
+}
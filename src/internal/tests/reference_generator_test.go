@@ -0,0 +1,71 @@
+package services_test
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+)
+
+func TestReferenceGeneratorTransactionReferenceFormat(t *testing.T) {
+	generator, err := services.NewReferenceGenerator(7, "100100", services.ReferenceSchemeEXT, map[string]string{"GreyApp": "gry"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	prefixed := generator.TransactionReference("GreyApp")
+	if !regexp.MustCompile(`^GRY\d{17}007\d{6}$`).MatchString(prefixed) {
+		t.Fatalf("unexpected prefixed reference %s", prefixed)
+	}
+	plain := generator.TransactionReference("unknown")
+	if !regexp.MustCompile(`^\d{17}007\d{6}$`).MatchString(plain) {
+		t.Fatalf("unexpected reference %s", plain)
+	}
+	external := generator.ExternalReference()
+	if !regexp.MustCompile(`^EXT\d{15}007\d{6}$`).MatchString(external) {
+		t.Fatalf("unexpected external reference %s", external)
+	}
+}
+
+func TestReferenceGeneratorNIPExternalReference(t *testing.T) {
+	generator, err := services.NewReferenceGenerator(12, "100100", services.ReferenceSchemeNIP, nil)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	reference := generator.ExternalReference()
+	if !regexp.MustCompile(`^100100\d{15}012\d{6}$`).MatchString(reference) || len(reference) != 30 {
+		t.Fatalf("unexpected NIP reference %s", reference)
+	}
+}
+
+func TestReferenceGeneratorIsUniqueAndSorted(t *testing.T) {
+	generator, err := services.NewReferenceGenerator(1, "100100", services.ReferenceSchemeEXT, nil)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	references := make([]string, 0, 10000)
+	seen := make(map[string]bool, 10000)
+	for i := 0; i < 10000; i++ {
+		reference := generator.TransactionReference("")
+		if seen[reference] {
+			t.Fatalf("duplicate reference %s after %d calls", reference, i)
+		}
+		seen[reference] = true
+		references = append(references, reference)
+	}
+	if !sort.StringsAreSorted(references) {
+		t.Fatal("expected references to sort in generation order")
+	}
+}
+
+func TestReferenceGeneratorRejectsInvalidConfig(t *testing.T) {
+	if _, err := services.NewReferenceGenerator(1000, "100100", services.ReferenceSchemeEXT, nil); err == nil {
+		t.Fatal("expected error for node ID beyond 3 digits")
+	}
+	if _, err := services.NewReferenceGenerator(1, "100100", "SWIFT", nil); err == nil {
+		t.Fatal("expected error for unsupported scheme")
+	}
+}
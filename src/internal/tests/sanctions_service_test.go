@@ -0,0 +1,243 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/file"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+)
+
+type sanctionsListStub struct {
+	list domain.SanctionsList
+	err  error
+}
+
+func (s sanctionsListStub) LoadList(_ context.Context) (domain.SanctionsList, error) {
+	return s.list, s.err
+}
+
+type sanctionsScreeningRepoStub struct {
+	saved []domain.SanctionsScreening
+}
+
+func (s *sanctionsScreeningRepoStub) SaveScreening(_ context.Context, screening domain.SanctionsScreening) (domain.SanctionsScreening, error) {
+	s.saved = append(s.saved, screening)
+	return screening, nil
+}
+
+func (s *sanctionsScreeningRepoStub) GetLatestBySubject(_ context.Context, _ domain.SanctionsSubjectType, _ string) (domain.SanctionsScreening, error) {
+	if len(s.saved) == 0 {
+		return domain.SanctionsScreening{}, errors.New("not found")
+	}
+	return s.saved[len(s.saved)-1], nil
+}
+
+func testSanctionsList() domain.SanctionsList {
+	return domain.SanctionsList{
+		Version: "UN@2026-01-01T00:00:00Z",
+		Entries: []domain.SanctionsEntry{
+			{ID: "UN-QDi.001", Source: "UN", Names: []string{"Muhammad Al-Rashid Haddad", "Abu Karim"}},
+			{ID: "OFAC-1234", Source: "OFAC", Names: []string{"PETROVIC, Dragan"}},
+		},
+	}
+}
+
+func TestSanctionsServiceMatchesReorderedTransliteratedName(t *testing.T) {
+	repo := &sanctionsScreeningRepoStub{}
+	svc := services.NewSanctionsService(sanctionsListStub{list: testSanctionsList()}, repo, 0.92, time.Hour)
+
+	screening, err := svc.ScreenName(context.Background(), domain.SanctionsSubjectUser, "0000000001", "Dragan Petrović")
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if screening.Decision != domain.SanctionsDecisionPotentialMatch {
+		t.Fatalf("expected potential match, got %s (score %.3f)", screening.Decision, screening.MatchScore)
+	}
+	if screening.MatchedEntryID == nil || *screening.MatchedEntryID != "OFAC-1234" {
+		t.Fatalf("expected OFAC-1234 to be matched, got %v", screening.MatchedEntryID)
+	}
+	if screening.ListVersion != "UN@2026-01-01T00:00:00Z" || len(repo.saved) != 1 {
+		t.Fatalf("expected decision stored with list version, got %q (%d saved)", screening.ListVersion, len(repo.saved))
+	}
+}
+
+func TestSanctionsServiceMatchesMissingMiddleName(t *testing.T) {
+	svc := services.NewSanctionsService(sanctionsListStub{list: testSanctionsList()}, &sanctionsScreeningRepoStub{}, 0.92, time.Hour)
+
+	screening, err := svc.ScreenName(context.Background(), domain.SanctionsSubjectTransfer, "tr-1", "Mohammad Haddad")
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if screening.Decision != domain.SanctionsDecisionPotentialMatch {
+		t.Fatalf("expected potential match, got %s (score %.3f)", screening.Decision, screening.MatchScore)
+	}
+}
+
+func TestSanctionsServiceClearsUnrelatedName(t *testing.T) {
+	svc := services.NewSanctionsService(sanctionsListStub{list: testSanctionsList()}, &sanctionsScreeningRepoStub{}, 0.92, time.Hour)
+
+	screening, err := svc.ScreenName(context.Background(), domain.SanctionsSubjectUser, "0000000002", "Adaeze Okonkwo")
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if screening.Decision != domain.SanctionsDecisionClear || screening.MatchedEntryID != nil {
+		t.Fatalf("expected clear decision, got %s (score %.3f)", screening.Decision, screening.MatchScore)
+	}
+}
+
+func TestSanctionsServiceHoldsWhenListUnavailable(t *testing.T) {
+	svc := services.NewSanctionsService(sanctionsListStub{err: errors.New("missing file")}, &sanctionsScreeningRepoStub{}, 0.92, time.Hour)
+
+	screening, err := svc.ScreenName(context.Background(), domain.SanctionsSubjectUser, "0000000003", "Adaeze Okonkwo")
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if screening.Decision != domain.SanctionsDecisionPotentialMatch || screening.ListVersion != "UNAVAILABLE" {
+		t.Fatalf("expected subject held against UNAVAILABLE list, got %s/%s", screening.Decision, screening.ListVersion)
+	}
+}
+
+func TestUserServiceCreateUserHeldOnSanctionsMatch(t *testing.T) {
+	svc := services.NewUserService(userRepoStub{
+		createFn: func(_ context.Context, user domain.User) (domain.User, error) {
+			user.ID = "user-1"
+			return user, nil
+		},
+	}, services.NewSanctionsService(sanctionsListStub{list: testSanctionsList()}, &sanctionsScreeningRepoStub{}, 0.92, time.Hour), testPinPolicy)
+
+	resp, err := svc.CreateUser(context.Background(), models.CreateUserRequest{
+		FirstName:      "Dragan",
+		LastName:       "Petrovic",
+		DOB:            "1970-05-01",
+		PhoneNumber:    "08000000000",
+		IDType:         "Passport",
+		IDNumber:       "A1234567",
+		KYCLevel:       1,
+		TransactionPin: "2580",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if resp.Data == nil || resp.Data.Status != string(domain.UserStatusHeld) {
+		t.Fatalf("expected user to be HELD, got %+v", resp.Data)
+	}
+}
+
+func TestUserServiceComplianceReviewResolvesHeldCustomer(t *testing.T) {
+	type decision struct {
+		customerID string
+		status     domain.UserStatus
+		actor      string
+		comment    string
+	}
+	var recorded []decision
+	svc := services.NewUserService(userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			return domain.User{CustomerID: customerID, Status: domain.UserStatusHeld}, nil
+		},
+		resolveComplianceHoldFn: func(_ context.Context, customerID string, status domain.UserStatus, actor string, comment string) (domain.User, error) {
+			recorded = append(recorded, decision{customerID, status, actor, comment})
+			return domain.User{CustomerID: customerID, Status: status}, nil
+		},
+	}, nil, testPinPolicy)
+	ctx := commons.WithPrincipal(commons.WithChannelID(context.Background(), "BackOffice"), commons.Principal{Subject: "compliance-officer"})
+
+	cleared, err := svc.ClearComplianceHold(ctx, models.ComplianceDecisionRequest{CustomerID: "CUST-1", Comment: "false positive: different date of birth"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if cleared.Data == nil || cleared.Data.Status != string(domain.UserStatusActive) {
+		t.Fatalf("expected customer to be ACTIVE, got %+v", cleared.Data)
+	}
+
+	rejected, err := svc.RejectComplianceHold(ctx, models.ComplianceDecisionRequest{CustomerID: "CUST-2", Comment: "confirmed match"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if rejected.Data == nil || rejected.Data.Status != string(domain.UserStatusRejected) {
+		t.Fatalf("expected customer to be REJECTED, got %+v", rejected.Data)
+	}
+
+	if len(recorded) != 2 || recorded[0].actor != "compliance-officer" || recorded[1].comment != "confirmed match" {
+		t.Fatalf("expected both decisions recorded with the authenticated reviewer, got %+v", recorded)
+	}
+}
+
+func TestUserServiceComplianceReviewRefusesCustomerNotHeld(t *testing.T) {
+	svc := services.NewUserService(userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			return domain.User{CustomerID: customerID, Status: domain.UserStatusActive}, nil
+		},
+		resolveComplianceHoldFn: func(context.Context, string, domain.UserStatus, string, string) (domain.User, error) {
+			t.Fatal("expected no decision to be recorded")
+			return domain.User{}, nil
+		},
+	}, nil, testPinPolicy)
+
+	resp, err := svc.RejectComplianceHold(commons.WithChannelID(context.Background(), "BackOffice"), models.ComplianceDecisionRequest{CustomerID: "CUST-1", Comment: "confirmed match"})
+	if err == nil || resp.Message != "Customer is not held for compliance review" {
+		t.Fatalf("expected not-held refusal, got %+v (%v)", resp, err)
+	}
+}
+
+func TestUserServiceComplianceReviewRequiresAuthenticatedReviewer(t *testing.T) {
+	svc := services.NewUserService(userRepoStub{}, nil, testPinPolicy)
+
+	resp, err := svc.ClearComplianceHold(context.Background(), models.ComplianceDecisionRequest{CustomerID: "CUST-1", Comment: "false positive"})
+	if err == nil || resp.Message != "validation failed" {
+		t.Fatalf("expected an unauthenticated decision to be refused, got %+v (%v)", resp, err)
+	}
+}
+
+func TestAccountServiceRefusesRejectedCustomer(t *testing.T) {
+	svc := services.NewAccountService(&lifecycleAccountRepoStub{}, userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			return domain.User{CustomerID: customerID, Status: domain.UserStatusRejected}, nil
+		},
+	}, nil, nil, nil, nil, nil, nil, "100100", "0123456789", "", "", nil, nil)
+
+	resp, err := svc.CreateAccount(context.Background(), models.CreateAccountRequest{CustomerID: "CUST-1", Currency: "USD"})
+	if err == nil || !strings.Contains(strings.Join(resp.Errors, " "), "rejected at compliance review") {
+		t.Fatalf("expected a rejected customer to be refused an account, got %+v (%v)", resp, err)
+	}
+}
+
+func TestFileSanctionsListRepositoryParsesOFACAndUN(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "sdn.csv")
+	csvContent := `1234,"PETROVIC, Dragan","individual","BALKANS",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"DOB 01 May 1970; a.k.a. 'PETROVICH, Dragan'; a.k.a. 'DRAGO'."` + "\n"
+	xmlPath := filepath.Join(dir, "un.xml")
+	xmlContent := `<CONSOLIDATED_LIST dateGenerated="2026-01-01T00:00:00Z"><INDIVIDUALS><INDIVIDUAL><DATAID>1</DATAID><REFERENCE_NUMBER>QDi.001</REFERENCE_NUMBER><FIRST_NAME>MUHAMMAD</FIRST_NAME><SECOND_NAME>AL-RASHID</SECOND_NAME><THIRD_NAME>HADDAD</THIRD_NAME><INDIVIDUAL_ALIAS><QUALITY>Good</QUALITY><ALIAS_NAME>Abu Karim</ALIAS_NAME></INDIVIDUAL_ALIAS></INDIVIDUAL></INDIVIDUALS><ENTITIES><ENTITY><DATAID>2</DATAID><REFERENCE_NUMBER>QDe.002</REFERENCE_NUMBER><FIRST_NAME>NORTHERN TRADING LLC</FIRST_NAME></ENTITY></ENTITIES></CONSOLIDATED_LIST>`
+	if err := os.WriteFile(csvPath, []byte(csvContent), 0o600); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	if err := os.WriteFile(xmlPath, []byte(xmlContent), 0o600); err != nil {
+		t.Fatalf("write xml: %v", err)
+	}
+
+	list, err := file.NewSanctionsListRepository(csvPath, xmlPath).LoadList(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(list.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(list.Entries))
+	}
+	if got := list.Entries[0].Names; len(got) != 3 || got[2] != "DRAGO" {
+		t.Fatalf("expected OFAC aliases from remarks, got %v", got)
+	}
+	if got := list.Entries[1].Names; got[0] != "MUHAMMAD AL-RASHID HADDAD" || got[1] != "Abu Karim" {
+		t.Fatalf("unexpected UN names %v", got)
+	}
+	if !strings.HasPrefix(list.Version, "OFAC-SDN@sha256:") || !strings.HasSuffix(list.Version, ";UN@2026-01-01T00:00:00Z") {
+		t.Fatalf("unexpected list version %q", list.Version)
+	}
+}
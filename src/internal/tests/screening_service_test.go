@@ -0,0 +1,203 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/file"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type screeningRepoStub struct {
+	rules           []domain.ScreeningRule
+	rulesErr        error
+	loadCalls       int
+	outgoingCount   int
+	outgoingTotal   decimal.Decimal
+	roundCount      int
+	seenBeneficiary bool
+	inbound         decimal.Decimal
+	lastOutgoingAt  *time.Time
+	saved           []domain.TransferScreening
+}
+
+func (s *screeningRepoStub) LoadRules(_ context.Context) ([]domain.ScreeningRule, error) {
+	s.loadCalls++
+	return s.rules, s.rulesErr
+}
+
+func (s *screeningRepoStub) GetOutgoingTransferStats(_ context.Context, _ string, _ time.Time, _ string) (int, decimal.Decimal, error) {
+	return s.outgoingCount, s.outgoingTotal, nil
+}
+
+func (s *screeningRepoStub) CountRoundAmountTransfers(_ context.Context, _ string, _ time.Time, _ decimal.Decimal, _ string) (int, error) {
+	return s.roundCount, nil
+}
+
+func (s *screeningRepoStub) HasPriorTransferToBeneficiary(_ context.Context, _ string, _ string, _ string, _ string) (bool, error) {
+	return s.seenBeneficiary, nil
+}
+
+func (s *screeningRepoStub) GetInboundCreditTotal(_ context.Context, _ string, _ time.Time) (decimal.Decimal, error) {
+	return s.inbound, nil
+}
+
+func (s *screeningRepoStub) GetLastOutgoingTransferAt(_ context.Context, _ string, _ string) (*time.Time, error) {
+	return s.lastOutgoingAt, nil
+}
+
+func (s *screeningRepoStub) SaveScreening(_ context.Context, screening domain.TransferScreening) (domain.TransferScreening, error) {
+	s.saved = append(s.saved, screening)
+	return screening, nil
+}
+
+func (s *screeningRepoStub) GetScreeningByTransferID(_ context.Context, transferID string) (domain.TransferScreening, error) {
+	for _, screening := range s.saved {
+		if screening.TransferID == transferID {
+			return screening, nil
+		}
+	}
+	return domain.TransferScreening{}, errors.New("not found")
+}
+
+func usdScreeningInput(amount int64) domain.ScreeningInput {
+	return domain.ScreeningInput{
+		TransferID:          "tr-1",
+		DebitAccountNumber:  "0123456789",
+		CreditAccountNumber: "0987654321",
+		BeneficiaryBankCode: "100100",
+		DebitCurrency:       "USD",
+		DebitAmount:         decimal.NewFromInt(amount),
+		AccountOpenedAt:     time.Now().Add(-24 * time.Hour),
+	}
+}
+
+func TestScreeningServiceVelocityRuleFlagsForReview(t *testing.T) {
+	repo := &screeningRepoStub{
+		outgoingCount: 5,
+		rules: []domain.ScreeningRule{{
+			ID: "velocity-count-1h", Type: domain.ScreeningRuleVelocity, Enabled: true,
+			Action: domain.ScreeningDecisionReview, Score: 30,
+			Params: domain.ScreeningRuleParams{WindowMinutes: 60, MaxCount: 5},
+		}},
+	}
+	svc := services.NewScreeningService(repo, repo, nil, time.Minute, 50, 100)
+
+	screening, err := svc.ScreenTransfer(context.Background(), usdScreeningInput(100))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if screening.Decision != domain.ScreeningDecisionReview || screening.TotalScore != 30 {
+		t.Fatalf("expected REVIEW with score 30, got %s/%d", screening.Decision, screening.TotalScore)
+	}
+	if len(repo.saved) != 1 {
+		t.Fatalf("expected screening to be persisted once, got %d", len(repo.saved))
+	}
+}
+
+func TestScreeningServiceBlockScoreEscalatesDecision(t *testing.T) {
+	repo := &screeningRepoStub{
+		roundCount: 2,
+		rules: []domain.ScreeningRule{
+			{
+				ID: "new-beneficiary-large", Type: domain.ScreeningRuleNewBeneficiaryLarge, Enabled: true,
+				Action: domain.ScreeningDecisionReview, Score: 60,
+				Params: domain.ScreeningRuleParams{ThresholdUSD: decimal.NewFromInt(5000)},
+			},
+			{
+				ID: "round-amount-structuring", Type: domain.ScreeningRuleRoundAmountStructuring, Enabled: true,
+				Action: domain.ScreeningDecisionReview, Score: 50,
+				Params: domain.ScreeningRuleParams{WindowMinutes: 1440, RoundUnit: decimal.NewFromInt(1000), MinCount: 3},
+			},
+		},
+	}
+	svc := services.NewScreeningService(repo, repo, nil, time.Minute, 50, 100)
+
+	screening, err := svc.ScreenTransfer(context.Background(), usdScreeningInput(9000))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if screening.Decision != domain.ScreeningDecisionBlock {
+		t.Fatalf("expected BLOCK once total score reaches block threshold, got %s (%d)", screening.Decision, screening.TotalScore)
+	}
+}
+
+func TestScreeningServiceAllowsWhenNoRuleTriggers(t *testing.T) {
+	lastOutgoing := time.Now().Add(-time.Hour)
+	repo := &screeningRepoStub{
+		seenBeneficiary: true,
+		lastOutgoingAt:  &lastOutgoing,
+		rules: []domain.ScreeningRule{
+			{
+				ID: "new-beneficiary-large", Type: domain.ScreeningRuleNewBeneficiaryLarge, Enabled: true,
+				Action: domain.ScreeningDecisionBlock, Score: 100,
+				Params: domain.ScreeningRuleParams{ThresholdUSD: decimal.NewFromInt(5000)},
+			},
+			{
+				ID: "dormant-reactivation", Type: domain.ScreeningRuleDormantReactivation, Enabled: true,
+				Action: domain.ScreeningDecisionReview, Score: 30,
+				Params: domain.ScreeningRuleParams{DormantDays: 180},
+			},
+		},
+	}
+	svc := services.NewScreeningService(repo, repo, nil, time.Minute, 50, 100)
+
+	screening, err := svc.ScreenTransfer(context.Background(), usdScreeningInput(9000))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if screening.Decision != domain.ScreeningDecisionAllow || len(screening.Results) != 2 {
+		t.Fatalf("expected ALLOW with 2 rule results, got %s with %d", screening.Decision, len(screening.Results))
+	}
+}
+
+func TestScreeningServiceKeepsLastRulesWhenReloadFails(t *testing.T) {
+	repo := &screeningRepoStub{
+		outgoingCount: 10,
+		rules: []domain.ScreeningRule{{
+			ID: "velocity-count-1h", Type: domain.ScreeningRuleVelocity, Enabled: true,
+			Action: domain.ScreeningDecisionBlock, Score: 10,
+			Params: domain.ScreeningRuleParams{WindowMinutes: 60, MaxCount: 5},
+		}},
+	}
+	svc := services.NewScreeningService(repo, repo, nil, time.Nanosecond, 50, 100)
+
+	if _, err := svc.ScreenTransfer(context.Background(), usdScreeningInput(100)); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	repo.rulesErr = errors.New("database unavailable")
+	repo.rules = nil
+
+	screening, err := svc.ScreenTransfer(context.Background(), usdScreeningInput(100))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if repo.loadCalls != 2 {
+		t.Fatalf("expected rules to be reloaded, got %d loads", repo.loadCalls)
+	}
+	if screening.Decision != domain.ScreeningDecisionBlock {
+		t.Fatalf("expected previous rules to still apply, got %s", screening.Decision)
+	}
+}
+
+func TestFileScreeningRuleRepositoryLoadsRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	content := `[{"id":"velocity-count-1h","name":"Velocity","type":"VELOCITY","enabled":true,"action":"REVIEW","score":30,"params":{"windowMinutes":60,"maxCount":5}}]`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write rules file: %v", err)
+	}
+
+	rules, err := file.NewScreeningRuleRepository(path).LoadRules(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(rules) != 1 || rules[0].Type != domain.ScreeningRuleVelocity || rules[0].Params.MaxCount != 5 {
+		t.Fatalf("unexpected rules loaded: %+v", rules)
+	}
+}
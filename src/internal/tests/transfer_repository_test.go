@@ -0,0 +1,94 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/implementations"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+func transferRow(status domain.TransferStatus) []driver.Value {
+	now := time.Now()
+	return []driver.Value{
+		"tr-1", "EXT-1", "REF-1", "0000000011", "9876543210", "200200", "Grey", "Other",
+		"USD", "USD", "100", "100", "1", "1", "0.08", "Salary", string(status), "{}", "channel-1",
+		now, now, now,
+	}
+}
+
+// openTransferRecordingDB answers balance postings and transfer status updates;
+// the updated transfer comes back with status.
+func openTransferRecordingDB(t *testing.T, status domain.TransferStatus) (*sql.DB, *statementRecorder) {
+	t.Helper()
+
+	return openRecordingDB(t, func(query string) *recordingRows {
+		switch {
+		case strings.Contains(query, "RETURNING customer_id, currency"):
+			return &recordingRows{columns: []string{"customer_id", "currency", "available_balance", "ledger_balance"}, values: [][]driver.Value{{"C1", "USD", "0", "0"}}}
+		case strings.Contains(query, "UPDATE transfers"):
+			return &recordingRows{columns: make([]string, 22), values: [][]driver.Value{transferRow(status)}}
+		}
+		return nil
+	})
+}
+
+// outboxEventTypes lists the event types queued in the outbox, in order.
+func outboxEventTypes(recorder *statementRecorder) []string {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	var eventTypes []string
+	for _, statement := range recorder.statements {
+		if strings.Contains(statement.query, "INSERT INTO outbox") {
+			eventTypes = append(eventTypes, statement.args[2].Value.(string))
+		}
+	}
+	return eventTypes
+}
+
+func TestTransferRepositoryProcessExternalTransferMarksSuccessInPostingTx(t *testing.T) {
+	db, recorder := openTransferRecordingDB(t, domain.TransferStatusSuccess)
+	repo := implementations.NewTransferRepository(db)
+
+	err := repo.ProcessExternalTransfer(context.Background(), "tr-1", "0000000011", decimal.NewFromInt(101), "0123456789", decimal.NewFromInt(100), "0123456795", decimal.NewFromInt(100), "USD")
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	update, ok := recorder.find("UPDATE transfers")
+	if !ok || update.args[1].Value != string(domain.TransferStatusSuccess) {
+		t.Fatalf("expected the transfer to be marked SUCCESS in the posting transaction, got %+v", update.args)
+	}
+	eventTypes := outboxEventTypes(recorder)
+	if len(eventTypes) == 0 || eventTypes[len(eventTypes)-1] != string(domain.TransferEventSucceeded) {
+		t.Fatalf("expected TRANSFER_SUCCEEDED to be queued with the posting, got %v", eventTypes)
+	}
+	if !recorder.committed {
+		t.Fatal("expected the posting to commit")
+	}
+}
+
+func TestTransientAccountRepositorySettlementMarksTransferClosed(t *testing.T) {
+	db, recorder := openTransferRecordingDB(t, domain.TransferStatusClosed)
+	repo := implementations.NewTransientAccountRepository(db)
+
+	err := repo.SettleFromSuspenseToFees(context.Background(), "tr-1", "0123456789", decimal.NewFromInt(1), decimal.RequireFromString("0.08"), "0123456790", "0123456791", decimal.NewFromInt(1), decimal.RequireFromString("0.08"))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	update, ok := recorder.find("UPDATE transfers")
+	if !ok || update.args[1].Value != string(domain.TransferStatusClosed) {
+		t.Fatalf("expected the transfer to be marked CLOSED in the settlement transaction, got %+v", update.args)
+	}
+	if eventTypes := outboxEventTypes(recorder); len(eventTypes) != 1 || eventTypes[0] != string(domain.TransferEventClosed) {
+		t.Fatalf("expected TRANSFER_CLOSED to be queued with the settlement, got %v", eventTypes)
+	}
+	if !recorder.committed {
+		t.Fatal("expected the settlement to commit")
+	}
+}
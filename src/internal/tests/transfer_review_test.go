@@ -0,0 +1,176 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+type heldTransferRepoStub struct {
+	acceptedTransferRepoStub
+	transfer   domain.Transfer
+	resolvedAs domain.TransferReviewStatus
+	decision   domain.TransferReviewDecision
+}
+
+func (s *heldTransferRepoStub) Get(_ context.Context, _ string, reference string, _ string) (domain.Transfer, error) {
+	if reference != valueOf(s.transfer.TransactionReference) {
+		return domain.Transfer{}, commons.ErrRecordNotFound
+	}
+	return s.transfer, nil
+}
+
+func (s *heldTransferRepoStub) ResolveHold(_ context.Context, _ domain.TransferReview, _ string, outcome domain.TransferReviewStatus, status domain.TransferStatus, decision domain.TransferReviewDecision) (domain.Transfer, error) {
+	s.resolvedAs = outcome
+	s.decision = decision
+	s.transfer.Status = status
+	return s.transfer, nil
+}
+
+type transferReviewRepoStub struct {
+	review domain.TransferReview
+}
+
+func (s *transferReviewRepoStub) GetByTransferID(context.Context, string) (domain.TransferReview, error) {
+	return s.review, nil
+}
+
+func (s *transferReviewRepoStub) GetByTransferIDs(context.Context, []string) ([]domain.TransferReview, error) {
+	return []domain.TransferReview{s.review}, nil
+}
+
+func newHeldTransferService() (*services.TransferService, *heldTransferRepoStub) {
+	repo := &heldTransferRepoStub{transfer: domain.Transfer{
+		ID:                   "tr-1",
+		TransactionReference: strPtr("REF-1"),
+		DebitAccountNumber:   "0000000001",
+		CreditAccountNumber:  strPtr("0000000002"),
+		BeneficiaryBankCode:  strPtr("100100"),
+		DebitAmount:          decimal.NewFromInt(10),
+		Status:               domain.TransferStatusHeld,
+	}}
+	reviews := &transferReviewRepoStub{review: domain.TransferReview{
+		ID:          "rv-1",
+		TransferID:  "tr-1",
+		Status:      domain.TransferReviewPending,
+		InitiatedBy: "maker",
+		HeldAmount:  decimal.NewFromInt(10),
+	}}
+	svc := services.NewTransferService(
+		repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, reviews, nil,
+		"100100", "0123456789", "0123456790", "0123456791", "0123456792", "0123456793", "0123456794", "0123456795",
+	)
+	return svc, repo
+}
+
+func TestTransferReviewRejectsDecisionByInitiator(t *testing.T) {
+	svc, repo := newHeldTransferService()
+
+	response, err := svc.ApproveHeldTransfer(commons.WithChannelID(context.Background(), "Maker"), models.TransferReviewDecisionRequest{
+		TransactionReference: "REF-1",
+		Comment:              "looks fine",
+	})
+	if !errors.Is(err, commons.ErrMakerCheckerViolation) {
+		t.Fatalf("expected maker-checker violation, got %v (%s)", err, response.Message)
+	}
+	if repo.resolvedAs != "" {
+		t.Fatalf("expected hold to stay open, got %s", repo.resolvedAs)
+	}
+}
+
+// reviewerContext authenticates the checker as a back-office operator token
+// arriving through the maker's own channel.
+func reviewerContext() context.Context {
+	ctx := commons.WithChannelID(context.Background(), "maker")
+	return commons.WithPrincipal(ctx, commons.Principal{Subject: "checker", Scopes: []string{string(domain.ChannelScopeBackOffice)}})
+}
+
+func TestTransferReviewRejectsOperatorTokenOfInitiator(t *testing.T) {
+	svc, repo := newHeldTransferService()
+
+	ctx := commons.WithChannelID(context.Background(), "backoffice-console")
+	ctx = commons.WithPrincipal(ctx, commons.Principal{Subject: "maker", Scopes: []string{string(domain.ChannelScopeBackOffice)}})
+	_, err := svc.ApproveHeldTransfer(ctx, models.TransferReviewDecisionRequest{
+		TransactionReference: "REF-1",
+		Comment:              "approving my own transfer",
+	})
+	if !errors.Is(err, commons.ErrMakerCheckerViolation) || repo.resolvedAs != "" {
+		t.Fatalf("expected maker-checker violation with hold open, got %v resolved=%s", err, repo.resolvedAs)
+	}
+}
+
+func TestTransferReviewRequiresAuthenticatedReviewer(t *testing.T) {
+	svc, repo := newHeldTransferService()
+
+	response, err := svc.ApproveHeldTransfer(context.Background(), models.TransferReviewDecisionRequest{
+		TransactionReference: "REF-1",
+		Comment:              "looks fine",
+	})
+	if err == nil || response.Message != "validation failed" || repo.resolvedAs != "" {
+		t.Fatalf("expected validation failure without an authenticated reviewer, got %v (%s)", err, response.Message)
+	}
+}
+
+func TestTransferReviewRejectFailsTransfer(t *testing.T) {
+	svc, repo := newHeldTransferService()
+
+	response, err := svc.RejectHeldTransfer(reviewerContext(), models.TransferReviewDecisionRequest{
+		TransactionReference: "REF-1",
+		Comment:              "beneficiary mismatch",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if repo.resolvedAs != domain.TransferReviewRejected || repo.decision.Action != domain.TransferReviewActionReject || repo.decision.Actor != "checker" {
+		t.Fatalf("unexpected resolution %s %+v", repo.resolvedAs, repo.decision)
+	}
+	if response.Data == nil || response.Data.Status != string(domain.TransferStatusFailed) {
+		t.Fatalf("expected FAILED transfer in response, got %+v", response.Data)
+	}
+	if repo.posted {
+		t.Fatal("expected no posting for a rejected transfer")
+	}
+}
+
+func TestTransferReviewApproveExecutesTransfer(t *testing.T) {
+	svc, repo := newHeldTransferService()
+
+	_, err := svc.ApproveHeldTransfer(reviewerContext(), models.TransferReviewDecisionRequest{
+		TransactionReference: "REF-1",
+		Comment:              "verified with customer",
+	})
+	// The stub posting reports insufficient balance, which proves the approved
+	// transfer went on to execution.
+	if !errors.Is(err, commons.ErrInsufficientBalance) {
+		t.Fatalf("expected posting to be attempted, got %v", err)
+	}
+	if repo.resolvedAs != domain.TransferReviewApproved || !repo.posted {
+		t.Fatalf("expected approved hold and posting, got %s posted=%v", repo.resolvedAs, repo.posted)
+	}
+}
+
+func TestTransferReviewRequiresPendingReview(t *testing.T) {
+	svc, repo := newHeldTransferService()
+	repo.transfer.Status = domain.TransferStatusSuccess
+
+	response, err := svc.RejectHeldTransfer(reviewerContext(), models.TransferReviewDecisionRequest{
+		TransactionReference: "REF-1",
+		Comment:              "late",
+	})
+	if err == nil || response.Message != "Transfer is not held for review" {
+		t.Fatalf("expected not-held error, got %v (%s)", err, response.Message)
+	}
+}
+
+func valueOf(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -2,10 +2,16 @@ package services_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
 	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
 )
 
 func TestTransferServiceTransferFundsValidationError(t *testing.T) {
@@ -19,6 +25,10 @@ func TestTransferServiceTransferFundsValidationError(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		"100100",
 		"0123456789",
 		"0123456790",
@@ -35,3 +45,126 @@ func TestTransferServiceTransferFundsValidationError(t *testing.T) {
 	}
 }
 
+// stepUpTransferRepoStub counts the transfers written before a step-up code
+// is confirmed.
+type stepUpTransferRepoStub struct {
+	acceptedTransferRepoStub
+	created int
+}
+
+func (s *stepUpTransferRepoStub) Create(_ context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	s.created++
+	return transfer, nil
+}
+
+func TestTransferServiceTransferFundsAboveStepUpThresholdIssuesChallenge(t *testing.T) {
+	transfers := &stepUpTransferRepoStub{}
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {AccountNumber: "0000000011", CustomerID: "C1", Currency: "USD", Status: domain.AccountStatusActive},
+		"0000000028": {AccountNumber: "0000000028", CustomerID: "C2", Currency: "USD", Status: domain.AccountStatusActive},
+	}}
+	userRepo := lockingUserRepo(t, "7294", &domain.PinState{CustomerID: "C1"}, nil)
+	userService := services.NewUserService(userRepo, nil, testPinPolicy)
+	sender := &otpSenderStub{}
+	otps := &otpRepoStub{}
+	svc := services.NewTransferService(
+		transfers, accounts, nil, nil, nil, nil, userService, nil, nil, nil, nil, nil, nil,
+		"100100", "0123456789", "0123456790", "0123456791", "0123456792", "0123456793", "0123456794", "0123456795",
+	)
+	svc.EnableStepUp(services.NewOTPService(otps, userRepo, sender, 5*time.Minute, 3), domain.TransferStepUpPolicy{
+		Currency:  "USD",
+		Threshold: decimal.NewFromInt(10000),
+	})
+
+	resp, err := svc.TransferFunds(context.Background(), models.InternalTransferRequest{
+		DebitAccountNumber:  "0000000011",
+		CreditAccountNumber: "0000000028",
+		BeneficiaryBankCode: "100100",
+		DebitCurrency:       "USD",
+		CreditCurrency:      "USD",
+		DebitAmount:         decimal.NewFromInt(25000),
+		DebitBankName:       "Grey",
+		CreditBankName:      "Grey",
+		Narration:           "Salary",
+		TransactionPIN:      "7294",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if resp.Data == nil || resp.Data.Status != string(domain.TransferStatusOTPRequired) || resp.Data.StepUpChallenge == nil {
+		t.Fatalf("expected an OTP_REQUIRED response with a challenge, got %+v", resp.Data)
+	}
+	if transfers.created != 0 || len(sender.codes) != 1 {
+		t.Fatalf("expected no transfer to be written and one code to be sent, got %d transfers and %d codes", transfers.created, len(sender.codes))
+	}
+	challenge := otps.challenges[resp.Data.StepUpChallenge.ChallengeID]
+	if challenge.Purpose != domain.OTPPurposeTransferStepUp || challenge.Payload == "" || strings.Contains(challenge.Payload, "7294") {
+		t.Fatalf("expected the PIN-less request to be stored with the challenge, got %+v", challenge)
+	}
+
+	confirmResp, err := svc.ConfirmTransfer(context.Background(), models.ConfirmTransferRequest{
+		DebitAccountNumber: "0000000011",
+		ChallengeID:        resp.Data.StepUpChallenge.ChallengeID,
+		OTP:                "x" + sender.codes[0][1:],
+	})
+	if !errors.Is(err, commons.ErrInvalidOTP) || confirmResp.Message != "invalid otp" {
+		t.Fatalf("expected invalid otp, got %v (%s)", err, confirmResp.Message)
+	}
+}
+
+func TestTransferServiceTransferFundsAboveKYCLimitIsRefused(t *testing.T) {
+	transfers := &stepUpTransferRepoStub{}
+	accounts := &lifecycleAccountRepoStub{accounts: map[string]domain.Account{
+		"0000000011": {AccountNumber: "0000000011", CustomerID: "C1", Currency: "USD", Status: domain.AccountStatusActive},
+		"0000000028": {AccountNumber: "0000000028", CustomerID: "C2", Currency: "USD", Status: domain.AccountStatusActive},
+	}}
+	userRepo := lockingUserRepo(t, "7294", &domain.PinState{CustomerID: "C1"}, nil)
+	userRepo.getByCustomerIDFn = func(_ context.Context, customerID string) (domain.User, error) {
+		return domain.User{CustomerID: customerID, KYCLevel: 1}, nil
+	}
+	svc := services.NewTransferService(
+		transfers, accounts, nil, nil, nil, nil, services.NewUserService(userRepo, nil, testPinPolicy), nil, nil, nil, nil, nil, nil,
+		"100100", "0123456789", "0123456790", "0123456791", "0123456792", "0123456793", "0123456794", "0123456795",
+	)
+	svc.EnableKYCLimits(userRepo, domain.TransferLimitPolicy{
+		Currency:    "USD",
+		PerTransfer: map[int]decimal.Decimal{1: decimal.NewFromInt(1000), 2: decimal.NewFromInt(10000)},
+	})
+
+	resp, err := svc.TransferFunds(context.Background(), models.InternalTransferRequest{
+		DebitAccountNumber:  "0000000011",
+		CreditAccountNumber: "0000000028",
+		BeneficiaryBankCode: "100100",
+		DebitCurrency:       "USD",
+		CreditCurrency:      "USD",
+		DebitAmount:         decimal.NewFromInt(2500),
+		DebitBankName:       "Grey",
+		CreditBankName:      "Grey",
+		Narration:           "Salary",
+		TransactionPIN:      "7294",
+	})
+	if !errors.Is(err, commons.ErrTransferLimitExceeded) || resp.Message != "Transfer limit exceeded" {
+		t.Fatalf("expected transfer limit exceeded, got %v (%s)", err, resp.Message)
+	}
+	if transfers.created != 0 {
+		t.Fatalf("expected no transfer to be written, got %d", transfers.created)
+	}
+}
+
+func TestTransferLimitPolicyLimitFor(t *testing.T) {
+	policy := domain.TransferLimitPolicy{PerTransfer: map[int]decimal.Decimal{
+		1: decimal.NewFromInt(1000),
+		2: decimal.NewFromInt(10000),
+		3: decimal.Zero,
+	}}
+
+	if limit, capped := policy.LimitFor(2); !capped || !limit.Equal(decimal.NewFromInt(10000)) {
+		t.Fatalf("expected level 2 to be capped at 10000, got %s (%v)", limit, capped)
+	}
+	if _, capped := policy.LimitFor(3); capped {
+		t.Fatal("expected level 3 to be uncapped")
+	}
+	if limit, capped := policy.LimitFor(5); capped || !limit.IsZero() {
+		t.Fatalf("expected levels above 3 to inherit the uncapped level 3, got %s (%v)", limit, capped)
+	}
+}
@@ -0,0 +1,218 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/shopspring/decimal"
+)
+
+func TestTransferWorkerPoolKeepsPerAccountOrder(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		inFlight  = map[string]bool{}
+		order     []string
+		overlap   bool
+		processed sync.WaitGroup
+	)
+
+	pool := services.NewTransferWorkerPool(func(_ context.Context, transfer domain.Transfer) error {
+		defer processed.Done()
+		mu.Lock()
+		if inFlight[transfer.DebitAccountNumber] {
+			overlap = true
+		}
+		inFlight[transfer.DebitAccountNumber] = true
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		inFlight[transfer.DebitAccountNumber] = false
+		if transfer.DebitAccountNumber == "0000000001" {
+			order = append(order, transfer.ID)
+		}
+		mu.Unlock()
+		return nil
+	}, 4, 20)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool.Start(ctx)
+
+	expected := []string{"a", "b", "c", "d", "e"}
+	processed.Add(len(expected) * 2)
+	for i, id := range expected {
+		if err := pool.Dispatch(ctx, domain.Transfer{ID: id, DebitAccountNumber: "0000000001"}); err != nil {
+			t.Fatalf("dispatch %s: %v", id, err)
+		}
+		if err := pool.Dispatch(ctx, domain.Transfer{ID: id + "-other", DebitAccountNumber: fmt.Sprintf("%010d", i+2)}); err != nil {
+			t.Fatalf("dispatch other %s: %v", id, err)
+		}
+	}
+	processed.Wait()
+
+	if overlap {
+		t.Fatal("expected transfers from the same account never to run concurrently")
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("expected order %v, got %v", expected, order)
+		}
+	}
+}
+
+func TestTransferWorkerPoolRunsRequeuedTransfersBeforeNewDispatches(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		order     []string
+		processed sync.WaitGroup
+	)
+	pool := services.NewTransferWorkerPool(func(_ context.Context, transfer domain.Transfer) error {
+		defer processed.Done()
+		mu.Lock()
+		order = append(order, transfer.ID)
+		mu.Unlock()
+		return nil
+	}, 2, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool.Start(ctx)
+
+	processed.Add(4)
+	// More recovered transfers than the queue holds, so Requeue has to wait for
+	// the worker before it returns.
+	pool.Requeue(ctx, []domain.Transfer{
+		{ID: "recovered-1", DebitAccountNumber: "0000000001"},
+		{ID: "recovered-2", DebitAccountNumber: "0000000001"},
+		{ID: "recovered-3", DebitAccountNumber: "0000000001"},
+	})
+	if err := pool.Dispatch(ctx, domain.Transfer{ID: "new", DebitAccountNumber: "0000000001"}); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	processed.Wait()
+
+	expected := []string{"recovered-1", "recovered-2", "recovered-3", "new"}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("expected order %v, got %v", expected, order)
+		}
+	}
+}
+
+func TestTransferWorkerPoolDispatchReportsFullQueue(t *testing.T) {
+	pool := services.NewTransferWorkerPool(func(context.Context, domain.Transfer) error { return nil }, 1, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Workers are not started, so the single slot fills up.
+	if err := pool.Dispatch(ctx, domain.Transfer{ID: "1", DebitAccountNumber: "0000000001"}); err != nil {
+		t.Fatalf("expected first dispatch to succeed, got %v", err)
+	}
+	err := pool.Dispatch(ctx, domain.Transfer{ID: "2", DebitAccountNumber: "0000000001"})
+	if err == nil {
+		t.Fatal("expected dispatch to fail when the queue is full")
+	}
+	if !errors.Is(err, commons.ErrTransferQueueFull) && !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+type acceptedTransferRepoStub struct {
+	transitionErr error
+	posted        bool
+	statuses      []domain.TransferStatus
+}
+
+func (s *acceptedTransferRepoStub) Create(_ context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	return transfer, nil
+}
+
+func (s *acceptedTransferRepoStub) Update(_ context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	return transfer, nil
+}
+
+func (s *acceptedTransferRepoStub) Get(context.Context, string, string, string) (domain.Transfer, error) {
+	return domain.Transfer{}, commons.ErrRecordNotFound
+}
+
+func (s *acceptedTransferRepoStub) ProcessInternalTransfer(context.Context, string, string, decimal.Decimal, string, decimal.Decimal, string, decimal.Decimal) error {
+	s.posted = true
+	return errors.New("transaction posting failed: record not found, inactive, or insufficient balance")
+}
+
+func (s *acceptedTransferRepoStub) ProcessExternalTransfer(context.Context, string, string, decimal.Decimal, string, decimal.Decimal, string, decimal.Decimal, string) error {
+	s.posted = true
+	return nil
+}
+
+func (s *acceptedTransferRepoStub) UpdateStatus(_ context.Context, _ string, status domain.TransferStatus) error {
+	s.statuses = append(s.statuses, status)
+	return nil
+}
+
+func (s *acceptedTransferRepoStub) TransitionStatus(context.Context, string, domain.TransferStatus, domain.TransferStatus) error {
+	return s.transitionErr
+}
+
+func (s *acceptedTransferRepoStub) GetByStatus(context.Context, domain.TransferStatus, int) ([]domain.Transfer, error) {
+	return nil, nil
+}
+
+func (s *acceptedTransferRepoStub) HoldTransfer(_ context.Context, review domain.TransferReview, _ string) (domain.TransferReview, error) {
+	return review, nil
+}
+
+func (s *acceptedTransferRepoStub) ResolveHold(context.Context, domain.TransferReview, string, domain.TransferReviewStatus, domain.TransferStatus, domain.TransferReviewDecision) (domain.Transfer, error) {
+	return domain.Transfer{}, commons.ErrRecordNotFound
+}
+
+func newAcceptedTransferService(repo *acceptedTransferRepoStub) *services.TransferService {
+	return services.NewTransferService(
+		repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		"100100", "0123456789", "0123456790", "0123456791", "0123456792", "0123456793", "0123456794", "0123456795",
+	)
+}
+
+func TestTransferServiceProcessAcceptedTransferSkipsAlreadyClaimed(t *testing.T) {
+	repo := &acceptedTransferRepoStub{transitionErr: commons.ErrRecordNotFound}
+	svc := newAcceptedTransferService(repo)
+
+	if err := svc.ProcessAcceptedTransfer(context.Background(), domain.Transfer{ID: "tr-1", BeneficiaryBankCode: strPtr("100100")}); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if repo.posted {
+		t.Fatal("expected no posting for a transfer that is no longer ACCEPTED")
+	}
+}
+
+func TestTransferServiceProcessAcceptedTransferFailsOnInsufficientBalance(t *testing.T) {
+	repo := &acceptedTransferRepoStub{}
+	svc := newAcceptedTransferService(repo)
+
+	err := svc.ProcessAcceptedTransfer(context.Background(), domain.Transfer{
+		ID:                  "tr-1",
+		DebitAccountNumber:  "0000000001",
+		CreditAccountNumber: strPtr("0000000002"),
+		BeneficiaryBankCode: strPtr("100100"),
+		DebitAmount:         decimal.NewFromInt(10),
+	})
+	if !errors.Is(err, commons.ErrInsufficientBalance) {
+		t.Fatalf("expected insufficient balance, got %v", err)
+	}
+	if !repo.posted || len(repo.statuses) != 1 || repo.statuses[0] != domain.TransferStatusFailed {
+		t.Fatalf("expected posting attempt and FAILED status, got posted=%v statuses=%v", repo.posted, repo.statuses)
+	}
+}
+
+func strPtr(value string) *string {
+	return &value
+}
@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,8 +18,16 @@ type userRepoStub struct {
 	getByCustomerIDFn             func(ctx context.Context, customerID string) (domain.User, error)
 	updateFn                      func(ctx context.Context, user domain.User) (domain.User, error)
 	getTransactionPinHashByCustFn func(ctx context.Context, customerID string) (string, error)
+	getPinStateFn                 func(ctx context.Context, customerID string) (domain.PinState, error)
+	recordFailedPinAttemptFn      func(ctx context.Context, customerID string, maxAttempts int, lockedUntil time.Time) (domain.PinState, error)
+	updatePinHashFn               func(ctx context.Context, customerID string, pinHash string, reason domain.PinChangeReason) (domain.PinState, error)
+	unlockPinFn                   func(ctx context.Context, customerID string, actor string, comment string) (domain.PinState, error)
+	updateContactDetailsFn        func(ctx context.Context, customerID string, details domain.ContactDetails, changedBy string) (domain.User, []domain.ProfileChange, error)
+	resolveComplianceHoldFn       func(ctx context.Context, customerID string, status domain.UserStatus, actor string, comment string) (domain.User, error)
 }
 
+var testPinPolicy = domain.PinPolicy{MaxFailedAttempts: 3, LockDuration: 30 * time.Minute}
+
 func (s userRepoStub) Create(ctx context.Context, user domain.User) (domain.User, error) {
 	if s.createFn != nil {
 		return s.createFn(ctx, user)
@@ -47,6 +56,17 @@ func (s userRepoStub) Update(ctx context.Context, user domain.User) (domain.User
 	return domain.User{}, nil
 }
 
+func (s userRepoStub) UpdateContactDetails(ctx context.Context, customerID string, details domain.ContactDetails, changedBy string) (domain.User, []domain.ProfileChange, error) {
+	if s.updateContactDetailsFn != nil {
+		return s.updateContactDetailsFn(ctx, customerID, details, changedBy)
+	}
+	return domain.User{}, nil, nil
+}
+
+func (s userRepoStub) GetProfileChanges(context.Context, string, int) ([]domain.ProfileChange, error) {
+	return nil, nil
+}
+
 func (s userRepoStub) GetTransactionPinHashByCustomerID(ctx context.Context, customerID string) (string, error) {
 	if s.getTransactionPinHashByCustFn != nil {
 		return s.getTransactionPinHashByCustFn(ctx, customerID)
@@ -54,6 +74,48 @@ func (s userRepoStub) GetTransactionPinHashByCustomerID(ctx context.Context, cus
 	return "", nil
 }
 
+// GetPinState falls back to getTransactionPinHashByCustFn so stubs that only
+// provide a PIN hash verify against an unlocked PIN.
+func (s userRepoStub) GetPinState(ctx context.Context, customerID string) (domain.PinState, error) {
+	if s.getPinStateFn != nil {
+		return s.getPinStateFn(ctx, customerID)
+	}
+	hash, err := s.GetTransactionPinHashByCustomerID(ctx, customerID)
+	return domain.PinState{CustomerID: customerID, PinHash: hash}, err
+}
+
+func (s userRepoStub) RecordFailedPinAttempt(ctx context.Context, customerID string, maxAttempts int, lockedUntil time.Time) (domain.PinState, error) {
+	if s.recordFailedPinAttemptFn != nil {
+		return s.recordFailedPinAttemptFn(ctx, customerID, maxAttempts, lockedUntil)
+	}
+	return domain.PinState{CustomerID: customerID, FailedAttempts: 1}, nil
+}
+
+func (s userRepoStub) ClearFailedPinAttempts(context.Context, string) error {
+	return nil
+}
+
+func (s userRepoStub) UpdatePinHash(ctx context.Context, customerID string, pinHash string, reason domain.PinChangeReason) (domain.PinState, error) {
+	if s.updatePinHashFn != nil {
+		return s.updatePinHashFn(ctx, customerID, pinHash, reason)
+	}
+	return domain.PinState{CustomerID: customerID, PinHash: pinHash}, nil
+}
+
+func (s userRepoStub) UnlockPin(ctx context.Context, customerID string, actor string, comment string) (domain.PinState, error) {
+	if s.unlockPinFn != nil {
+		return s.unlockPinFn(ctx, customerID, actor, comment)
+	}
+	return domain.PinState{CustomerID: customerID}, nil
+}
+
+func (s userRepoStub) ResolveComplianceHold(ctx context.Context, customerID string, status domain.UserStatus, actor string, comment string) (domain.User, error) {
+	if s.resolveComplianceHoldFn != nil {
+		return s.resolveComplianceHoldFn(ctx, customerID, status, actor, comment)
+	}
+	return domain.User{CustomerID: customerID, Status: status}, nil
+}
+
 func TestUserServiceCreateUserSuccess(t *testing.T) {
 	svc := services.NewUserService(userRepoStub{
 		createFn: func(_ context.Context, user domain.User) (domain.User, error) {
@@ -65,7 +127,7 @@ func TestUserServiceCreateUserSuccess(t *testing.T) {
 			user.UpdatedAt = time.Now().UTC()
 			return user, nil
 		},
-	})
+	}, nil, testPinPolicy)
 
 	resp, err := svc.CreateUser(context.Background(), models.CreateUserRequest{
 		FirstName:      "Ada",
@@ -75,7 +137,7 @@ func TestUserServiceCreateUserSuccess(t *testing.T) {
 		IDType:         "Passport",
 		IDNumber:       "A123456",
 		KYCLevel:       1,
-		TransactionPin: "1234",
+		TransactionPin: "2580",
 	})
 	if err != nil {
 		t.Fatalf("expected nil error, got %v", err)
@@ -85,8 +147,33 @@ func TestUserServiceCreateUserSuccess(t *testing.T) {
 	}
 }
 
+func TestUserServiceCreateUserRejectsWeakTransactionPin(t *testing.T) {
+	svc := services.NewUserService(userRepoStub{
+		createFn: func(context.Context, domain.User) (domain.User, error) {
+			t.Fatal("expected no user to be created")
+			return domain.User{}, nil
+		},
+	}, nil, testPinPolicy)
+
+	for _, pin := range []string{"1234", "0000", "1212"} {
+		resp, err := svc.CreateUser(context.Background(), models.CreateUserRequest{
+			FirstName:      "Ada",
+			LastName:       "Lovelace",
+			DOB:            "1990-01-01",
+			PhoneNumber:    "08010000000",
+			IDType:         "Passport",
+			IDNumber:       "A123456",
+			KYCLevel:       1,
+			TransactionPin: pin,
+		})
+		if err == nil || resp.Message != "validation failed" || !strings.Contains(err.Error(), "transactionPin") {
+			t.Fatalf("expected weak pin %s to fail validation, got %v (%s)", pin, err, resp.Message)
+		}
+	}
+}
+
 func TestUserServiceGetUserValidationError(t *testing.T) {
-	svc := services.NewUserService(userRepoStub{})
+	svc := services.NewUserService(userRepoStub{}, nil, testPinPolicy)
 
 	_, err := svc.GetUser(context.Background(), "")
 	if err == nil {
@@ -104,7 +191,7 @@ func TestUserServiceVerifyUserPinSuccess(t *testing.T) {
 		getTransactionPinHashByCustFn: func(context.Context, string) (string, error) {
 			return string(hash), nil
 		},
-	})
+	}, nil, testPinPolicy)
 
 	resp, verifyErr := svc.VerifyUserPin(context.Background(), "1000000001", "4321")
 	if verifyErr != nil {
@@ -115,3 +202,45 @@ func TestUserServiceVerifyUserPinSuccess(t *testing.T) {
 	}
 }
 
+func TestUserServiceUpdateContactDetails_KeepsOmittedFields(t *testing.T) {
+	email := "ada@example.com"
+	var saved domain.ContactDetails
+	svc := services.NewUserService(userRepoStub{
+		getByCustomerIDFn: func(_ context.Context, customerID string) (domain.User, error) {
+			return domain.User{CustomerID: customerID, PhoneNumber: "08010000000", Email: &email}, nil
+		},
+		updateContactDetailsFn: func(_ context.Context, customerID string, details domain.ContactDetails, changedBy string) (domain.User, []domain.ProfileChange, error) {
+			saved = details
+			current := domain.User{CustomerID: customerID, PhoneNumber: "08010000000", Email: &email}
+			return domain.User{CustomerID: customerID, PhoneNumber: details.PhoneNumber}, current.ContactDetails().Changes(details), nil
+		},
+	}, nil, testPinPolicy)
+
+	empty := ""
+	address := " 12 Marina, Lagos "
+	resp, err := svc.UpdateContactDetails(context.Background(), models.UpdateContactDetailsRequest{
+		CustomerID: "C1",
+		Email:      &empty,
+		Address:    &address,
+		UpdatedBy:  "branch-officer",
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v (%s)", err, resp.Message)
+	}
+	if saved != (domain.ContactDetails{PhoneNumber: "08010000000", Address: "12 Marina, Lagos"}) {
+		t.Fatalf("expected phone kept, email cleared and address trimmed, got %+v", saved)
+	}
+	if len(resp.Data.Changes) != 2 || resp.Data.Changes[0].Field != "email" || resp.Data.Changes[0].OldValue != email {
+		t.Fatalf("expected email and address changes, got %+v", resp.Data.Changes)
+	}
+}
+
+func TestUpdateContactDetailsRequest_Validate(t *testing.T) {
+	invalid := "not-an-email"
+	if err := (models.UpdateContactDetailsRequest{CustomerID: "C1", UpdatedBy: "ops", Email: &invalid}).Validate(); err == nil {
+		t.Fatal("expected invalid email to be rejected")
+	}
+	if err := (models.UpdateContactDetailsRequest{CustomerID: "C1", UpdatedBy: "ops"}).Validate(); err == nil {
+		t.Fatal("expected a request without any field to be rejected")
+	}
+}
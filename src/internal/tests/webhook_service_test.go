@@ -0,0 +1,288 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/services"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type webhookRepoStub struct {
+	subscriptions []domain.WebhookSubscription
+	created       []domain.WebhookDelivery
+	due           []domain.WebhookDelivery
+	updated       []domain.WebhookDelivery
+	deliveries    []domain.WebhookDelivery
+}
+
+func (s *webhookRepoStub) CreateSubscription(_ context.Context, subscription domain.WebhookSubscription) (domain.WebhookSubscription, error) {
+	subscription.ID = "sub-1"
+	s.subscriptions = append(s.subscriptions, subscription)
+	return subscription, nil
+}
+
+func (s *webhookRepoStub) GetSubscriptionsByChannelID(context.Context, string) ([]domain.WebhookSubscription, error) {
+	return s.subscriptions, nil
+}
+
+func (s *webhookRepoStub) GetActiveSubscriptionsForEvent(_ context.Context, channelID string, eventType domain.TransferEventType) ([]domain.WebhookSubscription, error) {
+	var out []domain.WebhookSubscription
+	for _, subscription := range s.subscriptions {
+		if subscription.ChannelID != channelID {
+			continue
+		}
+		for _, subscribed := range subscription.EventTypes {
+			if subscribed == eventType && subscription.Status == domain.WebhookSubscriptionActive {
+				out = append(out, subscription)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (s *webhookRepoStub) GetSubscriptionByID(_ context.Context, id string) (domain.WebhookSubscription, error) {
+	for _, subscription := range s.subscriptions {
+		if subscription.ID == id {
+			return subscription, nil
+		}
+	}
+	return domain.WebhookSubscription{}, commons.ErrRecordNotFound
+}
+
+// CreateDelivery mirrors the unique index on original deliveries of an event.
+func (s *webhookRepoStub) CreateDelivery(_ context.Context, delivery domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	for _, created := range s.created {
+		if delivery.RedeliveryOf == nil && created.RedeliveryOf == nil && created.SubscriptionID == delivery.SubscriptionID && created.EventID == delivery.EventID {
+			return domain.WebhookDelivery{}, &pq.Error{Code: "23505"}
+		}
+	}
+	delivery.ID = "del-new"
+	s.created = append(s.created, delivery)
+	return delivery, nil
+}
+
+func (s *webhookRepoStub) GetDeliveryByID(_ context.Context, id string) (domain.WebhookDelivery, error) {
+	for _, delivery := range s.deliveries {
+		if delivery.ID == id {
+			return delivery, nil
+		}
+	}
+	return domain.WebhookDelivery{}, commons.ErrRecordNotFound
+}
+
+func (s *webhookRepoStub) GetDeliveriesBySubscriptionID(_ context.Context, subscriptionID string, _ int) ([]domain.WebhookDelivery, error) {
+	var out []domain.WebhookDelivery
+	for _, delivery := range s.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			out = append(out, delivery)
+		}
+	}
+	return out, nil
+}
+
+func (s *webhookRepoStub) ClaimDueDeliveries(context.Context, int, time.Duration) ([]domain.WebhookDelivery, error) {
+	due := s.due
+	s.due = nil
+	return due, nil
+}
+
+func (s *webhookRepoStub) UpdateDeliveryAttempt(_ context.Context, delivery domain.WebhookDelivery) error {
+	s.updated = append(s.updated, delivery)
+	return nil
+}
+
+func TestWebhookServiceNotifyTransferEventCreatesDeliveriesForSubscribers(t *testing.T) {
+	repo := &webhookRepoStub{
+		subscriptions: []domain.WebhookSubscription{
+			{ID: "sub-1", ChannelID: "CHANNEL-A", Status: domain.WebhookSubscriptionActive, EventTypes: []domain.TransferEventType{domain.TransferEventSucceeded}},
+			{ID: "sub-2", ChannelID: "CHANNEL-A", Status: domain.WebhookSubscriptionActive, EventTypes: []domain.TransferEventType{domain.TransferEventFailed}},
+		},
+	}
+	svc := services.NewWebhookService(repo, 3, time.Second, time.Second)
+
+	event, err := domain.NewTransferOutboxEvent(domain.TransferEventSucceeded, domain.Transfer{
+		ID:          "tr-1",
+		DebitAmount: decimal.NewFromInt(100),
+		Narration:   strPtr("Rent"),
+		Status:      domain.TransferStatusSuccess,
+		ChannelID:   strPtr("CHANNEL-A"),
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	event.ID = "evt-1"
+
+	if err := svc.NotifyTransferEvent(context.Background(), event); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(repo.created) != 1 || repo.created[0].SubscriptionID != "sub-1" || repo.created[0].EventID != "evt-1" {
+		t.Fatalf("expected one delivery of evt-1 for sub-1, got %+v", repo.created)
+	}
+
+	var body models.TransferWebhookEvent
+	if err := json.Unmarshal([]byte(repo.created[0].Payload), &body); err != nil {
+		t.Fatalf("expected a transfer webhook payload, got %v", err)
+	}
+	if body.EventID != "evt-1" || body.TransferID != "tr-1" || body.Data.Status != string(domain.TransferStatusSuccess) || body.Data.Narration != "Rent" {
+		t.Fatalf("expected the payload to describe the relayed event, got %+v", body)
+	}
+
+	// The relay redelivers an event whose publish was not acknowledged.
+	if err := svc.NotifyTransferEvent(context.Background(), event); err != nil {
+		t.Fatalf("expected a relayed duplicate to be ignored, got %v", err)
+	}
+	if len(repo.created) != 1 {
+		t.Fatalf("expected no second delivery for the same event, got %+v", repo.created)
+	}
+}
+
+func TestWebhookServiceNotifyTransferEventSkipsOtherChannelsSubscriptions(t *testing.T) {
+	repo := &webhookRepoStub{
+		subscriptions: []domain.WebhookSubscription{
+			{ID: "sub-b", ChannelID: "CHANNEL-B", Status: domain.WebhookSubscriptionActive, EventTypes: []domain.TransferEventType{domain.TransferEventSucceeded}},
+		},
+	}
+	svc := services.NewWebhookService(repo, 3, time.Second, time.Second)
+
+	event, err := domain.NewTransferOutboxEvent(domain.TransferEventSucceeded, domain.Transfer{
+		ID:          "tr-1",
+		DebitAmount: decimal.NewFromInt(100),
+		Status:      domain.TransferStatusSuccess,
+		ChannelID:   strPtr("CHANNEL-A"),
+	})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	event.ID = "evt-1"
+
+	if err := svc.NotifyTransferEvent(context.Background(), event); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(repo.created) != 0 {
+		t.Fatalf("expected channel B to receive nothing for a channel A transfer, got %+v", repo.created)
+	}
+}
+
+func TestWebhookServiceDispatchSignsPayload(t *testing.T) {
+	const secret = "whsec_test"
+	var verifyErr error
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		verifyErr = services.VerifyWebhookSignature(secret, r.Header.Get(services.WebhookSignatureHeader), body, 5*time.Minute, time.Now())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	repo := &webhookRepoStub{
+		subscriptions: []domain.WebhookSubscription{
+			{ID: "sub-1", URL: server.URL, Secret: secret, Status: domain.WebhookSubscriptionActive},
+		},
+		due: []domain.WebhookDelivery{
+			{ID: "del-1", SubscriptionID: "sub-1", EventID: "evt-1", EventType: domain.TransferEventClosed, Payload: `{"eventId":"evt-1"}`},
+		},
+	}
+	svc := services.NewWebhookService(repo, 3, time.Second, time.Second)
+
+	if err := svc.DispatchDueDeliveries(context.Background()); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if verifyErr != nil {
+		t.Fatalf("expected valid signature, got %v", verifyErr)
+	}
+	if len(repo.updated) != 1 || repo.updated[0].Status != domain.WebhookDeliverySuccess {
+		t.Fatalf("expected delivery marked success, got %+v", repo.updated)
+	}
+}
+
+func TestWebhookServiceDispatchSchedulesRetryOnFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	repo := &webhookRepoStub{
+		subscriptions: []domain.WebhookSubscription{
+			{ID: "sub-1", URL: server.URL, Secret: "s", Status: domain.WebhookSubscriptionActive},
+		},
+		due: []domain.WebhookDelivery{
+			{ID: "del-1", SubscriptionID: "sub-1", EventID: "evt-1", Payload: `{}`},
+		},
+	}
+	svc := services.NewWebhookService(repo, 3, time.Minute, time.Second)
+
+	if err := svc.DispatchDueDeliveries(context.Background()); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(repo.updated) != 1 {
+		t.Fatalf("expected one updated delivery, got %d", len(repo.updated))
+	}
+	updated := repo.updated[0]
+	if updated.Status != domain.WebhookDeliveryPending || updated.Attempts != 1 {
+		t.Fatalf("expected pending retry after first failure, got status %s attempts %d", updated.Status, updated.Attempts)
+	}
+	if !updated.NextAttemptAt.After(time.Now().Add(30 * time.Second)) {
+		t.Fatal("expected next attempt to be scheduled with backoff")
+	}
+}
+
+func TestVerifyWebhookSignatureRejectsStaleTimestamp(t *testing.T) {
+	body := []byte(`{}`)
+	old := time.Now().Add(-time.Hour).Unix()
+	header := "t=" + strconv.FormatInt(old, 10) + ",v1=" + services.SignWebhookPayload("s", old, body)
+
+	if err := services.VerifyWebhookSignature("s", header, body, 5*time.Minute, time.Now()); err == nil {
+		t.Fatal("expected stale signature to be rejected")
+	}
+}
+
+func TestWebhookServiceDeliveriesAreScopedToTheOwningChannel(t *testing.T) {
+	repo := &webhookRepoStub{
+		subscriptions: []domain.WebhookSubscription{
+			{ID: "sub-1", ChannelID: "PartnerApp", Status: domain.WebhookSubscriptionActive, EventTypes: []domain.TransferEventType{domain.TransferEventSucceeded}},
+		},
+		deliveries: []domain.WebhookDelivery{
+			{ID: "del-1", SubscriptionID: "sub-1", EventID: "evt-1", EventType: domain.TransferEventSucceeded, Payload: `{"transferId":"tr-1"}`},
+		},
+	}
+	svc := services.NewWebhookService(repo, 3, time.Second, time.Second)
+	otherChannel := commons.WithChannelID(context.Background(), "OtherApp")
+
+	listResponse, err := svc.GetDeliveries(otherChannel, "sub-1")
+	if err == nil || listResponse.Message != "Webhook subscription not found" {
+		t.Fatalf("expected another channel's deliveries to be hidden, got %+v (%v)", listResponse, err)
+	}
+	if listResponse.Data != nil {
+		t.Fatalf("expected no deliveries to be returned, got %+v", *listResponse.Data)
+	}
+
+	redeliverResponse, err := svc.RedeliverWebhook(otherChannel, models.RedeliverWebhookRequest{DeliveryID: "del-1"})
+	if err == nil || redeliverResponse.Message != "Webhook subscription not found" {
+		t.Fatalf("expected redelivery by another channel to be refused, got %+v (%v)", redeliverResponse, err)
+	}
+	if len(repo.created) != 0 {
+		t.Fatalf("expected no delivery to be queued, got %+v", repo.created)
+	}
+
+	owner := commons.WithChannelID(context.Background(), "PartnerApp")
+	listResponse, err = svc.GetDeliveries(owner, "sub-1")
+	if err != nil || listResponse.Data == nil || len(*listResponse.Data) != 1 {
+		t.Fatalf("expected the owning channel to see its delivery, got %+v (%v)", listResponse, err)
+	}
+	if _, err := svc.RedeliverWebhook(owner, models.RedeliverWebhookRequest{DeliveryID: "del-1"}); err != nil {
+		t.Fatalf("expected the owning channel to redeliver, got %v", err)
+	}
+	if len(repo.created) != 1 || repo.created[0].EventID != "evt-1" {
+		t.Fatalf("expected one redelivery of evt-1, got %+v", repo.created)
+	}
+}
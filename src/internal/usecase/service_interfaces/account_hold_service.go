@@ -0,0 +1,15 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type AccountHoldService interface {
+	PlaceHold(ctx context.Context, req models.PlaceAccountHoldRequest) (commons.Response[models.AccountHoldResponse], error)
+	ReleaseHold(ctx context.Context, req models.ReleaseAccountHoldRequest) (commons.Response[models.AccountHoldResponse], error)
+	CaptureHold(ctx context.Context, req models.CaptureAccountHoldRequest) (commons.Response[models.AccountHoldResponse], error)
+	GetHolds(ctx context.Context, accountNumber string) (commons.Response[[]models.AccountHoldResponse], error)
+}
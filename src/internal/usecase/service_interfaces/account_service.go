@@ -11,4 +11,11 @@ type AccountService interface {
 	CreateAccount(ctx context.Context, req models.CreateAccountRequest) (commons.Response[models.CreateAccountResponse], error)
 	GetAccount(ctx context.Context, accountNumber string, bankCode string) (commons.Response[models.GetAccountResponse], error)
 	DepositFunds(ctx context.Context, req models.DepositFundsRequest) (commons.Response[models.DepositFundsResponse], error)
+	GetDeposit(ctx context.Context, req models.GetDepositRequest) (commons.Response[models.DepositResponse], error)
+	WithdrawFunds(ctx context.Context, req models.WithdrawFundsRequest) (commons.Response[models.WithdrawFundsResponse], error)
+	ConfirmWithdrawal(ctx context.Context, req models.ConfirmWithdrawalRequest) (commons.Response[models.WithdrawFundsResponse], error)
+	FreezeAccount(ctx context.Context, req models.AccountStatusChangeRequest) (commons.Response[models.AccountStatusChangeResponse], error)
+	UnfreezeAccount(ctx context.Context, req models.AccountStatusChangeRequest) (commons.Response[models.AccountStatusChangeResponse], error)
+	CloseAccount(ctx context.Context, req models.CloseAccountRequest) (commons.Response[models.AccountStatusChangeResponse], error)
+	GetAccountStatusHistory(ctx context.Context, accountNumber string) (commons.Response[[]models.AccountStatusEventResponse], error)
 }
@@ -0,0 +1,26 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type ChannelService interface {
+	ChannelAuthenticator
+	CreateChannel(ctx context.Context, req models.CreateChannelRequest) (commons.Response[models.ChannelResponse], error)
+	GetChannel(ctx context.Context, channelID string) (commons.Response[models.ChannelResponse], error)
+	GetChannels(ctx context.Context) (commons.Response[[]models.ChannelResponse], error)
+	UpdateChannel(ctx context.Context, req models.UpdateChannelRequest) (commons.Response[models.ChannelResponse], error)
+	RotateChannelKey(ctx context.Context, req models.RotateChannelKeyRequest) (commons.Response[models.ChannelResponse], error)
+}
+
+// ChannelAuthenticator checks the credentials a channel presents on a request.
+type ChannelAuthenticator interface {
+	// Authenticate returns the channel when apiKey is one of its valid keys,
+	// or commons.ErrInvalidChannelCredentials, commons.ErrChannelDisabled or
+	// commons.ErrChannelIPNotAllowed.
+	Authenticate(ctx context.Context, channelID string, apiKey string, remoteIP string) (domain.Channel, error)
+}
@@ -11,4 +11,6 @@ import (
 type ChargesService interface {
 	GetChargesSummary(ctx context.Context, req models.GetChargesRequest) (commons.Response[models.GetChargesResponse], error)
 	GetCharges(ctx context.Context, amount decimal.Decimal, fromCurrency string) (decimal.Decimal, string, decimal.Decimal, decimal.Decimal, decimal.Decimal, error)
+	GetWithdrawalCharges(ctx context.Context, amount decimal.Decimal, currency string) (decimal.Decimal, decimal.Decimal, decimal.Decimal, decimal.Decimal, error)
+	GetExchangeCharges(ctx context.Context, amount decimal.Decimal, currency string) (decimal.Decimal, decimal.Decimal, decimal.Decimal, decimal.Decimal, error)
 }
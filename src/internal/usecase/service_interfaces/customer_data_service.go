@@ -0,0 +1,15 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+// CustomerDataService fulfils data subject requests: exporting everything held
+// about a customer and erasing their personal data.
+type CustomerDataService interface {
+	ExportCustomerData(ctx context.Context, req models.CustomerDataRequest) (commons.Response[models.CustomerDataExportResponse], error)
+	EraseCustomerData(ctx context.Context, req models.CustomerDataRequest) (commons.Response[models.CustomerErasureResponse], error)
+}
@@ -0,0 +1,17 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+// CustomerDuplicateService works the queue of customers flagged as potential
+// duplicates at onboarding: a reviewer dismisses a candidate or merges the
+// two customers.
+type CustomerDuplicateService interface {
+	GetDuplicateCandidates(ctx context.Context, req models.GetDuplicateCandidatesRequest) (commons.Response[[]models.DuplicateCandidateResponse], error)
+	DismissDuplicateCandidate(ctx context.Context, req models.DismissDuplicateCandidateRequest) (commons.Response[models.DuplicateCandidateResponse], error)
+	MergeCustomers(ctx context.Context, req models.MergeCustomersRequest) (commons.Response[models.CustomerMergeResponse], error)
+}
@@ -0,0 +1,13 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type DormancyService interface {
+	ReactivateAccount(ctx context.Context, req models.ReactivateAccountRequest) (commons.Response[models.AccountStatusChangeResponse], error)
+	GetDormancyReport(ctx context.Context) (commons.Response[models.DormancyReportResponse], error)
+}
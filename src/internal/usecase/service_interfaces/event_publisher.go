@@ -0,0 +1,14 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// EventPublisher hands an outbox event to a downstream transport. Publishing is
+// at-least-once: the relay may retry an event that was already accepted, so
+// consumers must deduplicate on the envelope dedupKey.
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
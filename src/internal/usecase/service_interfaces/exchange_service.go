@@ -0,0 +1,12 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type ExchangeService interface {
+	Exchange(ctx context.Context, req models.ExchangeRequest) (commons.Response[models.ExchangeResponse], error)
+}
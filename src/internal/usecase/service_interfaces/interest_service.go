@@ -0,0 +1,13 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type InterestService interface {
+	RunBatch(ctx context.Context, req models.RunInterestBatchRequest) (commons.Response[models.InterestBatchResponse], error)
+	GetRateTiers(ctx context.Context) (commons.Response[[]models.InterestRateTierResponse], error)
+}
@@ -0,0 +1,18 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+// KYCService runs the KYC upgrade workflow: a customer submits identity
+// document metadata and the level is raised only when a different reviewer
+// approves it.
+type KYCService interface {
+	SubmitKYCUpgrade(ctx context.Context, req models.SubmitKYCUpgradeRequest) (commons.Response[models.KYCUpgradeResponse], error)
+	GetKYCUpgrades(ctx context.Context, req models.GetKYCUpgradesRequest) (commons.Response[[]models.KYCUpgradeResponse], error)
+	ApproveKYCUpgrade(ctx context.Context, req models.KYCUpgradeDecisionRequest) (commons.Response[models.KYCUpgradeResponse], error)
+	RejectKYCUpgrade(ctx context.Context, req models.KYCUpgradeDecisionRequest) (commons.Response[models.KYCUpgradeResponse], error)
+}
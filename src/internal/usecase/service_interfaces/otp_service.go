@@ -0,0 +1,24 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// OTPSender delivers a one-time code to the customer's registered phone
+// number. The plaintext code exists only for the duration of this call.
+type OTPSender interface {
+	Send(ctx context.Context, challenge domain.OTPChallenge, phoneNumber string, code string) error
+}
+
+// OTPService issues and verifies one-time codes for customer step-up flows.
+type OTPService interface {
+	// Issue sends a new code for purpose. payload is stored with the challenge
+	// and handed back by Verify; it may be empty.
+	Issue(ctx context.Context, customerID string, purpose domain.OTPPurpose, payload string) (domain.OTPChallenge, error)
+	// Verify spends the challenge on the right code and returns it. Any other
+	// outcome returns commons.ErrInvalidOTP without saying whether the code,
+	// expiry or attempt count was at fault.
+	Verify(ctx context.Context, customerID string, challengeID string, purpose domain.OTPPurpose, code string) (domain.OTPChallenge, error)
+}
@@ -0,0 +1,14 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type OverdraftService interface {
+	ApproveOverdraft(ctx context.Context, req models.ApproveOverdraftRequest) (commons.Response[models.OverdraftFacilityResponse], error)
+	GetOverdraft(ctx context.Context, req models.GetOverdraftRequest) (commons.Response[models.OverdraftFacilityResponse], error)
+	GetLimitBreaches(ctx context.Context) (commons.Response[[]models.OverdraftBreachResponse], error)
+}
@@ -0,0 +1,16 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type PinService interface {
+	ChangePin(ctx context.Context, req models.ChangePinRequest) (commons.Response[models.PinStatusResponse], error)
+	RequestPinReset(ctx context.Context, req models.RequestPinResetRequest) (commons.Response[models.OTPChallengeResponse], error)
+	ResetPin(ctx context.Context, req models.ResetPinRequest) (commons.Response[models.PinStatusResponse], error)
+	UnlockPin(ctx context.Context, req models.UnlockPinRequest) (commons.Response[models.PinStatusResponse], error)
+	GetPinStatus(ctx context.Context, req models.GetPinStatusRequest) (commons.Response[models.PinStatusResponse], error)
+}
@@ -0,0 +1,12 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+)
+
+type PortfolioService interface {
+	GetCustomerAccounts(ctx context.Context, req models.GetCustomerAccountsRequest) (commons.Response[models.CustomerAccountsResponse], error)
+}
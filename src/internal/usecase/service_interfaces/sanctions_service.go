@@ -0,0 +1,13 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// SanctionsScreener checks a name against the loaded sanctions lists and
+// records the decision together with the list version it was made against.
+type SanctionsScreener interface {
+	ScreenName(ctx context.Context, subjectType domain.SanctionsSubjectType, subjectID string, name string) (domain.SanctionsScreening, error)
+}
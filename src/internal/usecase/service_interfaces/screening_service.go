@@ -0,0 +1,13 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// TransferScreener evaluates fraud and AML rules for a transfer before posting
+// and persists the outcome against the transfer.
+type TransferScreener interface {
+	ScreenTransfer(ctx context.Context, input domain.ScreeningInput) (domain.TransferScreening, error)
+}
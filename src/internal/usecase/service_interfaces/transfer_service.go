@@ -5,8 +5,36 @@ import (
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
 	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
 )
 
 type TransferService interface {
 	TransferFunds(ctx context.Context, req models.InternalTransferRequest) (commons.Response[models.InternalTransferResponse], error)
+	ConfirmTransfer(ctx context.Context, req models.ConfirmTransferRequest) (commons.Response[models.InternalTransferResponse], error)
+	GetTransfer(ctx context.Context, transactionReference string) (commons.Response[models.InternalTransferResponse], error)
+}
+
+// TransferDispatcher queues an ACCEPTED transfer for background execution.
+type TransferDispatcher interface {
+	Dispatch(ctx context.Context, transfer domain.Transfer) error
+}
+
+// TransferReviewService works the manual review queue of HELD transfers. A hold
+// can only be approved or rejected by someone other than its initiator.
+type TransferReviewService interface {
+	GetHeldTransfers(ctx context.Context, limit int) (commons.Response[[]models.TransferReviewResponse], error)
+	GetTransferReview(ctx context.Context, transactionReference string) (commons.Response[models.TransferReviewResponse], error)
+	ApproveHeldTransfer(ctx context.Context, req models.TransferReviewDecisionRequest) (commons.Response[models.InternalTransferResponse], error)
+	RejectHeldTransfer(ctx context.Context, req models.TransferReviewDecisionRequest) (commons.Response[models.InternalTransferResponse], error)
+}
+
+// ReferenceGenerator issues transfer references. References must be unique
+// across every instance of the processor, not just within one process.
+type ReferenceGenerator interface {
+	// TransactionReference returns a client-facing reference, prefixed for the
+	// given channel when one is configured.
+	TransactionReference(channel string) string
+	// ExternalReference returns a reference in the format of the configured
+	// payment scheme.
+	ExternalReference() string
 }
@@ -10,5 +10,9 @@ import (
 type UserService interface {
 	CreateUser(ctx context.Context, req models.CreateUserRequest) (commons.Response[models.CreateUserResponse], error)
 	GetUser(ctx context.Context, id string) (commons.Response[models.GetUserResponse], error)
+	UpdateContactDetails(ctx context.Context, req models.UpdateContactDetailsRequest) (commons.Response[models.UpdateContactDetailsResponse], error)
+	GetProfileChanges(ctx context.Context, req models.GetProfileChangesRequest) (commons.Response[[]models.ProfileChangeResponse], error)
 	VerifyUserPin(ctx context.Context, customerID string, pin string) (commons.Response[models.VerifyUserPinResponse], error)
+	ClearComplianceHold(ctx context.Context, req models.ComplianceDecisionRequest) (commons.Response[models.GetUserResponse], error)
+	RejectComplianceHold(ctx context.Context, req models.ComplianceDecisionRequest) (commons.Response[models.GetUserResponse], error)
 }
@@ -0,0 +1,22 @@
+package service_interfaces
+
+import (
+	"context"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+type WebhookService interface {
+	CreateSubscription(ctx context.Context, req models.CreateWebhookSubscriptionRequest) (commons.Response[models.WebhookSubscriptionResponse], error)
+	GetSubscriptions(ctx context.Context, channelID string) (commons.Response[[]models.WebhookSubscriptionResponse], error)
+	GetDeliveries(ctx context.Context, subscriptionID string) (commons.Response[[]models.WebhookDeliveryResponse], error)
+	RedeliverWebhook(ctx context.Context, req models.RedeliverWebhookRequest) (commons.Response[models.WebhookDeliveryResponse], error)
+}
+
+// TransferEventNotifier receives committed transfer outbox events from the outbox relay.
+// It may see the same event more than once and must not duplicate its effects.
+type TransferEventNotifier interface {
+	NotifyTransferEvent(ctx context.Context, event domain.OutboxEvent) error
+}
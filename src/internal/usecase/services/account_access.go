@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+)
+
+// authorizeAccountAccess checks that the customer token on ctx owns an
+// account of customerID. Requests without a token (customer authentication
+// disabled) and service tokens with the back-office scope are allowed.
+func authorizeAccountAccess(ctx context.Context, customerID string) error {
+	principal, ok := commons.PrincipalFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if principal.IsService() {
+		if principal.HasScope(string(domain.ChannelScopeBackOffice)) {
+			return nil
+		}
+		return commons.ErrAccountAccessDenied
+	}
+	if principal.CustomerID != customerID {
+		return commons.ErrAccountAccessDenied
+	}
+	return nil
+}
+
+// authorizeBackOffice admits callers acting for the bank: a service token with
+// the back-office scope, or a channel granted it. Customer tokens are refused
+// whichever channel they arrive through. Requests without channel or token
+// authentication are allowed.
+func authorizeBackOffice(ctx context.Context) error {
+	principal, ok := commons.PrincipalFromContext(ctx)
+	if ok {
+		if !principal.IsService() {
+			return commons.ErrBackOfficeRequired
+		}
+		if principal.HasScope(string(domain.ChannelScopeBackOffice)) {
+			return nil
+		}
+	}
+	if commons.ChannelIDFromContext(ctx) == "" {
+		if ok {
+			return commons.ErrBackOfficeRequired
+		}
+		return nil
+	}
+
+	var channel domain.Channel
+	for _, scope := range commons.ChannelScopesFromContext(ctx) {
+		channel.Scopes = append(channel.Scopes, domain.ChannelScope(scope))
+	}
+	if !channel.HasScope(domain.ChannelScopeBackOffice) {
+		return commons.ErrBackOfficeRequired
+	}
+	return nil
+}
+
+// authorizeAccountNumber applies authorizeAccountAccess to the owner of
+// accountNumber. Without a token the account is not looked up.
+func authorizeAccountNumber(ctx context.Context, accountRepo repo_interfaces.AccountRepository, accountNumber string) error {
+	if _, ok := commons.PrincipalFromContext(ctx); !ok {
+		return nil
+	}
+	account, err := accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		return err
+	}
+	return authorizeAccountAccess(ctx, account.CustomerID)
+}
+
+// accountAccessErrorResponse maps an authorizeAccountNumber error.
+func accountAccessErrorResponse[T any](err error) commons.Response[T] {
+	switch {
+	case errors.Is(err, commons.ErrAccountAccessDenied):
+		return commons.ErrorResponse[T](err.Error())
+	case errors.Is(err, commons.ErrRecordNotFound):
+		return commons.ErrorResponse[T]("Account not found")
+	}
+	return commons.ErrorResponse[T]("failed to fetch account", "Unable to fetch account right now")
+}
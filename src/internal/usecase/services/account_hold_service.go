@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const accountHoldExpiryBatchSize = 100
+
+// Verify that AccountHoldService implements the service_interfaces.AccountHoldService interface
+var _ service_interfaces.AccountHoldService = (*AccountHoldService)(nil)
+
+// AccountHoldService places, releases, captures and expires holds on customer
+// accounts. Captured funds are credited to the internal suspense account.
+type AccountHoldService struct {
+	holdRepo              repo_interfaces.AccountHoldRepository
+	accountRepo           repo_interfaces.AccountRepository
+	suspenseAccountNumber string
+}
+
+func NewAccountHoldService(holdRepo repo_interfaces.AccountHoldRepository, accountRepo repo_interfaces.AccountRepository, suspenseAccountNumber string) *AccountHoldService {
+	return &AccountHoldService{
+		holdRepo:              holdRepo,
+		accountRepo:           accountRepo,
+		suspenseAccountNumber: strings.TrimSpace(suspenseAccountNumber),
+	}
+}
+
+func (s *AccountHoldService) PlaceHold(ctx context.Context, req models.PlaceAccountHoldRequest) (commons.Response[models.AccountHoldResponse], error) {
+	logger.Info("account hold service place hold request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error()), err
+	}
+	holdType := domain.AccountHoldType(strings.ToUpper(strings.TrimSpace(req.HoldType)))
+	if holdType == domain.AccountHoldTypeLien {
+		if err := authorizeBackOffice(ctx); err != nil {
+			return lienAccessErrorResponse(), err
+		}
+	}
+	if err := authorizeAccountNumber(ctx, s.accountRepo, strings.TrimSpace(req.AccountNumber)); err != nil {
+		return accountAccessErrorResponse[models.AccountHoldResponse](err), err
+	}
+
+	hold, err := s.holdRepo.PlaceHold(ctx, domain.AccountHold{
+		AccountNumber: strings.TrimSpace(req.AccountNumber),
+		HoldType:      holdType,
+		Amount:        req.Amount,
+		Reason:        strings.TrimSpace(req.Reason),
+		Reference:     strings.TrimSpace(req.Reference),
+		ExpiresAt:     req.ExpiresAt,
+	})
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return commons.ErrorResponse[models.AccountHoldResponse]("Hold reference already exists"), err
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.AccountHoldResponse]("Account not found"), err
+		case errors.Is(err, commons.ErrInsufficientBalance):
+			return commons.ErrorResponse[models.AccountHoldResponse]("Insufficient balance"), err
+		case strings.Contains(err.Error(), "account is not active"):
+			return commons.ErrorResponse[models.AccountHoldResponse]("Account is not active"), err
+		}
+		return commons.ErrorResponse[models.AccountHoldResponse]("failed to place hold", "Unable to place hold right now"), err
+	}
+
+	return commons.SuccessResponse("Hold placed successfully", mapAccountHoldToResponse(hold)), nil
+}
+
+func (s *AccountHoldService) ReleaseHold(ctx context.Context, req models.ReleaseAccountHoldRequest) (commons.Response[models.AccountHoldResponse], error) {
+	logger.Info("account hold service release hold request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error()), err
+	}
+
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	reference := strings.TrimSpace(req.Reference)
+	if err := authorizeAccountNumber(ctx, s.accountRepo, accountNumber); err != nil {
+		return accountAccessErrorResponse[models.AccountHoldResponse](err), err
+	}
+	if _, response, err := s.getActiveHold(ctx, accountNumber, reference); err != nil {
+		return response, err
+	}
+
+	hold, err := s.holdRepo.ReleaseHold(ctx, accountNumber, reference, domain.AccountHoldStatusReleased)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.AccountHoldResponse]("Active hold not found"), err
+		}
+		return commons.ErrorResponse[models.AccountHoldResponse]("failed to release hold", "Unable to release hold right now"), err
+	}
+
+	return commons.SuccessResponse("Hold released successfully", mapAccountHoldToResponse(hold)), nil
+}
+
+func (s *AccountHoldService) CaptureHold(ctx context.Context, req models.CaptureAccountHoldRequest) (commons.Response[models.AccountHoldResponse], error) {
+	logger.Info("account hold service capture hold request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error()), err
+	}
+
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	reference := strings.TrimSpace(req.Reference)
+	if err := authorizeAccountNumber(ctx, s.accountRepo, accountNumber); err != nil {
+		return accountAccessErrorResponse[models.AccountHoldResponse](err), err
+	}
+	hold, response, err := s.getActiveHold(ctx, accountNumber, reference)
+	if err != nil {
+		return response, err
+	}
+
+	amount := hold.Amount
+	if req.Amount != nil {
+		amount = *req.Amount
+	}
+	if amount.GreaterThan(hold.Amount) {
+		err := fmt.Errorf("amount exceeds held amount of %s", hold.Amount.StringFixed(2))
+		return commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error()), err
+	}
+
+	captured, err := s.holdRepo.CaptureHold(ctx, accountNumber, reference, amount, s.suspenseAccountNumber)
+	if err != nil {
+		switch {
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.AccountHoldResponse]("Active hold not found"), err
+		case errors.Is(err, commons.ErrAccountHoldExpired):
+			return commons.ErrorResponse[models.AccountHoldResponse](err.Error(), fmt.Sprintf("hold %s expired and is awaiting release", reference)), err
+		}
+		return commons.ErrorResponse[models.AccountHoldResponse]("failed to capture hold", "Unable to capture hold right now"), err
+	}
+
+	return commons.SuccessResponse("Hold captured successfully", mapAccountHoldToResponse(captured)), nil
+}
+
+func (s *AccountHoldService) GetHolds(ctx context.Context, accountNumber string) (commons.Response[[]models.AccountHoldResponse], error) {
+	logger.Info("account hold service get holds request", logger.Fields{
+		"accountNumber": accountNumber,
+	})
+
+	accountNumber = strings.TrimSpace(accountNumber)
+	if accountNumber == "" {
+		err := fmt.Errorf("accountNumber is required")
+		return commons.ErrorResponse[[]models.AccountHoldResponse]("validation failed", err.Error()), err
+	}
+	if err := authorizeAccountNumber(ctx, s.accountRepo, accountNumber); err != nil {
+		return accountAccessErrorResponse[[]models.AccountHoldResponse](err), err
+	}
+
+	holds, err := s.holdRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		return commons.ErrorResponse[[]models.AccountHoldResponse]("failed to fetch holds", "Unable to fetch holds right now"), err
+	}
+
+	response := make([]models.AccountHoldResponse, 0, len(holds))
+	for _, hold := range holds {
+		response = append(response, mapAccountHoldToResponse(hold))
+	}
+	return commons.SuccessResponse("Holds fetched successfully", response), nil
+}
+
+// StartExpiryWorker expires due holds every interval until ctx is cancelled.
+func (s *AccountHoldService) StartExpiryWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ExpireHolds(ctx); err != nil {
+			logger.Error("account hold expiry failed", err, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExpireHolds releases every ACTIVE hold whose expiry has passed. A hold released
+// or captured concurrently is skipped.
+func (s *AccountHoldService) ExpireHolds(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		holds, err := s.holdRepo.GetExpired(ctx, accountHoldExpiryBatchSize)
+		if err != nil {
+			return expired, err
+		}
+
+		released := 0
+		for _, hold := range holds {
+			if ctx.Err() != nil {
+				return expired, ctx.Err()
+			}
+			if _, err := s.holdRepo.ReleaseHold(ctx, hold.AccountNumber, hold.Reference, domain.AccountHoldStatusExpired); err != nil {
+				if errors.Is(err, commons.ErrRecordNotFound) {
+					continue
+				}
+				return expired, err
+			}
+			released++
+		}
+		expired += released
+
+		if released > 0 {
+			logger.Info("account hold service expired holds", logger.Fields{
+				"count": released,
+			})
+		}
+		if len(holds) < accountHoldExpiryBatchSize || released == 0 {
+			return expired, nil
+		}
+	}
+}
+
+// getActiveHold loads a hold that may be released or captured through the API.
+// TRANSFER holds belong to the transfer review flow and are rejected here, and
+// LIEN holds, being court or regulator ordered, are left to the back office.
+func (s *AccountHoldService) getActiveHold(ctx context.Context, accountNumber string, reference string) (domain.AccountHold, commons.Response[models.AccountHoldResponse], error) {
+	hold, err := s.holdRepo.GetByReference(ctx, accountNumber, reference)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.AccountHold{}, commons.ErrorResponse[models.AccountHoldResponse]("Active hold not found"), err
+		}
+		return domain.AccountHold{}, commons.ErrorResponse[models.AccountHoldResponse]("failed to fetch hold", "Unable to fetch hold right now"), err
+	}
+	if hold.Status != domain.AccountHoldStatusActive {
+		err := fmt.Errorf("hold %s is %s", reference, hold.Status)
+		return domain.AccountHold{}, commons.ErrorResponse[models.AccountHoldResponse]("Active hold not found"), err
+	}
+	if hold.HoldType == domain.AccountHoldTypeTransfer {
+		err := fmt.Errorf("transfer holds are resolved through transfer review")
+		return domain.AccountHold{}, commons.ErrorResponse[models.AccountHoldResponse]("validation failed", err.Error()), err
+	}
+	if hold.HoldType == domain.AccountHoldTypeLien {
+		if err := authorizeBackOffice(ctx); err != nil {
+			return domain.AccountHold{}, lienAccessErrorResponse(), err
+		}
+	}
+	return hold, commons.Response[models.AccountHoldResponse]{}, nil
+}
+
+func lienAccessErrorResponse() commons.Response[models.AccountHoldResponse] {
+	return commons.ErrorResponse[models.AccountHoldResponse](commons.ErrBackOfficeRequired.Error(), "Lien holds are placed, released and captured by the back office only")
+}
+
+func mapAccountHoldToResponse(hold domain.AccountHold) models.AccountHoldResponse {
+	response := models.AccountHoldResponse{
+		AccountNumber:  hold.AccountNumber,
+		HoldType:       string(hold.HoldType),
+		Amount:         hold.Amount,
+		CapturedAmount: hold.CapturedAmount,
+		Reason:         hold.Reason,
+		Reference:      hold.Reference,
+		Status:         string(hold.Status),
+		CreatedAt:      hold.CreatedAt.Format(time.RFC3339),
+	}
+	if hold.ExpiresAt != nil {
+		response.ExpiresAt = hold.ExpiresAt.Format(time.RFC3339)
+	}
+	if hold.ResolvedAt != nil {
+		response.ResolvedAt = hold.ResolvedAt.Format(time.RFC3339)
+	}
+	return response
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/shopspring/decimal"
+)
+
+const accountStatusConflictMessage = "Account status does not allow this change"
+
+// debitAccountStatusError explains why an account that is not ACTIVE cannot be
+// debited. subject names the account in the message, e.g. "debit account".
+func debitAccountStatusError(subject string, status domain.AccountStatus) error {
+	if status == domain.AccountStatusDormant {
+		return fmt.Errorf("%s is dormant and must be reactivated with KYC re-verification", subject)
+	}
+	return fmt.Errorf("%s is not active", subject)
+}
+
+// FreezeAccount moves an ACTIVE account to FROZEN. Frozen accounts reject every
+// posting until they are unfrozen.
+func (s *AccountService) FreezeAccount(ctx context.Context, req models.AccountStatusChangeRequest) (commons.Response[models.AccountStatusChangeResponse], error) {
+	logger.Info("account service freeze account request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	return s.changeAccountStatus(ctx, req, domain.AccountStatusActive, domain.AccountStatusFrozen, "Account frozen successfully")
+}
+
+// UnfreezeAccount moves a FROZEN account back to ACTIVE.
+func (s *AccountService) UnfreezeAccount(ctx context.Context, req models.AccountStatusChangeRequest) (commons.Response[models.AccountStatusChangeResponse], error) {
+	logger.Info("account service unfreeze account request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	return s.changeAccountStatus(ctx, req, domain.AccountStatusFrozen, domain.AccountStatusActive, "Account unfrozen successfully")
+}
+
+// CloseAccount closes an ACTIVE or FROZEN account. A remaining balance is swept
+// to SweepAccountNumber, converted at the current rate when the currencies differ.
+// A FROZEN account cannot be swept; it must be unfrozen first.
+func (s *AccountService) CloseAccount(ctx context.Context, req models.CloseAccountRequest) (commons.Response[models.AccountStatusChangeResponse], error) {
+	logger.Info("account service close account request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+	actor := commons.ActorFromContext(ctx)
+	if actor == "" {
+		err := fmt.Errorf("an authenticated requester is required")
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("Account not found"), err
+		}
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to close account", "Unable to close account right now"), err
+	}
+	if account.Status == domain.AccountStatusClosed {
+		err := fmt.Errorf("account %s is already closed", accountNumber)
+		return commons.ErrorResponse[models.AccountStatusChangeResponse](accountStatusConflictMessage), err
+	}
+
+	var sweep *domain.AccountSweep
+	if sweepAccountNumber := strings.TrimSpace(req.SweepAccountNumber); sweepAccountNumber != "" {
+		if account.Status == domain.AccountStatusFrozen {
+			err := commons.ErrFrozenAccountSweep
+			return commons.ErrorResponse[models.AccountStatusChangeResponse](err.Error()), err
+		}
+		destination, err := s.accountRepo.GetByAccountNumber(ctx, sweepAccountNumber)
+		if err != nil {
+			if errors.Is(err, commons.ErrRecordNotFound) {
+				return commons.ErrorResponse[models.AccountStatusChangeResponse]("Sweep account not found"), err
+			}
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to close account", "Unable to close account right now"), err
+		}
+		if destination.CustomerID != account.CustomerID {
+			err := fmt.Errorf("sweepAccountNumber must belong to the same customer")
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+		}
+		if destination.Status != domain.AccountStatusActive {
+			err := fmt.Errorf("sweepAccountNumber must be an active account")
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+		}
+
+		rate, err := s.sweepRate(ctx, account.Currency, destination.Currency)
+		if err != nil {
+			logger.Error("account service close account rate lookup failed", err, logger.Fields{
+				"fromCurrency": account.Currency,
+				"toCurrency":   destination.Currency,
+			})
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to close account", "Unable to fetch rate right now"), err
+		}
+		sweep = &domain.AccountSweep{
+			DestinationAccountNumber: destination.AccountNumber,
+			Rate:                     rate,
+			SuspenseAccountNumber:    s.suspenseAccountNumber,
+		}
+	}
+
+	closed, err := s.accountRepo.CloseAccount(ctx, accountNumber, sweep, domain.AccountStatusEvent{
+		Reason:  domain.AccountStatusReason(strings.ToUpper(strings.TrimSpace(req.ReasonCode))),
+		Comment: strings.TrimSpace(req.Comment),
+		Actor:   actor,
+	})
+	if err != nil {
+		logger.Error("account service close account failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		switch {
+		case errors.Is(err, commons.ErrAccountBalanceNotZero), errors.Is(err, commons.ErrAccountHasActiveHolds), errors.Is(err, commons.ErrFrozenAccountSweep):
+			return commons.ErrorResponse[models.AccountStatusChangeResponse](err.Error()), err
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.AccountStatusChangeResponse](accountStatusConflictMessage), err
+		}
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to close account", "Unable to close account right now"), err
+	}
+
+	logger.Info("account service close account success", logger.Fields{
+		"accountNumber": accountNumber,
+		"swept":         sweep != nil && !account.LedgerBalance.IsZero(),
+	})
+
+	return commons.SuccessResponse("Account closed successfully", mapAccountStatusChangeToResponse(closed)), nil
+}
+
+// GetAccountStatusHistory returns every status change of an account, oldest first.
+func (s *AccountService) GetAccountStatusHistory(ctx context.Context, accountNumber string) (commons.Response[[]models.AccountStatusEventResponse], error) {
+	logger.Info("account service get account status history request", logger.Fields{
+		"accountNumber": accountNumber,
+	})
+
+	accountNumber = strings.TrimSpace(accountNumber)
+	if !isTenDigitAccountNumber(accountNumber) {
+		err := fmt.Errorf("accountNumber must be exactly 10 digits")
+		return commons.ErrorResponse[[]models.AccountStatusEventResponse]("validation failed", err.Error()), err
+	}
+
+	events, err := s.accountRepo.GetStatusHistory(ctx, accountNumber)
+	if err != nil {
+		return commons.ErrorResponse[[]models.AccountStatusEventResponse]("failed to fetch account status history", "Unable to fetch account status history right now"), err
+	}
+
+	response := make([]models.AccountStatusEventResponse, 0, len(events))
+	for _, event := range events {
+		response = append(response, mapAccountStatusEventToResponse(event))
+	}
+	return commons.SuccessResponse("Account status history fetched successfully", response), nil
+}
+
+func (s *AccountService) changeAccountStatus(
+	ctx context.Context,
+	req models.AccountStatusChangeRequest,
+	from domain.AccountStatus,
+	to domain.AccountStatus,
+	successMessage string,
+) (commons.Response[models.AccountStatusChangeResponse], error) {
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+	actor := commons.ActorFromContext(ctx)
+	if actor == "" {
+		err := fmt.Errorf("an authenticated requester is required")
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	account, err := s.accountRepo.ChangeStatus(ctx, accountNumber, from, domain.AccountStatusEvent{
+		ToStatus: to,
+		Reason:   domain.AccountStatusReason(strings.ToUpper(strings.TrimSpace(req.ReasonCode))),
+		Comment:  strings.TrimSpace(req.Comment),
+		Actor:    actor,
+	})
+	if err != nil {
+		logger.Error("account service change account status failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+			"to":            to,
+		})
+		if !errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to change account status", "Unable to change account status right now"), err
+		}
+		// The conditional update matched nothing: tell a missing account apart
+		// from one in the wrong status.
+		if _, lookupErr := s.accountRepo.GetByAccountNumber(ctx, accountNumber); errors.Is(lookupErr, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("Account not found"), err
+		}
+		return commons.ErrorResponse[models.AccountStatusChangeResponse](accountStatusConflictMessage), err
+	}
+
+	return commons.SuccessResponse(successMessage, mapAccountStatusChangeToResponse(account)), nil
+}
+
+func (s *AccountService) sweepRate(ctx context.Context, fromCurrency string, toCurrency string) (decimal.Decimal, error) {
+	if strings.EqualFold(fromCurrency, toCurrency) {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rate, err := s.rateRepo.GetRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if rate.Rate.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, fmt.Errorf("rate %s to %s must be greater than zero", fromCurrency, toCurrency)
+	}
+	return rate.Rate, nil
+}
+
+func mapAccountStatusChangeToResponse(account domain.Account) models.AccountStatusChangeResponse {
+	return models.AccountStatusChangeResponse{
+		AccountNumber:    account.AccountNumber,
+		Currency:         account.Currency,
+		Status:           string(account.Status),
+		AvailableBalance: account.AvailableBalance,
+		LedgerBalance:    account.LedgerBalance,
+		UpdatedAt:        account.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func mapAccountStatusEventToResponse(event domain.AccountStatusEvent) models.AccountStatusEventResponse {
+	return models.AccountStatusEventResponse{
+		AccountNumber:      event.AccountNumber,
+		FromStatus:         string(event.FromStatus),
+		ToStatus:           string(event.ToStatus),
+		ReasonCode:         string(event.Reason),
+		Comment:            event.Comment,
+		RequestedBy:        event.Actor,
+		SweepAccountNumber: valueOrEmpty(event.SweepAccountNumber),
+		SweptAmount:        event.SweptAmount,
+		CreatedAt:          event.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const accountNumberAllocationAttempts = 5
+
 // Verify that AccountService implements the service_interfaces.AccountService interface
 var _ service_interfaces.AccountService = (*AccountService)(nil)
 
@@ -23,23 +25,81 @@ type AccountService struct {
 	accountRepo         repo_interfaces.AccountRepository
 	userRepo            domain.UserRepository
 	participantBankRepo domain.ParticipantBankRepository
+	rateRepo            repo_interfaces.RateRepository
+	withdrawalRepo      repo_interfaces.WithdrawalRepository
+	userService         service_interfaces.UserService
+	chargesService      service_interfaces.ChargesService
+	referenceGenerator  service_interfaces.ReferenceGenerator
 	greyBankCode        string
+	// suspenseAccountNumber routes balances swept out of closing accounts and
+	// withdrawals on their way to the cash GL and fee accounts.
+	suspenseAccountNumber string
+	chargesAccountNumber  string
+	vatAccountNumber      string
+	// cashGLAccountNumbers maps each currency to the cash/teller GL account
+	// withdrawals are paid out against.
+	cashGLAccountNumbers map[string]string
+	// fundingGLAccountNumbers maps each currency to the GL account that carries
+	// the contra entry of deposits.
+	fundingGLAccountNumbers map[string]string
+	kycLimits               kycLimits
+	otpService              service_interfaces.OTPService
+	stepUpRates             service_interfaces.RateService
+	stepUpPolicy            domain.TransferStepUpPolicy
 }
 
 func NewAccountService(
 	accountRepo repo_interfaces.AccountRepository,
 	userRepo domain.UserRepository,
 	participantBankRepo domain.ParticipantBankRepository,
+	rateRepo repo_interfaces.RateRepository,
+	withdrawalRepo repo_interfaces.WithdrawalRepository,
+	userService service_interfaces.UserService,
+	chargesService service_interfaces.ChargesService,
+	referenceGenerator service_interfaces.ReferenceGenerator,
 	greyBankCode string,
+	suspenseAccountNumber string,
+	chargesAccountNumber string,
+	vatAccountNumber string,
+	cashGLAccountNumbers map[string]string,
+	fundingGLAccountNumbers map[string]string,
 ) *AccountService {
+	if referenceGenerator == nil {
+		referenceGenerator, _ = NewReferenceGenerator(0, greyBankCode, ReferenceSchemeEXT, nil)
+	}
 	return &AccountService{
-		accountRepo:         accountRepo,
-		userRepo:            userRepo,
-		participantBankRepo: participantBankRepo,
-		greyBankCode:        strings.TrimSpace(greyBankCode),
+		accountRepo:             accountRepo,
+		userRepo:                userRepo,
+		participantBankRepo:     participantBankRepo,
+		rateRepo:                rateRepo,
+		withdrawalRepo:          withdrawalRepo,
+		userService:             userService,
+		chargesService:          chargesService,
+		referenceGenerator:      referenceGenerator,
+		greyBankCode:            strings.TrimSpace(greyBankCode),
+		suspenseAccountNumber:   strings.TrimSpace(suspenseAccountNumber),
+		chargesAccountNumber:    strings.TrimSpace(chargesAccountNumber),
+		vatAccountNumber:        strings.TrimSpace(vatAccountNumber),
+		cashGLAccountNumbers:    cashGLAccountNumbers,
+		fundingGLAccountNumbers: fundingGLAccountNumbers,
 	}
 }
 
+// EnableKYCLimits caps each withdrawal at the per-transfer limit of the
+// customer's KYC level, the same limit transfers are held to.
+func (s *AccountService) EnableKYCLimits(userRepo domain.UserRepository, rateService service_interfaces.RateService, policy domain.TransferLimitPolicy) {
+	s.kycLimits = kycLimits{userRepo: userRepo, rateService: rateService, policy: policy}
+}
+
+// EnableStepUp makes WithdrawFunds answer withdrawals above the transfer
+// step-up threshold with an OTP challenge; they are paid out by
+// ConfirmWithdrawal once the code is submitted.
+func (s *AccountService) EnableStepUp(otpService service_interfaces.OTPService, rateService service_interfaces.RateService, policy domain.TransferStepUpPolicy) {
+	s.otpService = otpService
+	s.stepUpRates = rateService
+	s.stepUpPolicy = policy
+}
+
 func (s *AccountService) CreateAccount(ctx context.Context, req models.CreateAccountRequest) (commons.Response[models.CreateAccountResponse], error) {
 	logger.Info("account service create account request", logger.Fields{
 		"payload": logger.SanitizePayload(req),
@@ -52,6 +112,38 @@ func (s *AccountService) CreateAccount(ctx context.Context, req models.CreateAcc
 
 	customerID := strings.TrimSpace(req.CustomerID)
 	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	product := domain.AccountProduct(strings.ToUpper(strings.TrimSpace(req.Product)))
+	if product == "" {
+		product = domain.AccountProductStandard
+	}
+
+	user, err := s.userRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.CreateAccountResponse]("Customer not found"), err
+		}
+		logger.Error("account service create account customer lookup failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.CreateAccountResponse]("failed to create account", "Unable to create account right now"), err
+	}
+	if user.Status == domain.UserStatusHeld {
+		err := fmt.Errorf("customer is held for compliance review")
+		return commons.ErrorResponse[models.CreateAccountResponse]("validation failed", err.Error()), err
+	}
+	if user.Status == domain.UserStatusRejected {
+		err := fmt.Errorf("customer was rejected at compliance review")
+		return commons.ErrorResponse[models.CreateAccountResponse]("validation failed", err.Error()), err
+	}
+	if user.Status == domain.UserStatusMerged {
+		err := fmt.Errorf("customer was merged into %s", valueOrEmpty(user.MergedIntoCustomerID))
+		return commons.ErrorResponse[models.CreateAccountResponse]("validation failed", err.Error()), err
+	}
+	if user.Status == domain.UserStatusErased {
+		err := fmt.Errorf("customer data was erased")
+		return commons.ErrorResponse[models.CreateAccountResponse]("validation failed", err.Error()), err
+	}
+
 	hasAccount, err := s.accountRepo.HasAccountForCustomerIDAndCurrency(ctx, customerID, currency)
 	if err != nil {
 		logger.Error("account service create account existing account check failed", err, logger.Fields{
@@ -71,19 +163,17 @@ func (s *AccountService) CreateAccount(ctx context.Context, req models.CreateAcc
 		return commons.ErrorResponse[models.CreateAccountResponse]("validation failed", err.Error()), err
 	}
 
-	account := domain.Account{
+	created, err := s.createWithAllocatedNumber(ctx, domain.Account{
 		CustomerID:       customerID,
-		AccountNumber:    generateAccountNumber(),
 		Currency:         currency,
+		Product:          product,
 		AvailableBalance: balance,
 		LedgerBalance:    balance,
 		Status:           domain.AccountStatusActive,
-	}
-
-	created, err := s.accountRepo.Create(ctx, account)
+	})
 	if err != nil {
 		logger.Error("account service create account repository failed", err, logger.Fields{
-			"customerId": account.CustomerID,
+			"customerId": customerID,
 		})
 		return commons.ErrorResponse[models.CreateAccountResponse]("failed to create account", "Unable to create account right now"), err
 	}
@@ -93,6 +183,7 @@ func (s *AccountService) CreateAccount(ctx context.Context, req models.CreateAcc
 		CustomerID:       created.CustomerID,
 		AccountNumber:    created.AccountNumber,
 		Currency:         created.Currency,
+		Product:          string(created.Product),
 		AvailableBalance: created.AvailableBalance,
 		LedgerBalance:    created.LedgerBalance,
 		Status:           string(created.Status),
@@ -130,6 +221,9 @@ func (s *AccountService) GetAccount(ctx context.Context, accountNumber string, b
 	if !isSixDigitBankCode(bankCode) {
 		return commons.ErrorResponse[models.GetAccountResponse]("validation failed", "bankCode must be exactly 6 digits"), fmt.Errorf("bankCode must be exactly 6 digits")
 	}
+	if !commons.IsValidAccountNumber(bankCode, accountNumber) {
+		return commons.ErrorResponse[models.GetAccountResponse]("validation failed", "accountNumber has an invalid check digit"), fmt.Errorf("accountNumber has an invalid check digit")
+	}
 
 	if bankCode != s.greyBankCode {
 		banks, err := s.participantBankRepo.GetAll(ctx)
@@ -196,6 +290,21 @@ func (s *AccountService) GetAccount(ctx context.Context, accountNumber string, b
 		strings.TrimSpace(user.LastName),
 	}, " "))
 
+	// Customers may look up other customers' accounts as transfer
+	// beneficiaries, so they get the name enquiry fields only.
+	if err := authorizeAccountAccess(ctx, account.CustomerID); err != nil {
+		logger.Info("account service get internal account name enquiry", logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return commons.SuccessResponse("account fetched successfully", models.GetAccountResponse{
+			AccountName:   accountName,
+			AccountNumber: account.AccountNumber,
+			BankCode:      bankCode,
+			BankName:      "Grey",
+			Currency:      account.Currency,
+		}), nil
+	}
+
 	response := models.GetAccountResponse{
 		ID:               account.ID,
 		CustomerID:       account.CustomerID,
@@ -204,12 +313,17 @@ func (s *AccountService) GetAccount(ctx context.Context, accountNumber string, b
 		BankCode:         bankCode,
 		BankName:         "Grey",
 		Currency:         account.Currency,
+		Product:          string(account.Product),
 		AvailableBalance: account.AvailableBalance,
 		LedgerBalance:    account.LedgerBalance,
+		OverdraftLimit:   account.OverdraftLimit,
 		Status:           string(account.Status),
 		CreatedAt:        account.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:        account.UpdatedAt.Format(time.RFC3339),
 	}
+	if !account.LastActivityAt.IsZero() {
+		response.LastActivityAt = account.LastActivityAt.Format(time.RFC3339)
+	}
 
 	logger.Info("account service get internal account success", logger.Fields{
 		"accountId":     response.ID,
@@ -220,6 +334,10 @@ func (s *AccountService) GetAccount(ctx context.Context, accountNumber string, b
 	return commons.SuccessResponse("account fetched successfully", response), nil
 }
 
+// DepositFunds credits an account from a funding provider against the funding
+// GL account of its currency. A deposit replayed with the same source and
+// external reference for the same account and amount returns the original
+// deposit without posting again.
 func (s *AccountService) DepositFunds(ctx context.Context, req models.DepositFundsRequest) (commons.Response[models.DepositFundsResponse], error) {
 	logger.Info("account service deposit funds request", logger.Fields{
 		"payload": logger.SanitizePayload(req),
@@ -232,19 +350,69 @@ func (s *AccountService) DepositFunds(ctx context.Context, req models.DepositFun
 
 	accountNumber := strings.TrimSpace(req.AccountNumber)
 	amount := req.Amount
+	source := domain.DepositSource(strings.ToUpper(strings.TrimSpace(req.Source)))
+	externalReference := strings.TrimSpace(req.ExternalReference)
 
-	if err := s.accountRepo.DepositFunds(ctx, accountNumber, amount); err != nil {
-		logger.Error("account service deposit funds failed", err, logger.Fields{
+	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.DepositFundsResponse]("Account not found"), err
+		}
+		logger.Error("account service deposit funds account lookup failed", err, logger.Fields{
 			"accountNumber": accountNumber,
-			"amount":        amount,
 		})
+		return commons.ErrorResponse[models.DepositFundsResponse]("failed to deposit funds", "Unable to deposit funds right now"), err
+	}
+
+	if err := authorizeAccountAccess(ctx, account.CustomerID); err != nil {
+		logger.Info("account service deposit funds account access denied", logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return commons.ErrorResponse[models.DepositFundsResponse](err.Error()), err
+	}
+
+	fundingGLAccountNumber := strings.TrimSpace(s.fundingGLAccountNumbers[account.Currency])
+	if fundingGLAccountNumber == "" {
+		err := fmt.Errorf("deposits are not supported for %s", account.Currency)
+		return commons.ErrorResponse[models.DepositFundsResponse]("validation failed", err.Error()), err
+	}
+
+	channel := commons.ChannelIDFromContext(ctx)
+	message := "funds deposited successfully"
+	deposit, err := s.accountRepo.DepositFunds(ctx, domain.Deposit{
+		Reference:              s.referenceGenerator.TransactionReference(channel),
+		AccountNumber:          accountNumber,
+		Currency:               account.Currency,
+		Amount:                 amount,
+		Source:                 source,
+		ExternalReference:      externalReference,
+		FundingGLAccountNumber: fundingGLAccountNumber,
+		Narration:              strings.TrimSpace(req.Narration),
+		Channel:                channel,
+	})
+	if errors.Is(err, commons.ErrDuplicateExternalReference) {
+		deposit, err = s.replayedDeposit(ctx, source, externalReference, accountNumber, amount)
+		message = "deposit already recorded"
+	}
+	if err != nil {
+		logger.Error("account service deposit funds failed", err, logger.Fields{
+			"accountNumber":     accountNumber,
+			"amount":            amount,
+			"externalReference": externalReference,
+		})
+		if errors.Is(err, commons.ErrDuplicateExternalReference) {
+			return commons.ErrorResponse[models.DepositFundsResponse]("Duplicate external reference", "externalReference was already used for a different deposit"), err
+		}
 		if errors.Is(err, commons.ErrRecordNotFound) {
 			return commons.ErrorResponse[models.DepositFundsResponse]("Account not found"), err
 		}
+		if strings.Contains(err.Error(), "account is not active") {
+			return commons.ErrorResponse[models.DepositFundsResponse]("Account is not active"), err
+		}
 		return commons.ErrorResponse[models.DepositFundsResponse]("failed to deposit funds", "Unable to deposit funds right now"), err
 	}
 
-	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	account, err = s.accountRepo.GetByAccountNumber(ctx, accountNumber)
 	if err != nil {
 		logger.Error("account service get account after deposit failed", err, logger.Fields{
 			"accountNumber": accountNumber,
@@ -256,20 +424,83 @@ func (s *AccountService) DepositFunds(ctx context.Context, req models.DepositFun
 	}
 
 	response := models.DepositFundsResponse{
-		AccountNumber:    account.AccountNumber,
-		Currency:         account.Currency,
-		DepositedAmount:  amount,
-		AvailableBalance: account.AvailableBalance,
-		LedgerBalance:    account.LedgerBalance,
+		Reference:         deposit.Reference,
+		AccountNumber:     account.AccountNumber,
+		Currency:          account.Currency,
+		DepositedAmount:   deposit.Amount,
+		AvailableBalance:  account.AvailableBalance,
+		LedgerBalance:     account.LedgerBalance,
+		Source:            string(deposit.Source),
+		ExternalReference: deposit.ExternalReference,
+		JournalEntries:    toJournalEntryResponses(deposit.JournalEntries),
+		CreatedAt:         deposit.CreatedAt.Format(time.RFC3339),
 	}
 
 	logger.Info("account service deposit funds success", logger.Fields{
+		"reference":        response.Reference,
 		"accountNumber":    response.AccountNumber,
 		"depositedAmount":  response.DepositedAmount,
 		"availableBalance": response.AvailableBalance,
 	})
 
-	return commons.SuccessResponse("funds deposited successfully", response), nil
+	return commons.SuccessResponse(message, response), nil
+}
+
+// replayedDeposit returns the deposit already recorded under source and
+// externalReference when it matches the replayed account and amount.
+func (s *AccountService) replayedDeposit(ctx context.Context, source domain.DepositSource, externalReference string, accountNumber string, amount decimal.Decimal) (domain.Deposit, error) {
+	existing, err := s.accountRepo.GetDepositByExternalReference(ctx, source, externalReference)
+	if err != nil {
+		return domain.Deposit{}, err
+	}
+	if existing.AccountNumber != accountNumber || !existing.Amount.Equal(amount) {
+		return domain.Deposit{}, commons.ErrDuplicateExternalReference
+	}
+	return existing, nil
+}
+
+func (s *AccountService) GetDeposit(ctx context.Context, req models.GetDepositRequest) (commons.Response[models.DepositResponse], error) {
+	logger.Info("account service get deposit request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		logger.Error("account service get deposit validation failed", err, nil)
+		return commons.ErrorResponse[models.DepositResponse]("validation failed", err.Error()), err
+	}
+
+	var deposit domain.Deposit
+	var err error
+	if reference := strings.TrimSpace(req.Reference); reference != "" {
+		deposit, err = s.accountRepo.GetDeposit(ctx, reference)
+	} else {
+		source := domain.DepositSource(strings.ToUpper(strings.TrimSpace(req.Source)))
+		deposit, err = s.accountRepo.GetDepositByExternalReference(ctx, source, strings.TrimSpace(req.ExternalReference))
+	}
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.DepositResponse]("Deposit not found"), err
+		}
+		logger.Error("account service get deposit failed", err, nil)
+		return commons.ErrorResponse[models.DepositResponse]("failed to fetch deposit", "Unable to fetch deposit right now"), err
+	}
+	if err := authorizeAccountNumber(ctx, s.accountRepo, deposit.AccountNumber); err != nil {
+		return accountAccessErrorResponse[models.DepositResponse](err), err
+	}
+
+	response := models.DepositResponse{
+		Reference:         deposit.Reference,
+		AccountNumber:     deposit.AccountNumber,
+		Currency:          deposit.Currency,
+		Amount:            deposit.Amount,
+		Source:            string(deposit.Source),
+		ExternalReference: deposit.ExternalReference,
+		Narration:         deposit.Narration,
+		JournalEntries:    toJournalEntryResponses(deposit.JournalEntries),
+		CreatedAt:         deposit.CreatedAt.Format(time.RFC3339),
+	}
+
+	return commons.SuccessResponse("deposit fetched successfully", response), nil
 }
 
 func parseBalance(raw *decimal.Decimal) (decimal.Decimal, error) {
@@ -283,8 +514,36 @@ func parseBalance(raw *decimal.Decimal) (decimal.Decimal, error) {
 	return raw.Round(2), nil
 }
 
-func generateAccountNumber() string {
-	return fmt.Sprintf("%010d", time.Now().UnixNano()%10_000_000_000)
+// createWithAllocatedNumber creates account under a freshly allocated account
+// number. A clash with a number issued before the allocator existed burns the
+// serial and tries the next one.
+func (s *AccountService) createWithAllocatedNumber(ctx context.Context, account domain.Account) (domain.Account, error) {
+	var err error
+	for attempt := 1; attempt <= accountNumberAllocationAttempts; attempt++ {
+		var serial int64
+		serial, err = s.accountRepo.NextAccountSerial(ctx)
+		if err != nil {
+			return domain.Account{}, err
+		}
+		account.AccountNumber, err = commons.BuildAccountNumber(s.greyBankCode, serial)
+		if err != nil {
+			return domain.Account{}, err
+		}
+
+		var created domain.Account
+		created, err = s.accountRepo.Create(ctx, account)
+		if err == nil {
+			return created, nil
+		}
+		if !isUniqueViolation(err) {
+			return domain.Account{}, err
+		}
+		logger.Info("account service account number already taken", logger.Fields{
+			"accountNumber": account.AccountNumber,
+			"attempt":       attempt,
+		})
+	}
+	return domain.Account{}, fmt.Errorf("allocate account number: %w", err)
 }
 
 func isTenDigitAccountNumber(accountNumber string) bool {
@@ -0,0 +1,307 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+// WithdrawFunds pays cash out of an account against the cash GL account of its
+// currency. It applies the same account checks as a transfer debit: the account
+// must be active and in the requested currency, the amount must be within the
+// KYC limit, the transaction PIN must match and the available balance must
+// cover the amount plus withdrawal fees. Withdrawals above the transfer step-up
+// threshold are answered with an OTP challenge and paid out by ConfirmWithdrawal.
+//
+// Withdrawals are not run through fraud/AML screening: its rules are evaluated
+// against the transfers table (beneficiaries, velocity, rapid in/out) and a
+// REVIEW decision parks a transfer in HELD, a state a withdrawal does not have.
+// Cash-outs are bounded by the KYC limit and step-up instead.
+func (s *AccountService) WithdrawFunds(ctx context.Context, req models.WithdrawFundsRequest) (commons.Response[models.WithdrawFundsResponse], error) {
+	logger.Info("account service withdraw funds request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		logger.Error("account service withdraw funds validation failed", err, nil)
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("validation failed", err.Error()), err
+	}
+
+	return s.processWithdrawal(ctx, req)
+}
+
+// ConfirmWithdrawal pays out a withdrawal that WithdrawFunds answered with a
+// step-up challenge. The request stored with the challenge is replayed without
+// the PIN, which was verified when the challenge was issued; the account,
+// limit, charges and balance are checked again at confirmation.
+func (s *AccountService) ConfirmWithdrawal(ctx context.Context, req models.ConfirmWithdrawalRequest) (commons.Response[models.WithdrawFundsResponse], error) {
+	logger.Info("account service confirm withdrawal request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("validation failed", err.Error()), err
+	}
+	if s.otpService == nil {
+		err := fmt.Errorf("withdrawal step-up is not enabled")
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("validation failed", err.Error()), err
+	}
+
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.WithdrawFundsResponse]("Account not found"), err
+		}
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), err
+	}
+	if err := authorizeAccountAccess(ctx, account.CustomerID); err != nil {
+		logger.Info("account service confirm withdrawal account access denied", logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse](err.Error()), err
+	}
+
+	challenge, err := s.otpService.Verify(ctx, account.CustomerID, req.ChallengeID, domain.OTPPurposeWithdrawalStepUp, req.OTP)
+	if err != nil {
+		if errors.Is(err, commons.ErrInvalidOTP) {
+			return commons.ErrorResponse[models.WithdrawFundsResponse]("invalid otp", err.Error()), err
+		}
+		logger.Error("account service confirm withdrawal otp verification failed", err, logger.Fields{
+			"challengeId": req.ChallengeID,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), err
+	}
+
+	var withdrawReq models.WithdrawFundsRequest
+	if err := json.Unmarshal([]byte(challenge.Payload), &withdrawReq); err != nil {
+		wrappedErr := fmt.Errorf("decode step-up withdrawal request: %w", err)
+		logger.Error("account service confirm withdrawal decode failed", wrappedErr, logger.Fields{
+			"challengeId": challenge.ID,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), wrappedErr
+	}
+	if strings.TrimSpace(withdrawReq.AccountNumber) != accountNumber {
+		err := fmt.Errorf("challenge was issued for another account")
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("invalid otp", err.Error()), err
+	}
+
+	logger.Info("account service withdrawal step-up confirmed", logger.Fields{
+		"challengeId":   challenge.ID,
+		"accountNumber": accountNumber,
+	})
+	return s.processWithdrawal(context.WithValue(ctx, stepUpVerifiedKey{}, true), withdrawReq)
+}
+
+func (s *AccountService) processWithdrawal(ctx context.Context, req models.WithdrawFundsRequest) (commons.Response[models.WithdrawFundsResponse], error) {
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	amount := req.Amount
+
+	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.WithdrawFundsResponse]("Account not found"), err
+		}
+		logger.Error("account service withdraw funds account lookup failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), err
+	}
+	if err := authorizeAccountAccess(ctx, account.CustomerID); err != nil {
+		logger.Info("account service withdraw funds account access denied", logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse](err.Error()), err
+	}
+	if account.Status != domain.AccountStatusActive {
+		err := debitAccountStatusError("account", account.Status)
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("Account is not active", err.Error()), err
+	}
+	if !strings.EqualFold(strings.TrimSpace(account.Currency), currency) {
+		err := fmt.Errorf("currency does not match account currency")
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("validation failed", err.Error()), err
+	}
+
+	cashGLAccountNumber := strings.TrimSpace(s.cashGLAccountNumbers[currency])
+	if cashGLAccountNumber == "" {
+		err := fmt.Errorf("withdrawals are not supported for %s", currency)
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("validation failed", err.Error()), err
+	}
+
+	if response, err := checkKYCLimit[models.WithdrawFundsResponse](ctx, s.kycLimits, account.CustomerID, amount, currency, "failed to withdraw funds", "Unable to withdraw funds right now"); err != nil {
+		return response, err
+	}
+
+	if verified, _ := ctx.Value(stepUpVerifiedKey{}).(bool); !verified {
+		if response, err := verifyTransactionPIN[models.WithdrawFundsResponse](ctx, s.userService, account.CustomerID, req.TransactionPIN, "failed to withdraw funds", "Unable to withdraw funds right now"); err != nil {
+			return response, err
+		}
+	}
+
+	if response, err := s.withdrawalStepUp(ctx, req, account.CustomerID); err != nil {
+		if errors.Is(err, errStepUpRequired) {
+			return response, nil
+		}
+		return response, err
+	}
+
+	chargeAmount, vatAmount, chargeUSD, vatUSD, err := s.chargesService.GetWithdrawalCharges(ctx, amount, currency)
+	if err != nil {
+		logger.Error("account service withdraw funds charges failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+			"currency":      currency,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), err
+	}
+
+	totalDebit := amount.Add(chargeAmount).Add(vatAmount)
+	if account.AvailableBalance.Add(account.OverdraftLimit).LessThan(totalDebit) {
+		err := commons.ErrInsufficientBalance
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("Insufficient balance", err.Error()), err
+	}
+
+	channel := commons.ChannelIDFromContext(ctx)
+	withdrawal, err := s.withdrawalRepo.Create(ctx, domain.Withdrawal{
+		Reference:           s.referenceGenerator.TransactionReference(channel),
+		AccountNumber:       accountNumber,
+		Currency:            currency,
+		Amount:              amount,
+		ChargeAmount:        chargeAmount,
+		VATAmount:           vatAmount,
+		TotalDebit:          totalDebit,
+		CashGLAccountNumber: cashGLAccountNumber,
+		Narration:           strings.TrimSpace(req.Narration),
+		Channel:             channel,
+	}, domain.WithdrawalPosting{
+		SuspenseAccountNumber: s.suspenseAccountNumber,
+		ChargesAccountNumber:  s.chargesAccountNumber,
+		VATAccountNumber:      s.vatAccountNumber,
+		ChargeUSD:             chargeUSD,
+		VATUSD:                vatUSD,
+	})
+	if err != nil {
+		logger.Error("account service withdraw funds posting failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+			"amount":        amount,
+		})
+		switch {
+		case errors.Is(err, commons.ErrInsufficientBalance):
+			return commons.ErrorResponse[models.WithdrawFundsResponse]("Insufficient balance", err.Error()), err
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.WithdrawFundsResponse]("Account not found"), err
+		case strings.Contains(err.Error(), "account is not active"):
+			return commons.ErrorResponse[models.WithdrawFundsResponse]("Account is not active"), err
+		}
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), err
+	}
+
+	account, err = s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		logger.Error("account service get account after withdrawal failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to fetch account", "Unable to fetch account right now"), err
+	}
+
+	response := toWithdrawFundsResponse(withdrawal, account)
+
+	logger.Info("account service withdraw funds success", logger.Fields{
+		"reference":        response.Reference,
+		"accountNumber":    response.AccountNumber,
+		"totalDebit":       response.TotalDebit,
+		"availableBalance": response.AvailableBalance,
+	})
+
+	return commons.SuccessResponse("funds withdrawn successfully", response), nil
+}
+
+// withdrawalStepUp issues an OTP challenge for a withdrawal whose amount,
+// converted into the step-up currency, is above the threshold and returns
+// errStepUpRequired with the challenge in the response. Withdrawals replayed by
+// ConfirmWithdrawal pass.
+func (s *AccountService) withdrawalStepUp(ctx context.Context, req models.WithdrawFundsRequest, customerID string) (commons.Response[models.WithdrawFundsResponse], error) {
+	verified, _ := ctx.Value(stepUpVerifiedKey{}).(bool)
+	if verified || s.otpService == nil || !s.stepUpPolicy.Threshold.IsPositive() {
+		return commons.Response[models.WithdrawFundsResponse]{}, nil
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	amount := req.Amount
+	if currency != s.stepUpPolicy.Currency {
+		converted, _, _, err := s.stepUpRates.ConvertRate(ctx, req.Amount, currency, s.stepUpPolicy.Currency)
+		if err != nil {
+			return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), err
+		}
+		amount = converted
+	}
+	if !amount.GreaterThan(s.stepUpPolicy.Threshold) {
+		return commons.Response[models.WithdrawFundsResponse]{}, nil
+	}
+
+	req.TransactionPIN = ""
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), fmt.Errorf("encode step-up withdrawal request: %w", err)
+	}
+	challenge, err := s.otpService.Issue(ctx, customerID, domain.OTPPurposeWithdrawalStepUp, string(payload))
+	if err != nil {
+		logger.Error("account service issue withdrawal step-up challenge failed", err, logger.Fields{
+			"accountNumber": req.AccountNumber,
+		})
+		return commons.ErrorResponse[models.WithdrawFundsResponse]("failed to withdraw funds", "Unable to withdraw funds right now"), err
+	}
+
+	logger.Info("account service withdrawal step-up required", logger.Fields{
+		"challengeId":       challenge.ID,
+		"accountNumber":     req.AccountNumber,
+		"normalizedAmount":  amount,
+		"thresholdCurrency": s.stepUpPolicy.Currency,
+	})
+	challengeResponse := mapOTPChallengeToResponse(challenge)
+	return commons.SuccessResponse("OTP required to complete withdrawal", models.WithdrawFundsResponse{
+		AccountNumber:   strings.TrimSpace(req.AccountNumber),
+		Currency:        currency,
+		Amount:          req.Amount,
+		Narration:       strings.TrimSpace(req.Narration),
+		StepUpChallenge: &challengeResponse,
+	}), errStepUpRequired
+}
+
+func toWithdrawFundsResponse(withdrawal domain.Withdrawal, account domain.Account) models.WithdrawFundsResponse {
+	return models.WithdrawFundsResponse{
+		Reference:        withdrawal.Reference,
+		AccountNumber:    withdrawal.AccountNumber,
+		Currency:         withdrawal.Currency,
+		Amount:           withdrawal.Amount,
+		ChargeAmount:     withdrawal.ChargeAmount,
+		VATAmount:        withdrawal.VATAmount,
+		TotalDebit:       withdrawal.TotalDebit,
+		AvailableBalance: account.AvailableBalance,
+		LedgerBalance:    account.LedgerBalance,
+		Narration:        withdrawal.Narration,
+		JournalEntries:   toJournalEntryResponses(withdrawal.JournalEntries),
+		CreatedAt:        withdrawal.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toJournalEntryResponses(entries []domain.JournalEntry) []models.JournalEntryResponse {
+	responses := make([]models.JournalEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, models.JournalEntryResponse{
+			AccountNumber: entry.AccountNumber,
+			EntryType:     string(entry.EntryType),
+			Currency:      entry.Currency,
+			Amount:        entry.Amount,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+// bootstrapChannelActor records who created the channel seeded from
+// CHANNEL_ID/CHANNEL_KEY.
+const bootstrapChannelActor = "bootstrap"
+
+// Verify that ChannelService implements the service_interfaces.ChannelService interface
+var _ service_interfaces.ChannelService = (*ChannelService)(nil)
+
+// ChannelService manages channel credentials and authenticates channel
+// requests.
+type ChannelService struct {
+	channelRepo repo_interfaces.ChannelRepository
+	gracePeriod time.Duration
+	now         func() time.Time
+}
+
+func NewChannelService(channelRepo repo_interfaces.ChannelRepository, gracePeriod time.Duration) *ChannelService {
+	return &ChannelService{
+		channelRepo: channelRepo,
+		gracePeriod: gracePeriod,
+		now:         time.Now,
+	}
+}
+
+func (s *ChannelService) Authenticate(ctx context.Context, channelID string, apiKey string, remoteIP string) (domain.Channel, error) {
+	now := s.now()
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID, now)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.Channel{}, commons.ErrInvalidChannelCredentials
+		}
+		return domain.Channel{}, err
+	}
+
+	hash := hashChannelKey(apiKey)
+	matched := false
+	for _, key := range channel.Keys {
+		if key.ValidAt(now) && subtle.ConstantTimeCompare([]byte(key.KeyHash), []byte(hash)) == 1 {
+			matched = true
+		}
+	}
+	if !matched {
+		return domain.Channel{}, commons.ErrInvalidChannelCredentials
+	}
+	if channel.Status != domain.ChannelActive {
+		return domain.Channel{}, commons.ErrChannelDisabled
+	}
+	if !channel.AllowsIP(net.ParseIP(remoteIP)) {
+		return domain.Channel{}, commons.ErrChannelIPNotAllowed
+	}
+	return channel, nil
+}
+
+// Bootstrap creates channelID with every scope and apiKey as its key when it
+// does not exist yet, so a fresh database can be administered. Once the
+// channel exists its stored keys win and apiKey is ignored.
+func (s *ChannelService) Bootstrap(ctx context.Context, channelID string, apiKey string) error {
+	_, err := s.channelRepo.GetByChannelID(ctx, channelID, s.now())
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, commons.ErrRecordNotFound) {
+		return err
+	}
+
+	_, err = s.channelRepo.Create(ctx, domain.Channel{
+		ChannelID: channelID,
+		Name:      channelID,
+		Scopes:    []domain.ChannelScope{domain.ChannelScopeAll},
+		Status:    domain.ChannelActive,
+		CreatedBy: bootstrapChannelActor,
+	}, domain.ChannelKey{
+		KeyHash:   hashChannelKey(apiKey),
+		CreatedBy: bootstrapChannelActor,
+	})
+	if errors.Is(err, commons.ErrDuplicateChannel) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Info("channel service bootstrap channel created", logger.Fields{
+		"channelId": channelID,
+	})
+	return nil
+}
+
+func (s *ChannelService) CreateChannel(ctx context.Context, req models.CreateChannelRequest) (commons.Response[models.ChannelResponse], error) {
+	logger.Info("channel service create channel request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error()), err
+	}
+
+	apiKey, err := generateChannelKey()
+	if err != nil {
+		logger.Error("channel service generate key failed", err, nil)
+		return commons.ErrorResponse[models.ChannelResponse]("failed to create channel", "Unable to create channel right now"), err
+	}
+
+	createdBy := strings.TrimSpace(req.CreatedBy)
+	created, err := s.channelRepo.Create(ctx, domain.Channel{
+		ChannelID:    strings.TrimSpace(req.ChannelID),
+		Name:         strings.TrimSpace(req.Name),
+		Scopes:       normalizeChannelScopes(req.Scopes),
+		AllowedCIDRs: normalizeAllowedIPs(req.AllowedIPs),
+		Status:       domain.ChannelActive,
+		CreatedBy:    createdBy,
+	}, domain.ChannelKey{
+		KeyHash:   hashChannelKey(apiKey),
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		if errors.Is(err, commons.ErrDuplicateChannel) {
+			return commons.ErrorResponse[models.ChannelResponse](err.Error()), err
+		}
+		logger.Error("channel service create channel failed", err, nil)
+		return commons.ErrorResponse[models.ChannelResponse]("failed to create channel", "Unable to create channel right now"), err
+	}
+
+	// The key is only ever returned when it is issued.
+	response := mapChannelToResponse(created)
+	response.APIKey = apiKey
+
+	logger.Info("channel service create channel success", logger.Fields{
+		"channelId": created.ChannelID,
+		"scopes":    created.Scopes,
+	})
+	return commons.SuccessResponse("Channel created successfully", response), nil
+}
+
+func (s *ChannelService) GetChannel(ctx context.Context, channelID string) (commons.Response[models.ChannelResponse], error) {
+	channelID = strings.TrimSpace(channelID)
+	if channelID == "" {
+		err := errors.New("channelId is required")
+		return commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error()), err
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID, s.now())
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.ChannelResponse]("Channel not found"), err
+		}
+		logger.Error("channel service get channel failed", err, logger.Fields{
+			"channelId": channelID,
+		})
+		return commons.ErrorResponse[models.ChannelResponse]("failed to get channel", "Unable to get channel right now"), err
+	}
+
+	return commons.SuccessResponse("Channel fetched successfully", mapChannelToResponse(channel)), nil
+}
+
+func (s *ChannelService) GetChannels(ctx context.Context) (commons.Response[[]models.ChannelResponse], error) {
+	channels, err := s.channelRepo.GetAll(ctx)
+	if err != nil {
+		logger.Error("channel service get channels failed", err, nil)
+		return commons.ErrorResponse[[]models.ChannelResponse]("failed to get channels", "Unable to get channels right now"), err
+	}
+
+	response := make([]models.ChannelResponse, 0, len(channels))
+	for _, channel := range channels {
+		response = append(response, mapChannelToResponse(channel))
+	}
+	return commons.SuccessResponse("Channels fetched successfully", response), nil
+}
+
+func (s *ChannelService) UpdateChannel(ctx context.Context, req models.UpdateChannelRequest) (commons.Response[models.ChannelResponse], error) {
+	logger.Info("channel service update channel request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error()), err
+	}
+
+	channelID := strings.TrimSpace(req.ChannelID)
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID, s.now())
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.ChannelResponse]("Channel not found"), err
+		}
+		logger.Error("channel service update channel lookup failed", err, logger.Fields{
+			"channelId": channelID,
+		})
+		return commons.ErrorResponse[models.ChannelResponse]("failed to update channel", "Unable to update channel right now"), err
+	}
+
+	// A channel cannot disable itself or drop its own administration scope,
+	// which could leave nobody able to manage channels.
+	if channelID == commons.ChannelIDFromContext(ctx) {
+		if strings.EqualFold(strings.TrimSpace(req.Status), string(domain.ChannelDisabled)) {
+			err := errors.New("a channel cannot disable itself")
+			return commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error()), err
+		}
+		if req.Scopes != nil && !(domain.Channel{Scopes: normalizeChannelScopes(*req.Scopes)}).HasScope(domain.ChannelScopeChannelsAdmin) {
+			err := errors.New("a channel cannot remove its own channels:admin scope")
+			return commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error()), err
+		}
+	}
+
+	if name := strings.TrimSpace(req.Name); name != "" {
+		channel.Name = name
+	}
+	if req.Scopes != nil {
+		channel.Scopes = normalizeChannelScopes(*req.Scopes)
+	}
+	if req.AllowedIPs != nil {
+		channel.AllowedCIDRs = normalizeAllowedIPs(*req.AllowedIPs)
+	}
+	if status := strings.ToUpper(strings.TrimSpace(req.Status)); status != "" {
+		channel.Status = domain.ChannelStatus(status)
+	}
+	channel.UpdatedBy = strings.TrimSpace(req.UpdatedBy)
+
+	keys := channel.Keys
+	updated, err := s.channelRepo.Update(ctx, channel)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.ChannelResponse]("Channel not found"), err
+		}
+		logger.Error("channel service update channel failed", err, logger.Fields{
+			"channelId": channelID,
+		})
+		return commons.ErrorResponse[models.ChannelResponse]("failed to update channel", "Unable to update channel right now"), err
+	}
+	updated.Keys = keys
+
+	logger.Info("channel service update channel success", logger.Fields{
+		"channelId": updated.ChannelID,
+		"status":    updated.Status,
+		"scopes":    updated.Scopes,
+	})
+	return commons.SuccessResponse("Channel updated successfully", mapChannelToResponse(updated)), nil
+}
+
+func (s *ChannelService) RotateChannelKey(ctx context.Context, req models.RotateChannelKeyRequest) (commons.Response[models.ChannelResponse], error) {
+	logger.Info("channel service rotate key request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.ChannelResponse]("validation failed", err.Error()), err
+	}
+
+	gracePeriod := s.gracePeriod
+	if req.GracePeriodSeconds != nil {
+		gracePeriod = time.Duration(*req.GracePeriodSeconds) * time.Second
+	}
+
+	apiKey, err := generateChannelKey()
+	if err != nil {
+		logger.Error("channel service generate key failed", err, nil)
+		return commons.ErrorResponse[models.ChannelResponse]("failed to rotate channel key", "Unable to rotate channel key right now"), err
+	}
+
+	channelID := strings.TrimSpace(req.ChannelID)
+	channel, err := s.channelRepo.RotateKey(ctx, domain.ChannelKey{
+		ChannelID: channelID,
+		KeyHash:   hashChannelKey(apiKey),
+		CreatedBy: strings.TrimSpace(req.RotatedBy),
+	}, s.now().Add(gracePeriod))
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.ChannelResponse]("Channel not found"), err
+		}
+		logger.Error("channel service rotate key failed", err, logger.Fields{
+			"channelId": channelID,
+		})
+		return commons.ErrorResponse[models.ChannelResponse]("failed to rotate channel key", "Unable to rotate channel key right now"), err
+	}
+
+	response := mapChannelToResponse(channel)
+	response.APIKey = apiKey
+
+	logger.Info("channel service rotate key success", logger.Fields{
+		"channelId":   channel.ChannelID,
+		"gracePeriod": gracePeriod.String(),
+		"validKeys":   len(channel.Keys),
+	})
+	return commons.SuccessResponse("Channel key rotated successfully", response), nil
+}
+
+// hashChannelKey is the stored form of an API key. Issued keys carry 256 bits
+// of randomness, so an unsalted fast hash is enough and keeps per-request
+// authentication cheap.
+func hashChannelKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateChannelKey() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return "chk_" + hex.EncodeToString(raw), nil
+}
+
+func normalizeChannelScopes(values []string) []domain.ChannelScope {
+	scopes := make([]domain.ChannelScope, 0, len(values))
+	seen := make(map[domain.ChannelScope]struct{}, len(values))
+	for _, value := range values {
+		scope := domain.ChannelScope(strings.TrimSpace(value))
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+func normalizeAllowedIPs(values []string) []string {
+	cidrs := make([]string, 0, len(values))
+	for _, value := range values {
+		if cidr, err := models.ParseAllowedIP(value); err == nil {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs
+}
+
+func mapChannelToResponse(channel domain.Channel) models.ChannelResponse {
+	response := models.ChannelResponse{
+		ChannelID:  channel.ChannelID,
+		Name:       channel.Name,
+		Scopes:     make([]string, 0, len(channel.Scopes)),
+		AllowedIPs: channel.AllowedCIDRs,
+		Status:     string(channel.Status),
+		CreatedBy:  channel.CreatedBy,
+		UpdatedBy:  channel.UpdatedBy,
+		CreatedAt:  channel.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  channel.UpdatedAt.Format(time.RFC3339),
+		Keys:       make([]models.ChannelKeyResponse, 0, len(channel.Keys)),
+	}
+	if response.AllowedIPs == nil {
+		response.AllowedIPs = []string{}
+	}
+	for _, scope := range channel.Scopes {
+		response.Scopes = append(response.Scopes, string(scope))
+	}
+	for _, key := range channel.Keys {
+		keyResponse := models.ChannelKeyResponse{
+			ID:        key.ID,
+			CreatedBy: key.CreatedBy,
+			CreatedAt: key.CreatedAt.Format(time.RFC3339),
+		}
+		if key.ExpiresAt != nil {
+			keyResponse.ExpiresAt = key.ExpiresAt.Format(time.RFC3339)
+		}
+		response.Keys = append(response.Keys, keyResponse)
+	}
+	return response
+}
@@ -18,20 +18,44 @@ import (
 var _ service_interfaces.ChargesService = (*ChargesService)(nil)
 
 type ChargesService struct {
-	rateRepo      repo_interfaces.RateRepository
-	chargePercent decimal.Decimal
-	vatPercent    decimal.Decimal
-	chargeMin     decimal.Decimal
-	chargeMax     decimal.Decimal
+	rateRepo                repo_interfaces.RateRepository
+	chargePercent           decimal.Decimal
+	vatPercent              decimal.Decimal
+	chargeMin               decimal.Decimal
+	chargeMax               decimal.Decimal
+	withdrawalChargePercent decimal.Decimal
+	withdrawalChargeMin     decimal.Decimal
+	withdrawalChargeMax     decimal.Decimal
+	exchangeChargePercent   decimal.Decimal
+	exchangeChargeMin       decimal.Decimal
+	exchangeChargeMax       decimal.Decimal
 }
 
-func NewChargesService(rateRepo repo_interfaces.RateRepository, chargePercent decimal.Decimal, vatPercent decimal.Decimal, chargeMin decimal.Decimal, chargeMax decimal.Decimal) *ChargesService {
+func NewChargesService(
+	rateRepo repo_interfaces.RateRepository,
+	chargePercent decimal.Decimal,
+	vatPercent decimal.Decimal,
+	chargeMin decimal.Decimal,
+	chargeMax decimal.Decimal,
+	withdrawalChargePercent decimal.Decimal,
+	withdrawalChargeMin decimal.Decimal,
+	withdrawalChargeMax decimal.Decimal,
+	exchangeChargePercent decimal.Decimal,
+	exchangeChargeMin decimal.Decimal,
+	exchangeChargeMax decimal.Decimal,
+) *ChargesService {
 	return &ChargesService{
-		rateRepo:      rateRepo,
-		chargePercent: chargePercent,
-		vatPercent:    vatPercent,
-		chargeMin:     chargeMin,
-		chargeMax:     chargeMax,
+		rateRepo:                rateRepo,
+		chargePercent:           chargePercent,
+		vatPercent:              vatPercent,
+		chargeMin:               chargeMin,
+		chargeMax:               chargeMax,
+		withdrawalChargePercent: withdrawalChargePercent,
+		withdrawalChargeMin:     withdrawalChargeMin,
+		withdrawalChargeMax:     withdrawalChargeMax,
+		exchangeChargePercent:   exchangeChargePercent,
+		exchangeChargeMin:       exchangeChargeMin,
+		exchangeChargeMax:       exchangeChargeMax,
 	}
 }
 
@@ -128,6 +152,59 @@ func (s *ChargesService) GetCharges(ctx context.Context, amount decimal.Decimal,
 	return amount, ccy, chargeValue, vatValue, totalValue, nil
 }
 
+// GetWithdrawalCharges prices a cash withdrawal of amount. The fee follows the
+// withdrawal schedule, clamped in USD like transfer charges, and VAT is levied on
+// the fee. Both are returned in the withdrawal currency and in USD, rounded to
+// two decimal places.
+func (s *ChargesService) GetWithdrawalCharges(ctx context.Context, amount decimal.Decimal, currency string) (decimal.Decimal, decimal.Decimal, decimal.Decimal, decimal.Decimal, error) {
+	return s.getScheduleCharges(ctx, amount, currency, s.withdrawalChargePercent, s.withdrawalChargeMin, s.withdrawalChargeMax)
+}
+
+// GetExchangeCharges prices an own-account currency exchange of amount, in the
+// sold currency, on the exchange schedule. It returns the same values as
+// GetWithdrawalCharges; a zero schedule yields zero fees.
+func (s *ChargesService) GetExchangeCharges(ctx context.Context, amount decimal.Decimal, currency string) (decimal.Decimal, decimal.Decimal, decimal.Decimal, decimal.Decimal, error) {
+	return s.getScheduleCharges(ctx, amount, currency, s.exchangeChargePercent, s.exchangeChargeMin, s.exchangeChargeMax)
+}
+
+func (s *ChargesService) getScheduleCharges(ctx context.Context, amount decimal.Decimal, currency string, percent decimal.Decimal, chargeMin decimal.Decimal, chargeMax decimal.Decimal) (decimal.Decimal, decimal.Decimal, decimal.Decimal, decimal.Decimal, error) {
+	ccy := strings.ToUpper(strings.TrimSpace(currency))
+	if len(ccy) != 3 {
+		return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("currency must be 3 characters")
+	}
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("amount must be greater than zero")
+	}
+
+	amountUSD := amount
+	usdToCurrencyRate := decimal.NewFromInt(1)
+	if ccy != "USD" {
+		currencyToUSDRate, err := s.getCurrencyToUSDRate(ctx, ccy)
+		if err != nil {
+			return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, err
+		}
+		usdToCurrencyRate, err = s.getUSDToCurrencyRate(ctx, ccy)
+		if err != nil {
+			return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, err
+		}
+		amountUSD = amount.Mul(currencyToUSDRate)
+	}
+
+	chargeUSD := amountUSD.Mul(percent.Div(decimal.NewFromInt(100)))
+	if chargeUSD.LessThan(chargeMin) {
+		chargeUSD = chargeMin
+	}
+	if chargeUSD.GreaterThan(chargeMax) {
+		chargeUSD = chargeMax
+	}
+	vatUSD := chargeUSD.Mul(s.vatPercent.Div(decimal.NewFromInt(100)))
+
+	charge := chargeUSD.Mul(usdToCurrencyRate).Round(2)
+	vat := vatUSD.Mul(usdToCurrencyRate).Round(2)
+
+	return charge, vat, chargeUSD.Round(2), vatUSD.Round(2), nil
+}
+
 func (s *ChargesService) getUSDToCurrencyRate(ctx context.Context, currency string) (decimal.Decimal, error) {
 	rate, err := s.rateRepo.GetRate(ctx, "USD", currency)
 	if err == nil {
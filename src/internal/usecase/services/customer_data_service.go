@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+// customerDataExportProfileChangesLimit bounds the profile changes in an
+// export; it is far above what a customer accumulates.
+const customerDataExportProfileChangesLimit = 10000
+
+// Verify that CustomerDataService implements the service_interfaces.CustomerDataService interface
+var _ service_interfaces.CustomerDataService = (*CustomerDataService)(nil)
+
+// CustomerDataService exports and erases customer personal data. Erasure
+// keeps the customer ID, accounts, transfers and ledger postings, which must
+// be retained, and removes the personal data attached to them.
+type CustomerDataService struct {
+	dataRepo    repo_interfaces.CustomerDataRepository
+	userRepo    domain.UserRepository
+	accountRepo repo_interfaces.AccountRepository
+	kycRepo     repo_interfaces.KYCRepository
+	now         func() time.Time
+}
+
+func NewCustomerDataService(
+	dataRepo repo_interfaces.CustomerDataRepository,
+	userRepo domain.UserRepository,
+	accountRepo repo_interfaces.AccountRepository,
+	kycRepo repo_interfaces.KYCRepository,
+) *CustomerDataService {
+	return &CustomerDataService{
+		dataRepo:    dataRepo,
+		userRepo:    userRepo,
+		accountRepo: accountRepo,
+		kycRepo:     kycRepo,
+		now:         time.Now,
+	}
+}
+
+// ExportCustomerData returns the profile, profile changes, KYC upgrades,
+// accounts and transfers (with their audit payloads) held for a customer, and
+// records the authenticated caller as having asked for it.
+func (s *CustomerDataService) ExportCustomerData(ctx context.Context, req models.CustomerDataRequest) (commons.Response[models.CustomerDataExportResponse], error) {
+	logger.Info("customer data service export request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.CustomerDataExportResponse]("validation failed", err.Error()), err
+	}
+	requestedBy := commons.ActorFromContext(ctx)
+	if requestedBy == "" {
+		err := fmt.Errorf("an authenticated requester is required")
+		return commons.ErrorResponse[models.CustomerDataExportResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	user, err := s.userRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.CustomerDataExportResponse]("User not found"), err
+		}
+		return s.exportFailed(customerID, "customer", err)
+	}
+
+	changes, err := s.userRepo.GetProfileChanges(ctx, customerID, customerDataExportProfileChangesLimit)
+	if err != nil {
+		return s.exportFailed(customerID, "profile changes", err)
+	}
+	kycUpgrades, err := s.kycRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		return s.exportFailed(customerID, "kyc upgrades", err)
+	}
+	accounts, err := s.accountRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		return s.exportFailed(customerID, "accounts", err)
+	}
+	transfers, err := s.dataRepo.GetTransfersByCustomerID(ctx, customerID)
+	if err != nil {
+		return s.exportFailed(customerID, "transfers", err)
+	}
+
+	request, err := s.dataRepo.RecordExport(ctx, domain.DataSubjectRequest{
+		CustomerID:  customerID,
+		RequestedBy: requestedBy,
+		Reason:      strings.TrimSpace(req.Reason),
+	})
+	if err != nil {
+		return s.exportFailed(customerID, "export record", err)
+	}
+
+	response := models.CustomerDataExportResponse{
+		RequestID:      request.ID,
+		CustomerID:     customerID,
+		GeneratedAt:    s.now().UTC().Format(time.RFC3339),
+		Profile:        mapUserToResponse(user),
+		ProfileChanges: mapProfileChangesToResponse(changes),
+		KYCUpgrades:    make([]models.KYCUpgradeResponse, 0, len(kycUpgrades)),
+		Accounts:       make([]models.CustomerDataAccountResponse, 0, len(accounts)),
+		Transfers:      make([]models.CustomerDataTransferResponse, 0, len(transfers)),
+	}
+	for _, upgrade := range kycUpgrades {
+		response.KYCUpgrades = append(response.KYCUpgrades, mapKYCUpgradeToResponse(upgrade))
+	}
+	for _, account := range accounts {
+		response.Accounts = append(response.Accounts, models.CustomerDataAccountResponse{
+			AccountNumber:    account.AccountNumber,
+			Currency:         account.Currency,
+			Product:          string(account.Product),
+			AvailableBalance: account.AvailableBalance,
+			LedgerBalance:    account.LedgerBalance,
+			Status:           string(account.Status),
+			CreatedAt:        account.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:        account.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	for _, transfer := range transfers {
+		response.Transfers = append(response.Transfers, mapTransferToCustomerDataResponse(transfer))
+	}
+
+	logger.Info("customer data service export success", logger.Fields{
+		"customerId": customerID,
+		"requestId":  request.ID,
+		"accounts":   len(response.Accounts),
+		"transfers":  len(response.Transfers),
+	})
+	return commons.SuccessResponse("Customer data exported successfully", response), nil
+}
+
+// EraseCustomerData pseudonymizes a customer whose accounts are all closed. The
+// authenticated caller is recorded as the requester and as the reviewer of the
+// duplicate candidates the erasure dismisses.
+func (s *CustomerDataService) EraseCustomerData(ctx context.Context, req models.CustomerDataRequest) (commons.Response[models.CustomerErasureResponse], error) {
+	logger.Info("customer data service erase request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.CustomerErasureResponse]("validation failed", err.Error()), err
+	}
+	requestedBy := commons.ActorFromContext(ctx)
+	if requestedBy == "" {
+		err := fmt.Errorf("an authenticated requester is required")
+		return commons.ErrorResponse[models.CustomerErasureResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	request, err := s.dataRepo.Erase(ctx, domain.DataSubjectRequest{
+		CustomerID:  customerID,
+		RequestedBy: requestedBy,
+		Reason:      strings.TrimSpace(req.Reason),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.CustomerErasureResponse]("User not found"), err
+		case errors.Is(err, commons.ErrCustomerHasOpenAccounts):
+			return commons.ErrorResponse[models.CustomerErasureResponse](err.Error(), "Close every account of the customer before erasing their data"), err
+		case errors.Is(err, commons.ErrCustomerErased):
+			return commons.ErrorResponse[models.CustomerErasureResponse](err.Error()), err
+		}
+		logger.Error("customer data service erase failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.CustomerErasureResponse]("failed to erase customer data", "Unable to erase customer data right now"), err
+	}
+
+	logger.Info("customer data service erase success", logger.Fields{
+		"customerId": customerID,
+		"requestId":  request.ID,
+	})
+	return commons.SuccessResponse("Customer data erased successfully", models.CustomerErasureResponse{
+		RequestID:              request.ID,
+		CustomerID:             request.CustomerID,
+		RequestedBy:            request.RequestedBy,
+		TransfersScrubbed:      request.TransfersScrubbed,
+		ProfileChangesScrubbed: request.ProfileChangesScrubbed,
+		ErasedAt:               request.CreatedAt.Format(time.RFC3339),
+	}), nil
+}
+
+func (s *CustomerDataService) exportFailed(customerID string, part string, err error) (commons.Response[models.CustomerDataExportResponse], error) {
+	logger.Error("customer data service export failed", err, logger.Fields{
+		"customerId": customerID,
+		"part":       part,
+	})
+	return commons.ErrorResponse[models.CustomerDataExportResponse]("failed to export customer data", "Unable to export customer data right now"), err
+}
+
+func mapTransferToCustomerDataResponse(transfer domain.Transfer) models.CustomerDataTransferResponse {
+	response := models.CustomerDataTransferResponse{
+		TransferID:           transfer.ID,
+		TransactionReference: valueOrEmpty(transfer.TransactionReference),
+		ExternalReference:    valueOrEmpty(transfer.ExternalRefernece),
+		DebitAccountNumber:   transfer.DebitAccountNumber,
+		CreditAccountNumber:  valueOrEmpty(transfer.CreditAccountNumber),
+		BeneficiaryBankCode:  valueOrEmpty(transfer.BeneficiaryBankCode),
+		DebitCurrency:        transfer.DebitCurrency,
+		CreditCurrency:       transfer.CreditCurrency,
+		DebitAmount:          transfer.DebitAmount,
+		CreditAmount:         transfer.CreditAmount,
+		FcyRate:              transfer.FCYRate,
+		ChargeAmount:         transfer.ChargeAmount,
+		VATAmount:            transfer.VATAmount,
+		Narration:            valueOrEmpty(transfer.Narration),
+		Status:               string(transfer.Status),
+		CreatedAt:            transfer.CreatedAt.Format(time.RFC3339),
+	}
+	if json.Valid([]byte(transfer.AuditPayload)) {
+		response.AuditPayload = json.RawMessage(transfer.AuditPayload)
+	}
+	if transfer.ProcessedAt != nil {
+		response.ProcessedAt = transfer.ProcessedAt.Format(time.RFC3339)
+	}
+	return response
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	defaultDuplicateCandidatesLimit = 50
+	maxDuplicateCandidatesLimit     = 200
+
+	// duplicateMinSignals is how many of name, date of birth and phone number
+	// must agree before a customer is flagged.
+	duplicateMinSignals = 2
+
+	duplicateCandidateNotOpenMessage = "Duplicate candidate is not open"
+)
+
+// Verify that CustomerDuplicateService implements the service_interfaces.CustomerDuplicateService interface
+var _ service_interfaces.CustomerDuplicateService = (*CustomerDuplicateService)(nil)
+
+type CustomerDuplicateService struct {
+	duplicateRepo repo_interfaces.CustomerDuplicateRepository
+}
+
+func NewCustomerDuplicateService(duplicateRepo repo_interfaces.CustomerDuplicateRepository) *CustomerDuplicateService {
+	return &CustomerDuplicateService{duplicateRepo: duplicateRepo}
+}
+
+// GetDuplicateCandidates lists the candidates involving a customer, newest
+// first, or the candidates in a status, oldest first. Without either it
+// returns the OPEN review queue.
+func (s *CustomerDuplicateService) GetDuplicateCandidates(ctx context.Context, req models.GetDuplicateCandidatesRequest) (commons.Response[[]models.DuplicateCandidateResponse], error) {
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[[]models.DuplicateCandidateResponse]("validation failed", err.Error()), err
+	}
+
+	var (
+		candidates []domain.DuplicateCandidate
+		err        error
+	)
+	if customerID := strings.TrimSpace(req.CustomerID); customerID != "" {
+		candidates, err = s.duplicateRepo.GetByCustomerID(ctx, customerID)
+	} else {
+		status := domain.DuplicateCandidateStatus(strings.ToUpper(strings.TrimSpace(req.Status)))
+		if status == "" {
+			status = domain.DuplicateCandidateOpen
+		}
+		limit := req.Limit
+		if limit <= 0 {
+			limit = defaultDuplicateCandidatesLimit
+		}
+		if limit > maxDuplicateCandidatesLimit {
+			limit = maxDuplicateCandidatesLimit
+		}
+		candidates, err = s.duplicateRepo.GetByStatus(ctx, status, limit)
+	}
+	if err != nil {
+		return commons.ErrorResponse[[]models.DuplicateCandidateResponse]("failed to fetch duplicate candidates", "Unable to fetch duplicate candidates right now"), err
+	}
+
+	response := make([]models.DuplicateCandidateResponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		response = append(response, mapDuplicateCandidateToResponse(candidate))
+	}
+	return commons.SuccessResponse("Duplicate candidates fetched successfully", response), nil
+}
+
+// DismissDuplicateCandidate records that the two customers are different
+// people.
+func (s *CustomerDuplicateService) DismissDuplicateCandidate(ctx context.Context, req models.DismissDuplicateCandidateRequest) (commons.Response[models.DuplicateCandidateResponse], error) {
+	logger.Info("customer duplicate service dismiss request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.DuplicateCandidateResponse]("validation failed", err.Error()), err
+	}
+	reviewer := commons.ActorFromContext(ctx)
+	if reviewer == "" {
+		err := fmt.Errorf("an authenticated reviewer is required")
+		return commons.ErrorResponse[models.DuplicateCandidateResponse]("validation failed", err.Error()), err
+	}
+
+	candidateID := strings.TrimSpace(req.CandidateID)
+	if _, response, err := openDuplicateCandidate[models.DuplicateCandidateResponse](ctx, s.duplicateRepo, candidateID); err != nil {
+		return response, err
+	}
+
+	dismissed, err := s.duplicateRepo.Dismiss(ctx, candidateID, reviewer, strings.TrimSpace(req.Comment))
+	if err != nil {
+		// Another reviewer closed it between the read and the update.
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.DuplicateCandidateResponse](duplicateCandidateNotOpenMessage), err
+		}
+		logger.Error("customer duplicate service dismiss failed", err, logger.Fields{
+			"candidateId": candidateID,
+		})
+		return commons.ErrorResponse[models.DuplicateCandidateResponse]("failed to dismiss duplicate candidate", "Unable to dismiss duplicate candidate right now"), err
+	}
+
+	logger.Info("customer duplicate service dismiss success", logger.Fields{
+		"candidateId": dismissed.ID,
+		"reviewerId":  reviewer,
+	})
+	return commons.SuccessResponse("Duplicate candidate dismissed successfully", mapDuplicateCandidateToResponse(dismissed)), nil
+}
+
+// MergeCustomers confirms a candidate as a duplicate: the other customer's
+// accounts move to the surviving customer and it is kept as MERGED.
+func (s *CustomerDuplicateService) MergeCustomers(ctx context.Context, req models.MergeCustomersRequest) (commons.Response[models.CustomerMergeResponse], error) {
+	logger.Info("customer duplicate service merge request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.CustomerMergeResponse]("validation failed", err.Error()), err
+	}
+	mergedBy := commons.ActorFromContext(ctx)
+	if mergedBy == "" {
+		err := fmt.Errorf("an authenticated reviewer is required")
+		return commons.ErrorResponse[models.CustomerMergeResponse]("validation failed", err.Error()), err
+	}
+
+	candidate, response, err := openDuplicateCandidate[models.CustomerMergeResponse](ctx, s.duplicateRepo, strings.TrimSpace(req.CandidateID))
+	if err != nil {
+		return response, err
+	}
+
+	survivingCustomerID := strings.TrimSpace(req.SurvivingCustomerID)
+	if !candidate.Involves(survivingCustomerID) {
+		err := fmt.Errorf("survivingCustomerId must be %s or %s", candidate.CustomerID, candidate.MatchedCustomerID)
+		return commons.ErrorResponse[models.CustomerMergeResponse]("validation failed", err.Error()), err
+	}
+	mergedCustomerID := candidate.CustomerID
+	if mergedCustomerID == survivingCustomerID {
+		mergedCustomerID = candidate.MatchedCustomerID
+	}
+
+	merge, err := s.duplicateRepo.Merge(ctx, domain.CustomerMerge{
+		CandidateID:         candidate.ID,
+		SurvivingCustomerID: survivingCustomerID,
+		MergedCustomerID:    mergedCustomerID,
+		MergedBy:            mergedBy,
+		Comment:             strings.TrimSpace(req.Comment),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.CustomerMergeResponse](duplicateCandidateNotOpenMessage), err
+		case errors.Is(err, commons.ErrCustomerNotMergeable):
+			return commons.ErrorResponse[models.CustomerMergeResponse](err.Error(), "Resolve the compliance review of both customers before merging"), err
+		case errors.Is(err, commons.ErrCustomerAccountConflict):
+			return commons.ErrorResponse[models.CustomerMergeResponse](err.Error(), "Close or sweep one of the accounts in the shared currency before merging"), err
+		}
+		logger.Error("customer duplicate service merge failed", err, logger.Fields{
+			"candidateId": candidate.ID,
+		})
+		return commons.ErrorResponse[models.CustomerMergeResponse]("failed to merge customers", "Unable to merge customers right now"), err
+	}
+
+	logger.Info("customer duplicate service merge success", logger.Fields{
+		"mergeId":             merge.ID,
+		"survivingCustomerId": merge.SurvivingCustomerID,
+		"mergedCustomerId":    merge.MergedCustomerID,
+		"accounts":            len(merge.AccountNumbers),
+	})
+	return commons.SuccessResponse("Customers merged successfully", models.CustomerMergeResponse{
+		MergeID:             merge.ID,
+		CandidateID:         merge.CandidateID,
+		SurvivingCustomerID: merge.SurvivingCustomerID,
+		MergedCustomerID:    merge.MergedCustomerID,
+		AccountNumbers:      merge.AccountNumbers,
+		MergedBy:            merge.MergedBy,
+		Comment:             merge.Comment,
+		CreatedAt:           merge.CreatedAt.Format(time.RFC3339),
+	}), nil
+}
+
+// openDuplicateCandidate loads a candidate that is still OPEN, or the error
+// response to return.
+func openDuplicateCandidate[T any](ctx context.Context, duplicateRepo repo_interfaces.CustomerDuplicateRepository, candidateID string) (domain.DuplicateCandidate, commons.Response[T], error) {
+	candidate, err := duplicateRepo.GetByID(ctx, candidateID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.DuplicateCandidate{}, commons.ErrorResponse[T]("Duplicate candidate not found"), err
+		}
+		return domain.DuplicateCandidate{}, commons.ErrorResponse[T]("failed to review duplicate candidate", "Unable to review duplicate candidate right now"), err
+	}
+	if candidate.Status != domain.DuplicateCandidateOpen {
+		err := fmt.Errorf("duplicate candidate %s is %s", candidate.ID, candidate.Status)
+		return domain.DuplicateCandidate{}, commons.ErrorResponse[T](duplicateCandidateNotOpenMessage, err.Error()), err
+	}
+	return candidate, commons.Response[T]{}, nil
+}
+
+// matchDuplicates compares a customer being onboarded with the existing
+// customers sharing its ID document, date of birth or phone number. A shared
+// ID document fails with commons.ErrDuplicateIdentity; otherwise every
+// customer agreeing on at least duplicateMinSignals of name, date of birth and
+// phone number is returned as a candidate.
+func matchDuplicates(user domain.User, existing []domain.User, nameThreshold float64) ([]domain.DuplicateCandidate, error) {
+	identityKey := domain.IdentityKey(user.IDType, user.IDNumber)
+	phoneKey := domain.PhoneKey(user.PhoneNumber)
+	nameTokens := normalizeNameTokens(userFullName(user))
+
+	candidates := make([]domain.DuplicateCandidate, 0)
+	for _, other := range existing {
+		if domain.IdentityKey(other.IDType, other.IDNumber) == identityKey {
+			return nil, commons.ErrDuplicateIdentity
+		}
+
+		signals := make([]domain.DuplicateSignal, 0, 3)
+		nameScore := nameMatchScore(nameTokens, normalizeNameTokens(userFullName(other)))
+		if nameScore >= nameThreshold {
+			signals = append(signals, domain.DuplicateSignalName)
+		}
+		if user.DOB.Format(time.DateOnly) == other.DOB.Format(time.DateOnly) {
+			signals = append(signals, domain.DuplicateSignalDOB)
+		}
+		if phoneKey != "" && domain.PhoneKey(other.PhoneNumber) == phoneKey {
+			signals = append(signals, domain.DuplicateSignalPhone)
+		}
+		if len(signals) < duplicateMinSignals {
+			continue
+		}
+
+		candidates = append(candidates, domain.DuplicateCandidate{
+			MatchedCustomerID: other.CustomerID,
+			MatchedOn:         signals,
+			NameScore:         nameScore,
+		})
+	}
+	return candidates, nil
+}
+
+func mapDuplicateCandidateToResponse(candidate domain.DuplicateCandidate) models.DuplicateCandidateResponse {
+	matchedOn := make([]string, 0, len(candidate.MatchedOn))
+	for _, signal := range candidate.MatchedOn {
+		matchedOn = append(matchedOn, string(signal))
+	}
+
+	response := models.DuplicateCandidateResponse{
+		CandidateID:       candidate.ID,
+		CustomerID:        candidate.CustomerID,
+		MatchedCustomerID: candidate.MatchedCustomerID,
+		MatchedOn:         matchedOn,
+		NameScore:         candidate.NameScore,
+		Status:            string(candidate.Status),
+		ReviewedBy:        valueOrEmpty(candidate.ReviewedBy),
+		ReviewComment:     candidate.ReviewComment,
+		CreatedAt:         candidate.CreatedAt.Format(time.RFC3339),
+	}
+	if candidate.ReviewedAt != nil {
+		response.ReviewedAt = candidate.ReviewedAt.Format(time.RFC3339)
+	}
+	return response
+}
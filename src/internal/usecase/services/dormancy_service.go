@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+// dormancyBatchSize bounds the accounts moved to DORMANT per transaction.
+const dormancyBatchSize = 500
+
+// Verify that DormancyService implements the service_interfaces.DormancyService interface
+var _ service_interfaces.DormancyService = (*DormancyService)(nil)
+
+// DormancyService flags accounts without customer-initiated activity as
+// DORMANT, reactivates them after KYC re-verification and reports dormant
+// balances per currency.
+type DormancyService struct {
+	dormancyRepo       repo_interfaces.DormancyRepository
+	accountRepo        repo_interfaces.AccountRepository
+	userRepo           domain.UserRepository
+	dormancyPeriodDays int
+	now                func() time.Time
+}
+
+func NewDormancyService(
+	dormancyRepo repo_interfaces.DormancyRepository,
+	accountRepo repo_interfaces.AccountRepository,
+	userRepo domain.UserRepository,
+	dormancyPeriodDays int,
+) *DormancyService {
+	return &DormancyService{
+		dormancyRepo:       dormancyRepo,
+		accountRepo:        accountRepo,
+		userRepo:           userRepo,
+		dormancyPeriodDays: dormancyPeriodDays,
+		now:                time.Now,
+	}
+}
+
+// StartWorker runs the dormancy batch every interval until ctx is cancelled.
+func (s *DormancyService) StartWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.MarkDormantAccounts(ctx); err != nil {
+			logger.Error("dormancy batch failed", err, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// MarkDormantAccounts moves every ACTIVE account without customer-initiated
+// activity for the dormancy period to DORMANT and returns how many it moved.
+func (s *DormancyService) MarkDormantAccounts(ctx context.Context) (int, error) {
+	inactiveSince := s.now().UTC().AddDate(0, 0, -s.dormancyPeriodDays)
+
+	marked := 0
+	for {
+		accounts, err := s.dormancyRepo.MarkDormant(ctx, inactiveSince, dormancyBatchSize)
+		if err != nil {
+			return marked, err
+		}
+		for _, account := range accounts {
+			logger.Info("account marked dormant", logger.Fields{
+				"accountNumber":  account.AccountNumber,
+				"lastActivityAt": account.LastActivityAt.Format(time.RFC3339),
+			})
+		}
+		marked += len(accounts)
+		if len(accounts) < dormancyBatchSize {
+			return marked, nil
+		}
+	}
+}
+
+// ReactivateAccount returns a DORMANT account to ACTIVE once the identity
+// document and date of birth on the request match the customer's KYC record.
+func (s *DormancyService) ReactivateAccount(ctx context.Context, req models.ReactivateAccountRequest) (commons.Response[models.AccountStatusChangeResponse], error) {
+	logger.Info("dormancy service reactivate account request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+	verifiedBy := commons.ActorFromContext(ctx)
+	if verifiedBy == "" {
+		err := fmt.Errorf("an authenticated verifier is required")
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("Account not found"), err
+		}
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to reactivate account", "Unable to reactivate account right now"), err
+	}
+	if account.Status != domain.AccountStatusDormant {
+		err := fmt.Errorf("account %s is %s, not DORMANT", accountNumber, account.Status)
+		return commons.ErrorResponse[models.AccountStatusChangeResponse](accountStatusConflictMessage, err.Error()), err
+	}
+
+	user, err := s.userRepo.GetByCustomerID(ctx, account.CustomerID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.AccountStatusChangeResponse]("Customer not found"), err
+		}
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to reactivate account", "Unable to reactivate account right now"), err
+	}
+	if user.Status == domain.UserStatusHeld {
+		err := fmt.Errorf("customer is held for compliance review")
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+	if user.Status == domain.UserStatusRejected {
+		err := fmt.Errorf("customer was rejected at compliance review")
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("validation failed", err.Error()), err
+	}
+	if !kycDetailsMatch(user, req) {
+		err := fmt.Errorf("identity details do not match the customer's KYC record")
+		logger.Info("dormancy service kyc re-verification failed", logger.Fields{
+			"accountNumber": accountNumber,
+			"verifiedBy":    verifiedBy,
+		})
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("KYC verification failed", err.Error()), err
+	}
+
+	reactivated, err := s.dormancyRepo.Reactivate(ctx, accountNumber, domain.AccountStatusEvent{
+		Reason:  domain.AccountStatusReasonKYCReverified,
+		Comment: strings.TrimSpace(req.Comment),
+		Actor:   verifiedBy,
+	})
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.AccountStatusChangeResponse](accountStatusConflictMessage), err
+		}
+		logger.Error("dormancy service reactivate account failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return commons.ErrorResponse[models.AccountStatusChangeResponse]("failed to reactivate account", "Unable to reactivate account right now"), err
+	}
+
+	logger.Info("dormancy service reactivate account success", logger.Fields{
+		"accountNumber": accountNumber,
+		"verifiedBy":    verifiedBy,
+	})
+	return commons.SuccessResponse("Account reactivated successfully", mapAccountStatusChangeToResponse(reactivated)), nil
+}
+
+// GetDormancyReport returns the count and balances of DORMANT accounts per
+// currency.
+func (s *DormancyService) GetDormancyReport(ctx context.Context) (commons.Response[models.DormancyReportResponse], error) {
+	lines, err := s.dormancyRepo.GetReport(ctx)
+	if err != nil {
+		logger.Error("dormancy service get report failed", err, nil)
+		return commons.ErrorResponse[models.DormancyReportResponse]("failed to fetch dormancy report", "Unable to fetch dormancy report right now"), err
+	}
+
+	response := models.DormancyReportResponse{
+		AsOf:               s.now().UTC().Format(time.RFC3339),
+		DormancyPeriodDays: s.dormancyPeriodDays,
+		Currencies:         make([]models.DormancyReportLineResponse, 0, len(lines)),
+	}
+	for _, line := range lines {
+		response.Currencies = append(response.Currencies, models.DormancyReportLineResponse{
+			Currency:         line.Currency,
+			AccountCount:     line.AccountCount,
+			AvailableBalance: line.AvailableBalance,
+			LedgerBalance:    line.LedgerBalance,
+			OldestActivityAt: line.OldestActivityAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return commons.SuccessResponse("Dormancy report fetched successfully", response), nil
+}
+
+func kycDetailsMatch(user domain.User, req models.ReactivateAccountRequest) bool {
+	dob, err := time.Parse("2006-01-02", strings.TrimSpace(req.DOB))
+	if err != nil {
+		return false
+	}
+	return string(user.IDType) == strings.TrimSpace(req.IDType) &&
+		strings.EqualFold(strings.TrimSpace(user.IDNumber), strings.TrimSpace(req.IDNumber)) &&
+		user.DOB.Format(time.DateOnly) == dob.Format(time.DateOnly)
+}
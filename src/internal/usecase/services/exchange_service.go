@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+	"github.com/shopspring/decimal"
+)
+
+// Verify that ExchangeService implements the service_interfaces.ExchangeService interface
+var _ service_interfaces.ExchangeService = (*ExchangeService)(nil)
+
+// ExchangeService converts funds between two accounts of the same customer at
+// the stored rate less a spread. The spread is booked to the FX income GL
+// account of the bought currency and fees follow the exchange schedule of the
+// charges service.
+type ExchangeService struct {
+	accountRepo        repo_interfaces.AccountRepository
+	rateRepo           repo_interfaces.RateRepository
+	exchangeRepo       repo_interfaces.ExchangeRepository
+	userService        service_interfaces.UserService
+	chargesService     service_interfaces.ChargesService
+	referenceGenerator service_interfaces.ReferenceGenerator
+	spreadPercent      decimal.Decimal
+	// suspenseAccountNumber routes exchanges on their way to the destination,
+	// FX income and fee accounts.
+	suspenseAccountNumber string
+	chargesAccountNumber  string
+	vatAccountNumber      string
+	// fxIncomeGLAccountNumbers maps each currency to the GL account the spread
+	// earned buying it is booked to.
+	fxIncomeGLAccountNumbers map[string]string
+}
+
+func NewExchangeService(
+	accountRepo repo_interfaces.AccountRepository,
+	rateRepo repo_interfaces.RateRepository,
+	exchangeRepo repo_interfaces.ExchangeRepository,
+	userService service_interfaces.UserService,
+	chargesService service_interfaces.ChargesService,
+	referenceGenerator service_interfaces.ReferenceGenerator,
+	spreadPercent decimal.Decimal,
+	suspenseAccountNumber string,
+	chargesAccountNumber string,
+	vatAccountNumber string,
+	fxIncomeGLAccountNumbers map[string]string,
+) *ExchangeService {
+	if referenceGenerator == nil {
+		referenceGenerator, _ = NewReferenceGenerator(0, "", ReferenceSchemeEXT, nil)
+	}
+	return &ExchangeService{
+		accountRepo:              accountRepo,
+		rateRepo:                 rateRepo,
+		exchangeRepo:             exchangeRepo,
+		userService:              userService,
+		chargesService:           chargesService,
+		referenceGenerator:       referenceGenerator,
+		spreadPercent:            spreadPercent,
+		suspenseAccountNumber:    strings.TrimSpace(suspenseAccountNumber),
+		chargesAccountNumber:     strings.TrimSpace(chargesAccountNumber),
+		vatAccountNumber:         strings.TrimSpace(vatAccountNumber),
+		fxIncomeGLAccountNumbers: fxIncomeGLAccountNumbers,
+	}
+}
+
+// Exchange sells req.Amount out of the source account and credits the
+// destination account with the amount bought at the quoted rate. The quoted
+// rate is the stored rate less the spread; the bought amount is rounded down to
+// two decimal places and the difference from the mid-rate amount is FX income.
+func (s *ExchangeService) Exchange(ctx context.Context, req models.ExchangeRequest) (commons.Response[models.ExchangeResponse], error) {
+	logger.Info("exchange service exchange request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		logger.Error("exchange service exchange validation failed", err, nil)
+		return commons.ErrorResponse[models.ExchangeResponse]("validation failed", err.Error()), err
+	}
+
+	sourceAccountNumber := strings.TrimSpace(req.SourceAccountNumber)
+	destinationAccountNumber := strings.TrimSpace(req.DestinationAccountNumber)
+	sourceAmount := req.Amount
+
+	source, response, err := s.getExchangeAccount(ctx, sourceAccountNumber, "Source account not found")
+	if err != nil {
+		return response, err
+	}
+	if err := authorizeAccountAccess(ctx, source.CustomerID); err != nil {
+		logger.Info("exchange service source account access denied", logger.Fields{
+			"sourceAccountNumber": sourceAccountNumber,
+		})
+		return commons.ErrorResponse[models.ExchangeResponse](err.Error()), err
+	}
+	destination, response, err := s.getExchangeAccount(ctx, destinationAccountNumber, "Destination account not found")
+	if err != nil {
+		return response, err
+	}
+
+	if strings.TrimSpace(source.CustomerID) != strings.TrimSpace(destination.CustomerID) {
+		err := fmt.Errorf("accounts belong to different customers")
+		return commons.ErrorResponse[models.ExchangeResponse]("Accounts belong to different customers", err.Error()), err
+	}
+	if source.Status != domain.AccountStatusActive {
+		err := debitAccountStatusError("source account", source.Status)
+		return commons.ErrorResponse[models.ExchangeResponse]("Account is not active", err.Error()), err
+	}
+	if destination.Status != domain.AccountStatusActive {
+		err := fmt.Errorf("destination account is not active")
+		return commons.ErrorResponse[models.ExchangeResponse]("Account is not active", err.Error()), err
+	}
+
+	sourceCurrency := strings.ToUpper(strings.TrimSpace(source.Currency))
+	destinationCurrency := strings.ToUpper(strings.TrimSpace(destination.Currency))
+	if sourceCurrency == destinationCurrency {
+		err := fmt.Errorf("accounts must be in different currencies")
+		return commons.ErrorResponse[models.ExchangeResponse]("validation failed", err.Error()), err
+	}
+
+	fxIncomeGLAccountNumber := strings.TrimSpace(s.fxIncomeGLAccountNumbers[destinationCurrency])
+	if fxIncomeGLAccountNumber == "" {
+		err := fmt.Errorf("exchanges into %s are not supported", destinationCurrency)
+		return commons.ErrorResponse[models.ExchangeResponse]("validation failed", err.Error()), err
+	}
+
+	if response, err := verifyTransactionPIN[models.ExchangeResponse](ctx, s.userService, source.CustomerID, req.TransactionPIN, "failed to exchange funds", "Unable to exchange funds right now"); err != nil {
+		return response, err
+	}
+
+	rate, err := s.rateRepo.GetRate(ctx, sourceCurrency, destinationCurrency)
+	if err != nil {
+		logger.Error("exchange service rate lookup failed", err, logger.Fields{
+			"sourceCurrency":      sourceCurrency,
+			"destinationCurrency": destinationCurrency,
+		})
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.ExchangeResponse]("Rate not found"), err
+		}
+		return commons.ErrorResponse[models.ExchangeResponse]("failed to exchange funds", "Unable to exchange funds right now"), err
+	}
+	if rate.Rate.LessThanOrEqual(decimal.Zero) {
+		err := fmt.Errorf("stored rate must be greater than zero")
+		return commons.ErrorResponse[models.ExchangeResponse]("failed to exchange funds", "Unable to exchange funds right now"), err
+	}
+
+	quotedRate := rate.Rate.Mul(decimal.NewFromInt(1).Sub(s.spreadPercent.Div(decimal.NewFromInt(100)))).Round(8)
+	destinationAmount := sourceAmount.Mul(quotedRate).Truncate(2)
+	if destinationAmount.LessThanOrEqual(decimal.Zero) {
+		err := fmt.Errorf("amount is too small to exchange")
+		return commons.ErrorResponse[models.ExchangeResponse]("validation failed", err.Error()), err
+	}
+	fxIncomeAmount := sourceAmount.Mul(rate.Rate).Round(2).Sub(destinationAmount)
+
+	chargeAmount, vatAmount, chargeUSD, vatUSD, err := s.chargesService.GetExchangeCharges(ctx, sourceAmount, sourceCurrency)
+	if err != nil {
+		logger.Error("exchange service charges failed", err, logger.Fields{
+			"sourceAccountNumber": sourceAccountNumber,
+			"sourceCurrency":      sourceCurrency,
+		})
+		return commons.ErrorResponse[models.ExchangeResponse]("failed to exchange funds", "Unable to exchange funds right now"), err
+	}
+
+	totalDebit := sourceAmount.Add(chargeAmount).Add(vatAmount)
+	if source.AvailableBalance.Add(source.OverdraftLimit).LessThan(totalDebit) {
+		err := commons.ErrInsufficientBalance
+		return commons.ErrorResponse[models.ExchangeResponse]("Insufficient balance", err.Error()), err
+	}
+
+	channel := commons.ChannelIDFromContext(ctx)
+	exchange, err := s.exchangeRepo.Create(ctx, domain.Exchange{
+		Reference:                s.referenceGenerator.TransactionReference(channel),
+		CustomerID:               source.CustomerID,
+		SourceAccountNumber:      sourceAccountNumber,
+		DestinationAccountNumber: destinationAccountNumber,
+		SourceCurrency:           sourceCurrency,
+		DestinationCurrency:      destinationCurrency,
+		SourceAmount:             sourceAmount,
+		DestinationAmount:        destinationAmount,
+		MidRate:                  rate.Rate,
+		QuotedRate:               quotedRate,
+		SpreadPercent:            s.spreadPercent,
+		FXIncomeAmount:           fxIncomeAmount,
+		ChargeAmount:             chargeAmount,
+		VATAmount:                vatAmount,
+		TotalDebit:               totalDebit,
+		FXIncomeGLAccountNumber:  fxIncomeGLAccountNumber,
+		Narration:                strings.TrimSpace(req.Narration),
+		Channel:                  channel,
+	}, domain.ExchangePosting{
+		SuspenseAccountNumber: s.suspenseAccountNumber,
+		ChargesAccountNumber:  s.chargesAccountNumber,
+		VATAccountNumber:      s.vatAccountNumber,
+		ChargeUSD:             chargeUSD,
+		VATUSD:                vatUSD,
+	})
+	if err != nil {
+		logger.Error("exchange service posting failed", err, logger.Fields{
+			"sourceAccountNumber":      sourceAccountNumber,
+			"destinationAccountNumber": destinationAccountNumber,
+			"sourceAmount":             sourceAmount,
+		})
+		switch {
+		case errors.Is(err, commons.ErrInsufficientBalance):
+			return commons.ErrorResponse[models.ExchangeResponse]("Insufficient balance", err.Error()), err
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.ExchangeResponse]("Account not found"), err
+		case strings.Contains(err.Error(), "account is not active"):
+			return commons.ErrorResponse[models.ExchangeResponse]("Account is not active"), err
+		}
+		return commons.ErrorResponse[models.ExchangeResponse]("failed to exchange funds", "Unable to exchange funds right now"), err
+	}
+
+	source, err = s.accountRepo.GetByAccountNumber(ctx, sourceAccountNumber)
+	if err == nil {
+		destination, err = s.accountRepo.GetByAccountNumber(ctx, destinationAccountNumber)
+	}
+	if err != nil {
+		logger.Error("exchange service get accounts after exchange failed", err, logger.Fields{
+			"reference": exchange.Reference,
+		})
+		return commons.ErrorResponse[models.ExchangeResponse]("failed to fetch account", "Unable to fetch account right now"), err
+	}
+
+	result := toExchangeResponse(exchange, source, destination)
+
+	logger.Info("exchange service exchange success", logger.Fields{
+		"reference":         result.Reference,
+		"sourceAmount":      result.SourceAmount,
+		"destinationAmount": result.DestinationAmount,
+		"quotedRate":        result.QuotedRate,
+	})
+
+	return commons.SuccessResponse("funds exchanged successfully", result), nil
+}
+
+func (s *ExchangeService) getExchangeAccount(ctx context.Context, accountNumber string, notFoundMessage string) (domain.Account, commons.Response[models.ExchangeResponse], error) {
+	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.Account{}, commons.ErrorResponse[models.ExchangeResponse](notFoundMessage), err
+		}
+		logger.Error("exchange service account lookup failed", err, logger.Fields{
+			"accountNumber": accountNumber,
+		})
+		return domain.Account{}, commons.ErrorResponse[models.ExchangeResponse]("failed to exchange funds", "Unable to exchange funds right now"), err
+	}
+	return account, commons.Response[models.ExchangeResponse]{}, nil
+}
+
+func toExchangeResponse(exchange domain.Exchange, source domain.Account, destination domain.Account) models.ExchangeResponse {
+	return models.ExchangeResponse{
+		Reference:                   exchange.Reference,
+		SourceAccountNumber:         exchange.SourceAccountNumber,
+		DestinationAccountNumber:    exchange.DestinationAccountNumber,
+		SourceCurrency:              exchange.SourceCurrency,
+		DestinationCurrency:         exchange.DestinationCurrency,
+		SourceAmount:                exchange.SourceAmount,
+		DestinationAmount:           exchange.DestinationAmount,
+		MidRate:                     exchange.MidRate,
+		QuotedRate:                  exchange.QuotedRate,
+		SpreadPercent:               exchange.SpreadPercent,
+		ChargeAmount:                exchange.ChargeAmount,
+		VATAmount:                   exchange.VATAmount,
+		TotalDebit:                  exchange.TotalDebit,
+		SourceAvailableBalance:      source.AvailableBalance,
+		DestinationAvailableBalance: destination.AvailableBalance,
+		Narration:                   exchange.Narration,
+		JournalEntries:              toJournalEntryResponses(exchange.JournalEntries),
+		CreatedAt:                   exchange.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+	"github.com/shopspring/decimal"
+)
+
+// Verify that InterestService implements the service_interfaces.InterestService interface
+var _ service_interfaces.InterestService = (*InterestService)(nil)
+
+// InterestService accrues daily interest on positive balances from the tiered
+// rate table and capitalizes the accruals at month end, net of withholding tax.
+type InterestService struct {
+	interestRepo            repo_interfaces.InterestRepository
+	referenceGenerator      service_interfaces.ReferenceGenerator
+	withholdingTaxPercent   decimal.Decimal
+	expenseGLAccountNumbers map[string]string
+	taxGLAccountNumbers     map[string]string
+	now                     func() time.Time
+}
+
+func NewInterestService(
+	interestRepo repo_interfaces.InterestRepository,
+	referenceGenerator service_interfaces.ReferenceGenerator,
+	withholdingTaxPercent decimal.Decimal,
+	expenseGLAccountNumbers map[string]string,
+	taxGLAccountNumbers map[string]string,
+) *InterestService {
+	if referenceGenerator == nil {
+		referenceGenerator, _ = NewReferenceGenerator(0, "", ReferenceSchemeEXT, nil)
+	}
+	return &InterestService{
+		interestRepo:            interestRepo,
+		referenceGenerator:      referenceGenerator,
+		withholdingTaxPercent:   withholdingTaxPercent,
+		expenseGLAccountNumbers: expenseGLAccountNumbers,
+		taxGLAccountNumbers:     taxGLAccountNumbers,
+		now:                     time.Now,
+	}
+}
+
+// RunBatch runs the interest batch for a past date. Accruals and
+// capitalizations are keyed by account and date, so a date can be run again
+// after a partial failure without posting anything twice.
+func (s *InterestService) RunBatch(ctx context.Context, req models.RunInterestBatchRequest) (commons.Response[models.InterestBatchResponse], error) {
+	logger.Info("interest service run batch request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		logger.Error("interest service run batch validation failed", err, nil)
+		return commons.ErrorResponse[models.InterestBatchResponse]("validation failed", err.Error()), err
+	}
+
+	date, _ := time.Parse(time.DateOnly, strings.TrimSpace(req.Date))
+	if !date.Before(startOfDay(s.now().UTC())) {
+		err := fmt.Errorf("date must be before today")
+		return commons.ErrorResponse[models.InterestBatchResponse]("validation failed", err.Error()), err
+	}
+
+	result, err := s.Run(ctx, date)
+	if err != nil {
+		logger.Error("interest service run batch failed", err, logger.Fields{
+			"date": req.Date,
+		})
+		return commons.ErrorResponse[models.InterestBatchResponse]("failed to run interest batch", "Unable to run interest batch right now"), err
+	}
+
+	return commons.SuccessResponse("interest batch completed successfully", result), nil
+}
+
+// GetRateTiers returns the configured rate table.
+func (s *InterestService) GetRateTiers(ctx context.Context) (commons.Response[[]models.InterestRateTierResponse], error) {
+	tiers, err := s.interestRepo.GetRateTiers(ctx)
+	if err != nil {
+		logger.Error("interest service get rate tiers failed", err, nil)
+		return commons.ErrorResponse[[]models.InterestRateTierResponse]("failed to fetch interest rate tiers", "Unable to fetch interest rate tiers right now"), err
+	}
+
+	response := make([]models.InterestRateTierResponse, 0, len(tiers))
+	for _, tier := range tiers {
+		response = append(response, models.InterestRateTierResponse{
+			Currency:          tier.Currency,
+			Product:           string(tier.Product),
+			MinBalance:        tier.MinBalance,
+			AnnualRatePercent: tier.AnnualRatePercent,
+			DayCount:          string(tier.DayCount),
+		})
+	}
+	return commons.SuccessResponse("interest rate tiers fetched successfully", response), nil
+}
+
+// StartBatchRunner runs the batch every interval until ctx is cancelled. Each
+// tick catches up on every day since the last one the runner completed, so days
+// missed while the service was down are still accrued.
+func (s *InterestService) StartBatchRunner(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.catchUp(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// catchUp runs the batch for each day after the last completed run up to the
+// previous UTC day, oldest first; the first run starts from the previous day.
+// It stops at the first failure so no day is capitalized before every earlier
+// day is accrued; the next tick retries from there.
+func (s *InterestService) catchUp(ctx context.Context) {
+	yesterday := startOfDay(s.now().UTC()).AddDate(0, 0, -1)
+
+	last, err := s.interestRepo.GetLastBatchRunDate(ctx)
+	if err != nil {
+		logger.Error("interest batch catch up failed", err, nil)
+		return
+	}
+	date := yesterday
+	if last != nil {
+		date = startOfDay(*last).AddDate(0, 0, 1)
+	}
+
+	for ; !date.After(yesterday); date = date.AddDate(0, 0, 1) {
+		if _, err := s.Run(ctx, date); err != nil {
+			logger.Error("interest batch failed", err, logger.Fields{
+				"date": date.Format(time.DateOnly),
+			})
+			return
+		}
+		if err := s.interestRepo.RecordBatchRun(ctx, date); err != nil {
+			logger.Error("interest batch run record failed", err, logger.Fields{
+				"date": date.Format(time.DateOnly),
+			})
+			return
+		}
+	}
+}
+
+// Run accrues interest for the date and, on the last day of a month,
+// capitalizes every account's open accruals up to that date.
+func (s *InterestService) Run(ctx context.Context, date time.Time) (models.InterestBatchResponse, error) {
+	date = startOfDay(date)
+	result := models.InterestBatchResponse{
+		Date:            date.Format(time.DateOnly),
+		MonthEnd:        date.AddDate(0, 0, 1).Day() == 1,
+		Capitalizations: make([]models.InterestCapitalizationResponse, 0),
+	}
+
+	tiers, err := s.interestRepo.GetRateTiers(ctx)
+	if err != nil {
+		return result, err
+	}
+	accounts, err := s.interestRepo.GetInterestBearingAccounts(ctx, date)
+	if err != nil {
+		return result, err
+	}
+	result.AccountsEvaluated = len(accounts)
+
+	accruals := make([]domain.InterestAccrual, 0, len(accounts))
+	for _, account := range accounts {
+		accrual, ok := accrueInterest(account, tiers, date)
+		if ok {
+			accruals = append(accruals, accrual)
+		}
+	}
+	if result.AccrualsRecorded, err = s.interestRepo.RecordAccruals(ctx, accruals); err != nil {
+		return result, err
+	}
+	result.AccrualsSkipped = len(accruals) - result.AccrualsRecorded
+
+	if result.MonthEnd {
+		if err := s.capitalize(ctx, date, &result); err != nil {
+			return result, err
+		}
+	}
+
+	logger.Info("interest batch completed", logger.Fields{
+		"date":                   result.Date,
+		"accountsEvaluated":      result.AccountsEvaluated,
+		"accrualsRecorded":       result.AccrualsRecorded,
+		"capitalizations":        len(result.Capitalizations),
+		"capitalizationsSkipped": result.CapitalizationsSkipped,
+		"capitalizationsFailed":  result.CapitalizationsFailed,
+	})
+	return result, nil
+}
+
+// capitalize pays the open accruals of each account. A gross amount that
+// rounds to zero stays open and carries into the next month. A failure on one
+// account is counted and logged; its accruals stay open for a re-run.
+func (s *InterestService) capitalize(ctx context.Context, periodEnd time.Time, result *models.InterestBatchResponse) error {
+	totals, err := s.interestRepo.GetOpenAccrualTotals(ctx, periodEnd)
+	if err != nil {
+		return err
+	}
+
+	for _, total := range totals {
+		gross := total.AccruedAmount.Round(2)
+		if !gross.IsPositive() {
+			continue
+		}
+
+		currency := strings.ToUpper(strings.TrimSpace(total.Currency))
+		expenseGL, taxGL := s.expenseGLAccountNumbers[currency], s.taxGLAccountNumbers[currency]
+		if expenseGL == "" || taxGL == "" {
+			logger.Error("interest capitalization skipped", fmt.Errorf("no interest GL accounts configured for %s", currency), logger.Fields{
+				"accountNumber": total.AccountNumber,
+			})
+			result.CapitalizationsFailed++
+			continue
+		}
+
+		tax := gross.Mul(s.withholdingTaxPercent).Div(decimal.NewFromInt(100)).Round(2)
+		total.Reference = s.referenceGenerator.TransactionReference("")
+		total.Currency = currency
+		total.GrossAmount = gross
+		total.TaxAmount = tax
+		total.NetAmount = gross.Sub(tax)
+		total.WithholdingTaxPercent = s.withholdingTaxPercent
+		total.ExpenseGLAccountNumber = expenseGL
+		total.TaxGLAccountNumber = taxGL
+
+		capitalization, err := s.interestRepo.Capitalize(ctx, total)
+		if err != nil {
+			if errors.Is(err, commons.ErrInterestAlreadyCapitalized) {
+				result.CapitalizationsSkipped++
+				continue
+			}
+			logger.Error("interest capitalization failed", err, logger.Fields{
+				"accountNumber": total.AccountNumber,
+				"periodEnd":     periodEnd.Format(time.DateOnly),
+			})
+			result.CapitalizationsFailed++
+			continue
+		}
+		result.Capitalizations = append(result.Capitalizations, mapInterestCapitalizationToResponse(capitalization))
+	}
+	return nil
+}
+
+// accrueInterest computes one day of interest on the account's end-of-day
+// ledger balance. The tier with the highest minimum balance not above the
+// balance applies to all of it. Accounts without a matching tier, or whose tier pays nothing, do not accrue.
+func accrueInterest(account domain.Account, tiers []domain.InterestRateTier, date time.Time) (domain.InterestAccrual, bool) {
+	product := account.Product
+	if product == "" {
+		product = domain.AccountProductStandard
+	}
+
+	var applied *domain.InterestRateTier
+	for i := range tiers {
+		tier := &tiers[i]
+		if !strings.EqualFold(tier.Currency, account.Currency) || tier.Product != product {
+			continue
+		}
+		if tier.MinBalance.GreaterThan(account.LedgerBalance) {
+			continue
+		}
+		if applied == nil || tier.MinBalance.GreaterThan(applied.MinBalance) {
+			applied = tier
+		}
+	}
+	if applied == nil || !applied.AnnualRatePercent.IsPositive() {
+		return domain.InterestAccrual{}, false
+	}
+
+	amount := account.LedgerBalance.
+		Mul(applied.AnnualRatePercent).
+		Div(decimal.NewFromInt(100 * applied.DayCount.DaysInYear())).
+		Round(8)
+
+	return domain.InterestAccrual{
+		AccountNumber:     account.AccountNumber,
+		AccrualDate:       date,
+		Currency:          strings.ToUpper(account.Currency),
+		Product:           product,
+		Balance:           account.LedgerBalance,
+		AnnualRatePercent: applied.AnnualRatePercent,
+		DayCount:          applied.DayCount,
+		Amount:            amount,
+	}, true
+}
+
+func mapInterestCapitalizationToResponse(capitalization domain.InterestCapitalization) models.InterestCapitalizationResponse {
+	return models.InterestCapitalizationResponse{
+		Reference:     capitalization.Reference,
+		AccountNumber: capitalization.AccountNumber,
+		Currency:      capitalization.Currency,
+		PeriodStart:   capitalization.PeriodStart.Format(time.DateOnly),
+		PeriodEnd:     capitalization.PeriodEnd.Format(time.DateOnly),
+		GrossAmount:   capitalization.GrossAmount,
+		TaxAmount:     capitalization.TaxAmount,
+		NetAmount:     capitalization.NetAmount,
+		CreatedAt:     capitalization.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+	"github.com/shopspring/decimal"
+)
+
+// kycLimits caps a single debit at the limit of the customer's KYC level.
+// Transfers and withdrawals share it, so cashing out is held to the same limit
+// as sending money. The zero value caps nothing.
+type kycLimits struct {
+	userRepo    domain.UserRepository
+	rateService service_interfaces.RateService
+	policy      domain.TransferLimitPolicy
+}
+
+// checkKYCLimit refuses a debit of amount in currency above the limit of the
+// customer's KYC level with commons.ErrTransferLimitExceeded. Lookup and
+// conversion failures are reported with failureMessage and failureDetail.
+func checkKYCLimit[T any](
+	ctx context.Context,
+	limits kycLimits,
+	customerID string,
+	amount decimal.Decimal,
+	currency string,
+	failureMessage string,
+	failureDetail string,
+) (commons.Response[T], error) {
+	if limits.userRepo == nil {
+		return commons.Response[T]{}, nil
+	}
+
+	user, err := limits.userRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		logger.Error("kyc limit customer lookup failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[T](failureMessage, failureDetail), err
+	}
+	limit, capped := limits.policy.LimitFor(user.KYCLevel)
+	if !capped {
+		return commons.Response[T]{}, nil
+	}
+
+	normalized := amount
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency != limits.policy.Currency {
+		normalized, _, _, err = limits.rateService.ConvertRate(ctx, amount, currency, limits.policy.Currency)
+		if err != nil {
+			logger.Error("kyc limit amount conversion failed", err, logger.Fields{
+				"customerId": customerID,
+				"currency":   currency,
+			})
+			return commons.ErrorResponse[T](failureMessage, failureDetail), err
+		}
+	}
+	if normalized.GreaterThan(limit) {
+		logger.Info("kyc limit exceeded", logger.Fields{
+			"customerId":       customerID,
+			"kycLevel":         user.KYCLevel,
+			"normalizedAmount": normalized,
+			"limit":            limit,
+		})
+		err := commons.ErrTransferLimitExceeded
+		return commons.ErrorResponse[T](err.Error(), fmt.Sprintf("KYC level %d allows up to %s %s per transaction", user.KYCLevel, limit.StringFixed(2), limits.policy.Currency)), err
+	}
+	return commons.Response[T]{}, nil
+}
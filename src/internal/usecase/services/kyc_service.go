@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	defaultKYCUpgradesLimit = 50
+	maxKYCUpgradesLimit     = 200
+
+	kycUpgradePendingMessage    = "KYC upgrade already pending"
+	kycUpgradeNotPendingMessage = "KYC upgrade is not pending"
+)
+
+// Verify that KYCService implements the service_interfaces.KYCService interface
+var _ service_interfaces.KYCService = (*KYCService)(nil)
+
+type KYCService struct {
+	kycRepo  repo_interfaces.KYCRepository
+	userRepo domain.UserRepository
+	now      func() time.Time
+}
+
+func NewKYCService(kycRepo repo_interfaces.KYCRepository, userRepo domain.UserRepository) *KYCService {
+	return &KYCService{
+		kycRepo:  kycRepo,
+		userRepo: userRepo,
+		now:      time.Now,
+	}
+}
+
+// SubmitKYCUpgrade queues a request to raise the customer's KYC level. The
+// level is unchanged until a reviewer approves it. The submitter is the
+// authenticated caller, never a request field.
+func (s *KYCService) SubmitKYCUpgrade(ctx context.Context, req models.SubmitKYCUpgradeRequest) (commons.Response[models.KYCUpgradeResponse], error) {
+	logger.Info("kyc service submit upgrade request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+	submittedBy := commons.ActorFromContext(ctx)
+	if submittedBy == "" {
+		err := fmt.Errorf("an authenticated submitter is required")
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	user, err := s.userRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.KYCUpgradeResponse]("User not found"), err
+		}
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("failed to submit kyc upgrade", "Unable to submit KYC upgrade right now"), err
+	}
+	if user.Status == domain.UserStatusHeld {
+		err := fmt.Errorf("customer is held for compliance review")
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+	if user.Status == domain.UserStatusRejected {
+		err := fmt.Errorf("customer was rejected at compliance review")
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+	if user.Status == domain.UserStatusMerged {
+		err := fmt.Errorf("customer was merged into %s", valueOrEmpty(user.MergedIntoCustomerID))
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+	if user.Status == domain.UserStatusErased {
+		err := fmt.Errorf("customer data was erased")
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+	if req.RequestedLevel <= user.KYCLevel {
+		err := fmt.Errorf("requestedLevel must be above the current level %d", user.KYCLevel)
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+
+	var documentExpiresAt *time.Time
+	if raw := strings.TrimSpace(req.DocumentExpiresAt); raw != "" {
+		expiresAt, _ := time.Parse(time.DateOnly, raw)
+		if !expiresAt.After(s.now().UTC()) {
+			err := fmt.Errorf("document has expired")
+			return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+		}
+		documentExpiresAt = &expiresAt
+	}
+
+	request, err := s.kycRepo.Submit(ctx, domain.KYCUpgradeRequest{
+		CustomerID:        customerID,
+		CurrentLevel:      user.KYCLevel,
+		RequestedLevel:    req.RequestedLevel,
+		DocumentType:      domain.KYCDocumentType(strings.TrimSpace(req.DocumentType)),
+		DocumentNumber:    strings.TrimSpace(req.DocumentNumber),
+		IssuingCountry:    strings.ToUpper(strings.TrimSpace(req.IssuingCountry)),
+		DocumentExpiresAt: documentExpiresAt,
+		DocumentReference: strings.TrimSpace(req.DocumentReference),
+		SubmittedBy:       submittedBy,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return commons.ErrorResponse[models.KYCUpgradeResponse](kycUpgradePendingMessage, "The customer already has a KYC upgrade awaiting review"), err
+		}
+		logger.Error("kyc service submit upgrade failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("failed to submit kyc upgrade", "Unable to submit KYC upgrade right now"), err
+	}
+
+	logger.Info("kyc service submit upgrade success", logger.Fields{
+		"requestId":      request.ID,
+		"customerId":     request.CustomerID,
+		"requestedLevel": request.RequestedLevel,
+	})
+	return commons.SuccessResponse("KYC upgrade submitted successfully", mapKYCUpgradeToResponse(request)), nil
+}
+
+// GetKYCUpgrades lists a customer's upgrade requests, newest first, or the
+// requests in a status, oldest first. Without either it returns the PENDING
+// review queue.
+func (s *KYCService) GetKYCUpgrades(ctx context.Context, req models.GetKYCUpgradesRequest) (commons.Response[[]models.KYCUpgradeResponse], error) {
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[[]models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+
+	var (
+		requests []domain.KYCUpgradeRequest
+		err      error
+	)
+	if customerID := strings.TrimSpace(req.CustomerID); customerID != "" {
+		requests, err = s.kycRepo.GetByCustomerID(ctx, customerID)
+	} else {
+		status := domain.KYCUpgradeStatus(strings.ToUpper(strings.TrimSpace(req.Status)))
+		if status == "" {
+			status = domain.KYCUpgradePending
+		}
+		limit := req.Limit
+		if limit <= 0 {
+			limit = defaultKYCUpgradesLimit
+		}
+		if limit > maxKYCUpgradesLimit {
+			limit = maxKYCUpgradesLimit
+		}
+		requests, err = s.kycRepo.GetByStatus(ctx, status, limit)
+	}
+	if err != nil {
+		return commons.ErrorResponse[[]models.KYCUpgradeResponse]("failed to fetch kyc upgrades", "Unable to fetch KYC upgrades right now"), err
+	}
+
+	response := make([]models.KYCUpgradeResponse, 0, len(requests))
+	for _, request := range requests {
+		response = append(response, mapKYCUpgradeToResponse(request))
+	}
+	return commons.SuccessResponse("KYC upgrades fetched successfully", response), nil
+}
+
+// ApproveKYCUpgrade raises the customer's KYC level to the requested level.
+func (s *KYCService) ApproveKYCUpgrade(ctx context.Context, req models.KYCUpgradeDecisionRequest) (commons.Response[models.KYCUpgradeResponse], error) {
+	return s.decide(ctx, req, domain.KYCUpgradeApproved)
+}
+
+// RejectKYCUpgrade closes the request without changing the KYC level.
+func (s *KYCService) RejectKYCUpgrade(ctx context.Context, req models.KYCUpgradeDecisionRequest) (commons.Response[models.KYCUpgradeResponse], error) {
+	return s.decide(ctx, req, domain.KYCUpgradeRejected)
+}
+
+func (s *KYCService) decide(ctx context.Context, req models.KYCUpgradeDecisionRequest, outcome domain.KYCUpgradeStatus) (commons.Response[models.KYCUpgradeResponse], error) {
+	logger.Info("kyc service decide upgrade request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+		"outcome": outcome,
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+	reviewerID := commons.ActorFromContext(ctx)
+	if reviewerID == "" {
+		err := fmt.Errorf("an authenticated reviewer is required")
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("validation failed", err.Error()), err
+	}
+
+	requestID := strings.TrimSpace(req.RequestID)
+	request, err := s.kycRepo.GetByID(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.KYCUpgradeResponse]("KYC upgrade not found"), err
+		}
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("failed to review kyc upgrade", "Unable to review KYC upgrade right now"), err
+	}
+	if request.Status != domain.KYCUpgradePending {
+		err := fmt.Errorf("kyc upgrade %s is %s", request.ID, request.Status)
+		return commons.ErrorResponse[models.KYCUpgradeResponse](kycUpgradeNotPendingMessage, err.Error()), err
+	}
+
+	// Both sides are authenticated callers, so a reviewer cannot pass as
+	// someone else to approve their own submission.
+	if strings.EqualFold(reviewerID, strings.TrimSpace(request.SubmittedBy)) {
+		err := commons.ErrMakerCheckerViolation
+		return commons.ErrorResponse[models.KYCUpgradeResponse](err.Error()), err
+	}
+
+	resolve := s.kycRepo.Reject
+	if outcome == domain.KYCUpgradeApproved {
+		resolve = s.kycRepo.Approve
+	}
+	resolved, err := resolve(ctx, request.ID, reviewerID, strings.TrimSpace(req.Comment))
+	if err != nil {
+		// Another reviewer decided it between the read and the update.
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.KYCUpgradeResponse](kycUpgradeNotPendingMessage), err
+		}
+		logger.Error("kyc service decide upgrade failed", err, logger.Fields{
+			"requestId": request.ID,
+		})
+		return commons.ErrorResponse[models.KYCUpgradeResponse]("failed to review kyc upgrade", "Unable to review KYC upgrade right now"), err
+	}
+
+	logger.Info("kyc service decide upgrade success", logger.Fields{
+		"requestId":  resolved.ID,
+		"customerId": resolved.CustomerID,
+		"status":     resolved.Status,
+		"reviewerId": reviewerID,
+	})
+	if outcome == domain.KYCUpgradeApproved {
+		return commons.SuccessResponse("KYC upgrade approved successfully", mapKYCUpgradeToResponse(resolved)), nil
+	}
+	return commons.SuccessResponse("KYC upgrade rejected successfully", mapKYCUpgradeToResponse(resolved)), nil
+}
+
+func mapKYCUpgradeToResponse(request domain.KYCUpgradeRequest) models.KYCUpgradeResponse {
+	response := models.KYCUpgradeResponse{
+		RequestID:         request.ID,
+		CustomerID:        request.CustomerID,
+		CurrentLevel:      request.CurrentLevel,
+		RequestedLevel:    request.RequestedLevel,
+		DocumentType:      string(request.DocumentType),
+		DocumentNumber:    request.DocumentNumber,
+		IssuingCountry:    request.IssuingCountry,
+		DocumentReference: request.DocumentReference,
+		Status:            string(request.Status),
+		SubmittedBy:       request.SubmittedBy,
+		ReviewedBy:        valueOrEmpty(request.ReviewedBy),
+		ReviewComment:     request.ReviewComment,
+		CreatedAt:         request.CreatedAt.Format(time.RFC3339),
+	}
+	if request.DocumentExpiresAt != nil {
+		response.DocumentExpiresAt = request.DocumentExpiresAt.Format(time.DateOnly)
+	}
+	if request.ReviewedAt != nil {
+		response.ReviewedAt = request.ReviewedAt.Format(time.RFC3339)
+	}
+	return response
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// transliterations folds accented Latin and Cyrillic letters onto plain ASCII so
+// "José Müller" and "Jose Muller" compare as the same name.
+var transliterations = map[rune]string{
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Ā': "A", 'Ă': "A", 'Ą': "A",
+	'Æ': "AE", 'Ç': "C", 'Ć': "C", 'Č': "C", 'Ď': "D", 'Đ': "D", 'Ð': "D",
+	'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ē': "E", 'Ė': "E", 'Ę': "E", 'Ě': "E",
+	'Ğ': "G", 'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I", 'Ī': "I", 'İ': "I", 'Ł': "L",
+	'Ñ': "N", 'Ń': "N", 'Ň': "N", 'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O", 'Ō': "O", 'Ő': "O",
+	'Œ': "OE", 'Ř': "R", 'Ś': "S", 'Š': "S", 'Ş': "S", 'ß': "SS", 'Ť': "T", 'Ţ': "T", 'Þ': "TH",
+	'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ū': "U", 'Ů': "U", 'Ű': "U", 'Ý': "Y", 'Ÿ': "Y",
+	'Ź': "Z", 'Ż': "Z", 'Ž': "Z",
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ё': "E", 'Ж': "ZH", 'З': "Z",
+	'И': "I", 'Й': "Y", 'К': "K", 'Л': "L", 'М': "M", 'Н': "N", 'О': "O", 'П': "P", 'Р': "R",
+	'С': "S", 'Т': "T", 'У': "U", 'Ф': "F", 'Х': "KH", 'Ц': "TS", 'Ч': "CH", 'Ш': "SH", 'Щ': "SHCH",
+	'Ъ': "", 'Ы': "Y", 'Ь': "", 'Э': "E", 'Ю': "YU", 'Я': "YA",
+}
+
+// normalizeNameTokens upper-cases, transliterates and strips punctuation from a
+// name, returning its tokens in original order.
+func normalizeNameTokens(name string) []string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(name) {
+		if replacement, ok := transliterations[r]; ok {
+			b.WriteString(replacement)
+			continue
+		}
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			// Scripts without a transliteration table are compared as-is.
+			b.WriteRune(r)
+		case r == '\'' || r == '`' || r == '’':
+			// Apostrophes join rather than split: O'NEIL -> ONEIL.
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Fields(b.String())
+}
+
+// nameMatchScore returns a 0..1 similarity between two normalized names. It takes
+// the best of the straight Jaro-Winkler score, the score with tokens sorted (so
+// "SMITH JOHN" matches "JOHN SMITH") and a per-token alignment that tolerates a
+// missing middle name.
+func nameMatchScore(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	best := jaroWinkler(strings.Join(a, " "), strings.Join(b, " "))
+	if score := jaroWinkler(sortedTokenString(a), sortedTokenString(b)); score > best {
+		best = score
+	}
+	if score := jaroWinkler(strings.Join(a, ""), strings.Join(b, "")); score > best {
+		best = score
+	}
+	if score := tokenAlignmentScore(a, b); score > best {
+		best = score
+	}
+	return best
+}
+
+func sortedTokenString(tokens []string) string {
+	sorted := append([]string(nil), tokens...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, " ")
+}
+
+// tokenAlignmentScore pairs every token of the shorter name with its best match
+// in the longer one. Single-token names are not aligned, otherwise a lone first
+// name would match every listed person sharing it.
+func tokenAlignmentScore(a, b []string) float64 {
+	shorter, longer := a, b
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	if len(shorter) < 2 {
+		return 0
+	}
+
+	var total float64
+	for _, token := range shorter {
+		var tokenBest float64
+		for _, candidate := range longer {
+			if score := jaroWinkler(token, candidate); score > tokenBest {
+				tokenBest = score
+			}
+		}
+		total += tokenBest
+	}
+
+	coverage := float64(len(shorter)) / float64(len(longer))
+	return (total / float64(len(shorter))) * (0.95 + 0.05*coverage)
+}
+
+func jaroWinkler(a, b string) float64 {
+	s1, s2 := []rune(a), []rune(b)
+	if len(s1) == 0 && len(s2) == 0 {
+		return 1
+	}
+	if len(s1) == 0 || len(s2) == 0 {
+		return 0
+	}
+
+	matchDistance := max(len(s1), len(s2))/2 - 1
+	if matchDistance < 0 {
+		matchDistance = 0
+	}
+
+	s1Matches := make([]bool, len(s1))
+	s2Matches := make([]bool, len(s2))
+	matches := 0
+	for i := range s1 {
+		start := max(0, i-matchDistance)
+		end := min(len(s2), i+matchDistance+1)
+		for j := start; j < end; j++ {
+			if s2Matches[j] || s1[i] != s2[j] {
+				continue
+			}
+			s1Matches[i] = true
+			s2Matches[j] = true
+			matches++
+			break
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	k := 0
+	for i := range s1 {
+		if !s1Matches[i] {
+			continue
+		}
+		for !s2Matches[k] {
+			k++
+		}
+		if s1[i] != s2[k] {
+			transpositions++
+		}
+		k++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for i := 0; i < min(4, len(s1), len(s2)); i++ {
+		if s1[i] != s2[i] {
+			break
+		}
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const otpCodeDigits = 6
+
+// Verify that OTPService implements the service_interfaces.OTPService interface
+var _ service_interfaces.OTPService = (*OTPService)(nil)
+
+// OTPService issues one-time codes to the customer's phone number and
+// verifies them. Each challenge is bound to a customer and a purpose, expires
+// after the TTL and is spent by the first correct code or by too many wrong
+// ones.
+type OTPService struct {
+	otpRepo     repo_interfaces.OTPRepository
+	userRepo    domain.UserRepository
+	sender      service_interfaces.OTPSender
+	ttl         time.Duration
+	maxAttempts int
+	now         func() time.Time
+}
+
+func NewOTPService(
+	otpRepo repo_interfaces.OTPRepository,
+	userRepo domain.UserRepository,
+	sender service_interfaces.OTPSender,
+	ttl time.Duration,
+	maxAttempts int,
+) *OTPService {
+	return &OTPService{
+		otpRepo:     otpRepo,
+		userRepo:    userRepo,
+		sender:      sender,
+		ttl:         ttl,
+		maxAttempts: maxAttempts,
+		now:         time.Now,
+	}
+}
+
+// Issue creates a challenge for the customer and sends its code. It returns
+// commons.ErrRecordNotFound when the customer does not exist.
+func (s *OTPService) Issue(ctx context.Context, customerID string, purpose domain.OTPPurpose, payload string) (domain.OTPChallenge, error) {
+	user, err := s.userRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		return domain.OTPChallenge{}, err
+	}
+
+	code, err := generateOTPCode()
+	if err != nil {
+		return domain.OTPChallenge{}, fmt.Errorf("generate otp code: %w", err)
+	}
+	codeHash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return domain.OTPChallenge{}, fmt.Errorf("hash otp code: %w", err)
+	}
+
+	challenge, err := s.otpRepo.Create(ctx, domain.OTPChallenge{
+		CustomerID:  user.CustomerID,
+		Purpose:     purpose,
+		CodeHash:    string(codeHash),
+		Payload:     payload,
+		MaxAttempts: s.maxAttempts,
+		ExpiresAt:   s.now().UTC().Add(s.ttl),
+	})
+	if err != nil {
+		return domain.OTPChallenge{}, err
+	}
+
+	if err := s.sender.Send(ctx, challenge, user.PhoneNumber, code); err != nil {
+		return domain.OTPChallenge{}, fmt.Errorf("send otp: %w", err)
+	}
+
+	logger.Info("otp service challenge issued", logger.Fields{
+		"challengeId": challenge.ID,
+		"customerId":  challenge.CustomerID,
+		"purpose":     challenge.Purpose,
+		"expiresAt":   challenge.ExpiresAt.Format(time.RFC3339),
+	})
+	return challenge, nil
+}
+
+func (s *OTPService) Verify(ctx context.Context, customerID string, challengeID string, purpose domain.OTPPurpose, code string) (domain.OTPChallenge, error) {
+	challengeID = strings.TrimSpace(challengeID)
+	challenge, err := s.otpRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.OTPChallenge{}, commons.ErrInvalidOTP
+		}
+		return domain.OTPChallenge{}, err
+	}
+
+	if challenge.CustomerID != customerID ||
+		challenge.Purpose != purpose ||
+		challenge.ConsumedAt != nil ||
+		!challenge.ExpiresAt.After(s.now()) ||
+		challenge.Attempts >= challenge.MaxAttempts {
+		logger.Info("otp service challenge not usable", logger.Fields{
+			"challengeId": challengeID,
+			"customerId":  customerID,
+			"purpose":     purpose,
+		})
+		return domain.OTPChallenge{}, commons.ErrInvalidOTP
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(challenge.CodeHash), []byte(strings.TrimSpace(code))); err != nil {
+		if !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return domain.OTPChallenge{}, fmt.Errorf("verify otp: %w", err)
+		}
+		if _, err := s.otpRepo.RecordFailedAttempt(ctx, challengeID); err != nil && !errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.OTPChallenge{}, err
+		}
+		logger.Info("otp service code mismatch", logger.Fields{
+			"challengeId": challengeID,
+			"attempts":    challenge.Attempts + 1,
+		})
+		return domain.OTPChallenge{}, commons.ErrInvalidOTP
+	}
+
+	// Consume re-checks the challenge under the row update, so a code can
+	// only be spent once even when two requests race.
+	consumed, err := s.otpRepo.Consume(ctx, challengeID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.OTPChallenge{}, commons.ErrInvalidOTP
+		}
+		return domain.OTPChallenge{}, err
+	}
+	return consumed, nil
+}
+
+func generateOTPCode() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < otpCodeDigits; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", otpCodeDigits, n), nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	outboxClaimLease  = 30 * time.Second
+	outboxMaxBackoff  = 5 * time.Minute
+	outboxMaxRounds   = 100
+	outboxDefaultSize = 100
+)
+
+// OutboxRelayService moves committed outbox rows to the configured EventPublisher
+// and, once enabled, hands transfer events to the webhook notifier. An event is
+// marked published only after every sink accepts it, so a crash in between
+// results in a redelivery rather than a lost event.
+type OutboxRelayService struct {
+	outboxRepo            repo_interfaces.OutboxRepository
+	publisher             service_interfaces.EventPublisher
+	transferEventNotifier service_interfaces.TransferEventNotifier
+	batchSize             int
+	retryBackoff          time.Duration
+}
+
+func NewOutboxRelayService(
+	outboxRepo repo_interfaces.OutboxRepository,
+	publisher service_interfaces.EventPublisher,
+	batchSize int,
+	retryBackoff time.Duration,
+) *OutboxRelayService {
+	if batchSize <= 0 {
+		batchSize = outboxDefaultSize
+	}
+	return &OutboxRelayService{
+		outboxRepo:   outboxRepo,
+		publisher:    publisher,
+		batchSize:    batchSize,
+		retryBackoff: retryBackoff,
+	}
+}
+
+// EnableWebhooks feeds transfer events to notifier alongside the publisher, so
+// webhook deliveries come from the same committed events as published ones.
+func (s *OutboxRelayService) EnableWebhooks(notifier service_interfaces.TransferEventNotifier) {
+	s.transferEventNotifier = notifier
+}
+
+// Start relays pending events every interval until ctx is cancelled.
+func (s *OutboxRelayService) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RelayPending(ctx); err != nil {
+			logger.Error("outbox relay failed", err, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending drains the outbox. Each claim returns at most one event per
+// aggregate, so it keeps claiming until nothing is due; failed events are pushed
+// into the future by their backoff and do not cause the loop to spin.
+func (s *OutboxRelayService) RelayPending(ctx context.Context) (int, error) {
+	published := 0
+	for round := 0; round < outboxMaxRounds; round++ {
+		events, err := s.outboxRepo.ClaimPending(ctx, s.batchSize, outboxClaimLease)
+		if err != nil {
+			return published, err
+		}
+		if len(events) == 0 {
+			return published, nil
+		}
+
+		for _, event := range events {
+			if ctx.Err() != nil {
+				return published, ctx.Err()
+			}
+
+			if err := s.relay(ctx, event); err != nil {
+				event.Attempts++
+				event.NextAttemptAt = time.Now().UTC().Add(exponentialBackoff(s.retryBackoff, outboxMaxBackoff, event.Attempts))
+				message := err.Error()
+				event.LastError = &message
+				logger.Error("outbox relay publish failed", err, logger.Fields{
+					"eventId":       event.ID,
+					"aggregateType": event.AggregateType,
+					"aggregateId":   event.AggregateID,
+					"attempts":      event.Attempts,
+				})
+				if markErr := s.outboxRepo.MarkFailed(ctx, event); markErr != nil {
+					logger.Error("outbox relay mark failed failed", markErr, logger.Fields{"eventId": event.ID})
+				}
+				continue
+			}
+
+			if err := s.outboxRepo.MarkPublished(ctx, event.ID); err != nil {
+				// The event went out but will be published again once the lease expires.
+				logger.Error("outbox relay mark published failed", err, logger.Fields{"eventId": event.ID})
+				continue
+			}
+			published++
+		}
+	}
+
+	return published, nil
+}
+
+// relay hands event to every sink. A failed sink fails the whole event, and the
+// retry goes to every sink again; both tolerate an event seen more than once.
+func (s *OutboxRelayService) relay(ctx context.Context, event domain.OutboxEvent) error {
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		return err
+	}
+	if s.transferEventNotifier != nil && event.AggregateType == domain.AggregateTypeTransfer {
+		if err := s.transferEventNotifier.NotifyTransferEvent(ctx, event); err != nil {
+			return fmt.Errorf("record webhook deliveries: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+	"github.com/shopspring/decimal"
+)
+
+// Verify that OverdraftService implements the service_interfaces.OverdraftService interface
+var _ service_interfaces.OverdraftService = (*OverdraftService)(nil)
+
+// OverdraftService manages per-account overdraft facilities: approval, daily
+// interest on overdrawn balances, suspension at expiry and breach reporting.
+type OverdraftService struct {
+	overdraftRepo repo_interfaces.OverdraftRepository
+	now           func() time.Time
+}
+
+func NewOverdraftService(overdraftRepo repo_interfaces.OverdraftRepository) *OverdraftService {
+	return &OverdraftService{
+		overdraftRepo: overdraftRepo,
+		now:           time.Now,
+	}
+}
+
+// ApproveOverdraft approves a facility for an ACTIVE account, or replaces the
+// terms of its existing one. The limit applies to debits immediately. The
+// approver is the authenticated caller.
+func (s *OverdraftService) ApproveOverdraft(ctx context.Context, req models.ApproveOverdraftRequest) (commons.Response[models.OverdraftFacilityResponse], error) {
+	logger.Info("overdraft service approve request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		logger.Error("overdraft service approve validation failed", err, nil)
+		return commons.ErrorResponse[models.OverdraftFacilityResponse]("validation failed", err.Error()), err
+	}
+	approvedBy := commons.ActorFromContext(ctx)
+	if approvedBy == "" {
+		err := fmt.Errorf("an authenticated approver is required")
+		return commons.ErrorResponse[models.OverdraftFacilityResponse]("validation failed", err.Error()), err
+	}
+
+	dayCount := domain.DayCountConvention(strings.ToUpper(strings.TrimSpace(req.DayCount)))
+	if dayCount == "" {
+		dayCount = domain.DayCountActual365
+	}
+
+	facility, err := s.overdraftRepo.Approve(ctx, domain.OverdraftFacility{
+		AccountNumber:     strings.TrimSpace(req.AccountNumber),
+		Limit:             req.Limit,
+		AnnualRatePercent: req.AnnualRatePercent,
+		DayCount:          dayCount,
+		ApprovedBy:        approvedBy,
+		ExpiresAt:         req.ExpiresAt.UTC(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, commons.ErrRecordNotFound):
+			return commons.ErrorResponse[models.OverdraftFacilityResponse]("Account not found"), err
+		case strings.Contains(strings.ToLower(err.Error()), "account is not active"):
+			return commons.ErrorResponse[models.OverdraftFacilityResponse]("Account is not active", err.Error()), err
+		default:
+			logger.Error("overdraft service approve failed", err, logger.Fields{
+				"accountNumber": req.AccountNumber,
+			})
+			return commons.ErrorResponse[models.OverdraftFacilityResponse]("failed to approve overdraft", "Unable to approve overdraft right now"), err
+		}
+	}
+
+	logger.Info("overdraft service approve success", logger.Fields{
+		"accountNumber": facility.AccountNumber,
+		"limit":         facility.Limit,
+	})
+	return commons.SuccessResponse("overdraft approved successfully", mapOverdraftFacilityToResponse(facility)), nil
+}
+
+// GetOverdraft returns the facility of an account with its accrued interest.
+func (s *OverdraftService) GetOverdraft(ctx context.Context, req models.GetOverdraftRequest) (commons.Response[models.OverdraftFacilityResponse], error) {
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.OverdraftFacilityResponse]("validation failed", err.Error()), err
+	}
+
+	facility, err := s.overdraftRepo.GetByAccountNumber(ctx, strings.TrimSpace(req.AccountNumber))
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.OverdraftFacilityResponse]("Overdraft not found"), err
+		}
+		logger.Error("overdraft service get failed", err, logger.Fields{
+			"accountNumber": req.AccountNumber,
+		})
+		return commons.ErrorResponse[models.OverdraftFacilityResponse]("failed to fetch overdraft", "Unable to fetch overdraft right now"), err
+	}
+
+	return commons.SuccessResponse("overdraft fetched successfully", mapOverdraftFacilityToResponse(facility)), nil
+}
+
+// GetLimitBreaches lists the accounts drawn beyond their overdraft limit, the
+// largest excess first.
+func (s *OverdraftService) GetLimitBreaches(ctx context.Context) (commons.Response[[]models.OverdraftBreachResponse], error) {
+	positions, err := s.overdraftRepo.GetLimitBreaches(ctx)
+	if err != nil {
+		logger.Error("overdraft service get limit breaches failed", err, nil)
+		return commons.ErrorResponse[[]models.OverdraftBreachResponse]("failed to fetch overdraft breaches", "Unable to fetch overdraft breaches right now"), err
+	}
+
+	response := make([]models.OverdraftBreachResponse, 0, len(positions))
+	for _, position := range positions {
+		response = append(response, mapOverdraftBreachToResponse(position))
+	}
+	return commons.SuccessResponse("overdraft breaches fetched successfully", response), nil
+}
+
+// StartWorker suspends expired facilities, accrues overdraft interest for the
+// previous UTC day and reports limit breaches every interval until ctx is
+// cancelled. Accruals are keyed by account and date, so later ticks of the
+// same day record nothing new.
+func (s *OverdraftService) StartWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.SuspendExpired(ctx); err != nil {
+			logger.Error("overdraft suspension failed", err, nil)
+		}
+		yesterday := startOfDay(s.now().UTC()).AddDate(0, 0, -1)
+		if _, err := s.AccrueInterest(ctx, yesterday); err != nil {
+			logger.Error("overdraft interest accrual failed", err, logger.Fields{
+				"date": yesterday.Format(time.DateOnly),
+			})
+		}
+		s.reportBreaches(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SuspendExpired suspends every ACTIVE facility past its expiry and drops the
+// limit of its account to zero.
+func (s *OverdraftService) SuspendExpired(ctx context.Context) (int, error) {
+	suspended, err := s.overdraftRepo.SuspendExpired(ctx, s.now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	for _, facility := range suspended {
+		logger.Info("overdraft facility suspended", logger.Fields{
+			"accountNumber": facility.AccountNumber,
+			"expiresAt":     facility.ExpiresAt.Format(time.RFC3339),
+			"reason":        facility.SuspensionReason,
+		})
+	}
+	return len(suspended), nil
+}
+
+// AccrueInterest records one day of overdraft interest for every overdrawn
+// account with a facility, at the facility's rate. Suspended facilities keep
+// accruing until the balance is repaid. It returns the number of new accruals.
+func (s *OverdraftService) AccrueInterest(ctx context.Context, date time.Time) (int, error) {
+	date = startOfDay(date)
+	positions, err := s.overdraftRepo.GetOverdrawnPositions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	accruals := make([]domain.OverdraftInterestAccrual, 0, len(positions))
+	for _, position := range positions {
+		if position.FacilityStatus == "" || !position.AnnualRatePercent.IsPositive() || !position.LedgerBalance.IsNegative() {
+			continue
+		}
+		overdrawn := position.LedgerBalance.Neg()
+		accruals = append(accruals, domain.OverdraftInterestAccrual{
+			AccountNumber:     position.AccountNumber,
+			AccrualDate:       date,
+			Currency:          strings.ToUpper(position.Currency),
+			OverdrawnAmount:   overdrawn,
+			AnnualRatePercent: position.AnnualRatePercent,
+			DayCount:          position.DayCount,
+			Amount: overdrawn.
+				Mul(position.AnnualRatePercent).
+				Div(decimal.NewFromInt(100 * position.DayCount.DaysInYear())).
+				Round(8),
+		})
+	}
+
+	recorded, err := s.overdraftRepo.RecordInterestAccruals(ctx, accruals)
+	if err != nil {
+		return 0, err
+	}
+	if recorded > 0 {
+		logger.Info("overdraft interest accrued", logger.Fields{
+			"date":  date.Format(time.DateOnly),
+			"count": recorded,
+		})
+	}
+	return recorded, nil
+}
+
+func (s *OverdraftService) reportBreaches(ctx context.Context) {
+	positions, err := s.overdraftRepo.GetLimitBreaches(ctx)
+	if err != nil {
+		logger.Error("overdraft breach report failed", err, nil)
+		return
+	}
+	for _, position := range positions {
+		breach := mapOverdraftBreachToResponse(position)
+		logger.Info("overdraft limit breached", logger.Fields{
+			"accountNumber":  breach.AccountNumber,
+			"currency":       breach.Currency,
+			"overdraftLimit": breach.OverdraftLimit,
+			"excessAmount":   breach.ExcessAmount,
+		})
+	}
+}
+
+func mapOverdraftFacilityToResponse(facility domain.OverdraftFacility) models.OverdraftFacilityResponse {
+	response := models.OverdraftFacilityResponse{
+		AccountNumber:     facility.AccountNumber,
+		Currency:          facility.Currency,
+		Limit:             facility.Limit,
+		AnnualRatePercent: facility.AnnualRatePercent,
+		DayCount:          string(facility.DayCount),
+		Status:            string(facility.Status),
+		ApprovedBy:        facility.ApprovedBy,
+		ExpiresAt:         facility.ExpiresAt.Format(time.RFC3339),
+		SuspensionReason:  facility.SuspensionReason,
+		AccruedInterest:   facility.AccruedInterest.Round(2),
+		CreatedAt:         facility.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         facility.UpdatedAt.Format(time.RFC3339),
+	}
+	if facility.SuspendedAt != nil {
+		response.SuspendedAt = facility.SuspendedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func mapOverdraftBreachToResponse(position domain.OverdraftPosition) models.OverdraftBreachResponse {
+	response := models.OverdraftBreachResponse{
+		AccountNumber:    position.AccountNumber,
+		Currency:         position.Currency,
+		AvailableBalance: position.AvailableBalance,
+		LedgerBalance:    position.LedgerBalance,
+		OverdraftLimit:   position.OverdraftLimit,
+		ExcessAmount:     position.AvailableBalance.Add(position.OverdraftLimit).Neg(),
+		FacilityStatus:   string(position.FacilityStatus),
+	}
+	if position.ExpiresAt != nil {
+		response.ExpiresAt = position.ExpiresAt.Format(time.RFC3339)
+	}
+	return response
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+)
+
+// piiReencryptionBatchSize bounds the rows re-encrypted per transaction.
+const piiReencryptionBatchSize = 200
+
+// PIIKeyRotationService moves stored customer PII onto the current
+// key-encryption key after a rotation, and encrypts rows written before
+// encryption was introduced.
+type PIIKeyRotationService struct {
+	rotationRepo repo_interfaces.PIIKeyRotationRepository
+}
+
+func NewPIIKeyRotationService(rotationRepo repo_interfaces.PIIKeyRotationRepository) *PIIKeyRotationService {
+	return &PIIKeyRotationService{rotationRepo: rotationRepo}
+}
+
+// StartWorker re-encrypts stale rows every interval until ctx is cancelled.
+func (s *PIIKeyRotationService) StartWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := s.ReencryptStale(ctx); err != nil {
+			logger.Error("pii re-encryption batch failed", err, nil)
+		}
+	}
+}
+
+// ReencryptStale re-encrypts every customer and profile change not under the
+// current key version and returns how many rows it rewrote.
+func (s *PIIKeyRotationService) ReencryptStale(ctx context.Context) (int, error) {
+	total := 0
+	for _, reencrypt := range []func(context.Context, int) (int, error){
+		s.rotationRepo.ReencryptUsers,
+		s.rotationRepo.ReencryptProfileChanges,
+	} {
+		for {
+			count, err := reencrypt(ctx, piiReencryptionBatchSize)
+			if err != nil {
+				return total, err
+			}
+			total += count
+			if count < piiReencryptionBatchSize {
+				break
+			}
+		}
+	}
+
+	if total > 0 {
+		logger.Info("pii re-encryption completed", logger.Fields{
+			"rows": total,
+		})
+	}
+	return total, nil
+}
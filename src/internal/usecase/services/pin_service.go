@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const pinNotLockedMessage = "PIN is not locked"
+
+// Verify that PinService implements the service_interfaces.PinService interface
+var _ service_interfaces.PinService = (*PinService)(nil)
+
+// PinService manages transaction PINs after onboarding: changing a known PIN,
+// resetting a forgotten one through an OTP challenge, and lifting lockouts.
+// Verification and the lockout itself stay in UserService.VerifyUserPin.
+type PinService struct {
+	userRepo    domain.UserRepository
+	userService service_interfaces.UserService
+	otpService  service_interfaces.OTPService
+	pinPolicy   domain.PinPolicy
+	now         func() time.Time
+}
+
+func NewPinService(
+	userRepo domain.UserRepository,
+	userService service_interfaces.UserService,
+	otpService service_interfaces.OTPService,
+	pinPolicy domain.PinPolicy,
+) *PinService {
+	return &PinService{
+		userRepo:    userRepo,
+		userService: userService,
+		otpService:  otpService,
+		pinPolicy:   pinPolicy,
+		now:         time.Now,
+	}
+}
+
+// ChangePin replaces the PIN once the old one verifies. A locked PIN cannot be
+// changed; it has to be reset or unlocked first.
+func (s *PinService) ChangePin(ctx context.Context, req models.ChangePinRequest) (commons.Response[models.PinStatusResponse], error) {
+	logger.Info("pin service change pin request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.PinStatusResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	verifyResp, err := s.userService.VerifyUserPin(ctx, customerID, req.OldPin)
+	if err != nil {
+		switch verifyResp.Message {
+		case "invalid pin", "pin locked", "User not found":
+			return commons.ErrorResponse[models.PinStatusResponse](verifyResp.Message, verifyResp.Errors...), err
+		default:
+			return commons.ErrorResponse[models.PinStatusResponse]("failed to change pin", "Unable to change pin right now"), err
+		}
+	}
+
+	return s.replacePin(ctx, customerID, req.NewPin, domain.PinChangeReasonChange, "PIN changed successfully")
+}
+
+// RequestPinReset sends a PIN_RESET code to the customer's phone number.
+func (s *PinService) RequestPinReset(ctx context.Context, req models.RequestPinResetRequest) (commons.Response[models.OTPChallengeResponse], error) {
+	logger.Info("pin service request pin reset", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.OTPChallengeResponse]("validation failed", err.Error()), err
+	}
+
+	challenge, err := s.otpService.Issue(ctx, strings.TrimSpace(req.CustomerID), domain.OTPPurposePinReset, "")
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.OTPChallengeResponse]("User not found"), err
+		}
+		logger.Error("pin service request pin reset failed", err, logger.Fields{
+			"customerId": req.CustomerID,
+		})
+		return commons.ErrorResponse[models.OTPChallengeResponse]("failed to request pin reset", "Unable to send a reset code right now"), err
+	}
+
+	return commons.SuccessResponse("PIN reset code sent", mapOTPChallengeToResponse(challenge)), nil
+}
+
+// ResetPin replaces a forgotten PIN with the code from RequestPinReset. It is
+// also the customer's own way out of a lockout.
+func (s *PinService) ResetPin(ctx context.Context, req models.ResetPinRequest) (commons.Response[models.PinStatusResponse], error) {
+	logger.Info("pin service reset pin request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.PinStatusResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	if _, err := s.otpService.Verify(ctx, customerID, req.ChallengeID, domain.OTPPurposePinReset, req.OTP); err != nil {
+		if errors.Is(err, commons.ErrInvalidOTP) {
+			return commons.ErrorResponse[models.PinStatusResponse]("invalid otp", err.Error()), err
+		}
+		logger.Error("pin service reset pin otp verification failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.PinStatusResponse]("failed to reset pin", "Unable to reset pin right now"), err
+	}
+
+	return s.replacePin(ctx, customerID, req.NewPin, domain.PinChangeReasonReset, "PIN reset successfully")
+}
+
+// UnlockPin lifts a current lockout on behalf of an operator.
+func (s *PinService) UnlockPin(ctx context.Context, req models.UnlockPinRequest) (commons.Response[models.PinStatusResponse], error) {
+	logger.Info("pin service unlock pin request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.PinStatusResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	state, err := s.userRepo.GetPinState(ctx, customerID)
+	if err != nil {
+		return s.pinStateLookupError(err, customerID, "failed to unlock pin")
+	}
+	if !state.LockedAt(s.now()) {
+		err := fmt.Errorf("customer %s has no active pin lock", customerID)
+		return commons.ErrorResponse[models.PinStatusResponse](pinNotLockedMessage, err.Error()), err
+	}
+
+	state, err = s.userRepo.UnlockPin(ctx, customerID, strings.TrimSpace(req.UnlockedBy), strings.TrimSpace(req.Comment))
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.PinStatusResponse](pinNotLockedMessage, "the pin lock lapsed before it was lifted"), err
+		}
+		logger.Error("pin service unlock pin failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.PinStatusResponse]("failed to unlock pin", "Unable to unlock pin right now"), err
+	}
+
+	logger.Info("pin service unlock pin success", logger.Fields{
+		"customerId": customerID,
+		"unlockedBy": req.UnlockedBy,
+	})
+	return commons.SuccessResponse("PIN unlocked successfully", s.mapPinStateToResponse(state)), nil
+}
+
+func (s *PinService) GetPinStatus(ctx context.Context, req models.GetPinStatusRequest) (commons.Response[models.PinStatusResponse], error) {
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.PinStatusResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	state, err := s.userRepo.GetPinState(ctx, customerID)
+	if err != nil {
+		return s.pinStateLookupError(err, customerID, "failed to fetch pin status")
+	}
+
+	return commons.SuccessResponse("PIN status fetched successfully", s.mapPinStateToResponse(state)), nil
+}
+
+func (s *PinService) replacePin(ctx context.Context, customerID string, newPin string, reason domain.PinChangeReason, successMessage string) (commons.Response[models.PinStatusResponse], error) {
+	pinHash, err := hashTransactionPin(strings.TrimSpace(newPin))
+	if err != nil {
+		logger.Error("pin service hash pin failed", err, nil)
+		return commons.ErrorResponse[models.PinStatusResponse]("failed to update pin", "failed to hash transaction pin"), err
+	}
+
+	state, err := s.userRepo.UpdatePinHash(ctx, customerID, pinHash, reason)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.PinStatusResponse]("User not found"), err
+		}
+		logger.Error("pin service update pin failed", err, logger.Fields{
+			"customerId": customerID,
+			"reason":     reason,
+		})
+		return commons.ErrorResponse[models.PinStatusResponse]("failed to update pin", "Unable to update pin right now"), err
+	}
+
+	logger.Info("pin service pin replaced", logger.Fields{
+		"customerId": customerID,
+		"reason":     reason,
+	})
+	return commons.SuccessResponse(successMessage, s.mapPinStateToResponse(state)), nil
+}
+
+func (s *PinService) pinStateLookupError(err error, customerID string, failureMessage string) (commons.Response[models.PinStatusResponse], error) {
+	if errors.Is(err, commons.ErrRecordNotFound) {
+		return commons.ErrorResponse[models.PinStatusResponse]("User not found"), err
+	}
+	logger.Error("pin service get pin state failed", err, logger.Fields{
+		"customerId": customerID,
+	})
+	return commons.ErrorResponse[models.PinStatusResponse](failureMessage, "Unable to fetch pin state right now"), err
+}
+
+func (s *PinService) mapPinStateToResponse(state domain.PinState) models.PinStatusResponse {
+	response := models.PinStatusResponse{
+		CustomerID:        state.CustomerID,
+		FailedAttempts:    state.FailedAttempts,
+		RemainingAttempts: s.pinPolicy.MaxFailedAttempts - state.FailedAttempts,
+	}
+	if state.LockedAt(s.now()) {
+		response.Locked = true
+		response.LockedUntil = state.LockedUntil.UTC().Format(time.RFC3339)
+		response.RemainingAttempts = 0
+	}
+	if state.PinChangedAt != nil {
+		response.PinChangedAt = state.PinChangedAt.UTC().Format(time.RFC3339)
+	}
+	return response
+}
+
+func mapOTPChallengeToResponse(challenge domain.OTPChallenge) models.OTPChallengeResponse {
+	return models.OTPChallengeResponse{
+		ChallengeID: challenge.ID,
+		Purpose:     string(challenge.Purpose),
+		ExpiresAt:   challenge.ExpiresAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// verifyTransactionPIN checks the transaction PIN of a debit. Every debit path
+// uses it so they refuse a locked PIN and a wrong PIN the same way; other
+// verification failures are reported with failureMessage and failureDetail.
+func verifyTransactionPIN[T any](
+	ctx context.Context,
+	userService service_interfaces.UserService,
+	customerID string,
+	pin string,
+	failureMessage string,
+	failureDetail string,
+) (commons.Response[T], error) {
+	pinVerificationResp, pinVerificationErr := userService.VerifyUserPin(ctx, customerID, strings.TrimSpace(pin))
+	if pinVerificationErr != nil {
+		if errors.Is(pinVerificationErr, commons.ErrPinLocked) {
+			return commons.ErrorResponse[T]("pin locked", pinVerificationResp.Errors...), pinVerificationErr
+		}
+		if errors.Is(pinVerificationErr, commons.ErrInvalidPin) {
+			err := fmt.Errorf("invalid transactionPIN")
+			return commons.ErrorResponse[T]("validation failed", err.Error()), err
+		}
+		return commons.ErrorResponse[T](failureMessage, failureDetail), pinVerificationErr
+	}
+	if pinVerificationResp.Data == nil || !pinVerificationResp.Data.IsValidPin {
+		err := fmt.Errorf("invalid transactionPIN")
+		return commons.ErrorResponse[T]("validation failed", err.Error()), err
+	}
+	return commons.Response[T]{}, nil
+}
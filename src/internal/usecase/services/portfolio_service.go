@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+	"github.com/shopspring/decimal"
+)
+
+const defaultPortfolioBaseCurrency = "USD"
+
+// Verify that PortfolioService implements the service_interfaces.PortfolioService interface
+var _ service_interfaces.PortfolioService = (*PortfolioService)(nil)
+
+// PortfolioService lists a customer's accounts and consolidates their balances
+// into one base currency at the current rates.
+type PortfolioService struct {
+	userRepo    domain.UserRepository
+	accountRepo repo_interfaces.AccountRepository
+	holdRepo    repo_interfaces.AccountHoldRepository
+	rateService service_interfaces.RateService
+}
+
+func NewPortfolioService(
+	userRepo domain.UserRepository,
+	accountRepo repo_interfaces.AccountRepository,
+	holdRepo repo_interfaces.AccountHoldRepository,
+	rateService service_interfaces.RateService,
+) *PortfolioService {
+	return &PortfolioService{
+		userRepo:    userRepo,
+		accountRepo: accountRepo,
+		holdRepo:    holdRepo,
+		rateService: rateService,
+	}
+}
+
+type portfolioRate struct {
+	rate     decimal.Decimal
+	rateDate string
+}
+
+// GetCustomerAccounts returns every account of the customer with its active
+// holds and its balances converted into the base currency (USD when none is
+// given). Each currency is converted once, so accounts in the same currency
+// share a rate and rate date.
+func (s *PortfolioService) GetCustomerAccounts(ctx context.Context, req models.GetCustomerAccountsRequest) (commons.Response[models.CustomerAccountsResponse], error) {
+	logger.Info("portfolio service get customer accounts request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		logger.Error("portfolio service get customer accounts validation failed", err, nil)
+		return commons.ErrorResponse[models.CustomerAccountsResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	baseCurrency := strings.ToUpper(strings.TrimSpace(req.BaseCurrency))
+	if baseCurrency == "" {
+		baseCurrency = defaultPortfolioBaseCurrency
+	}
+
+	if err := authorizeAccountAccess(ctx, customerID); err != nil {
+		logger.Info("portfolio service customer access denied", logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.CustomerAccountsResponse](err.Error()), err
+	}
+
+	if _, err := s.userRepo.GetByCustomerID(ctx, customerID); err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.CustomerAccountsResponse]("Customer not found"), err
+		}
+		logger.Error("portfolio service customer lookup failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.CustomerAccountsResponse]("failed to fetch accounts", "Unable to fetch accounts right now"), err
+	}
+
+	accounts, err := s.accountRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		logger.Error("portfolio service get accounts failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.CustomerAccountsResponse]("failed to fetch accounts", "Unable to fetch accounts right now"), err
+	}
+
+	response := models.CustomerAccountsResponse{
+		CustomerID:            customerID,
+		BaseCurrency:          baseCurrency,
+		Accounts:              make([]models.PortfolioAccountResponse, 0, len(accounts)),
+		TotalAvailableBalance: decimal.Zero,
+		TotalLedgerBalance:    decimal.Zero,
+	}
+	rates := make(map[string]portfolioRate)
+	for _, account := range accounts {
+		currency := strings.ToUpper(strings.TrimSpace(account.Currency))
+		rate, ok := rates[currency]
+		if !ok {
+			// ConvertRate needs a positive amount; a unit amount yields the rate itself.
+			_, usedRate, rateDate, err := s.rateService.ConvertRate(ctx, decimal.NewFromInt(1), currency, baseCurrency)
+			if err != nil {
+				logger.Error("portfolio service rate lookup failed", err, logger.Fields{
+					"currency":     currency,
+					"baseCurrency": baseCurrency,
+				})
+				if errors.Is(err, commons.ErrRecordNotFound) {
+					return commons.ErrorResponse[models.CustomerAccountsResponse]("Rate not found"), err
+				}
+				return commons.ErrorResponse[models.CustomerAccountsResponse]("failed to fetch accounts", "Unable to fetch accounts right now"), err
+			}
+			rate = portfolioRate{rate: usedRate, rateDate: rateDate}
+			rates[currency] = rate
+		}
+
+		holds, err := s.holdRepo.GetByAccountNumber(ctx, account.AccountNumber)
+		if err != nil {
+			logger.Error("portfolio service get holds failed", err, logger.Fields{
+				"accountNumber": account.AccountNumber,
+			})
+			return commons.ErrorResponse[models.CustomerAccountsResponse]("failed to fetch accounts", "Unable to fetch accounts right now"), err
+		}
+
+		entry := toPortfolioAccountResponse(account, holds, rate)
+		response.TotalAvailableBalance = response.TotalAvailableBalance.Add(entry.BaseAvailableBalance)
+		response.TotalLedgerBalance = response.TotalLedgerBalance.Add(entry.BaseLedgerBalance)
+		response.Accounts = append(response.Accounts, entry)
+	}
+
+	logger.Info("portfolio service get customer accounts success", logger.Fields{
+		"customerId":            customerID,
+		"count":                 len(response.Accounts),
+		"baseCurrency":          baseCurrency,
+		"totalAvailableBalance": response.TotalAvailableBalance,
+	})
+
+	return commons.SuccessResponse("accounts fetched successfully", response), nil
+}
+
+func toPortfolioAccountResponse(account domain.Account, holds []domain.AccountHold, rate portfolioRate) models.PortfolioAccountResponse {
+	activeHolds := make([]models.AccountHoldResponse, 0)
+	activeHoldAmount := decimal.Zero
+	for _, hold := range holds {
+		if hold.Status != domain.AccountHoldStatusActive {
+			continue
+		}
+		activeHolds = append(activeHolds, mapAccountHoldToResponse(hold))
+		activeHoldAmount = activeHoldAmount.Add(hold.Amount)
+	}
+
+	return models.PortfolioAccountResponse{
+		AccountNumber:        account.AccountNumber,
+		Currency:             account.Currency,
+		Status:               string(account.Status),
+		AvailableBalance:     account.AvailableBalance,
+		LedgerBalance:        account.LedgerBalance,
+		ActiveHoldAmount:     activeHoldAmount,
+		ActiveHolds:          activeHolds,
+		Rate:                 rate.rate,
+		RateDate:             rate.rateDate,
+		BaseAvailableBalance: account.AvailableBalance.Mul(rate.rate).Round(2),
+		BaseLedgerBalance:    account.LedgerBalance.Mul(rate.rate).Round(2),
+	}
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	ReferenceSchemeEXT = "EXT"
+	// ReferenceSchemeNIP follows the NIBSS session ID layout: the sending bank
+	// code, the timestamp and a 12-digit unique part, 30 digits in total.
+	ReferenceSchemeNIP = "NIP"
+
+	maxReferenceNodeID   = 999
+	maxReferenceSequence = 999_999
+)
+
+// Verify that ReferenceGenerator implements the service_interfaces.ReferenceGenerator interface
+var _ service_interfaces.ReferenceGenerator = (*ReferenceGenerator)(nil)
+
+// ReferenceGenerator builds references from a millisecond timestamp, the node ID
+// of this instance and a per-millisecond sequence. References are unique as long
+// as every instance runs with its own node ID, and sort by creation time.
+type ReferenceGenerator struct {
+	nodeID          int
+	bankCode        string
+	externalScheme  string
+	channelPrefixes map[string]string
+	now             func() time.Time
+
+	mu         sync.Mutex
+	lastMillis int64
+	sequence   int
+}
+
+func NewReferenceGenerator(nodeID int, bankCode string, externalScheme string, channelPrefixes map[string]string) (*ReferenceGenerator, error) {
+	if nodeID < 0 || nodeID > maxReferenceNodeID {
+		return nil, fmt.Errorf("reference node ID must be between 0 and %d", maxReferenceNodeID)
+	}
+	bankCode = strings.TrimSpace(bankCode)
+	externalScheme = strings.ToUpper(strings.TrimSpace(externalScheme))
+	switch externalScheme {
+	case ReferenceSchemeEXT:
+	case ReferenceSchemeNIP:
+		if len(bankCode) != 6 {
+			return nil, fmt.Errorf("NIP references require a 6-digit bank code")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported external reference scheme %q", externalScheme)
+	}
+
+	prefixes := make(map[string]string, len(channelPrefixes))
+	for channel, prefix := range channelPrefixes {
+		prefixes[strings.TrimSpace(channel)] = strings.ToUpper(strings.TrimSpace(prefix))
+	}
+
+	return &ReferenceGenerator{
+		nodeID:          nodeID,
+		bankCode:        bankCode,
+		externalScheme:  externalScheme,
+		channelPrefixes: prefixes,
+		now:             time.Now,
+	}, nil
+}
+
+// TransactionReference returns the channel prefix followed by 26 digits:
+// yyyyMMddHHmmssSSS, the 3-digit node ID and a 6-digit sequence.
+func (g *ReferenceGenerator) TransactionReference(channel string) string {
+	at, sequence := g.next()
+	return g.channelPrefixes[strings.TrimSpace(channel)] +
+		at.Format("20060102150405") + fmt.Sprintf("%03d%03d%06d", at.Nanosecond()/int(time.Millisecond), g.nodeID, sequence)
+}
+
+// ExternalReference returns a reference in the configured scheme format.
+func (g *ReferenceGenerator) ExternalReference() string {
+	at, sequence := g.next()
+	unique := fmt.Sprintf("%03d%03d%06d", at.Nanosecond()/int(time.Millisecond), g.nodeID, sequence)
+
+	if g.externalScheme == ReferenceSchemeNIP {
+		return g.bankCode + at.Format("060102150405") + unique
+	}
+	return ReferenceSchemeEXT + at.Format("060102150405") + unique
+}
+
+// next reserves a (millisecond, sequence) pair. The clock never moves backwards
+// from the generator's point of view, and a full millisecond rolls over into the
+// next one instead of wrapping the sequence.
+func (g *ReferenceGenerator) next() (time.Time, int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	millis := g.now().UTC().UnixMilli()
+	if millis > g.lastMillis {
+		g.lastMillis = millis
+		g.sequence = 0
+	} else {
+		g.sequence++
+		if g.sequence > maxReferenceSequence {
+			g.lastMillis++
+			g.sequence = 0
+		}
+	}
+	return time.UnixMilli(g.lastMillis).UTC(), g.sequence
+}
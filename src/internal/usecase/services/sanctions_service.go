@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+// sanctionsListUnavailable is recorded as the list version when no list could be
+// loaded; such screenings are held rather than cleared.
+const sanctionsListUnavailable = "UNAVAILABLE"
+
+// Verify that SanctionsService implements the service_interfaces.SanctionsScreener interface
+var _ service_interfaces.SanctionsScreener = (*SanctionsService)(nil)
+
+type SanctionsService struct {
+	listSource      repo_interfaces.SanctionsListSource
+	screeningRepo   repo_interfaces.SanctionsScreeningRepository
+	matchThreshold  float64
+	refreshInterval time.Duration
+
+	mu       sync.Mutex
+	version  string
+	index    []sanctionsIndexEntry
+	loadedAt time.Time
+}
+
+type sanctionsIndexEntry struct {
+	entryID string
+	name    string
+	tokens  []string
+}
+
+func NewSanctionsService(
+	listSource repo_interfaces.SanctionsListSource,
+	screeningRepo repo_interfaces.SanctionsScreeningRepository,
+	matchThreshold float64,
+	refreshInterval time.Duration,
+) *SanctionsService {
+	return &SanctionsService{
+		listSource:      listSource,
+		screeningRepo:   screeningRepo,
+		matchThreshold:  matchThreshold,
+		refreshInterval: refreshInterval,
+	}
+}
+
+func (s *SanctionsService) ScreenName(ctx context.Context, subjectType domain.SanctionsSubjectType, subjectID string, name string) (domain.SanctionsScreening, error) {
+	logger.Info("sanctions service screen name request", logger.Fields{
+		"subjectType": subjectType,
+		"subjectId":   subjectID,
+	})
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.SanctionsScreening{}, fmt.Errorf("name is required")
+	}
+
+	screening := domain.SanctionsScreening{
+		SubjectType:  subjectType,
+		SubjectID:    subjectID,
+		ScreenedName: name,
+		Decision:     domain.SanctionsDecisionClear,
+	}
+
+	version, index, err := s.loadIndex(ctx)
+	if err != nil {
+		logger.Error("sanctions service list unavailable, holding subject", err, logger.Fields{
+			"subjectType": subjectType,
+			"subjectId":   subjectID,
+		})
+		screening.ListVersion = sanctionsListUnavailable
+		screening.Decision = domain.SanctionsDecisionPotentialMatch
+	} else {
+		screening.ListVersion = version
+		tokens := normalizeNameTokens(name)
+		for _, entry := range index {
+			score := nameMatchScore(tokens, entry.tokens)
+			if score <= screening.MatchScore {
+				continue
+			}
+			screening.MatchScore = score
+			if score >= s.matchThreshold {
+				screening.Decision = domain.SanctionsDecisionPotentialMatch
+				screening.MatchedEntryID = stringPtr(entry.entryID)
+				screening.MatchedName = stringPtr(entry.name)
+			}
+		}
+	}
+
+	saved, err := s.screeningRepo.SaveScreening(ctx, screening)
+	if err != nil {
+		return domain.SanctionsScreening{}, err
+	}
+
+	logger.Info("sanctions service screen name result", logger.Fields{
+		"subjectType": subjectType,
+		"subjectId":   subjectID,
+		"decision":    saved.Decision,
+		"listVersion": saved.ListVersion,
+	})
+	return saved, nil
+}
+
+// loadIndex returns the normalized name index, rebuilding it from the list
+// source once the refresh interval has passed. A failed reload keeps the
+// previous list in service.
+func (s *SanctionsService) loadIndex(ctx context.Context) (string, []sanctionsIndexEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < s.refreshInterval {
+		return s.version, s.index, nil
+	}
+
+	list, err := s.listSource.LoadList(ctx)
+	if err != nil {
+		if s.loadedAt.IsZero() {
+			return "", nil, err
+		}
+		logger.Error("sanctions service reload list failed, keeping previous list", err, logger.Fields{
+			"listVersion": s.version,
+		})
+		return s.version, s.index, nil
+	}
+
+	index := make([]sanctionsIndexEntry, 0, len(list.Entries))
+	for _, entry := range list.Entries {
+		for _, name := range entry.Names {
+			tokens := normalizeNameTokens(name)
+			if len(tokens) == 0 {
+				continue
+			}
+			index = append(index, sanctionsIndexEntry{entryID: entry.ID, name: name, tokens: tokens})
+		}
+	}
+
+	s.version = list.Version
+	s.index = index
+	s.loadedAt = time.Now()
+	return s.version, s.index, nil
+}
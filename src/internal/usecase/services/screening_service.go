@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+	"github.com/shopspring/decimal"
+)
+
+// Verify that ScreeningService implements the service_interfaces.TransferScreener interface
+var _ service_interfaces.TransferScreener = (*ScreeningService)(nil)
+
+type ScreeningService struct {
+	ruleSource      repo_interfaces.ScreeningRuleSource
+	screeningRepo   repo_interfaces.ScreeningRepository
+	rateService     service_interfaces.RateService
+	refreshInterval time.Duration
+	reviewScore     int
+	blockScore      int
+
+	mu       sync.Mutex
+	rules    []domain.ScreeningRule
+	loadedAt time.Time
+}
+
+// NewScreeningService builds the screening engine. Rules are re-read from
+// ruleSource once refreshInterval has passed; a total score at or above
+// reviewScore/blockScore escalates the decision even if no single rule did.
+// A zero threshold disables that escalation.
+func NewScreeningService(
+	ruleSource repo_interfaces.ScreeningRuleSource,
+	screeningRepo repo_interfaces.ScreeningRepository,
+	rateService service_interfaces.RateService,
+	refreshInterval time.Duration,
+	reviewScore int,
+	blockScore int,
+) *ScreeningService {
+	return &ScreeningService{
+		ruleSource:      ruleSource,
+		screeningRepo:   screeningRepo,
+		rateService:     rateService,
+		refreshInterval: refreshInterval,
+		reviewScore:     reviewScore,
+		blockScore:      blockScore,
+	}
+}
+
+func (s *ScreeningService) ScreenTransfer(ctx context.Context, input domain.ScreeningInput) (domain.TransferScreening, error) {
+	logger.Info("screening service screen transfer request", logger.Fields{
+		"transferId":         input.TransferID,
+		"debitAccountNumber": input.DebitAccountNumber,
+	})
+
+	screening := domain.TransferScreening{
+		TransferID: input.TransferID,
+		Decision:   domain.ScreeningDecisionAllow,
+		Results:    make([]domain.ScreeningResult, 0),
+	}
+
+	rules, err := s.loadRules(ctx)
+	if err != nil {
+		// Without rules nothing can be cleared automatically; hold for a human.
+		screening.Results = append(screening.Results, domain.ScreeningResult{
+			RuleID:   "rules-unavailable",
+			Decision: domain.ScreeningDecisionReview,
+			Reason:   "screening rules could not be loaded",
+		})
+	}
+
+	usdRate, rateErr := s.usdRate(ctx, input.DebitCurrency)
+	now := time.Now().UTC()
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		triggered, reason, evalErr := s.evaluateRule(ctx, rule, input, usdRate, rateErr, now)
+		result := domain.ScreeningResult{
+			RuleID:   rule.ID,
+			RuleType: rule.Type,
+			Decision: domain.ScreeningDecisionAllow,
+			Reason:   "within limits",
+		}
+		switch {
+		case evalErr != nil:
+			logger.Error("screening service rule evaluation failed", evalErr, logger.Fields{
+				"transferId": input.TransferID,
+				"ruleId":     rule.ID,
+			})
+			result.Decision = domain.ScreeningDecisionReview
+			result.Reason = "rule could not be evaluated"
+		case triggered:
+			result.Decision = rule.Action
+			result.Score = rule.Score
+			result.Reason = reason
+		}
+		screening.Results = append(screening.Results, result)
+	}
+
+	for _, result := range screening.Results {
+		screening.TotalScore += result.Score
+		if result.Decision.Severity() > screening.Decision.Severity() {
+			screening.Decision = result.Decision
+		}
+	}
+	if s.blockScore > 0 && screening.TotalScore >= s.blockScore {
+		screening.Decision = domain.ScreeningDecisionBlock
+	} else if s.reviewScore > 0 && screening.TotalScore >= s.reviewScore && screening.Decision == domain.ScreeningDecisionAllow {
+		screening.Decision = domain.ScreeningDecisionReview
+	}
+
+	saved, err := s.screeningRepo.SaveScreening(ctx, screening)
+	if err != nil {
+		return domain.TransferScreening{}, err
+	}
+
+	logger.Info("screening service screen transfer result", logger.Fields{
+		"transferId": input.TransferID,
+		"decision":   saved.Decision,
+		"totalScore": saved.TotalScore,
+	})
+	return saved, nil
+}
+
+func (s *ScreeningService) evaluateRule(
+	ctx context.Context,
+	rule domain.ScreeningRule,
+	input domain.ScreeningInput,
+	usdRate decimal.Decimal,
+	rateErr error,
+	now time.Time,
+) (bool, string, error) {
+	params := rule.Params
+	since := now.Add(-time.Duration(params.WindowMinutes) * time.Minute)
+
+	switch rule.Type {
+	case domain.ScreeningRuleVelocity:
+		if rateErr != nil && params.MaxAmountUSD.GreaterThan(decimal.Zero) {
+			return false, "", rateErr
+		}
+		count, total, err := s.screeningRepo.GetOutgoingTransferStats(ctx, input.DebitAccountNumber, since, input.TransferID)
+		if err != nil {
+			return false, "", err
+		}
+		count++
+		total = total.Add(input.DebitAmount)
+		if params.MaxCount > 0 && count > params.MaxCount {
+			return true, fmt.Sprintf("%d transfers in %d minutes exceeds %d", count, params.WindowMinutes, params.MaxCount), nil
+		}
+		if params.MaxAmountUSD.GreaterThan(decimal.Zero) && total.Mul(usdRate).GreaterThan(params.MaxAmountUSD) {
+			return true, fmt.Sprintf("%s USD sent in %d minutes exceeds %s USD", total.Mul(usdRate).StringFixed(2), params.WindowMinutes, params.MaxAmountUSD.String()), nil
+		}
+		return false, "", nil
+
+	case domain.ScreeningRuleNewBeneficiaryLarge:
+		if rateErr != nil {
+			return false, "", rateErr
+		}
+		amountUSD := input.DebitAmount.Mul(usdRate)
+		if amountUSD.LessThan(params.ThresholdUSD) {
+			return false, "", nil
+		}
+		seen, err := s.screeningRepo.HasPriorTransferToBeneficiary(ctx, input.DebitAccountNumber, input.CreditAccountNumber, input.BeneficiaryBankCode, input.TransferID)
+		if err != nil {
+			return false, "", err
+		}
+		if !seen {
+			return true, fmt.Sprintf("first transfer to beneficiary is %s USD, at or above %s USD", amountUSD.StringFixed(2), params.ThresholdUSD.String()), nil
+		}
+		return false, "", nil
+
+	case domain.ScreeningRuleRoundAmountStructuring:
+		if params.RoundUnit.LessThanOrEqual(decimal.Zero) || !input.DebitAmount.Mod(params.RoundUnit).IsZero() {
+			return false, "", nil
+		}
+		count, err := s.screeningRepo.CountRoundAmountTransfers(ctx, input.DebitAccountNumber, since, params.RoundUnit, input.TransferID)
+		if err != nil {
+			return false, "", err
+		}
+		count++
+		if params.MinCount > 0 && count >= params.MinCount {
+			return true, fmt.Sprintf("%d round-amount transfers (multiples of %s) in %d minutes", count, params.RoundUnit.String(), params.WindowMinutes), nil
+		}
+		return false, "", nil
+
+	case domain.ScreeningRuleRapidInOut:
+		if rateErr != nil {
+			return false, "", rateErr
+		}
+		inbound, err := s.screeningRepo.GetInboundCreditTotal(ctx, input.DebitAccountNumber, since)
+		if err != nil {
+			return false, "", err
+		}
+		if inbound.LessThanOrEqual(decimal.Zero) || inbound.Mul(usdRate).LessThan(params.ThresholdUSD) {
+			return false, "", nil
+		}
+		if input.DebitAmount.GreaterThanOrEqual(inbound.Mul(params.InOutRatio)) {
+			return true, fmt.Sprintf("sending %s of %s %s received in the last %d minutes", input.DebitAmount.String(), inbound.String(), input.DebitCurrency, params.WindowMinutes), nil
+		}
+		return false, "", nil
+
+	case domain.ScreeningRuleDormantReactivation:
+		if params.DormantDays <= 0 {
+			return false, "", nil
+		}
+		last, err := s.screeningRepo.GetLastOutgoingTransferAt(ctx, input.DebitAccountNumber, input.TransferID)
+		if err != nil {
+			return false, "", err
+		}
+		reference := input.AccountOpenedAt
+		if last != nil {
+			reference = *last
+		}
+		if reference.IsZero() {
+			return false, "", nil
+		}
+		idle := now.Sub(reference)
+		if idle >= time.Duration(params.DormantDays)*24*time.Hour {
+			return true, fmt.Sprintf("no outgoing activity for %d days", int(idle.Hours()/24)), nil
+		}
+		return false, "", nil
+
+	default:
+		return false, "", fmt.Errorf("unsupported screening rule type %q", rule.Type)
+	}
+}
+
+// loadRules returns the cached rule set, reloading it once the refresh interval
+// has passed. A failed reload keeps serving the last good rules.
+func (s *ScreeningService) loadRules(ctx context.Context) ([]domain.ScreeningRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < s.refreshInterval {
+		return s.rules, nil
+	}
+
+	rules, err := s.ruleSource.LoadRules(ctx)
+	if err != nil {
+		if s.loadedAt.IsZero() {
+			return nil, err
+		}
+		logger.Error("screening service reload rules failed, keeping previous rules", err, nil)
+		return s.rules, nil
+	}
+
+	valid := make([]domain.ScreeningRule, 0, len(rules))
+	for _, rule := range rules {
+		if err := validateScreeningRule(rule); err != nil {
+			logger.Error("screening service skipping invalid rule", err, logger.Fields{"ruleId": rule.ID})
+			continue
+		}
+		valid = append(valid, rule)
+	}
+
+	s.rules = valid
+	s.loadedAt = time.Now()
+	return s.rules, nil
+}
+
+func (s *ScreeningService) usdRate(ctx context.Context, currency string) (decimal.Decimal, error) {
+	if strings.EqualFold(strings.TrimSpace(currency), "USD") {
+		return decimal.NewFromInt(1), nil
+	}
+	_, rate, _, err := s.rateService.ConvertRate(ctx, decimal.NewFromInt(1), currency, "USD")
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return rate, nil
+}
+
+func validateScreeningRule(rule domain.ScreeningRule) error {
+	if strings.TrimSpace(rule.ID) == "" {
+		return fmt.Errorf("rule id is required")
+	}
+	switch rule.Type {
+	case domain.ScreeningRuleVelocity, domain.ScreeningRuleRoundAmountStructuring, domain.ScreeningRuleRapidInOut:
+		if rule.Params.WindowMinutes <= 0 {
+			return fmt.Errorf("windowMinutes must be greater than zero")
+		}
+	case domain.ScreeningRuleNewBeneficiaryLarge, domain.ScreeningRuleDormantReactivation:
+	default:
+		return fmt.Errorf("unsupported rule type %q", rule.Type)
+	}
+	if rule.Action != domain.ScreeningDecisionReview && rule.Action != domain.ScreeningDecisionBlock {
+		return fmt.Errorf("action must be REVIEW or BLOCK")
+	}
+	return nil
+}
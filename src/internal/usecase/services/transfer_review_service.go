@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+const (
+	defaultHeldTransfersLimit = 50
+	maxHeldTransfersLimit     = 200
+)
+
+// Verify that TransferService implements the service_interfaces.TransferReviewService interface
+var _ service_interfaces.TransferReviewService = (*TransferService)(nil)
+
+// GetHeldTransfers returns the open review queue, oldest first.
+func (s *TransferService) GetHeldTransfers(ctx context.Context, limit int) (commons.Response[[]models.TransferReviewResponse], error) {
+	logger.Info("transfer service get held transfers request", logger.Fields{
+		"limit": limit,
+	})
+
+	if limit <= 0 {
+		limit = defaultHeldTransfersLimit
+	}
+	if limit > maxHeldTransfersLimit {
+		limit = maxHeldTransfersLimit
+	}
+
+	transfers, err := s.transferRepo.GetByStatus(ctx, domain.TransferStatusHeld, limit)
+	if err != nil {
+		return commons.ErrorResponse[[]models.TransferReviewResponse]("failed to fetch held transfers", "Unable to fetch held transfers right now"), err
+	}
+
+	transferIDs := make([]string, 0, len(transfers))
+	for _, transfer := range transfers {
+		transferIDs = append(transferIDs, transfer.ID)
+	}
+	reviews, err := s.transferReviewRepo.GetByTransferIDs(ctx, transferIDs)
+	if err != nil {
+		return commons.ErrorResponse[[]models.TransferReviewResponse]("failed to fetch held transfers", "Unable to fetch held transfers right now"), err
+	}
+	reviewsByTransferID := make(map[string]domain.TransferReview, len(reviews))
+	for _, review := range reviews {
+		reviewsByTransferID[review.TransferID] = review
+	}
+
+	queue := make([]models.TransferReviewResponse, 0, len(transfers))
+	for _, transfer := range transfers {
+		review, ok := reviewsByTransferID[transfer.ID]
+		if !ok {
+			logger.Info("transfer service held transfer has no review", logger.Fields{
+				"transferId": transfer.ID,
+			})
+			continue
+		}
+		queue = append(queue, mapTransferReviewToResponse(transfer, review))
+	}
+
+	return commons.SuccessResponse("Held transfers fetched successfully", queue), nil
+}
+
+// GetTransferReview returns the review of a transfer with its full audit trail.
+func (s *TransferService) GetTransferReview(ctx context.Context, transactionReference string) (commons.Response[models.TransferReviewResponse], error) {
+	logger.Info("transfer service get transfer review request", logger.Fields{
+		"transactionReference": transactionReference,
+	})
+
+	reference := strings.TrimSpace(transactionReference)
+	if reference == "" {
+		err := fmt.Errorf("transactionReference is required")
+		return commons.ErrorResponse[models.TransferReviewResponse]("validation failed", err.Error()), err
+	}
+
+	transfer, err := s.transferRepo.Get(ctx, "", reference, "")
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.TransferReviewResponse]("Transfer not found"), err
+		}
+		return commons.ErrorResponse[models.TransferReviewResponse]("failed to fetch transfer review", "Unable to fetch transfer review right now"), err
+	}
+
+	review, err := s.transferReviewRepo.GetByTransferID(ctx, transfer.ID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.TransferReviewResponse]("Transfer review not found"), err
+		}
+		return commons.ErrorResponse[models.TransferReviewResponse]("failed to fetch transfer review", "Unable to fetch transfer review right now"), err
+	}
+
+	return commons.SuccessResponse("Transfer review fetched successfully", mapTransferReviewToResponse(transfer, review)), nil
+}
+
+// ApproveHeldTransfer sends a HELD transfer on to execution, exactly as if it
+// had cleared screening. Its reservation stays in place until the posting
+// transaction releases it, so the funds cannot be spent elsewhere in between.
+func (s *TransferService) ApproveHeldTransfer(ctx context.Context, req models.TransferReviewDecisionRequest) (commons.Response[models.InternalTransferResponse], error) {
+	logger.Info("transfer service approve held transfer request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	transfer, review, reviewerID, response, err := s.loadPendingReview(ctx, req)
+	if err != nil {
+		return response, err
+	}
+
+	released, err := s.transferRepo.ResolveHold(ctx, review, transfer.DebitAccountNumber, domain.TransferReviewApproved, s.initialTransferStatus(), domain.TransferReviewDecision{
+		Action:  domain.TransferReviewActionApprove,
+		Actor:   reviewerID,
+		Comment: strings.TrimSpace(req.Comment),
+	})
+	if err != nil {
+		return resolveHoldErrorResponse(transfer, err)
+	}
+
+	logger.Info("transfer service held transfer approved", logger.Fields{
+		"transferId": released.ID,
+		"reviewerId": reviewerID,
+	})
+
+	if s.dispatcher != nil {
+		return s.dispatchTransfer(ctx, released)
+	}
+	return s.executeTransfer(ctx, released)
+}
+
+// RejectHeldTransfer releases the reservation of a HELD transfer and fails it.
+func (s *TransferService) RejectHeldTransfer(ctx context.Context, req models.TransferReviewDecisionRequest) (commons.Response[models.InternalTransferResponse], error) {
+	logger.Info("transfer service reject held transfer request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	transfer, review, reviewerID, response, err := s.loadPendingReview(ctx, req)
+	if err != nil {
+		return response, err
+	}
+
+	rejected, err := s.transferRepo.ResolveHold(ctx, review, transfer.DebitAccountNumber, domain.TransferReviewRejected, domain.TransferStatusFailed, domain.TransferReviewDecision{
+		Action:  domain.TransferReviewActionReject,
+		Actor:   reviewerID,
+		Comment: strings.TrimSpace(req.Comment),
+	})
+	if err != nil {
+		return resolveHoldErrorResponse(transfer, err)
+	}
+
+	logger.Info("transfer service held transfer rejected", logger.Fields{
+		"transferId": rejected.ID,
+		"reviewerId": reviewerID,
+	})
+	return commons.SuccessResponse("Transfer rejected", mapTransferToResponse(rejected, transferSumTotal(rejected))), nil
+}
+
+// loadPendingReview resolves the transfer and open review a decision applies to
+// and enforces the maker-checker rule. Both the reviewer and the initiator are
+// the authenticated callers (commons.ActorFromContext), never request fields.
+func (s *TransferService) loadPendingReview(ctx context.Context, req models.TransferReviewDecisionRequest) (domain.Transfer, domain.TransferReview, string, commons.Response[models.InternalTransferResponse], error) {
+	if err := req.Validate(); err != nil {
+		return domain.Transfer{}, domain.TransferReview{}, "", commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+	}
+	reviewerID := commons.ActorFromContext(ctx)
+	if reviewerID == "" {
+		err := fmt.Errorf("an authenticated reviewer is required")
+		return domain.Transfer{}, domain.TransferReview{}, "", commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+	}
+
+	transfer, err := s.transferRepo.Get(ctx, "", strings.TrimSpace(req.TransactionReference), "")
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.Transfer{}, domain.TransferReview{}, "", commons.ErrorResponse[models.InternalTransferResponse]("Transfer not found"), err
+		}
+		return domain.Transfer{}, domain.TransferReview{}, "", commons.ErrorResponse[models.InternalTransferResponse]("failed to review transfer", "Unable to review transfer right now"), err
+	}
+
+	review, err := s.transferReviewRepo.GetByTransferID(ctx, transfer.ID)
+	if err != nil && !errors.Is(err, commons.ErrRecordNotFound) {
+		return domain.Transfer{}, domain.TransferReview{}, "", commons.ErrorResponse[models.InternalTransferResponse]("failed to review transfer", "Unable to review transfer right now"), err
+	}
+	if err != nil || review.Status != domain.TransferReviewPending || transfer.Status != domain.TransferStatusHeld {
+		err = fmt.Errorf("transfer %s is not held for review", transfer.ID)
+		return domain.Transfer{}, domain.TransferReview{}, "", commons.ErrorResponse[models.InternalTransferResponse]("Transfer is not held for review"), err
+	}
+
+	if strings.EqualFold(reviewerID, strings.TrimSpace(review.InitiatedBy)) {
+		err := commons.ErrMakerCheckerViolation
+		return domain.Transfer{}, domain.TransferReview{}, "", commons.ErrorResponse[models.InternalTransferResponse](err.Error()), err
+	}
+
+	return transfer, review, reviewerID, commons.Response[models.InternalTransferResponse]{}, nil
+}
+
+func resolveHoldErrorResponse(transfer domain.Transfer, err error) (commons.Response[models.InternalTransferResponse], error) {
+	logger.Error("transfer service resolve hold failed", err, logger.Fields{
+		"transferId": transfer.ID,
+	})
+	if errors.Is(err, commons.ErrRecordNotFound) {
+		return commons.ErrorResponse[models.InternalTransferResponse]("Transfer is not held for review"), err
+	}
+	return commons.ErrorResponse[models.InternalTransferResponse]("failed to review transfer", "Unable to review transfer right now"), err
+}
+
+func mapTransferReviewToResponse(transfer domain.Transfer, review domain.TransferReview) models.TransferReviewResponse {
+	response := models.TransferReviewResponse{
+		Transfer:    mapTransferToResponse(transfer, transferSumTotal(transfer)),
+		Reason:      review.Reason,
+		Status:      string(review.Status),
+		InitiatedBy: review.InitiatedBy,
+		HeldAmount:  review.HeldAmount,
+		DecidedBy:   valueOrEmpty(review.DecidedBy),
+		DecidedAt:   review.DecidedAt,
+		CreatedAt:   review.CreatedAt,
+	}
+	for _, decision := range review.Decisions {
+		response.Decisions = append(response.Decisions, models.TransferReviewDecisionResponse{
+			Action:    string(decision.Action),
+			Actor:     decision.Actor,
+			Comment:   decision.Comment,
+			CreatedAt: decision.CreatedAt,
+		})
+	}
+	return response
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
@@ -21,6 +20,15 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// errStepUpRequired stops a transfer or withdrawal that is waiting for its
+// step-up code before anything is persisted. TransferFunds and WithdrawFunds
+// report it as a success carrying the challenge.
+var errStepUpRequired = errors.New("transfer step-up required")
+
+// stepUpVerifiedKey marks the context of a transfer or withdrawal replayed by
+// ConfirmTransfer or ConfirmWithdrawal after its code was verified.
+type stepUpVerifiedKey struct{}
+
 // Verify that TransferService implements the service_interfaces.TransferService interface
 var _ service_interfaces.TransferService = (*TransferService)(nil)
 
@@ -34,6 +42,14 @@ type TransferService struct {
 	userService                     service_interfaces.UserService
 	rateService                     service_interfaces.RateService
 	chargeService                   service_interfaces.ChargesService
+	screener                        service_interfaces.TransferScreener
+	sanctionsScreener               service_interfaces.SanctionsScreener
+	transferReviewRepo              repo_interfaces.TransferReviewRepository
+	referenceGenerator              service_interfaces.ReferenceGenerator
+	dispatcher                      service_interfaces.TransferDispatcher
+	otpService                      service_interfaces.OTPService
+	stepUpPolicy                    domain.TransferStepUpPolicy
+	kycLimits                       kycLimits
 	greyBankCode                    string
 	internalTransientAccountNumber  string
 	internalChargesAccountNumber    string
@@ -54,6 +70,10 @@ func NewTransferService(
 	userService service_interfaces.UserService,
 	rateService service_interfaces.RateService,
 	chargeService service_interfaces.ChargesService,
+	screener service_interfaces.TransferScreener,
+	sanctionsScreener service_interfaces.SanctionsScreener,
+	transferReviewRepo repo_interfaces.TransferReviewRepository,
+	referenceGenerator service_interfaces.ReferenceGenerator,
 	greyBankCode string,
 	internalTransientAccountNumber string,
 	internalChargesAccountNumber string,
@@ -63,6 +83,9 @@ func NewTransferService(
 	externalEURGLAccountNumber string,
 	externalNGNGLAccountNumber string,
 ) *TransferService {
+	if referenceGenerator == nil {
+		referenceGenerator, _ = NewReferenceGenerator(0, greyBankCode, ReferenceSchemeEXT, nil)
+	}
 	return &TransferService{
 		transferRepo:                    transferRepo,
 		accountRepo:                     accountRepo,
@@ -73,6 +96,10 @@ func NewTransferService(
 		userService:                     userService,
 		rateService:                     rateService,
 		chargeService:                   chargeService,
+		screener:                        screener,
+		sanctionsScreener:               sanctionsScreener,
+		transferReviewRepo:              transferReviewRepo,
+		referenceGenerator:              referenceGenerator,
 		greyBankCode:                    strings.TrimSpace(greyBankCode),
 		internalTransientAccountNumber:  strings.TrimSpace(internalTransientAccountNumber),
 		internalChargesAccountNumber:    strings.TrimSpace(internalChargesAccountNumber),
@@ -84,10 +111,27 @@ func NewTransferService(
 	}
 }
 
-var transferRefCounter uint32
+// EnableAsyncProcessing switches TransferFunds to intake mode: transfers are
+// persisted as ACCEPTED and handed to dispatcher instead of being posted inline.
+func (s *TransferService) EnableAsyncProcessing(dispatcher service_interfaces.TransferDispatcher) {
+	s.dispatcher = dispatcher
+}
+
+// EnableStepUp makes TransferFunds answer transfers above the policy
+// threshold with an OTP challenge; they are posted by ConfirmTransfer once the
+// code is submitted.
+func (s *TransferService) EnableStepUp(otpService service_interfaces.OTPService, policy domain.TransferStepUpPolicy) {
+	s.otpService = otpService
+	s.stepUpPolicy = policy
+}
+
+// EnableKYCLimits caps each transfer at the limit of the debit customer's KYC
+// level, so an approved KYC upgrade takes effect on the next transfer.
+func (s *TransferService) EnableKYCLimits(userRepo domain.UserRepository, policy domain.TransferLimitPolicy) {
+	s.kycLimits = kycLimits{userRepo: userRepo, rateService: s.rateService, policy: policy}
+}
 
 func (s *TransferService) TransferFunds(ctx context.Context, req models.InternalTransferRequest) (commons.Response[models.InternalTransferResponse], error) {
-	transferStartTime := time.Now()
 	logger.Info("transfer service transfer request", logger.Fields{
 		"payload": logger.SanitizePayload(req),
 	})
@@ -96,16 +140,196 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
 	}
 
-	beneficiaryBankCode := strings.TrimSpace(req.BeneficiaryBankCode)
-	if beneficiaryBankCode != s.greyBankCode {
-		return s.processExternalTransfer(ctx, req)
+	return s.processTransfer(ctx, req)
+}
+
+// ConfirmTransfer posts a transfer that TransferFunds answered with a step-up
+// challenge. The request stored with the challenge is replayed without the
+// PIN, which was verified when the challenge was issued; accounts, rates,
+// charges and screening are evaluated again at confirmation.
+func (s *TransferService) ConfirmTransfer(ctx context.Context, req models.ConfirmTransferRequest) (commons.Response[models.InternalTransferResponse], error) {
+	logger.Info("transfer service confirm transfer request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+	}
+	if s.otpService == nil {
+		err := fmt.Errorf("transfer step-up is not enabled")
+		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
 	}
 
+	debitAccountNumber := strings.TrimSpace(req.DebitAccountNumber)
+	debitAccount, err := s.accountRepo.GetByAccountNumber(ctx, debitAccountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.InternalTransferResponse]("Debit account not found"), err
+		}
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+
+	if err := authorizeAccountAccess(ctx, debitAccount.CustomerID); err != nil {
+		logger.Info("transfer service confirm transfer account access denied", logger.Fields{
+			"debitAccountNumber": debitAccountNumber,
+		})
+		return commons.ErrorResponse[models.InternalTransferResponse](err.Error()), err
+	}
+
+	challenge, err := s.otpService.Verify(ctx, debitAccount.CustomerID, req.ChallengeID, domain.OTPPurposeTransferStepUp, req.OTP)
+	if err != nil {
+		if errors.Is(err, commons.ErrInvalidOTP) {
+			return commons.ErrorResponse[models.InternalTransferResponse]("invalid otp", err.Error()), err
+		}
+		logger.Error("transfer service confirm transfer otp verification failed", err, logger.Fields{
+			"challengeId": req.ChallengeID,
+		})
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+
+	var transferReq models.InternalTransferRequest
+	if err := json.Unmarshal([]byte(challenge.Payload), &transferReq); err != nil {
+		wrappedErr := fmt.Errorf("decode step-up transfer request: %w", err)
+		logger.Error("transfer service confirm transfer decode failed", wrappedErr, logger.Fields{
+			"challengeId": challenge.ID,
+		})
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), wrappedErr
+	}
+	if strings.TrimSpace(transferReq.DebitAccountNumber) != debitAccountNumber {
+		err := fmt.Errorf("challenge was issued for another debit account")
+		return commons.ErrorResponse[models.InternalTransferResponse]("invalid otp", err.Error()), err
+	}
+
+	logger.Info("transfer service step-up confirmed", logger.Fields{
+		"challengeId":        challenge.ID,
+		"debitAccountNumber": debitAccountNumber,
+	})
+	return s.processTransfer(context.WithValue(ctx, stepUpVerifiedKey{}, true), transferReq)
+}
+
+func (s *TransferService) processTransfer(ctx context.Context, req models.InternalTransferRequest) (commons.Response[models.InternalTransferResponse], error) {
+	transferStartTime := time.Now()
+
+	var (
+		createdTransfer domain.Transfer
+		response        commons.Response[models.InternalTransferResponse]
+		err             error
+	)
+	if strings.TrimSpace(req.BeneficiaryBankCode) != s.greyBankCode {
+		createdTransfer, response, err = s.prepareExternalTransfer(ctx, req)
+	} else {
+		createdTransfer, response, err = s.prepareInternalTransfer(ctx, req)
+	}
+	if errors.Is(err, errStepUpRequired) {
+		return response, nil
+	}
+	if err != nil {
+		return response, err
+	}
+	if createdTransfer.Status == domain.TransferStatusHeld {
+		return commons.SuccessResponse("Transfer held for review", mapTransferToResponse(createdTransfer, transferSumTotal(createdTransfer))), nil
+	}
+
+	if s.dispatcher != nil {
+		return s.dispatchTransfer(ctx, createdTransfer)
+	}
+
+	response, err = s.executeTransfer(ctx, createdTransfer)
+	if err != nil {
+		return response, err
+	}
+
+	transferDuration := time.Since(transferStartTime)
+	logger.Info("transfer completed successfully", logger.Fields{
+		"startTime":   transferStartTime.Format("2006-01-02 15:04:05.000"),
+		"endTime":     time.Now().Format("2006-01-02 15:04:05.000"),
+		"duration":    transferDuration.String(),
+		"transferRef": valueOrEmpty(createdTransfer.TransactionReference),
+	})
+	return response, nil
+}
+
+// dispatchTransfer hands an ACCEPTED transfer to the async workers. A transfer
+// that cannot be queued is failed before any money moves.
+func (s *TransferService) dispatchTransfer(ctx context.Context, transfer domain.Transfer) (commons.Response[models.InternalTransferResponse], error) {
+	if err := s.dispatcher.Dispatch(ctx, transfer); err != nil {
+		logger.Error("transfer service dispatch failed", err, logger.Fields{
+			"transferId": transfer.ID,
+		})
+		_ = s.transferRepo.UpdateStatus(ctx, transfer.ID, domain.TransferStatusFailed)
+		if errors.Is(err, commons.ErrTransferQueueFull) {
+			return commons.ErrorResponse[models.InternalTransferResponse]("Transfer queue is full", "Please retry shortly"), err
+		}
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+	return commons.SuccessResponse("Transfer accepted for processing", mapTransferToResponse(transfer, transferSumTotal(transfer))), nil
+}
+
+// ProcessAcceptedTransfer runs the postings for a transfer taken in through async
+// intake. The ACCEPTED -> PENDING transition is conditional, so a transfer that
+// was already picked up (for example after a restart re-enqueue) is skipped.
+func (s *TransferService) ProcessAcceptedTransfer(ctx context.Context, transfer domain.Transfer) error {
+	if err := s.transferRepo.TransitionStatus(ctx, transfer.ID, domain.TransferStatusAccepted, domain.TransferStatusPending); err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			logger.Info("transfer service accepted transfer already picked up", logger.Fields{
+				"transferId": transfer.ID,
+			})
+			return nil
+		}
+		return err
+	}
+	transfer.Status = domain.TransferStatusPending
+
+	_, err := s.executeTransfer(ctx, transfer)
+	return err
+}
+
+// GetAcceptedTransfers lists transfers still waiting for a worker, oldest first.
+func (s *TransferService) GetAcceptedTransfers(ctx context.Context, limit int) ([]domain.Transfer, error) {
+	return s.transferRepo.GetByStatus(ctx, domain.TransferStatusAccepted, limit)
+}
+
+func (s *TransferService) GetTransfer(ctx context.Context, transactionReference string) (commons.Response[models.InternalTransferResponse], error) {
+	logger.Info("transfer service get transfer request", logger.Fields{
+		"transactionReference": transactionReference,
+	})
+
+	reference := strings.TrimSpace(transactionReference)
+	if reference == "" {
+		err := fmt.Errorf("transactionReference is required")
+		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+	}
+
+	transfer, err := s.transferRepo.Get(ctx, "", reference, "")
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.InternalTransferResponse]("Transfer not found"), err
+		}
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to fetch transfer", "Unable to fetch transfer right now"), err
+	}
+	if err := authorizeAccountNumber(ctx, s.accountRepo, transfer.DebitAccountNumber); err != nil {
+		return accountAccessErrorResponse[models.InternalTransferResponse](err), err
+	}
+
+	return commons.SuccessResponse("Transfer fetched successfully", mapTransferToResponse(transfer, transferSumTotal(transfer))), nil
+}
+
+// initialTransferStatus is PENDING when postings run inline and ACCEPTED when
+// they are left to the worker pool.
+func (s *TransferService) initialTransferStatus() domain.TransferStatus {
+	if s.dispatcher != nil {
+		return domain.TransferStatusAccepted
+	}
+	return domain.TransferStatusPending
+}
+
+func (s *TransferService) prepareInternalTransfer(ctx context.Context, req models.InternalTransferRequest) (domain.Transfer, commons.Response[models.InternalTransferResponse], error) {
+	beneficiaryBankCode := strings.TrimSpace(req.BeneficiaryBankCode)
 	debitAccountNumber := strings.TrimSpace(req.DebitAccountNumber)
 	creditAccountNumber := strings.TrimSpace(req.CreditAccountNumber)
 	if debitAccountNumber == creditAccountNumber {
 		err := fmt.Errorf("debitAccountNumber and creditAccountNumber cannot be the same")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
 	}
 
 	debitCurrency := strings.ToUpper(strings.TrimSpace(req.DebitCurrency))
@@ -145,49 +369,36 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 	if err := eg.Wait(); err != nil {
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "debit account not found") {
-			return commons.ErrorResponse[models.InternalTransferResponse]("Debit account not found"), err
+			return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("Debit account not found"), err
 		}
 		if strings.Contains(errMsg, "credit account not found") {
-			return commons.ErrorResponse[models.InternalTransferResponse]("Credit account not found"), err
+			return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("Credit account not found"), err
 		}
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
 	}
 
 	if debitAccount.Status != domain.AccountStatusActive {
-		err := fmt.Errorf("debit account is not active")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+		err := debitAccountStatusError("debit account", debitAccount.Status)
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
 	}
-	if creditAccount.Status != domain.AccountStatusActive {
+	if !creditAccount.Status.AcceptsCredits() {
 		err := fmt.Errorf("credit account is not active")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
 	}
 	if !strings.EqualFold(strings.TrimSpace(debitAccount.Currency), debitCurrency) {
 		err := fmt.Errorf("debit currency does not match debit account currency")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
 	}
 	if !strings.EqualFold(strings.TrimSpace(creditAccount.Currency), creditCurrency) {
 		err := fmt.Errorf("credit currency does not match credit account currency")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
 	}
-	pinVerificationResp, pinVerificationErr := s.userService.VerifyUserPin(
-		ctx,
-		debitAccount.CustomerID,
-		strings.TrimSpace(req.TransactionPIN),
-	)
-	if pinVerificationErr != nil {
-		if pinVerificationResp.Message == "invalid pin" {
-			err := fmt.Errorf("invalid transactionPIN")
-			return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
-		}
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), pinVerificationErr
-	}
-	if pinVerificationResp.Data == nil || !pinVerificationResp.Data.IsValidPin {
-		err := fmt.Errorf("invalid transactionPIN")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+	if response, err := s.authorizeTransfer(ctx, req, debitAccount.CustomerID); err != nil {
+		return domain.Transfer{}, response, err
 	}
 
 	// Fetch rate conversion and charges in parallel
-	var convertedAmount, rateUsed, chargeAmount, vatAmount, sumTotal decimal.Decimal
+	var convertedAmount, rateUsed, chargeAmount, vatAmount decimal.Decimal
 	eg2, eg2Ctx := errgroup.WithContext(ctx)
 
 	eg2.Go(func() error {
@@ -201,27 +412,21 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 	eg2.Go(func() error {
 		var err error
 		var tempChargeAmount, tempVatAmount decimal.Decimal
-		_, _, tempChargeAmount, tempVatAmount, sumTotal, err = s.chargeService.GetCharges(eg2Ctx, debitAmount, debitCurrency)
+		_, _, tempChargeAmount, tempVatAmount, _, err = s.chargeService.GetCharges(eg2Ctx, debitAmount, debitCurrency)
 		chargeAmount = tempChargeAmount
 		vatAmount = tempVatAmount
 		return err
 	})
 
 	if err := eg2.Wait(); err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
 	}
 
-	creditAmount := convertedAmount
-
-	narration := strings.TrimSpace(req.Narration)
 	auditPayloadBytes, _ := json.Marshal(logger.SanitizePayload(req))
-	auditPayload := string(auditPayloadBytes)
 
-	var createdTransfer domain.Transfer
-	var err error
-	for attempt := 0; attempt < 5; attempt++ {
-		reference := generateThirtyDigitTransferReference()
-		transferRecord := domain.Transfer{
+	createdTransfer, response, err := s.createTransferRecord(ctx, func() domain.Transfer {
+		reference := s.referenceGenerator.TransactionReference(commons.ChannelIDFromContext(ctx))
+		return domain.Transfer{
 			ExternalRefernece:    stringPtr(reference),
 			TransactionReference: stringPtr(reference),
 			DebitAccountNumber:   debitAccountNumber,
@@ -232,29 +437,341 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 			DebitCurrency:        debitCurrency,
 			CreditCurrency:       creditCurrency,
 			DebitAmount:          debitAmount,
+			CreditAmount:         convertedAmount,
+			FCYRate:              rateUsed,
+			ChargeAmount:         chargeAmount,
+			VATAmount:            vatAmount,
+			Narration:            stringPtr(strings.TrimSpace(req.Narration)),
+			Status:               s.initialTransferStatus(),
+			AuditPayload:         string(auditPayloadBytes),
+			ChannelID:            optionalString(commons.ChannelIDFromContext(ctx)),
+		}
+	})
+	if err != nil {
+		return domain.Transfer{}, response, err
+	}
+
+	return s.screenTransfer(ctx, createdTransfer, debitAccount.CreatedAt, transferInitiator(ctx, debitAccount))
+}
+
+func (s *TransferService) prepareExternalTransfer(ctx context.Context, req models.InternalTransferRequest) (domain.Transfer, commons.Response[models.InternalTransferResponse], error) {
+	beneficiaryBankCode := strings.TrimSpace(req.BeneficiaryBankCode)
+	beneficiaryBankName, foundBankCode, err := s.getParticipantBankNameByCode(ctx, beneficiaryBankCode)
+	if err != nil {
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+	if !foundBankCode {
+		validationErr := fmt.Errorf("beneficiaryBankCode is not supported")
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
+	}
+
+	debitAccountNumber := strings.TrimSpace(req.DebitAccountNumber)
+	debitCurrency := strings.ToUpper(strings.TrimSpace(req.DebitCurrency))
+	creditCurrency := strings.ToUpper(strings.TrimSpace(req.CreditCurrency))
+	debitAmount := req.DebitAmount
+	creditAccountNumber := strings.TrimSpace(req.CreditAccountNumber)
+
+	debitAccount, err := s.accountRepo.GetByAccountNumber(ctx, debitAccountNumber)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("Debit account not found"), err
+		}
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+	if debitAccount.Status != domain.AccountStatusActive {
+		validationErr := debitAccountStatusError("debit account", debitAccount.Status)
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
+	}
+	if !strings.EqualFold(strings.TrimSpace(debitAccount.Currency), debitCurrency) {
+		validationErr := fmt.Errorf("debit currency does not match debit account currency")
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
+	}
+
+	if response, err := s.authorizeTransfer(ctx, req, debitAccount.CustomerID); err != nil {
+		return domain.Transfer{}, response, err
+	}
+
+	creditAmount, rateUsed, _, err := s.rateService.ConvertRate(ctx, debitAmount, debitCurrency, creditCurrency)
+	if err != nil {
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+
+	_, _, chargeAmount, vatAmount, _, err := s.chargeService.GetCharges(ctx, debitAmount, debitCurrency)
+	if err != nil {
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+
+	// Settlement needs the fees in USD; refuse the transfer up front if that conversion is unavailable.
+	if _, _, err := s.convertFeesToUSD(ctx, chargeAmount, vatAmount, debitCurrency); err != nil {
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+
+	if _, err := s.resolveExternalGLAccountNumber(creditCurrency); err != nil {
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
+	}
+
+	beneficiaryName := strings.TrimSpace(req.BeneficiaryName)
+	if beneficiaryName == "" {
+		validationErr := fmt.Errorf("beneficiaryName is required for external transfers")
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
+	}
+
+	auditPayloadBytes, _ := json.Marshal(logger.SanitizePayload(req))
+
+	createdTransfer, response, err := s.createTransferRecord(ctx, func() domain.Transfer {
+		return domain.Transfer{
+			ExternalRefernece:    stringPtr(s.referenceGenerator.ExternalReference()),
+			TransactionReference: stringPtr(s.referenceGenerator.TransactionReference(commons.ChannelIDFromContext(ctx))),
+			DebitAccountNumber:   debitAccountNumber,
+			CreditAccountNumber:  stringPtr(creditAccountNumber),
+			BeneficiaryBankCode:  stringPtr(beneficiaryBankCode),
+			DebitBankName:        stringPtr(req.DebitBankName),
+			CreditBankName:       stringPtr(beneficiaryBankName),
+			DebitCurrency:        debitCurrency,
+			CreditCurrency:       creditCurrency,
+			DebitAmount:          debitAmount,
 			CreditAmount:         creditAmount,
 			FCYRate:              rateUsed,
 			ChargeAmount:         chargeAmount,
 			VATAmount:            vatAmount,
-			Narration:            stringPtr(narration),
-			Status:               domain.TransferStatusPending,
-			AuditPayload:         auditPayload,
+			Narration:            stringPtr(strings.TrimSpace(req.Narration)),
+			Status:               s.initialTransferStatus(),
+			AuditPayload:         string(auditPayloadBytes),
+			ChannelID:            optionalString(commons.ChannelIDFromContext(ctx)),
+		}
+	})
+	if err != nil {
+		return domain.Transfer{}, response, err
+	}
+
+	createdTransfer, response, err = s.screenBeneficiary(ctx, createdTransfer, beneficiaryName, transferInitiator(ctx, debitAccount))
+	if err != nil || createdTransfer.Status == domain.TransferStatusHeld {
+		return createdTransfer, response, err
+	}
+
+	return s.screenTransfer(ctx, createdTransfer, debitAccount.CreatedAt, transferInitiator(ctx, debitAccount))
+}
+
+// authorizeTransfer checks the transaction PIN and the customer's KYC limit
+// and, for transfers above the step-up threshold, issues an OTP challenge and
+// returns errStepUpRequired with the challenge in the response. When the
+// transfer is replayed by ConfirmTransfer only the limit is checked again.
+func (s *TransferService) authorizeTransfer(ctx context.Context, req models.InternalTransferRequest, customerID string) (commons.Response[models.InternalTransferResponse], error) {
+	if err := authorizeAccountAccess(ctx, customerID); err != nil {
+		logger.Info("transfer service debit account access denied", logger.Fields{
+			"debitAccountNumber": req.DebitAccountNumber,
+		})
+		return commons.ErrorResponse[models.InternalTransferResponse](err.Error()), err
+	}
+	verified, _ := ctx.Value(stepUpVerifiedKey{}).(bool)
+	if !verified {
+		if response, err := verifyTransactionPIN[models.InternalTransferResponse](ctx, s.userService, customerID, req.TransactionPIN, "failed to process transfer", "Unable to process transfer right now"); err != nil {
+			return response, err
 		}
+	}
+	if response, err := checkKYCLimit[models.InternalTransferResponse](ctx, s.kycLimits, customerID, req.DebitAmount, req.DebitCurrency, "failed to process transfer", "Unable to process transfer right now"); err != nil {
+		return response, err
+	}
+	if verified || s.otpService == nil || !s.stepUpPolicy.Threshold.IsPositive() {
+		return commons.Response[models.InternalTransferResponse]{}, nil
+	}
 
-		createdTransfer, err = s.transferRepo.Create(ctx, transferRecord)
+	debitCurrency := strings.ToUpper(strings.TrimSpace(req.DebitCurrency))
+	amount, err := s.debitAmountIn(ctx, req, s.stepUpPolicy.Currency)
+	if err != nil {
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+	if !amount.GreaterThan(s.stepUpPolicy.Threshold) {
+		return commons.Response[models.InternalTransferResponse]{}, nil
+	}
+
+	req.TransactionPIN = ""
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), fmt.Errorf("encode step-up transfer request: %w", err)
+	}
+	challenge, err := s.otpService.Issue(ctx, customerID, domain.OTPPurposeTransferStepUp, string(payload))
+	if err != nil {
+		logger.Error("transfer service issue step-up challenge failed", err, logger.Fields{
+			"debitAccountNumber": req.DebitAccountNumber,
+		})
+		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+
+	logger.Info("transfer service step-up required", logger.Fields{
+		"challengeId":        challenge.ID,
+		"debitAccountNumber": req.DebitAccountNumber,
+		"normalizedAmount":   amount,
+		"thresholdCurrency":  s.stepUpPolicy.Currency,
+	})
+	challengeResponse := mapOTPChallengeToResponse(challenge)
+	return commons.SuccessResponse("OTP required to complete transfer", models.InternalTransferResponse{
+		DebitAccountNumber:  strings.TrimSpace(req.DebitAccountNumber),
+		CreditAccountNumber: strings.TrimSpace(req.CreditAccountNumber),
+		BeneficiaryBankCode: strings.TrimSpace(req.BeneficiaryBankCode),
+		DebitCurrency:       debitCurrency,
+		CreditCurrency:      strings.ToUpper(strings.TrimSpace(req.CreditCurrency)),
+		DebitAmount:         decimalPtr(req.DebitAmount),
+		Narration:           strings.TrimSpace(req.Narration),
+		Status:              string(domain.TransferStatusOTPRequired),
+		StepUpChallenge:     &challengeResponse,
+	}), errStepUpRequired
+}
+
+// debitAmountIn converts the debit amount of req into currency at the current
+// rate.
+func (s *TransferService) debitAmountIn(ctx context.Context, req models.InternalTransferRequest, currency string) (decimal.Decimal, error) {
+	debitCurrency := strings.ToUpper(strings.TrimSpace(req.DebitCurrency))
+	if debitCurrency == currency {
+		return req.DebitAmount, nil
+	}
+	converted, _, _, err := s.rateService.ConvertRate(ctx, req.DebitAmount, debitCurrency, currency)
+	return converted, err
+}
+
+// createTransferRecord persists the transfer built by newRecord, retrying with fresh
+// references on a unique violation.
+func (s *TransferService) createTransferRecord(ctx context.Context, newRecord func() domain.Transfer) (domain.Transfer, commons.Response[models.InternalTransferResponse], error) {
+	var createdTransfer domain.Transfer
+	var err error
+	for attempt := 0; attempt < 5; attempt++ {
+		createdTransfer, err = s.transferRepo.Create(ctx, newRecord())
 		if err == nil {
 			break
 		}
 		if !isUniqueViolation(err) {
-			return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+			return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
 		}
 	}
 	if err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+
+	return createdTransfer, commons.Response[models.InternalTransferResponse]{}, nil
+}
+
+// screenBeneficiary checks the beneficiary of an external transfer against the
+// sanctions lists. A potential match parks the transfer in HELD for manual
+// review instead of failing it.
+func (s *TransferService) screenBeneficiary(ctx context.Context, transfer domain.Transfer, beneficiaryName string, initiatedBy string) (domain.Transfer, commons.Response[models.InternalTransferResponse], error) {
+	if s.sanctionsScreener == nil {
+		return transfer, commons.Response[models.InternalTransferResponse]{}, nil
+	}
+
+	screening, err := s.sanctionsScreener.ScreenName(ctx, domain.SanctionsSubjectTransfer, transfer.ID, beneficiaryName)
+	if err != nil {
+		logger.Error("transfer service sanctions screening failed", err, logger.Fields{
+			"transferId": transfer.ID,
+		})
+		_ = s.transferRepo.UpdateStatus(ctx, transfer.ID, domain.TransferStatusFailed)
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+	}
+	if screening.Decision != domain.SanctionsDecisionPotentialMatch {
+		return transfer, commons.Response[models.InternalTransferResponse]{}, nil
+	}
+
+	reason := fmt.Sprintf("sanctions: no list available to screen %q", beneficiaryName)
+	if screening.MatchedName != nil {
+		reason = fmt.Sprintf("sanctions: %q potentially matches %q (%s, score %.2f, list %s)",
+			beneficiaryName, *screening.MatchedName, valueOrEmpty(screening.MatchedEntryID), screening.MatchScore, screening.ListVersion)
+	}
+	return s.holdTransfer(ctx, transfer, initiatedBy, reason)
+}
+
+// screenTransfer runs fraud and AML screening on a freshly persisted transfer.
+// A BLOCK decision fails the transfer before any money moves; REVIEW parks it in
+// HELD for manual review.
+func (s *TransferService) screenTransfer(ctx context.Context, transfer domain.Transfer, accountOpenedAt time.Time, initiatedBy string) (domain.Transfer, commons.Response[models.InternalTransferResponse], error) {
+	if s.screener == nil {
+		return transfer, commons.Response[models.InternalTransferResponse]{}, nil
+	}
+
+	screening, err := s.screener.ScreenTransfer(ctx, domain.ScreeningInput{
+		TransferID:          transfer.ID,
+		DebitAccountNumber:  transfer.DebitAccountNumber,
+		CreditAccountNumber: valueOrEmpty(transfer.CreditAccountNumber),
+		BeneficiaryBankCode: valueOrEmpty(transfer.BeneficiaryBankCode),
+		DebitCurrency:       transfer.DebitCurrency,
+		DebitAmount:         transfer.DebitAmount,
+		AccountOpenedAt:     accountOpenedAt,
+	})
+	if err != nil {
+		logger.Error("transfer service screening failed", err, logger.Fields{
+			"transferId": transfer.ID,
+		})
+		_ = s.transferRepo.UpdateStatus(ctx, transfer.ID, domain.TransferStatusFailed)
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
 	}
 
+	switch screening.Decision {
+	case domain.ScreeningDecisionBlock:
+		_ = s.transferRepo.UpdateStatus(ctx, transfer.ID, domain.TransferStatusFailed)
+		err := commons.ErrTransferBlocked
+		return domain.Transfer{}, commons.ErrorResponse[models.InternalTransferResponse]("Transfer blocked by screening", err.Error()), err
+	case domain.ScreeningDecisionReview:
+		reasons := make([]string, 0, len(screening.Results))
+		for _, result := range screening.Results {
+			if result.Decision != domain.ScreeningDecisionAllow {
+				reasons = append(reasons, result.RuleID+": "+result.Reason)
+			}
+		}
+		if len(reasons) == 0 {
+			reasons = append(reasons, fmt.Sprintf("total score %d", screening.TotalScore))
+		}
+		return s.holdTransfer(ctx, transfer, initiatedBy, "screening: "+strings.Join(reasons, "; "))
+	}
+
+	return transfer, commons.Response[models.InternalTransferResponse]{}, nil
+}
+
+// holdTransfer parks a persisted transfer in HELD with its full debit (principal
+// plus fees) reserved from the available balance, and opens a review case.
+func (s *TransferService) holdTransfer(ctx context.Context, transfer domain.Transfer, initiatedBy string, reason string) (domain.Transfer, commons.Response[models.InternalTransferResponse], error) {
+	_, err := s.transferRepo.HoldTransfer(ctx, domain.TransferReview{
+		TransferID:  transfer.ID,
+		Reason:      reason,
+		InitiatedBy: initiatedBy,
+		HeldAmount:  transferSumTotal(transfer),
+	}, transfer.DebitAccountNumber)
+	if err != nil {
+		logger.Error("transfer service hold transfer failed", err, logger.Fields{
+			"transferId": transfer.ID,
+		})
+		response, err := s.failTransferPosting(ctx, transfer, err)
+		return domain.Transfer{}, response, err
+	}
+
+	transfer.Status = domain.TransferStatusHeld
+	logger.Info("transfer service transfer held for review", logger.Fields{
+		"transferId": transfer.ID,
+		"reason":     reason,
+	})
+	return transfer, commons.Response[models.InternalTransferResponse]{}, nil
+}
+
+// executeTransfer runs the postings, ledger entries and fee settlement for a
+// persisted PENDING transfer. Sync intake and the async workers both end here.
+func (s *TransferService) executeTransfer(ctx context.Context, transfer domain.Transfer) (commons.Response[models.InternalTransferResponse], error) {
+	if valueOrEmpty(transfer.BeneficiaryBankCode) == s.greyBankCode {
+		return s.executeInternalTransfer(ctx, transfer)
+	}
+	return s.executeExternalTransfer(ctx, transfer)
+}
+
+func (s *TransferService) executeInternalTransfer(ctx context.Context, createdTransfer domain.Transfer) (commons.Response[models.InternalTransferResponse], error) {
+	debitAccountNumber := createdTransfer.DebitAccountNumber
+	creditAccountNumber := valueOrEmpty(createdTransfer.CreditAccountNumber)
+	debitCurrency := createdTransfer.DebitCurrency
+	creditCurrency := createdTransfer.CreditCurrency
+	debitAmount := createdTransfer.DebitAmount
+	creditAmount := createdTransfer.CreditAmount
+	chargeAmount := createdTransfer.ChargeAmount
+	vatAmount := createdTransfer.VATAmount
+	sumTotal := transferSumTotal(createdTransfer)
+
 	postingErr := s.transferRepo.ProcessInternalTransfer(
 		ctx,
+		createdTransfer.ID,
 		debitAccountNumber,
 		sumTotal,
 		s.internalTransientAccountNumber,
@@ -263,12 +780,7 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 		creditAmount,
 	)
 	if postingErr != nil {
-		_ = s.transferRepo.UpdateStatus(ctx, createdTransfer.ID, domain.TransferStatusFailed)
-		if strings.Contains(strings.ToLower(postingErr.Error()), "insufficient balance") {
-			err := commons.ErrInsufficientBalance
-			return commons.ErrorResponse[models.InternalTransferResponse]("Insufficient balance", err.Error()), err
-		}
-		return commons.ErrorResponse[models.InternalTransferResponse]("transfer failed", "Unable to complete transfer posting"), postingErr
+		return s.failTransferPosting(ctx, createdTransfer, postingErr)
 	}
 
 	// Create both transient account transaction entries in parallel
@@ -300,7 +812,6 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 	}()
 	wg.Wait()
 
-	_ = s.transferRepo.UpdateStatus(ctx, createdTransfer.ID, domain.TransferStatusSuccess)
 	createdTransfer.Status = domain.TransferStatusSuccess
 
 	chargeUSD, vatUSD, err := s.convertFeesToUSD(ctx, chargeAmount, vatAmount, debitCurrency)
@@ -314,6 +825,7 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 
 	settlementErr := s.transientAccountRepo.SettleFromSuspenseToFees(
 		ctx,
+		createdTransfer.ID,
 		s.internalTransientAccountNumber,
 		chargeAmount,
 		vatAmount,
@@ -383,132 +895,30 @@ func (s *TransferService) TransferFunds(ctx context.Context, req models.Internal
 	}()
 	wg2.Wait()
 
-	_ = s.transferRepo.UpdateStatus(ctx, createdTransfer.ID, domain.TransferStatusClosed)
 	createdTransfer.Status = domain.TransferStatusClosed
 
 	response := mapTransferToResponse(createdTransfer, sumTotal)
-	transferDuration := time.Since(transferStartTime)
-	logger.Info("transfer completed successfully", logger.Fields{
-		"startTime":   transferStartTime.Format("2006-01-02 15:04:05.000"),
-		"endTime":     time.Now().Format("2006-01-02 15:04:05.000"),
-		"duration":    transferDuration.String(),
-		"transferRef": valueOrEmpty(createdTransfer.TransactionReference),
-	})
 	return commons.SuccessResponse("Transaction successful", response), nil
 }
 
-func (s *TransferService) processExternalTransfer(ctx context.Context, req models.InternalTransferRequest) (commons.Response[models.InternalTransferResponse], error) {
-	beneficiaryBankCode := strings.TrimSpace(req.BeneficiaryBankCode)
-	beneficiaryBankName, foundBankCode, err := s.getParticipantBankNameByCode(ctx, beneficiaryBankCode)
-	if err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
-	}
-	if !foundBankCode {
-		validationErr := fmt.Errorf("beneficiaryBankCode is not supported")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
-	}
-
-	debitAccountNumber := strings.TrimSpace(req.DebitAccountNumber)
-	debitCurrency := strings.ToUpper(strings.TrimSpace(req.DebitCurrency))
-	creditCurrency := strings.ToUpper(strings.TrimSpace(req.CreditCurrency))
-	debitAmount := req.DebitAmount
-	creditAccountNumber := strings.TrimSpace(req.CreditAccountNumber)
-
-	debitAccount, err := s.accountRepo.GetByAccountNumber(ctx, debitAccountNumber)
-	if err != nil {
-		if errors.Is(err, commons.ErrRecordNotFound) {
-			return commons.ErrorResponse[models.InternalTransferResponse]("Debit account not found"), err
-		}
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
-	}
-	if debitAccount.Status != domain.AccountStatusActive {
-		validationErr := fmt.Errorf("debit account is not active")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
-	}
-	if !strings.EqualFold(strings.TrimSpace(debitAccount.Currency), debitCurrency) {
-		validationErr := fmt.Errorf("debit currency does not match debit account currency")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
-	}
-
-	pinVerificationResp, pinVerificationErr := s.userService.VerifyUserPin(
-		ctx,
-		debitAccount.CustomerID,
-		strings.TrimSpace(req.TransactionPIN),
-	)
-	if pinVerificationErr != nil {
-		if pinVerificationResp.Message == "invalid pin" {
-			validationErr := fmt.Errorf("invalid transactionPIN")
-			return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
-		}
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), pinVerificationErr
-	}
-	if pinVerificationResp.Data == nil || !pinVerificationResp.Data.IsValidPin {
-		validationErr := fmt.Errorf("invalid transactionPIN")
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", validationErr.Error()), validationErr
-	}
-
-	creditAmount, rateUsed, _, err := s.rateService.ConvertRate(ctx, debitAmount, debitCurrency, creditCurrency)
-	if err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
-	}
-
-	_, _, chargeAmount, vatAmount, sumTotal, err := s.chargeService.GetCharges(ctx, debitAmount, debitCurrency)
-	if err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
-	}
-
-	chargeUSD, vatUSD, err := s.convertFeesToUSD(ctx, chargeAmount, vatAmount, debitCurrency)
-	if err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
-	}
+func (s *TransferService) executeExternalTransfer(ctx context.Context, createdTransfer domain.Transfer) (commons.Response[models.InternalTransferResponse], error) {
+	debitAccountNumber := createdTransfer.DebitAccountNumber
+	debitCurrency := createdTransfer.DebitCurrency
+	creditCurrency := createdTransfer.CreditCurrency
+	debitAmount := createdTransfer.DebitAmount
+	creditAmount := createdTransfer.CreditAmount
+	chargeAmount := createdTransfer.ChargeAmount
+	vatAmount := createdTransfer.VATAmount
+	sumTotal := transferSumTotal(createdTransfer)
 
 	externalAccountNumber, err := s.resolveExternalGLAccountNumber(creditCurrency)
 	if err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("validation failed", err.Error()), err
-	}
-
-	narration := strings.TrimSpace(req.Narration)
-	auditPayloadBytes, _ := json.Marshal(logger.SanitizePayload(req))
-	auditPayload := string(auditPayloadBytes)
-
-	var createdTransfer domain.Transfer
-	for attempt := 0; attempt < 5; attempt++ {
-		transactionReference := generateThirtyDigitTransferReference()
-		externalReference := generateExternalTransferReference()
-		transferRecord := domain.Transfer{
-			ExternalRefernece:    stringPtr(externalReference),
-			TransactionReference: stringPtr(transactionReference),
-			DebitAccountNumber:   debitAccountNumber,
-			CreditAccountNumber:  stringPtr(creditAccountNumber),
-			BeneficiaryBankCode:  stringPtr(beneficiaryBankCode),
-			DebitBankName:        stringPtr(req.DebitBankName),
-			CreditBankName:       stringPtr(beneficiaryBankName),
-			DebitCurrency:        debitCurrency,
-			CreditCurrency:       creditCurrency,
-			DebitAmount:          debitAmount,
-			CreditAmount:         creditAmount,
-			FCYRate:              rateUsed,
-			ChargeAmount:         chargeAmount,
-			VATAmount:            vatAmount,
-			Narration:            stringPtr(narration),
-			Status:               domain.TransferStatusPending,
-			AuditPayload:         auditPayload,
-		}
-
-		createdTransfer, err = s.transferRepo.Create(ctx, transferRecord)
-		if err == nil {
-			break
-		}
-		if !isUniqueViolation(err) {
-			return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
-		}
-	}
-	if err != nil {
-		return commons.ErrorResponse[models.InternalTransferResponse]("failed to process transfer", "Unable to process transfer right now"), err
+		return s.failTransferPosting(ctx, createdTransfer, err)
 	}
 
 	postingErr := s.transferRepo.ProcessExternalTransfer(
 		ctx,
+		createdTransfer.ID,
 		debitAccountNumber,
 		sumTotal,
 		s.internalTransientAccountNumber,
@@ -518,12 +928,7 @@ func (s *TransferService) processExternalTransfer(ctx context.Context, req model
 		creditCurrency,
 	)
 	if postingErr != nil {
-		_ = s.transferRepo.UpdateStatus(ctx, createdTransfer.ID, domain.TransferStatusFailed)
-		if strings.Contains(strings.ToLower(postingErr.Error()), "insufficient balance") {
-			insufficientErr := commons.ErrInsufficientBalance
-			return commons.ErrorResponse[models.InternalTransferResponse]("Insufficient balance", insufficientErr.Error()), insufficientErr
-		}
-		return commons.ErrorResponse[models.InternalTransferResponse]("transfer failed", "Unable to complete transfer posting"), postingErr
+		return s.failTransferPosting(ctx, createdTransfer, postingErr)
 	}
 
 	_, _ = s.transientAccountTransactionRepo.Create(ctx, domain.TransientAccountTransaction{
@@ -545,11 +950,20 @@ func (s *TransferService) processExternalTransfer(ctx context.Context, req model
 		Amount:            creditAmount,
 	})
 
-	_ = s.transferRepo.UpdateStatus(ctx, createdTransfer.ID, domain.TransferStatusSuccess)
 	createdTransfer.Status = domain.TransferStatusSuccess
 
+	chargeUSD, vatUSD, err := s.convertFeesToUSD(ctx, chargeAmount, vatAmount, debitCurrency)
+	if err != nil {
+		logger.Error("transfer service convert external settlement fees to usd failed", err, logger.Fields{
+			"transferId": createdTransfer.ID,
+		})
+		response := mapTransferToResponse(createdTransfer, sumTotal)
+		return commons.SuccessResponse("Transaction successful. Settlement pending", response), nil
+	}
+
 	settlementErr := s.transientAccountRepo.SettleFromSuspenseToFees(
 		ctx,
+		createdTransfer.ID,
 		s.internalTransientAccountNumber,
 		chargeAmount,
 		vatAmount,
@@ -603,13 +1017,26 @@ func (s *TransferService) processExternalTransfer(ctx context.Context, req model
 		Amount:            vatUSD,
 	})
 
-	_ = s.transferRepo.UpdateStatus(ctx, createdTransfer.ID, domain.TransferStatusClosed)
 	createdTransfer.Status = domain.TransferStatusClosed
 
 	response := mapTransferToResponse(createdTransfer, sumTotal)
 	return commons.SuccessResponse("Transaction successful", response), nil
 }
 
+// failTransferPosting marks a transfer FAILED after its main posting leg was rejected.
+func (s *TransferService) failTransferPosting(ctx context.Context, transfer domain.Transfer, postingErr error) (commons.Response[models.InternalTransferResponse], error) {
+	if err := s.transferRepo.UpdateStatus(ctx, transfer.ID, domain.TransferStatusFailed); err != nil {
+		logger.Error("transfer service failed status update failed", err, logger.Fields{
+			"transferId": transfer.ID,
+		})
+	}
+	if strings.Contains(strings.ToLower(postingErr.Error()), "insufficient balance") {
+		err := commons.ErrInsufficientBalance
+		return commons.ErrorResponse[models.InternalTransferResponse]("Insufficient balance", err.Error()), err
+	}
+	return commons.ErrorResponse[models.InternalTransferResponse]("transfer failed", "Unable to complete transfer posting"), postingErr
+}
+
 func (s *TransferService) convertFeesToUSD(
 	ctx context.Context,
 	chargeAmount decimal.Decimal,
@@ -643,6 +1070,11 @@ func (s *TransferService) convertFeesToUSD(
 	return chargeUSD, vatUSD, nil
 }
 
+// transferSumTotal is the full amount taken from the debit account: principal plus fees.
+func transferSumTotal(transfer domain.Transfer) decimal.Decimal {
+	return transfer.DebitAmount.Add(transfer.ChargeAmount).Add(transfer.VATAmount)
+}
+
 func mapTransferToResponse(transfer domain.Transfer, sumTotal decimal.Decimal) models.InternalTransferResponse {
 	return models.InternalTransferResponse{
 		TransactionReference: valueOrEmpty(transfer.TransactionReference),
@@ -660,22 +1092,10 @@ func mapTransferToResponse(transfer domain.Transfer, sumTotal decimal.Decimal) m
 		SumTotalDebit:        decimalPtr(sumTotal),
 		Narration:            valueOrEmpty(transfer.Narration),
 		Status:               string(transfer.Status),
+		ChannelID:            valueOrEmpty(transfer.ChannelID),
 	}
 }
 
-func generateThirtyDigitTransferReference() string {
-	now := time.Now().UTC()
-	base := now.Format("20060102150405") + fmt.Sprintf("%09d", now.Nanosecond())
-	counter := atomic.AddUint32(&transferRefCounter, 1) % 10000000
-	suffix := fmt.Sprintf("%07d", counter)
-	return base + suffix
-}
-
-func generateExternalTransferReference() string {
-	base := generateThirtyDigitTransferReference()
-	return "EXT" + base[:27]
-}
-
 func (s *TransferService) getParticipantBankNameByCode(ctx context.Context, bankCode string) (string, bool, error) {
 	banks, err := s.participantBankRepo.GetAll(ctx)
 	if err != nil {
@@ -714,6 +1134,15 @@ func isUniqueViolation(err error) bool {
 	return false
 }
 
+// transferInitiator is the authenticated caller keying the transfer, falling
+// back to the debit account's customer outside an authenticated request.
+func transferInitiator(ctx context.Context, debitAccount domain.Account) string {
+	if actor := commons.ActorFromContext(ctx); actor != "" {
+		return actor
+	}
+	return debitAccount.CustomerID
+}
+
 func stringPtr(value string) *string {
 	v := strings.TrimSpace(value)
 	return &v
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+// Verify that TransferWorkerPool implements the service_interfaces.TransferDispatcher interface
+var _ service_interfaces.TransferDispatcher = (*TransferWorkerPool)(nil)
+
+const defaultTransferEnqueueTimeout = 2 * time.Second
+
+// TransferWorkerPool executes accepted transfers in the background. Each worker
+// owns one queue and transfers are routed by debit account number, so two
+// transfers from the same account are always executed one after the other in
+// the order they were queued. The ordering only holds within one process:
+// replicas sharing a database each run their own pool, and transfers from one
+// account accepted by different replicas may run concurrently. The guarded
+// balance updates of the posting still keep such a pair from overdrawing it.
+type TransferWorkerPool struct {
+	process        func(ctx context.Context, transfer domain.Transfer) error
+	queues         []chan domain.Transfer
+	enqueueTimeout time.Duration
+}
+
+func NewTransferWorkerPool(process func(ctx context.Context, transfer domain.Transfer) error, workers int, queueSize int) *TransferWorkerPool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+
+	queues := make([]chan domain.Transfer, workers)
+	for i := range queues {
+		queues[i] = make(chan domain.Transfer, queueSize)
+	}
+
+	return &TransferWorkerPool{
+		process:        process,
+		queues:         queues,
+		enqueueTimeout: defaultTransferEnqueueTimeout,
+	}
+}
+
+// Start launches one goroutine per queue. Workers stop when ctx is cancelled.
+func (p *TransferWorkerPool) Start(ctx context.Context) {
+	for i, queue := range p.queues {
+		go p.work(ctx, i, queue)
+	}
+}
+
+// Dispatch queues the transfer on its account's worker, waiting briefly for room.
+func (p *TransferWorkerPool) Dispatch(ctx context.Context, transfer domain.Transfer) error {
+	timer := time.NewTimer(p.enqueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case p.queueFor(transfer.DebitAccountNumber) <- transfer:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return commons.ErrTransferQueueFull
+	}
+}
+
+// Requeue blocks until every transfer has been queued. It is used on startup to
+// resume transfers that were accepted before the last shutdown, and must return
+// before the pool is handed out for new dispatches so recovered transfers, given
+// oldest first, run ahead of anything accepted later from the same account.
+func (p *TransferWorkerPool) Requeue(ctx context.Context, transfers []domain.Transfer) {
+	for _, transfer := range transfers {
+		select {
+		case p.queueFor(transfer.DebitAccountNumber) <- transfer:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *TransferWorkerPool) work(ctx context.Context, worker int, queue <-chan domain.Transfer) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case transfer := <-queue:
+			if err := p.process(ctx, transfer); err != nil {
+				logger.Error("transfer worker process failed", err, logger.Fields{
+					"worker":     worker,
+					"transferId": transfer.ID,
+				})
+			}
+		}
+	}
+}
+
+func (p *TransferWorkerPool) queueFor(debitAccountNumber string) chan domain.Transfer {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(strings.TrimSpace(debitAccountNumber)))
+	return p.queues[hash.Sum32()%uint32(len(p.queues))]
+}
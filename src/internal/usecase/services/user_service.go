@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
 	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
 	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
 	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
@@ -15,15 +16,40 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	defaultProfileChangesLimit = 50
+	maxProfileChangesLimit     = 200
+
+	customerNotHeldMessage = "Customer is not held for compliance review"
+)
+
 // Verify that UserService implements the service_interfaces.UserService interface
 var _ service_interfaces.UserService = (*UserService)(nil)
 
 type UserService struct {
-	userRepo domain.UserRepository
+	userRepo               domain.UserRepository
+	sanctionsScreener      service_interfaces.SanctionsScreener
+	pinPolicy              domain.PinPolicy
+	duplicateRepo          repo_interfaces.CustomerDuplicateRepository
+	duplicateNameThreshold float64
+	now                    func() time.Time
 }
 
-func NewUserService(userRepo domain.UserRepository) *UserService {
-	return &UserService{userRepo: userRepo}
+func NewUserService(userRepo domain.UserRepository, sanctionsScreener service_interfaces.SanctionsScreener, pinPolicy domain.PinPolicy) *UserService {
+	return &UserService{
+		userRepo:          userRepo,
+		sanctionsScreener: sanctionsScreener,
+		pinPolicy:         pinPolicy,
+		now:               time.Now,
+	}
+}
+
+// EnableDuplicateDetection refuses onboarding with an ID document another
+// customer holds, and flags customers agreeing with an existing one on two of
+// name (scoring at least nameThreshold), date of birth and phone number.
+func (s *UserService) EnableDuplicateDetection(duplicateRepo repo_interfaces.CustomerDuplicateRepository, nameThreshold float64) {
+	s.duplicateRepo = duplicateRepo
+	s.duplicateNameThreshold = nameThreshold
 }
 
 func (s *UserService) CreateUser(ctx context.Context, req models.CreateUserRequest) (commons.Response[models.CreateUserResponse], error) {
@@ -42,10 +68,7 @@ func (s *UserService) CreateUser(ctx context.Context, req models.CreateUserReque
 		return commons.ErrorResponse[models.CreateUserResponse]("validation failed", "dob must be in YYYY-MM-DD format"), err
 	}
 
-	var middleName *string
-	if trimmed := strings.TrimSpace(req.MiddleName); trimmed != "" {
-		middleName = &trimmed
-	}
+	middleName := optionalString(req.MiddleName)
 
 	hashedPin, err := hashTransactionPin(strings.TrimSpace(req.TransactionPin))
 	if err != nil {
@@ -60,25 +83,77 @@ func (s *UserService) CreateUser(ctx context.Context, req models.CreateUserReque
 		LastName:           strings.TrimSpace(req.LastName),
 		DOB:                dob,
 		PhoneNumber:        strings.TrimSpace(req.PhoneNumber),
+		Email:              optionalString(req.Email),
+		Address:            optionalString(req.Address),
 		IDType:             domain.IDType(strings.TrimSpace(req.IDType)),
 		IDNumber:           strings.TrimSpace(req.IDNumber),
 		KYCLevel:           req.KYCLevel,
 		TransactionPinHash: hashedPin,
+		Status:             domain.UserStatusActive,
+	}
+
+	var duplicates []domain.DuplicateCandidate
+	if s.duplicateRepo != nil {
+		existing, err := s.duplicateRepo.FindPotentialDuplicates(ctx, domain.IdentityKey(user.IDType, user.IDNumber), user.DOB, domain.PhoneKey(user.PhoneNumber))
+		if err != nil {
+			logger.Error("user service create user duplicate lookup failed", err, logger.Fields{
+				"customerId": user.CustomerID,
+			})
+			return commons.ErrorResponse[models.CreateUserResponse]("failed to create user", "Unable to create user right now"), err
+		}
+		duplicates, err = matchDuplicates(user, existing, s.duplicateNameThreshold)
+		if err != nil {
+			return commons.ErrorResponse[models.CreateUserResponse](err.Error(), "Another customer is already registered with this ID document"), err
+		}
+	}
+
+	// Onboarding is screened before the user is stored; a potential match still
+	// creates the user, but HELD until compliance clears it.
+	if s.sanctionsScreener != nil {
+		screening, err := s.sanctionsScreener.ScreenName(ctx, domain.SanctionsSubjectUser, user.CustomerID, userFullName(user))
+		if err != nil {
+			logger.Error("user service create user sanctions screening failed", err, logger.Fields{
+				"customerId": user.CustomerID,
+			})
+			return commons.ErrorResponse[models.CreateUserResponse]("failed to create user", "Unable to create user right now"), err
+		}
+		if screening.Decision == domain.SanctionsDecisionPotentialMatch {
+			user.Status = domain.UserStatusHeld
+		}
 	}
 
 	created, err := s.userRepo.Create(ctx, user)
 	if err != nil {
+		if errors.Is(err, commons.ErrDuplicateIdentity) {
+			return commons.ErrorResponse[models.CreateUserResponse](err.Error(), "Another customer is already registered with this ID document"), err
+		}
 		logger.Error("user service create user repository failed", err, logger.Fields{
 			"customerId": user.CustomerID,
 		})
 		return commons.ErrorResponse[models.CreateUserResponse]("failed to create user", "Unable to create user right now"), err
 	}
 
+	// The customer exists by now, so a failure to flag is logged rather than
+	// failing onboarding.
+	var flagged []domain.DuplicateCandidate
+	if len(duplicates) > 0 {
+		flagged, err = s.duplicateRepo.Flag(ctx, created.CustomerID, duplicates)
+		if err != nil {
+			logger.Error("user service create user duplicate flag failed", err, logger.Fields{
+				"customerId": created.CustomerID,
+			})
+		}
+	}
+
 	response := models.CreateUserResponse{
 		ID:         created.ID,
 		CustomerID: created.CustomerID,
 		FirstName:  created.FirstName,
 		LastName:   created.LastName,
+		Status:     string(created.Status),
+	}
+	for _, candidate := range flagged {
+		response.PotentialDuplicateOf = append(response.PotentialDuplicateOf, candidate.MatchedCustomerID)
 	}
 
 	logger.Info("user service create user success", logger.Fields{
@@ -89,6 +164,12 @@ func (s *UserService) CreateUser(ctx context.Context, req models.CreateUserReque
 		"transaction": "create",
 	})
 
+	if created.Status == domain.UserStatusHeld {
+		return commons.SuccessResponse("user created and held for compliance review", response), nil
+	}
+	if len(flagged) > 0 {
+		return commons.SuccessResponse("user created and flagged for duplicate review", response), nil
+	}
 	return commons.SuccessResponse("user created successfully", response), nil
 }
 
@@ -112,21 +193,7 @@ func (s *UserService) GetUser(ctx context.Context, id string) (commons.Response[
 		return commons.ErrorResponse[models.GetUserResponse]("failed to get user", "Unable to fetch user right now"), err
 	}
 
-	response := models.GetUserResponse{
-		ID:                 user.ID,
-		CustomerID:         user.CustomerID,
-		FirstName:          user.FirstName,
-		MiddleName:         user.MiddleName,
-		LastName:           user.LastName,
-		DOB:                user.DOB.Format("2006-01-02"),
-		PhoneNumber:        user.PhoneNumber,
-		IDType:             string(user.IDType),
-		IDNumber:           user.IDNumber,
-		KYCLevel:           user.KYCLevel,
-		TransactionPinHash: user.TransactionPinHash,
-		CreatedAt:          user.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:          user.UpdatedAt.Format(time.RFC3339),
-	}
+	response := mapUserToResponse(user)
 
 	logger.Info("user service get user success", logger.Fields{
 		"userId":     response.ID,
@@ -136,6 +203,157 @@ func (s *UserService) GetUser(ctx context.Context, id string) (commons.Response[
 	return commons.SuccessResponse("user fetched successfully", response), nil
 }
 
+// UpdateContactDetails applies the fields present on the request to the
+// customer's contact details and returns the audited changes.
+func (s *UserService) UpdateContactDetails(ctx context.Context, req models.UpdateContactDetailsRequest) (commons.Response[models.UpdateContactDetailsResponse], error) {
+	logger.Info("user service update contact details request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.UpdateContactDetailsResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	user, err := s.userRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.UpdateContactDetailsResponse]("User not found"), err
+		}
+		return commons.ErrorResponse[models.UpdateContactDetailsResponse]("failed to update contact details", "Unable to update contact details right now"), err
+	}
+	if user.Status == domain.UserStatusErased {
+		err := fmt.Errorf("customer data was erased")
+		return commons.ErrorResponse[models.UpdateContactDetailsResponse]("validation failed", err.Error()), err
+	}
+
+	details := user.ContactDetails()
+	if req.PhoneNumber != nil {
+		details.PhoneNumber = strings.TrimSpace(*req.PhoneNumber)
+	}
+	if req.Email != nil {
+		details.Email = strings.TrimSpace(*req.Email)
+	}
+	if req.Address != nil {
+		details.Address = strings.TrimSpace(*req.Address)
+	}
+
+	updated, changes, err := s.userRepo.UpdateContactDetails(ctx, customerID, details, strings.TrimSpace(req.UpdatedBy))
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.UpdateContactDetailsResponse]("User not found"), err
+		}
+		logger.Error("user service update contact details failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.UpdateContactDetailsResponse]("failed to update contact details", "Unable to update contact details right now"), err
+	}
+
+	response := models.UpdateContactDetailsResponse{
+		User:    mapUserToResponse(updated),
+		Changes: mapProfileChangesToResponse(changes),
+	}
+	logger.Info("user service update contact details success", logger.Fields{
+		"customerId": customerID,
+		"changes":    len(changes),
+	})
+	if len(changes) == 0 {
+		return commons.SuccessResponse("contact details unchanged", response), nil
+	}
+	return commons.SuccessResponse("contact details updated successfully", response), nil
+}
+
+// GetProfileChanges returns the audit trail of a customer's contact details,
+// newest first.
+func (s *UserService) GetProfileChanges(ctx context.Context, req models.GetProfileChangesRequest) (commons.Response[[]models.ProfileChangeResponse], error) {
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[[]models.ProfileChangeResponse]("validation failed", err.Error()), err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultProfileChangesLimit
+	}
+	if limit > maxProfileChangesLimit {
+		limit = maxProfileChangesLimit
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	if _, err := s.userRepo.GetByCustomerID(ctx, customerID); err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[[]models.ProfileChangeResponse]("User not found"), err
+		}
+		return commons.ErrorResponse[[]models.ProfileChangeResponse]("failed to fetch profile changes", "Unable to fetch profile changes right now"), err
+	}
+
+	changes, err := s.userRepo.GetProfileChanges(ctx, customerID, limit)
+	if err != nil {
+		return commons.ErrorResponse[[]models.ProfileChangeResponse]("failed to fetch profile changes", "Unable to fetch profile changes right now"), err
+	}
+	return commons.SuccessResponse("profile changes fetched successfully", mapProfileChangesToResponse(changes)), nil
+}
+
+// ClearComplianceHold releases a customer held on a potential sanctions match
+// that compliance found to be a false positive.
+func (s *UserService) ClearComplianceHold(ctx context.Context, req models.ComplianceDecisionRequest) (commons.Response[models.GetUserResponse], error) {
+	return s.resolveComplianceHold(ctx, req, domain.UserStatusActive, "customer cleared by compliance review")
+}
+
+// RejectComplianceHold confirms the potential sanctions match; the customer
+// stays unusable.
+func (s *UserService) RejectComplianceHold(ctx context.Context, req models.ComplianceDecisionRequest) (commons.Response[models.GetUserResponse], error) {
+	return s.resolveComplianceHold(ctx, req, domain.UserStatusRejected, "customer rejected by compliance review")
+}
+
+// resolveComplianceHold records the reviewer's decision on a HELD customer.
+// The reviewer is taken from the authenticated caller, never the request body.
+func (s *UserService) resolveComplianceHold(ctx context.Context, req models.ComplianceDecisionRequest, status domain.UserStatus, successMessage string) (commons.Response[models.GetUserResponse], error) {
+	logger.Info("user service resolve compliance hold request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+		"status":  status,
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.GetUserResponse]("validation failed", err.Error()), err
+	}
+	reviewer := commons.ActorFromContext(ctx)
+	if reviewer == "" {
+		err := fmt.Errorf("an authenticated reviewer is required")
+		return commons.ErrorResponse[models.GetUserResponse]("validation failed", err.Error()), err
+	}
+
+	customerID := strings.TrimSpace(req.CustomerID)
+	user, err := s.userRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.GetUserResponse]("User not found"), err
+		}
+		return commons.ErrorResponse[models.GetUserResponse]("failed to resolve compliance hold", "Unable to resolve compliance hold right now"), err
+	}
+	if user.Status != domain.UserStatusHeld {
+		err := fmt.Errorf("customer %s is %s", customerID, user.Status)
+		return commons.ErrorResponse[models.GetUserResponse](customerNotHeldMessage, err.Error()), err
+	}
+
+	updated, err := s.userRepo.ResolveComplianceHold(ctx, customerID, status, reviewer, strings.TrimSpace(req.Comment))
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.GetUserResponse](customerNotHeldMessage, "the compliance hold was resolved concurrently"), err
+		}
+		logger.Error("user service resolve compliance hold failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.GetUserResponse]("failed to resolve compliance hold", "Unable to resolve compliance hold right now"), err
+	}
+
+	logger.Info("user service resolve compliance hold success", logger.Fields{
+		"customerId": customerID,
+		"status":     updated.Status,
+		"reviewer":   reviewer,
+	})
+	return commons.SuccessResponse(successMessage, mapUserToResponse(updated)), nil
+}
+
 func (s *UserService) VerifyUserPin(ctx context.Context, customerID string, pin string) (commons.Response[models.VerifyUserPinResponse], error) {
 	logger.Info("user service verify pin request", logger.Fields{
 		"payload": logger.SanitizePayload(map[string]string{
@@ -154,7 +372,7 @@ func (s *UserService) VerifyUserPin(ctx context.Context, customerID string, pin
 		return commons.ErrorResponse[models.VerifyUserPinResponse]("validation failed", "pin is required"), fmt.Errorf("pin is required")
 	}
 
-	storedPinHash, err := s.userRepo.GetTransactionPinHashByCustomerID(ctx, customerID)
+	state, err := s.userRepo.GetPinState(ctx, customerID)
 	if err != nil {
 		logger.Error("user service verify pin lookup failed", err, logger.Fields{
 			"customerId": customerID,
@@ -165,12 +383,19 @@ func (s *UserService) VerifyUserPin(ctx context.Context, customerID string, pin
 		return commons.ErrorResponse[models.VerifyUserPinResponse]("failed to verify pin", "Unable to verify pin right now"), err
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(storedPinHash), []byte(pin)); err != nil {
+	// A locked PIN is refused without comparing, so guesses made during the
+	// lock neither succeed nor extend it.
+	if state.LockedAt(s.now()) {
+		logger.Info("user service verify pin locked", logger.Fields{
+			"customerId":  customerID,
+			"lockedUntil": state.LockedUntil,
+		})
+		return commons.ErrorResponse[models.VerifyUserPinResponse]("pin locked", pinLockedDetail(state)), commons.ErrPinLocked
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(state.PinHash), []byte(pin)); err != nil {
 		if err == bcrypt.ErrMismatchedHashAndPassword {
-			logger.Info("user service verify pin mismatch", logger.Fields{
-				"customerId": customerID,
-			})
-			return commons.ErrorResponse[models.VerifyUserPinResponse]("invalid pin", "provided pin does not match"), fmt.Errorf("invalid pin")
+			return s.recordFailedPinAttempt(ctx, customerID)
 		}
 		wrappedErr := fmt.Errorf("verify user pin: %w", err)
 		logger.Error("user service verify pin compare failed", wrappedErr, logger.Fields{
@@ -179,6 +404,14 @@ func (s *UserService) VerifyUserPin(ctx context.Context, customerID string, pin
 		return commons.ErrorResponse[models.VerifyUserPinResponse]("failed to verify pin", "Unable to verify pin right now"), wrappedErr
 	}
 
+	if state.FailedAttempts > 0 {
+		if err := s.userRepo.ClearFailedPinAttempts(ctx, customerID); err != nil {
+			logger.Error("user service clear failed pin attempts failed", err, logger.Fields{
+				"customerId": customerID,
+			})
+		}
+	}
+
 	response := models.VerifyUserPinResponse{
 		CustomerID: customerID,
 		IsValidPin: true,
@@ -192,6 +425,41 @@ func (s *UserService) VerifyUserPin(ctx context.Context, customerID string, pin
 	return commons.SuccessResponse("pin verified successfully", response), nil
 }
 
+// recordFailedPinAttempt counts a mismatched PIN and locks the PIN once the
+// policy's attempt limit is reached.
+func (s *UserService) recordFailedPinAttempt(ctx context.Context, customerID string) (commons.Response[models.VerifyUserPinResponse], error) {
+	now := s.now().UTC()
+	state, err := s.userRepo.RecordFailedPinAttempt(ctx, customerID, s.pinPolicy.MaxFailedAttempts, now.Add(s.pinPolicy.LockDuration))
+	if err != nil {
+		logger.Error("user service record failed pin attempt failed", err, logger.Fields{
+			"customerId": customerID,
+		})
+		return commons.ErrorResponse[models.VerifyUserPinResponse]("failed to verify pin", "Unable to verify pin right now"), err
+	}
+
+	if state.LockedAt(now) {
+		logger.Info("user service verify pin mismatch locked pin", logger.Fields{
+			"customerId":  customerID,
+			"lockedUntil": state.LockedUntil,
+		})
+		return commons.ErrorResponse[models.VerifyUserPinResponse]("pin locked", pinLockedDetail(state)), commons.ErrPinLocked
+	}
+
+	remaining := s.pinPolicy.MaxFailedAttempts - state.FailedAttempts
+	logger.Info("user service verify pin mismatch", logger.Fields{
+		"customerId":        customerID,
+		"remainingAttempts": remaining,
+	})
+	return commons.ErrorResponse[models.VerifyUserPinResponse](
+		"invalid pin",
+		fmt.Sprintf("provided pin does not match; %d attempt(s) remaining before the pin is locked", remaining),
+	), commons.ErrInvalidPin
+}
+
+func pinLockedDetail(state domain.PinState) string {
+	return fmt.Sprintf("transaction pin is locked until %s after too many failed attempts", state.LockedUntil.UTC().Format(time.RFC3339))
+}
+
 func generateCustomerID() string {
 	return fmt.Sprintf("%010d", time.Now().UnixNano()%10_000_000_000)
 }
@@ -204,3 +472,63 @@ func hashTransactionPin(pin string) (string, error) {
 
 	return string(hashed), nil
 }
+
+// userFullName joins the name parts in the order they appear on ID documents.
+func userFullName(user domain.User) string {
+	parts := []string{user.FirstName}
+	if user.MiddleName != nil {
+		parts = append(parts, *user.MiddleName)
+	}
+	parts = append(parts, user.LastName)
+	return strings.Join(parts, " ")
+}
+
+func mapUserToResponse(user domain.User) models.GetUserResponse {
+	return models.GetUserResponse{
+		ID:                   user.ID,
+		CustomerID:           user.CustomerID,
+		FirstName:            user.FirstName,
+		MiddleName:           user.MiddleName,
+		LastName:             user.LastName,
+		DOB:                  formatDOB(user.DOB),
+		PhoneNumber:          user.PhoneNumber,
+		Email:                user.Email,
+		Address:              user.Address,
+		IDType:               string(user.IDType),
+		IDNumber:             user.IDNumber,
+		KYCLevel:             user.KYCLevel,
+		Status:               string(user.Status),
+		MergedIntoCustomerID: user.MergedIntoCustomerID,
+		CreatedAt:            user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            user.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// formatDOB is empty for erased customers, who have no date of birth.
+func formatDOB(dob time.Time) string {
+	if dob.IsZero() {
+		return ""
+	}
+	return dob.Format("2006-01-02")
+}
+
+func mapProfileChangesToResponse(changes []domain.ProfileChange) []models.ProfileChangeResponse {
+	response := make([]models.ProfileChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		response = append(response, models.ProfileChangeResponse{
+			Field:     string(change.Field),
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return response
+}
+
+func optionalString(value string) *string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return &trimmed
+	}
+	return nil
+}
@@ -0,0 +1,548 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/http/models"
+	"github.com/api-sage/fcy-payment-processor/src/internal/adapter/repository/repo_interfaces"
+	"github.com/api-sage/fcy-payment-processor/src/internal/commons"
+	"github.com/api-sage/fcy-payment-processor/src/internal/domain"
+	"github.com/api-sage/fcy-payment-processor/src/internal/logger"
+	"github.com/api-sage/fcy-payment-processor/src/internal/usecase/service_interfaces"
+)
+
+// Verify that WebhookService implements the service_interfaces.WebhookService and TransferEventNotifier interfaces
+var _ service_interfaces.WebhookService = (*WebhookService)(nil)
+var _ service_interfaces.TransferEventNotifier = (*WebhookService)(nil)
+
+const (
+	WebhookSignatureHeader  = "X-Webhook-Signature"
+	WebhookTimestampHeader  = "X-Webhook-Timestamp"
+	WebhookEventTypeHeader  = "X-Webhook-Event"
+	WebhookEventIDHeader    = "X-Webhook-Event-ID"
+	WebhookDeliveryIDHeader = "X-Webhook-Delivery-ID"
+
+	webhookDispatchBatchSize = 50
+	webhookDeliveryLease     = 2 * time.Minute
+	webhookMaxBackoff        = time.Hour
+	webhookDeliveriesLimit   = 100
+)
+
+type WebhookService struct {
+	webhookRepo  repo_interfaces.WebhookRepository
+	httpClient   *http.Client
+	maxAttempts  int
+	retryBackoff time.Duration
+}
+
+func NewWebhookService(
+	webhookRepo repo_interfaces.WebhookRepository,
+	maxAttempts int,
+	retryBackoff time.Duration,
+	requestTimeout time.Duration,
+) *WebhookService {
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	return &WebhookService{
+		webhookRepo:  webhookRepo,
+		httpClient:   &http.Client{Timeout: requestTimeout},
+		maxAttempts:  maxAttempts,
+		retryBackoff: retryBackoff,
+	}
+}
+
+func (s *WebhookService) CreateSubscription(ctx context.Context, req models.CreateWebhookSubscriptionRequest) (commons.Response[models.WebhookSubscriptionResponse], error) {
+	logger.Info("webhook service create subscription request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		logger.Error("webhook service create subscription validation failed", err, nil)
+		return commons.ErrorResponse[models.WebhookSubscriptionResponse]("validation failed", err.Error()), err
+	}
+	if err := checkAuthenticatedChannel(ctx, req.ChannelID); err != nil {
+		return commons.ErrorResponse[models.WebhookSubscriptionResponse]("validation failed", err.Error()), err
+	}
+
+	eventTypes := make([]domain.TransferEventType, 0, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		eventTypes = append(eventTypes, domain.TransferEventType(strings.ToUpper(strings.TrimSpace(eventType))))
+	}
+	if len(eventTypes) == 0 {
+		eventTypes = append(eventTypes, domain.TransferEventTypes...)
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		logger.Error("webhook service generate secret failed", err, nil)
+		return commons.ErrorResponse[models.WebhookSubscriptionResponse]("failed to create webhook subscription", "Unable to create webhook subscription right now"), err
+	}
+
+	created, err := s.webhookRepo.CreateSubscription(ctx, domain.WebhookSubscription{
+		ChannelID:  strings.TrimSpace(req.ChannelID),
+		URL:        strings.TrimSpace(req.URL),
+		Secret:     secret,
+		EventTypes: eventTypes,
+		Status:     domain.WebhookSubscriptionActive,
+	})
+	if err != nil {
+		logger.Error("webhook service create subscription repository failed", err, nil)
+		return commons.ErrorResponse[models.WebhookSubscriptionResponse]("failed to create webhook subscription", "Unable to create webhook subscription right now"), err
+	}
+
+	// The signing secret is only ever returned on creation.
+	response := mapWebhookSubscriptionToResponse(created)
+	response.Secret = created.Secret
+
+	logger.Info("webhook service create subscription success", logger.Fields{
+		"subscriptionId": created.ID,
+		"channelId":      created.ChannelID,
+	})
+
+	return commons.SuccessResponse("webhook subscription created successfully", response), nil
+}
+
+func (s *WebhookService) GetSubscriptions(ctx context.Context, channelID string) (commons.Response[[]models.WebhookSubscriptionResponse], error) {
+	logger.Info("webhook service get subscriptions request", logger.Fields{
+		"channelId": channelID,
+	})
+
+	channelID = strings.TrimSpace(channelID)
+	if channelID == "" {
+		return commons.ErrorResponse[[]models.WebhookSubscriptionResponse]("validation failed", "channelId is required"), fmt.Errorf("channelId is required")
+	}
+	if err := checkAuthenticatedChannel(ctx, channelID); err != nil {
+		return commons.ErrorResponse[[]models.WebhookSubscriptionResponse]("validation failed", err.Error()), err
+	}
+
+	subscriptions, err := s.webhookRepo.GetSubscriptionsByChannelID(ctx, channelID)
+	if err != nil {
+		logger.Error("webhook service get subscriptions failed", err, logger.Fields{
+			"channelId": channelID,
+		})
+		return commons.ErrorResponse[[]models.WebhookSubscriptionResponse]("failed to get webhook subscriptions", "Unable to fetch webhook subscriptions right now"), err
+	}
+
+	response := make([]models.WebhookSubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, mapWebhookSubscriptionToResponse(subscription))
+	}
+
+	return commons.SuccessResponse("webhook subscriptions fetched successfully", response), nil
+}
+
+func (s *WebhookService) GetDeliveries(ctx context.Context, subscriptionID string) (commons.Response[[]models.WebhookDeliveryResponse], error) {
+	logger.Info("webhook service get deliveries request", logger.Fields{
+		"subscriptionId": subscriptionID,
+	})
+
+	subscriptionID = strings.TrimSpace(subscriptionID)
+	if subscriptionID == "" {
+		return commons.ErrorResponse[[]models.WebhookDeliveryResponse]("validation failed", "subscriptionId is required"), fmt.Errorf("subscriptionId is required")
+	}
+	if _, err := s.getChannelSubscription(ctx, subscriptionID); err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[[]models.WebhookDeliveryResponse]("Webhook subscription not found"), err
+		}
+		return commons.ErrorResponse[[]models.WebhookDeliveryResponse]("failed to get webhook deliveries", "Unable to fetch webhook deliveries right now"), err
+	}
+
+	deliveries, err := s.webhookRepo.GetDeliveriesBySubscriptionID(ctx, subscriptionID, webhookDeliveriesLimit)
+	if err != nil {
+		logger.Error("webhook service get deliveries failed", err, logger.Fields{
+			"subscriptionId": subscriptionID,
+		})
+		return commons.ErrorResponse[[]models.WebhookDeliveryResponse]("failed to get webhook deliveries", "Unable to fetch webhook deliveries right now"), err
+	}
+
+	response := make([]models.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		response = append(response, mapWebhookDeliveryToResponse(delivery))
+	}
+
+	return commons.SuccessResponse("webhook deliveries fetched successfully", response), nil
+}
+
+// RedeliverWebhook queues a fresh delivery of a previously logged event. The event ID is
+// kept so subscribers can deduplicate, and the original delivery log entry is left intact.
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, req models.RedeliverWebhookRequest) (commons.Response[models.WebhookDeliveryResponse], error) {
+	logger.Info("webhook service redeliver request", logger.Fields{
+		"payload": logger.SanitizePayload(req),
+	})
+
+	if err := req.Validate(); err != nil {
+		return commons.ErrorResponse[models.WebhookDeliveryResponse]("validation failed", err.Error()), err
+	}
+
+	original, err := s.webhookRepo.GetDeliveryByID(ctx, strings.TrimSpace(req.DeliveryID))
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.WebhookDeliveryResponse]("Webhook delivery not found"), err
+		}
+		return commons.ErrorResponse[models.WebhookDeliveryResponse]("failed to redeliver webhook", "Unable to redeliver webhook right now"), err
+	}
+
+	subscription, err := s.getChannelSubscription(ctx, original.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, commons.ErrRecordNotFound) {
+			return commons.ErrorResponse[models.WebhookDeliveryResponse]("Webhook subscription not found"), err
+		}
+		return commons.ErrorResponse[models.WebhookDeliveryResponse]("failed to redeliver webhook", "Unable to redeliver webhook right now"), err
+	}
+	if subscription.Status != domain.WebhookSubscriptionActive {
+		err := fmt.Errorf("webhook subscription is not active")
+		return commons.ErrorResponse[models.WebhookDeliveryResponse]("validation failed", err.Error()), err
+	}
+
+	originalID := original.ID
+	created, err := s.webhookRepo.CreateDelivery(ctx, domain.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		TransferID:     original.TransferID,
+		Payload:        original.Payload,
+		RedeliveryOf:   &originalID,
+	})
+	if err != nil {
+		return commons.ErrorResponse[models.WebhookDeliveryResponse]("failed to redeliver webhook", "Unable to redeliver webhook right now"), err
+	}
+
+	logger.Info("webhook service redeliver success", logger.Fields{
+		"deliveryId":   created.ID,
+		"redeliveryOf": originalID,
+	})
+
+	return commons.SuccessResponse("webhook redelivery queued successfully", mapWebhookDeliveryToResponse(created)), nil
+}
+
+// NotifyTransferEvent records one pending delivery per active subscription of the
+// transfer's channel for a transfer outbox event. Transfers without a channel
+// notify nobody. The outbox event ID is the webhook event ID, so an event relayed
+// again finds its deliveries already recorded and adds none. Actual HTTP delivery
+// happens asynchronously in the dispatcher.
+func (s *WebhookService) NotifyTransferEvent(ctx context.Context, event domain.OutboxEvent) error {
+	if event.AggregateType != domain.AggregateTypeTransfer {
+		return nil
+	}
+
+	var data domain.TransferEventData
+	if err := json.Unmarshal([]byte(event.Payload), &data); err != nil {
+		return fmt.Errorf("unmarshal transfer event payload: %w", err)
+	}
+	if data.ChannelID == "" {
+		return nil
+	}
+
+	eventType := domain.TransferEventType(event.EventType)
+	subscriptions, err := s.webhookRepo.GetActiveSubscriptionsForEvent(ctx, data.ChannelID, eventType)
+	if err != nil {
+		return fmt.Errorf("get webhook subscriptions for event: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	transfer := transferFromEventData(data)
+
+	payload, err := json.Marshal(models.TransferWebhookEvent{
+		EventID:    event.ID,
+		EventType:  event.EventType,
+		OccurredAt: event.CreatedAt.UTC().Format(time.RFC3339Nano),
+		TransferID: transfer.ID,
+		Data:       mapTransferToResponse(transfer, transferSumTotal(transfer)),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal webhook event: %w", err)
+	}
+
+	transferID := transfer.ID
+	var errs []error
+	for _, subscription := range subscriptions {
+		if _, err := s.webhookRepo.CreateDelivery(ctx, domain.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			TransferID:     &transferID,
+			Payload:        string(payload),
+		}); err != nil && !isUniqueViolation(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// transferFromEventData rebuilds the transfer as it was when the event was written.
+func transferFromEventData(data domain.TransferEventData) domain.Transfer {
+	return domain.Transfer{
+		ID:                   data.TransferID,
+		TransactionReference: optionalString(data.TransactionReference),
+		ExternalRefernece:    optionalString(data.ExternalReference),
+		DebitAccountNumber:   data.DebitAccountNumber,
+		CreditAccountNumber:  optionalString(data.CreditAccountNumber),
+		BeneficiaryBankCode:  optionalString(data.BeneficiaryBankCode),
+		DebitCurrency:        data.DebitCurrency,
+		CreditCurrency:       data.CreditCurrency,
+		DebitAmount:          data.DebitAmount,
+		CreditAmount:         data.CreditAmount,
+		FCYRate:              data.FCYRate,
+		ChargeAmount:         data.ChargeAmount,
+		VATAmount:            data.VATAmount,
+		Narration:            optionalString(data.Narration),
+		Status:               data.Status,
+		ChannelID:            optionalString(data.ChannelID),
+	}
+}
+
+// StartDispatcher polls for due deliveries until ctx is cancelled.
+func (s *WebhookService) StartDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.DispatchDueDeliveries(ctx); err != nil {
+			logger.Error("webhook service dispatch failed", err, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDueDeliveries sends one batch of due deliveries.
+func (s *WebhookService) DispatchDueDeliveries(ctx context.Context) error {
+	deliveries, err := s.webhookRepo.ClaimDueDeliveries(ctx, webhookDispatchBatchSize, webhookDeliveryLease)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		s.deliver(ctx, delivery)
+	}
+	return nil
+}
+
+func (s *WebhookService) deliver(ctx context.Context, delivery domain.WebhookDelivery) {
+	subscription, err := s.webhookRepo.GetSubscriptionByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		logger.Error("webhook service delivery subscription lookup failed", err, logger.Fields{
+			"deliveryId": delivery.ID,
+		})
+		return
+	}
+
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.LastResponseCode = nil
+	delivery.LastError = nil
+
+	if subscription.Status != domain.WebhookSubscriptionActive {
+		delivery.Status = domain.WebhookDeliveryFailed
+		delivery.LastError = stringPtr("webhook subscription is not active")
+	} else {
+		statusCode, sendErr := s.send(ctx, subscription, delivery, now)
+		if statusCode > 0 {
+			delivery.LastResponseCode = &statusCode
+		}
+
+		switch {
+		case sendErr == nil:
+			delivery.Status = domain.WebhookDeliverySuccess
+		case delivery.Attempts >= s.maxAttempts:
+			delivery.Status = domain.WebhookDeliveryFailed
+			delivery.LastError = stringPtr(sendErr.Error())
+		default:
+			delivery.Status = domain.WebhookDeliveryPending
+			delivery.NextAttemptAt = now.Add(exponentialBackoff(s.retryBackoff, webhookMaxBackoff, delivery.Attempts))
+			delivery.LastError = stringPtr(sendErr.Error())
+		}
+	}
+
+	if err := s.webhookRepo.UpdateDeliveryAttempt(ctx, delivery); err != nil {
+		logger.Error("webhook service update delivery failed", err, logger.Fields{
+			"deliveryId": delivery.ID,
+		})
+		return
+	}
+
+	logger.Info("webhook service delivery attempted", logger.Fields{
+		"deliveryId": delivery.ID,
+		"status":     delivery.Status,
+		"attempts":   delivery.Attempts,
+	})
+}
+
+func (s *WebhookService) send(ctx context.Context, subscription domain.WebhookSubscription, delivery domain.WebhookDelivery, now time.Time) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := now.Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, fmt.Sprintf("t=%d,v1=%s", timestamp, SignWebhookPayload(subscription.Secret, timestamp, body)))
+	req.Header.Set(WebhookEventTypeHeader, string(delivery.EventType))
+	req.Header.Set(WebhookEventIDHeader, delivery.EventID)
+	req.Header.Set(WebhookDeliveryIDHeader, delivery.ID)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the hex HMAC-SHA256 of "<timestamp>.<body>". Signing the
+// timestamp lets subscribers reject replays outside their tolerance window.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%d.", timestamp)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks a signature header produced by the dispatcher and rejects
+// timestamps further than tolerance from now.
+func VerifyWebhookSignature(secret string, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var timestamp int64
+	var signature string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			parsed, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid signature timestamp")
+			}
+			timestamp = parsed
+		case "v1":
+			signature = kv[1]
+		}
+	}
+	if timestamp == 0 || signature == "" {
+		return fmt.Errorf("malformed signature header")
+	}
+
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > tolerance || age < -tolerance {
+		return fmt.Errorf("signature timestamp outside tolerance")
+	}
+
+	expected := SignWebhookPayload(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+// exponentialBackoff doubles base for every attempt after the first, capped at max.
+func exponentialBackoff(base time.Duration, max time.Duration, attempts int) time.Duration {
+	backoff := base
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	return backoff
+}
+
+// getChannelSubscription loads a subscription of the authenticated channel.
+// Another channel's subscription is reported as commons.ErrRecordNotFound so
+// its existence is not disclosed.
+func (s *WebhookService) getChannelSubscription(ctx context.Context, subscriptionID string) (domain.WebhookSubscription, error) {
+	subscription, err := s.webhookRepo.GetSubscriptionByID(ctx, subscriptionID)
+	if err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+	if checkAuthenticatedChannel(ctx, subscription.ChannelID) != nil {
+		logger.Info("webhook service subscription of another channel requested", logger.Fields{
+			"subscriptionId": subscriptionID,
+			"channelId":      commons.ChannelIDFromContext(ctx),
+		})
+		return domain.WebhookSubscription{}, commons.ErrRecordNotFound
+	}
+	return subscription, nil
+}
+
+func checkAuthenticatedChannel(ctx context.Context, channelID string) error {
+	authenticated := commons.ChannelIDFromContext(ctx)
+	if authenticated != "" && strings.TrimSpace(channelID) != authenticated {
+		return fmt.Errorf("channelId must be the authenticated channel %s", authenticated)
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(raw), nil
+}
+
+func mapWebhookSubscriptionToResponse(subscription domain.WebhookSubscription) models.WebhookSubscriptionResponse {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return models.WebhookSubscriptionResponse{
+		ID:         subscription.ID,
+		ChannelID:  subscription.ChannelID,
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		Status:     string(subscription.Status),
+		CreatedAt:  subscription.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  subscription.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func mapWebhookDeliveryToResponse(delivery domain.WebhookDelivery) models.WebhookDeliveryResponse {
+	response := models.WebhookDeliveryResponse{
+		ID:               delivery.ID,
+		SubscriptionID:   delivery.SubscriptionID,
+		EventID:          delivery.EventID,
+		EventType:        string(delivery.EventType),
+		TransferID:       valueOrEmpty(delivery.TransferID),
+		Status:           string(delivery.Status),
+		Attempts:         delivery.Attempts,
+		NextAttemptAt:    delivery.NextAttemptAt.Format(time.RFC3339),
+		LastResponseCode: delivery.LastResponseCode,
+		LastError:        delivery.LastError,
+		RedeliveryOf:     valueOrEmpty(delivery.RedeliveryOf),
+		CreatedAt:        delivery.CreatedAt.Format(time.RFC3339),
+	}
+	if delivery.LastAttemptAt != nil {
+		response.LastAttemptAt = delivery.LastAttemptAt.Format(time.RFC3339)
+	}
+	return response
+}
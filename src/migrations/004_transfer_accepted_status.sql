@@ -0,0 +1,5 @@
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+    CHECK (status IN ('ACCEPTED', 'PENDING', 'SUCCESS', 'FAILED', 'CLOSED'));
+
+CREATE INDEX IF NOT EXISTS idx_transfers_status_created_at ON transfers(status, created_at);
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS screening_rules (
+    id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    rule_type VARCHAR(64) NOT NULL CHECK (rule_type IN ('VELOCITY', 'NEW_BENEFICIARY_LARGE_AMOUNT', 'ROUND_AMOUNT_STRUCTURING', 'RAPID_IN_OUT', 'DORMANT_REACTIVATION')),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('REVIEW', 'BLOCK')),
+    score INTEGER NOT NULL DEFAULT 0 CHECK (score >= 0),
+    params TEXT NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO screening_rules (id, name, rule_type, action, score, params) VALUES
+    ('velocity-count-1h', 'More than 5 transfers from one account in an hour', 'VELOCITY', 'REVIEW', 30, '{"windowMinutes":60,"maxCount":5}'),
+    ('velocity-amount-24h', 'More than 25,000 USD sent from one account in 24 hours', 'VELOCITY', 'REVIEW', 40, '{"windowMinutes":1440,"maxAmountUsd":"25000"}'),
+    ('new-beneficiary-large', 'First transfer to a beneficiary of 5,000 USD or more', 'NEW_BENEFICIARY_LARGE_AMOUNT', 'REVIEW', 30, '{"thresholdUsd":"5000"}'),
+    ('round-amount-structuring', 'Three or more round-amount transfers within 24 hours', 'ROUND_AMOUNT_STRUCTURING', 'REVIEW', 25, '{"windowMinutes":1440,"roundUnit":"1000","minCount":3}'),
+    ('rapid-in-out', 'Most of the funds received in the last 24 hours sent straight out', 'RAPID_IN_OUT', 'REVIEW', 35, '{"windowMinutes":1440,"inOutRatio":"0.8","thresholdUsd":"1000"}'),
+    ('dormant-reactivation', 'Outgoing transfer from an account idle for 180 days', 'DORMANT_REACTIVATION', 'REVIEW', 30, '{"dormantDays":180}')
+ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS transfer_screenings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transfer_id UUID NOT NULL UNIQUE REFERENCES transfers(id) ON DELETE CASCADE,
+    decision VARCHAR(16) NOT NULL CHECK (decision IN ('ALLOW', 'REVIEW', 'BLOCK')),
+    total_score INTEGER NOT NULL DEFAULT 0,
+    results TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_screenings_decision ON transfer_screenings(decision);
+CREATE INDEX IF NOT EXISTS idx_transfers_debit_account_created_at ON transfers(debit_account_number, created_at);
+CREATE INDEX IF NOT EXISTS idx_outbox_aggregate_created_at ON outbox(aggregate_type, aggregate_id, created_at);
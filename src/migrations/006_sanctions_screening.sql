@@ -0,0 +1,23 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'ACTIVE';
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (status IN ('ACTIVE', 'HELD'));
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+    CHECK (status IN ('ACCEPTED', 'PENDING', 'SUCCESS', 'FAILED', 'CLOSED', 'HELD'));
+
+CREATE TABLE IF NOT EXISTS sanctions_screenings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subject_type VARCHAR(16) NOT NULL CHECK (subject_type IN ('USER', 'TRANSFER')),
+    subject_id VARCHAR(64) NOT NULL,
+    screened_name VARCHAR(255) NOT NULL,
+    decision VARCHAR(32) NOT NULL CHECK (decision IN ('CLEAR', 'POTENTIAL_MATCH')),
+    list_version VARCHAR(255) NOT NULL,
+    matched_entry_id VARCHAR(64),
+    matched_name VARCHAR(255),
+    match_score NUMERIC(5, 4) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_sanctions_screenings_subject ON sanctions_screenings(subject_type, subject_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_sanctions_screenings_decision ON sanctions_screenings(decision);
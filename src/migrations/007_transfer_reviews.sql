@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS transfer_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transfer_id UUID NOT NULL UNIQUE REFERENCES transfers(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')),
+    initiated_by VARCHAR(64) NOT NULL,
+    held_amount NUMERIC(20, 2) NOT NULL,
+    decided_by VARCHAR(64),
+    decided_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_reviews_status_created_at ON transfer_reviews(status, created_at);
+
+CREATE TABLE IF NOT EXISTS transfer_review_decisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL REFERENCES transfer_reviews(id) ON DELETE CASCADE,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('HOLD', 'APPROVE', 'REJECT')),
+    actor VARCHAR(64) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_review_decisions_review_id ON transfer_review_decisions(review_id, created_at);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS account_holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    hold_type VARCHAR(16) NOT NULL CHECK (hold_type IN ('AUTHORIZATION', 'LIEN', 'TRANSFER')),
+    amount NUMERIC(20, 2) NOT NULL CHECK (amount > 0),
+    captured_amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL,
+    reference VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'RELEASED', 'CAPTURED', 'EXPIRED')),
+    expires_at TIMESTAMPTZ,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (account_number, reference)
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_holds_account_status ON account_holds(account_number, status);
+CREATE INDEX IF NOT EXISTS idx_account_holds_active_expiry ON account_holds(expires_at) WHERE status = 'ACTIVE' AND expires_at IS NOT NULL;
+
+-- Reservations made for transfers held before holds were tracked become explicit
+-- TRANSFER holds, keeping available_balance = ledger_balance - active holds.
+INSERT INTO account_holds (account_number, hold_type, amount, reason, reference)
+SELECT t.debit_account_number, 'TRANSFER', r.held_amount, r.reason, t.id::text
+FROM transfer_reviews r
+JOIN transfers t ON t.id = r.transfer_id
+WHERE r.status = 'PENDING'
+ON CONFLICT (account_number, reference) DO NOTHING;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS account_status_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    from_status VARCHAR(16) NOT NULL CHECK (from_status IN ('ACTIVE', 'FROZEN', 'CLOSED')),
+    to_status VARCHAR(16) NOT NULL CHECK (to_status IN ('ACTIVE', 'FROZEN', 'CLOSED')),
+    reason VARCHAR(32) NOT NULL CHECK (reason IN ('FRAUD', 'COURT_ORDER', 'CUSTOMER_REQUEST', 'COMPLIANCE', 'OTHER')),
+    comment TEXT NOT NULL DEFAULT '',
+    actor VARCHAR(64) NOT NULL,
+    sweep_account_number VARCHAR(32),
+    swept_amount NUMERIC(20, 2),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_status_events_account ON account_status_events(account_number, created_at);
@@ -0,0 +1,9 @@
+-- Serial part of NUBAN-style account numbers (9 digits before the check digit).
+-- Numbers issued before this migration were random and carry no check digit;
+-- the allocator retries on the rare clash with one of them.
+CREATE SEQUENCE IF NOT EXISTS account_number_serial_seq
+    AS BIGINT
+    START WITH 1
+    MINVALUE 1
+    MAXVALUE 999999999
+    NO CYCLE;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS withdrawals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reference VARCHAR(64) NOT NULL UNIQUE,
+    account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    currency CHAR(3) NOT NULL,
+    amount NUMERIC(20, 2) NOT NULL CHECK (amount > 0),
+    charge_amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    vat_amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    total_debit NUMERIC(20, 2) NOT NULL,
+    cash_gl_account_number VARCHAR(32) NOT NULL REFERENCES transient_accounts(account_number),
+    narration TEXT,
+    channel VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_withdrawals_account_created ON withdrawals(account_number, created_at);
+
+-- Journal for postings that are not transfers; transient_account_transactions
+-- stays keyed to transfers.
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reference_type VARCHAR(16) NOT NULL CHECK (reference_type IN ('WITHDRAWAL')),
+    reference_id UUID NOT NULL,
+    reference VARCHAR(64) NOT NULL,
+    account_number VARCHAR(32) NOT NULL,
+    entry_type VARCHAR(6) NOT NULL CHECK (entry_type IN ('DEBIT', 'CREDIT')),
+    currency CHAR(3) NOT NULL,
+    amount NUMERIC(20, 2) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_reference ON journal_entries(reference_type, reference_id);
+CREATE INDEX IF NOT EXISTS idx_journal_entries_account_created ON journal_entries(account_number, created_at);
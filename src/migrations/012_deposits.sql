@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS deposits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reference VARCHAR(64) NOT NULL UNIQUE,
+    account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    currency CHAR(3) NOT NULL,
+    amount NUMERIC(20, 2) NOT NULL CHECK (amount > 0),
+    source VARCHAR(16) NOT NULL CHECK (source IN ('CASH', 'CARD', 'EXTERNAL_BANK')),
+    external_reference VARCHAR(64) NOT NULL,
+    funding_gl_account_number VARCHAR(32) NOT NULL REFERENCES transient_accounts(account_number),
+    narration TEXT,
+    channel VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (source, external_reference)
+);
+
+CREATE INDEX IF NOT EXISTS idx_deposits_account_created ON deposits(account_number, created_at);
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_reference_type_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_reference_type_check
+    CHECK (reference_type IN ('WITHDRAWAL', 'DEPOSIT'));
+
+-- Legs of one posting are written in one transaction; clock_timestamp keeps their
+-- written order, which NOW() would not.
+ALTER TABLE journal_entries ALTER COLUMN created_at SET DEFAULT clock_timestamp();
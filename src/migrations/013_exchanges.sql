@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS exchanges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reference VARCHAR(64) NOT NULL UNIQUE,
+    customer_id VARCHAR(64) NOT NULL REFERENCES users(customer_id),
+    source_account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    destination_account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    source_currency CHAR(3) NOT NULL,
+    destination_currency CHAR(3) NOT NULL,
+    source_amount NUMERIC(20, 2) NOT NULL CHECK (source_amount > 0),
+    destination_amount NUMERIC(20, 2) NOT NULL CHECK (destination_amount > 0),
+    mid_rate NUMERIC(20, 8) NOT NULL,
+    quoted_rate NUMERIC(20, 8) NOT NULL,
+    spread_percent NUMERIC(9, 4) NOT NULL,
+    fx_income_amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    charge_amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    vat_amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    total_debit NUMERIC(20, 2) NOT NULL,
+    fx_income_gl_account_number VARCHAR(32) NOT NULL REFERENCES transient_accounts(account_number),
+    narration TEXT,
+    channel VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (source_account_number <> destination_account_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_exchanges_customer_created ON exchanges(customer_id, created_at);
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_reference_type_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_reference_type_check
+    CHECK (reference_type IN ('WITHDRAWAL', 'DEPOSIT', 'EXCHANGE'));
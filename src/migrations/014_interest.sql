@@ -0,0 +1,67 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS product VARCHAR(32) NOT NULL DEFAULT 'STANDARD';
+
+CREATE TABLE IF NOT EXISTS interest_rate_tiers (
+    id BIGSERIAL PRIMARY KEY,
+    currency CHAR(3) NOT NULL,
+    product VARCHAR(32) NOT NULL,
+    min_balance NUMERIC(20, 2) NOT NULL DEFAULT 0 CHECK (min_balance >= 0),
+    annual_rate_percent NUMERIC(9, 4) NOT NULL CHECK (annual_rate_percent >= 0),
+    day_count VARCHAR(8) NOT NULL CHECK (day_count IN ('ACT/365', 'ACT/360')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (currency, product, min_balance)
+);
+
+INSERT INTO interest_rate_tiers (currency, product, min_balance, annual_rate_percent, day_count) VALUES
+    ('USD', 'STANDARD', 0, 0, 'ACT/360'),
+    ('USD', 'SAVINGS', 0, 0.5, 'ACT/360'),
+    ('USD', 'SAVINGS', 10000, 1, 'ACT/360'),
+    ('EUR', 'STANDARD', 0, 0, 'ACT/360'),
+    ('EUR', 'SAVINGS', 0, 0.25, 'ACT/360'),
+    ('GBP', 'STANDARD', 0, 0, 'ACT/365'),
+    ('GBP', 'SAVINGS', 0, 0.5, 'ACT/365'),
+    ('NGN', 'STANDARD', 0, 0, 'ACT/365'),
+    ('NGN', 'STANDARD', 1000000, 1.25, 'ACT/365'),
+    ('NGN', 'SAVINGS', 0, 4, 'ACT/365'),
+    ('NGN', 'SAVINGS', 1000000, 6, 'ACT/365')
+ON CONFLICT (currency, product, min_balance) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS interest_capitalizations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reference VARCHAR(64) NOT NULL UNIQUE,
+    account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    currency CHAR(3) NOT NULL,
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    accrued_amount NUMERIC(20, 8) NOT NULL,
+    gross_amount NUMERIC(20, 2) NOT NULL CHECK (gross_amount > 0),
+    tax_amount NUMERIC(20, 2) NOT NULL DEFAULT 0 CHECK (tax_amount >= 0),
+    net_amount NUMERIC(20, 2) NOT NULL CHECK (net_amount >= 0),
+    withholding_tax_percent NUMERIC(9, 4) NOT NULL,
+    expense_gl_account_number VARCHAR(32) NOT NULL REFERENCES transient_accounts(account_number),
+    tax_gl_account_number VARCHAR(32) NOT NULL REFERENCES transient_accounts(account_number),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (account_number, period_end)
+);
+
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    accrual_date DATE NOT NULL,
+    currency CHAR(3) NOT NULL,
+    product VARCHAR(32) NOT NULL,
+    balance NUMERIC(20, 2) NOT NULL,
+    annual_rate_percent NUMERIC(9, 4) NOT NULL,
+    day_count VARCHAR(8) NOT NULL,
+    amount NUMERIC(20, 8) NOT NULL CHECK (amount >= 0),
+    capitalization_id UUID REFERENCES interest_capitalizations(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (account_number, accrual_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_open ON interest_accruals(account_number, accrual_date)
+    WHERE capitalization_id IS NULL;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_reference_type_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_reference_type_check
+    CHECK (reference_type IN ('WITHDRAWAL', 'DEPOSIT', 'EXCHANGE', 'INTEREST'));
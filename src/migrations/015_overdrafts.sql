@@ -0,0 +1,35 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS overdraft_limit NUMERIC(20, 2) NOT NULL DEFAULT 0;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_overdraft_limit_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_overdraft_limit_check CHECK (overdraft_limit >= 0);
+
+CREATE TABLE IF NOT EXISTS overdraft_facilities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_number VARCHAR(32) NOT NULL UNIQUE REFERENCES accounts(account_number),
+    currency CHAR(3) NOT NULL,
+    limit_amount NUMERIC(20, 2) NOT NULL CHECK (limit_amount > 0),
+    annual_rate_percent NUMERIC(9, 4) NOT NULL CHECK (annual_rate_percent >= 0),
+    day_count VARCHAR(8) NOT NULL CHECK (day_count IN ('ACT/365', 'ACT/360')),
+    status VARCHAR(16) NOT NULL CHECK (status IN ('ACTIVE', 'SUSPENDED')),
+    approved_by VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    suspended_at TIMESTAMPTZ,
+    suspension_reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_overdraft_facilities_active_expiry ON overdraft_facilities(expires_at)
+    WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS overdraft_interest_accruals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_number VARCHAR(32) NOT NULL REFERENCES accounts(account_number),
+    accrual_date DATE NOT NULL,
+    currency CHAR(3) NOT NULL,
+    overdrawn_amount NUMERIC(20, 2) NOT NULL CHECK (overdrawn_amount > 0),
+    annual_rate_percent NUMERIC(9, 4) NOT NULL,
+    day_count VARCHAR(8) NOT NULL,
+    amount NUMERIC(20, 8) NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (account_number, accrual_date)
+);
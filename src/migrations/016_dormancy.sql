@@ -0,0 +1,25 @@
+-- Existing accounts start their inactivity period from their last update so the
+-- first batch does not flag every account at once.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS last_activity_at TIMESTAMPTZ;
+UPDATE accounts SET last_activity_at = updated_at WHERE last_activity_at IS NULL;
+ALTER TABLE accounts ALTER COLUMN last_activity_at SET DEFAULT NOW();
+ALTER TABLE accounts ALTER COLUMN last_activity_at SET NOT NULL;
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_status_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_status_check
+    CHECK (status IN ('ACTIVE', 'FROZEN', 'DORMANT', 'CLOSED'));
+
+CREATE INDEX IF NOT EXISTS idx_accounts_active_last_activity ON accounts(last_activity_at)
+    WHERE status = 'ACTIVE';
+CREATE INDEX IF NOT EXISTS idx_accounts_dormant_currency ON accounts(currency)
+    WHERE status = 'DORMANT';
+
+ALTER TABLE account_status_events DROP CONSTRAINT IF EXISTS account_status_events_from_status_check;
+ALTER TABLE account_status_events ADD CONSTRAINT account_status_events_from_status_check
+    CHECK (from_status IN ('ACTIVE', 'FROZEN', 'DORMANT', 'CLOSED'));
+ALTER TABLE account_status_events DROP CONSTRAINT IF EXISTS account_status_events_to_status_check;
+ALTER TABLE account_status_events ADD CONSTRAINT account_status_events_to_status_check
+    CHECK (to_status IN ('ACTIVE', 'FROZEN', 'DORMANT', 'CLOSED'));
+ALTER TABLE account_status_events DROP CONSTRAINT IF EXISTS account_status_events_reason_check;
+ALTER TABLE account_status_events ADD CONSTRAINT account_status_events_reason_check
+    CHECK (reason IN ('FRAUD', 'COURT_ORDER', 'CUSTOMER_REQUEST', 'COMPLIANCE', 'OTHER', 'INACTIVITY', 'KYC_REVERIFIED'));
@@ -0,0 +1,4 @@
+ALTER TABLE otp_challenges ADD COLUMN IF NOT EXISTS payload JSONB;
+
+ALTER TABLE otp_challenges DROP CONSTRAINT IF EXISTS otp_challenges_purpose_check;
+ALTER TABLE otp_challenges ADD CONSTRAINT otp_challenges_purpose_check CHECK (purpose IN ('PIN_RESET', 'TRANSFER_STEP_UP'));
@@ -0,0 +1,37 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email VARCHAR(254);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS address TEXT;
+
+CREATE TABLE IF NOT EXISTS customer_profile_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id VARCHAR(64) NOT NULL REFERENCES users(customer_id) ON DELETE CASCADE,
+    field VARCHAR(32) NOT NULL CHECK (field IN ('phoneNumber', 'email', 'address')),
+    old_value TEXT NOT NULL DEFAULT '',
+    new_value TEXT NOT NULL DEFAULT '',
+    changed_by VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_profile_changes_customer ON customer_profile_changes(customer_id, created_at);
+
+CREATE TABLE IF NOT EXISTS kyc_upgrade_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id VARCHAR(64) NOT NULL REFERENCES users(customer_id) ON DELETE CASCADE,
+    current_level INTEGER NOT NULL CHECK (current_level > 0),
+    requested_level INTEGER NOT NULL CHECK (requested_level > current_level),
+    document_type VARCHAR(16) NOT NULL CHECK (document_type IN ('Passport', 'DL', 'NationalID', 'UtilityBill')),
+    document_number VARCHAR(128) NOT NULL,
+    issuing_country CHAR(2) NOT NULL,
+    document_expires_at DATE,
+    document_reference TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')),
+    submitted_by VARCHAR(64) NOT NULL,
+    reviewed_by VARCHAR(64),
+    review_comment TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A customer has at most one upgrade awaiting review.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_kyc_upgrade_requests_pending_customer ON kyc_upgrade_requests(customer_id) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_kyc_upgrade_requests_status_created_at ON kyc_upgrade_requests(status, created_at);
@@ -0,0 +1,58 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS identity_key VARCHAR(160);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS merged_into_customer_id VARCHAR(64) REFERENCES users(customer_id);
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (status IN ('ACTIVE', 'HELD', 'MERGED'));
+
+CREATE TABLE IF NOT EXISTS customer_duplicate_candidates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id VARCHAR(64) NOT NULL REFERENCES users(customer_id) ON DELETE CASCADE,
+    matched_customer_id VARCHAR(64) NOT NULL REFERENCES users(customer_id) ON DELETE CASCADE,
+    matched_on VARCHAR(64) NOT NULL,
+    name_score NUMERIC(5, 4) NOT NULL DEFAULT 0,
+    status VARCHAR(16) NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'DISMISSED', 'MERGED')),
+    reviewed_by VARCHAR(64),
+    review_comment TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (customer_id, matched_customer_id),
+    CHECK (customer_id <> matched_customer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_duplicate_candidates_status_created_at ON customer_duplicate_candidates(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_customer_duplicate_candidates_matched_customer ON customer_duplicate_candidates(matched_customer_id);
+
+CREATE TABLE IF NOT EXISTS customer_merges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    candidate_id UUID NOT NULL REFERENCES customer_duplicate_candidates(id),
+    surviving_customer_id VARCHAR(64) NOT NULL REFERENCES users(customer_id),
+    merged_customer_id VARCHAR(64) NOT NULL UNIQUE REFERENCES users(customer_id),
+    account_numbers TEXT[] NOT NULL DEFAULT '{}',
+    merged_by VARCHAR(64) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The identity key is the ID type plus the upper-cased alphanumerics of the ID
+-- number (domain.IdentityKey). Customers onboarded before it was enforced may
+-- share an ID document: the earliest keeps the key and every later one is
+-- queued for review against it instead of failing the unique index.
+UPDATE users
+SET identity_key = id_type || ':' || UPPER(regexp_replace(id_number, '[^A-Za-z0-9]', '', 'g'))
+WHERE customer_id IN (
+    SELECT DISTINCT ON (id_type, UPPER(regexp_replace(id_number, '[^A-Za-z0-9]', '', 'g'))) customer_id
+    FROM users
+    ORDER BY id_type, UPPER(regexp_replace(id_number, '[^A-Za-z0-9]', '', 'g')), created_at, customer_id
+);
+
+INSERT INTO customer_duplicate_candidates (customer_id, matched_customer_id, matched_on)
+SELECT later.customer_id, earliest.customer_id, 'ID_NUMBER'
+FROM users later
+JOIN users earliest
+  ON earliest.identity_key = later.id_type || ':' || UPPER(regexp_replace(later.id_number, '[^A-Za-z0-9]', '', 'g'))
+WHERE later.identity_key IS NULL
+ON CONFLICT (customer_id, matched_customer_id) DO NOTHING;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_identity_key ON users(identity_key);
+CREATE INDEX IF NOT EXISTS idx_users_dob ON users(dob);
+CREATE INDEX IF NOT EXISTS idx_users_phone_key ON users(RIGHT(regexp_replace(phone_number, '[^0-9]', '', 'g'), 10));
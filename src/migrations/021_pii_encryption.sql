@@ -0,0 +1,27 @@
+-- Customer PII is stored encrypted (see encryption.FieldCipher). phone_number
+-- and id_number hold ciphertext in place; dob moves to dob_encrypted because
+-- the DATE column cannot. Searches use keyed blind indexes instead of the
+-- plaintext: identity_key, phone_index and dob_index.
+--
+-- pii_key_version is the key-encryption-key version the row is encrypted
+-- under; 0 marks a row still in plaintext. The application re-encrypts every
+-- row whose version is not the current one at startup and on a timer, which
+-- also replaces the plaintext identity keys written by migration 020.
+ALTER TABLE users ALTER COLUMN phone_number TYPE TEXT;
+ALTER TABLE users ALTER COLUMN id_number TYPE TEXT;
+ALTER TABLE users ALTER COLUMN dob DROP NOT NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS dob_encrypted TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS dob_index VARCHAR(64);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone_index VARCHAR(64);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS pii_key_version INTEGER NOT NULL DEFAULT 0;
+
+DROP INDEX IF EXISTS idx_users_dob;
+DROP INDEX IF EXISTS idx_users_phone_key;
+CREATE INDEX IF NOT EXISTS idx_users_dob_index ON users(dob_index);
+CREATE INDEX IF NOT EXISTS idx_users_phone_index ON users(phone_index);
+CREATE INDEX IF NOT EXISTS idx_users_pii_key_version ON users(pii_key_version);
+
+-- Profile change values include old and new phone numbers, so they are
+-- encrypted the same way.
+ALTER TABLE customer_profile_changes ADD COLUMN IF NOT EXISTS key_version INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_customer_profile_changes_key_version ON customer_profile_changes(key_version);
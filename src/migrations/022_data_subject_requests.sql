@@ -0,0 +1,19 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS erased_at TIMESTAMPTZ;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (status IN ('ACTIVE', 'HELD', 'MERGED', 'ERASED'));
+
+-- One row per fulfilled data subject request. Rows are kept after an erasure
+-- as evidence that it was carried out; they hold no personal data.
+CREATE TABLE IF NOT EXISTS customer_data_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_id VARCHAR(64) NOT NULL REFERENCES users(customer_id),
+    request_type VARCHAR(16) NOT NULL CHECK (request_type IN ('EXPORT', 'ERASURE')),
+    requested_by VARCHAR(64) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    transfers_scrubbed INTEGER NOT NULL DEFAULT 0,
+    profile_changes_scrubbed INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_data_requests_customer ON customer_data_requests(customer_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_transfers_credit_account_number ON transfers(credit_account_number);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS channels (
+    channel_id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(128) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    allowed_cidrs TEXT[] NOT NULL DEFAULT '{}',
+    status VARCHAR(16) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'DISABLED')),
+    created_by VARCHAR(64) NOT NULL,
+    updated_by VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- key_hash is the hex SHA-256 of the API key; the key itself is only returned
+-- when it is issued. Rotation sets expires_at on the keys it replaces, so two
+-- keys are valid during the grace period.
+CREATE TABLE IF NOT EXISTS channel_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    channel_id VARCHAR(64) NOT NULL REFERENCES channels(channel_id) ON DELETE CASCADE,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    created_by VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_channel_keys_channel_id ON channel_keys(channel_id);
+
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS channel_id VARCHAR(64);
@@ -0,0 +1,4 @@
+-- A customer held on a potential sanctions match is cleared back to ACTIVE or
+-- rejected by compliance.
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (status IN ('ACTIVE', 'HELD', 'REJECTED', 'MERGED', 'ERASED'));
@@ -0,0 +1,7 @@
+-- Webhook deliveries are recorded by the outbox relay, which may relay an event
+-- more than once. The outbox event ID is the webhook event ID, so one original
+-- delivery per subscription and event keeps redelivered events from fanning out
+-- twice. Manual redeliveries reuse the event ID and are exempt.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_event
+    ON webhook_deliveries(subscription_id, event_id)
+    WHERE redelivery_of IS NULL;
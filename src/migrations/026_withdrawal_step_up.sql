@@ -0,0 +1,2 @@
+ALTER TABLE otp_challenges DROP CONSTRAINT IF EXISTS otp_challenges_purpose_check;
+ALTER TABLE otp_challenges ADD CONSTRAINT otp_challenges_purpose_check CHECK (purpose IN ('PIN_RESET', 'TRANSFER_STEP_UP', 'WITHDRAWAL_STEP_UP'));
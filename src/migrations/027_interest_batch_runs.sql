@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS interest_batch_runs (
+    batch_date DATE PRIMARY KEY,
+    completed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);